@@ -0,0 +1,417 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+	"github.com/areteacademy/internal/infra/database"
+	adminHandler "github.com/areteacademy/internal/infra/http/handler/admin"
+	apiKeyHandler "github.com/areteacademy/internal/infra/http/handler/apikey"
+	authHandler "github.com/areteacademy/internal/infra/http/handler/auth"
+	categoryHandler "github.com/areteacademy/internal/infra/http/handler/category"
+	healthHandler "github.com/areteacademy/internal/infra/http/handler/health"
+	jwksHandler "github.com/areteacademy/internal/infra/http/handler/jwks"
+	productHandler "github.com/areteacademy/internal/infra/http/handler/product"
+	sessionHandler "github.com/areteacademy/internal/infra/http/handler/session"
+	twoFactorHandler "github.com/areteacademy/internal/infra/http/handler/twofactor"
+	userHandler "github.com/areteacademy/internal/infra/http/handler/user"
+	"github.com/areteacademy/internal/infra/http/middleware"
+	"github.com/areteacademy/internal/infra/http/router"
+	"github.com/areteacademy/internal/infra/mail"
+	"github.com/areteacademy/internal/infra/report"
+	apiKeyRepository "github.com/areteacademy/internal/infra/repository/apikey"
+	categoryRepository "github.com/areteacademy/internal/infra/repository/category"
+	emailVerificationRepository "github.com/areteacademy/internal/infra/repository/emailverification"
+	loginChallengeRepository "github.com/areteacademy/internal/infra/repository/loginchallenge"
+	loginThrottleRepository "github.com/areteacademy/internal/infra/repository/loginthrottle"
+	passwordResetRepository "github.com/areteacademy/internal/infra/repository/passwordreset"
+	productRepository "github.com/areteacademy/internal/infra/repository/product"
+	sessionRepository "github.com/areteacademy/internal/infra/repository/session"
+	"github.com/areteacademy/internal/infra/repository/transaction"
+	twoFactorRepository "github.com/areteacademy/internal/infra/repository/twofactor"
+	userRepository "github.com/areteacademy/internal/infra/repository/user"
+	security "github.com/areteacademy/internal/infra/security"
+	bootstrapAdmin "github.com/areteacademy/internal/usecase/admin/bootstrapadmin"
+	changeRole "github.com/areteacademy/internal/usecase/admin/changerole"
+	deactivateUser "github.com/areteacademy/internal/usecase/admin/deactivateuser"
+	listUserCategories "github.com/areteacademy/internal/usecase/admin/listcategories"
+	listLockoutEvents "github.com/areteacademy/internal/usecase/admin/listlockoutevents"
+	listUserProducts "github.com/areteacademy/internal/usecase/admin/listproducts"
+	listUsers "github.com/areteacademy/internal/usecase/admin/listusers"
+	createApiKey "github.com/areteacademy/internal/usecase/apikey/create"
+	listApiKeys "github.com/areteacademy/internal/usecase/apikey/list"
+	renameApiKey "github.com/areteacademy/internal/usecase/apikey/rename"
+	revokeApiKey "github.com/areteacademy/internal/usecase/apikey/revoke"
+	authenticate "github.com/areteacademy/internal/usecase/auth/authenticate"
+	confirmPasswordReset "github.com/areteacademy/internal/usecase/auth/confirmpasswordreset"
+	login "github.com/areteacademy/internal/usecase/auth/login"
+	refreshSession "github.com/areteacademy/internal/usecase/auth/refreshsession"
+	requestPasswordReset "github.com/areteacademy/internal/usecase/auth/requestpasswordreset"
+	unlockAccount "github.com/areteacademy/internal/usecase/auth/unlockaccount"
+	verifyTwoFactor "github.com/areteacademy/internal/usecase/auth/verifytwofactor"
+	createCategory "github.com/areteacademy/internal/usecase/category/create"
+	deleteCategory "github.com/areteacademy/internal/usecase/category/delete"
+	getByIdCategory "github.com/areteacademy/internal/usecase/category/getbyid"
+	listCategory "github.com/areteacademy/internal/usecase/category/listbyuserid"
+	purgeCategory "github.com/areteacademy/internal/usecase/category/purge"
+	restoreCategory "github.com/areteacademy/internal/usecase/category/restore"
+	updateCategory "github.com/areteacademy/internal/usecase/category/update"
+	createProduct "github.com/areteacademy/internal/usecase/product/create"
+	deleteProduct "github.com/areteacademy/internal/usecase/product/delete"
+	getByIdProduct "github.com/areteacademy/internal/usecase/product/getbyid"
+	listProduct "github.com/areteacademy/internal/usecase/product/listbyuserid"
+	purgeProduct "github.com/areteacademy/internal/usecase/product/purge"
+	restoreProduct "github.com/areteacademy/internal/usecase/product/restore"
+	searchProduct "github.com/areteacademy/internal/usecase/product/search"
+	updateProduct "github.com/areteacademy/internal/usecase/product/update"
+	listSessions "github.com/areteacademy/internal/usecase/session/list"
+	revokeSession "github.com/areteacademy/internal/usecase/session/revoke"
+	revokeAllSessions "github.com/areteacademy/internal/usecase/session/revokeall"
+	confirmTwoFactor "github.com/areteacademy/internal/usecase/twofactor/confirm"
+	disableTwoFactor "github.com/areteacademy/internal/usecase/twofactor/disable"
+	enrollTwoFactor "github.com/areteacademy/internal/usecase/twofactor/enroll"
+	changePasswordUser "github.com/areteacademy/internal/usecase/user/changepassword"
+	createUser "github.com/areteacademy/internal/usecase/user/create"
+	getByIdUser "github.com/areteacademy/internal/usecase/user/getbyid"
+	sendVerification "github.com/areteacademy/internal/usecase/user/sendverification"
+	updateUser "github.com/areteacademy/internal/usecase/user/update"
+	verifyEmail "github.com/areteacademy/internal/usecase/user/verifyemail"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func main() {
+	addr := getEnv("HTTP_ADDR", ":8080")
+	databasePath := getEnv("DATABASE_PATH", "app.db")
+
+	jwtExpiration, err := time.ParseDuration(getEnv("JWT_EXPIRATION", "15m"))
+	if err != nil {
+		log.Fatalf("parse JWT_EXPIRATION: %v", err)
+	}
+
+	refreshTokenTTL, err := time.ParseDuration(getEnv("REFRESH_TOKEN_TTL", domain.DefaultRefreshTokenTTL.String()))
+	if err != nil {
+		log.Fatalf("parse REFRESH_TOKEN_TTL: %v", err)
+	}
+
+	passwordHistorySize, err := strconv.Atoi(getEnv("PASSWORD_HISTORY_SIZE", strconv.Itoa(domain.DefaultPasswordHistorySize)))
+	if err != nil {
+		log.Fatalf("parse PASSWORD_HISTORY_SIZE: %v", err)
+	}
+
+	passwordResetTTL, err := time.ParseDuration(getEnv("PASSWORD_RESET_TTL", domain.DefaultPasswordResetTokenTTL.String()))
+	if err != nil {
+		log.Fatalf("parse PASSWORD_RESET_TTL: %v", err)
+	}
+
+	emailVerificationTTL, err := time.ParseDuration(getEnv("EMAIL_VERIFICATION_TTL", domain.DefaultEmailVerificationTokenTTL.String()))
+	if err != nil {
+		log.Fatalf("parse EMAIL_VERIFICATION_TTL: %v", err)
+	}
+
+	requireVerifiedEmail, err := strconv.ParseBool(getEnv("REQUIRE_VERIFIED_EMAIL", "false"))
+	if err != nil {
+		log.Fatalf("parse REQUIRE_VERIFIED_EMAIL: %v", err)
+	}
+
+	trustForwardedFor, err := strconv.ParseBool(getEnv("TRUST_FORWARDED_FOR", "false"))
+	if err != nil {
+		log.Fatalf("parse TRUST_FORWARDED_FOR: %v", err)
+	}
+
+	loginThrottlePolicy := domain.DefaultLoginThrottlePolicy()
+
+	loginThrottlePolicy.AccountLockoutThreshold, err = strconv.Atoi(getEnv("LOGIN_LOCKOUT_THRESHOLD", strconv.Itoa(loginThrottlePolicy.AccountLockoutThreshold)))
+	if err != nil {
+		log.Fatalf("parse LOGIN_LOCKOUT_THRESHOLD: %v", err)
+	}
+
+	loginThrottlePolicy.LockoutDuration, err = time.ParseDuration(getEnv("LOGIN_LOCKOUT_DURATION", loginThrottlePolicy.LockoutDuration.String()))
+	if err != nil {
+		log.Fatalf("parse LOGIN_LOCKOUT_DURATION: %v", err)
+	}
+
+	if err := loginThrottlePolicy.Validate(); err != nil {
+		log.Fatalf("configure login throttle: %v", err)
+	}
+
+	jwtKeysReloadInterval, err := time.ParseDuration(getEnv("JWT_KEYS_RELOAD_INTERVAL", "1m"))
+	if err != nil {
+		log.Fatalf("parse JWT_KEYS_RELOAD_INTERVAL: %v", err)
+	}
+
+	jwtKeysFile := os.Getenv("JWT_KEYS_FILE")
+	if jwtKeysFile == "" {
+		log.Fatalf("JWT_KEYS_FILE is required")
+	}
+
+	// Replaced keys are accepted for as long as the tokens they signed last.
+	jwtKeys, err := security.LoadJwtKeySet(jwtKeysFile, jwtExpiration)
+	if err != nil {
+		log.Fatalf("configure jwt keys: %v", err)
+	}
+
+	tokens, err := security.NewJwtTokenService(security.JwtConfig{
+		Keys:       jwtKeys,
+		Issuer:     getEnv("JWT_ISSUER", "areteacademy"),
+		Expiration: jwtExpiration,
+	})
+	if err != nil {
+		log.Fatalf("configure jwt: %v", err)
+	}
+
+	db, err := database.NewSqlite(databasePath)
+	if err != nil {
+		log.Fatalf("open database: %v", err)
+	}
+
+	if err := database.Migrate(db); err != nil {
+		log.Fatalf("migrate database: %v", err)
+	}
+
+	if !productRepository.SearchAvailable(db) {
+		log.Printf("sqlite built without FTS5, product search disabled; build with -tags sqlite_fts5 to enable it")
+	}
+
+	productRepo, err := productRepository.NewGormSearchableProductRepository(db)
+	if err != nil {
+		log.Fatalf("open product repository: %v", err)
+	}
+
+	userRepo := userRepository.NewGoUserRepository(db)
+	categoryRepo := categoryRepository.NewGormCategoryRepository(db)
+	passwordResetRepo := passwordResetRepository.NewGormPasswordResetTokenRepository(db)
+	emailVerificationRepo := emailVerificationRepository.NewGormEmailVerificationTokenRepository(db)
+	loginThrottleRepo := loginThrottleRepository.NewGormLoginThrottleRepository(db)
+	apiKeyRepo := apiKeyRepository.NewGormApiKeyRepository(db)
+	twoFactorRepo := twoFactorRepository.NewGormTwoFactorRepository(db)
+	loginChallengeRepo := loginChallengeRepository.NewGormLoginChallengeRepository(db)
+	sessionRepo := sessionRepository.NewGormSessionRepository(db)
+	unitOfWork := transaction.NewGormUnitOfWork(db)
+	mailer := mail.NewOutboxMailer(db)
+	errorReporter := report.NewLogErrorReporter(nil)
+	hasher, err := newPasswordHasher()
+	if err != nil {
+		log.Fatalf("configure password hashing: %v", err)
+	}
+
+	passwordPolicy, err := newPasswordPolicy()
+	if err != nil {
+		log.Fatalf("configure password policy: %v", err)
+	}
+
+	if email := os.Getenv("BOOTSTRAP_ADMIN_EMAIL"); email != "" {
+		err := bootstrapAdmin.NewBootstrapAdminUseCase(userRepo).Perform(context.Background(), bootstrapAdmin.BootstrapAdminInput{Email: email})
+		if errors.Is(err, domain.ErrUserNotFound) {
+			log.Printf("BOOTSTRAP_ADMIN_EMAIL %s is not registered yet, restart after it signs up", email)
+		} else if err != nil {
+			log.Fatalf("bootstrap admin: %v", err)
+		}
+	}
+
+	sendEmailVerification := sendVerification.NewSendEmailVerificationUserUseCase(userRepo, emailVerificationRepo, mailer, emailVerificationTTL)
+	authenticateUseCase := authenticate.NewAuthenticateUseCase(userRepo, tokens, apiKeyRepo, sessionRepo)
+	listCategoryUseCase := listCategory.NewListByUserIdCategoryUseCase(categoryRepo, userRepo)
+	listProductUseCase := listProduct.NewListByUserIdProductUseCase(productRepo, userRepo)
+
+	auth := middleware.NewAuthenticate(authenticateUseCase)
+	scoped := func(scope domain.ApiKeyScope) router.Middleware {
+		return middleware.NewAuthenticateScoped(authenticateUseCase, requireVerifiedEmail, scope)
+	}
+
+	handlers := router.Handlers{
+		Health: healthHandler.NewHandler(
+			database.NewPingChecker(db),
+			database.NewMigrationChecker(db, database.SchemaVersion),
+		),
+		Jwks: jwksHandler.NewHandler(jwtKeys),
+		Auth: authHandler.NewHandler(
+			login.NewLoginUseCase(userRepo, hasher, tokens, loginThrottleRepo, twoFactorRepo, loginChallengeRepo, sessionRepo, loginThrottlePolicy, refreshTokenTTL),
+			verifyTwoFactor.NewVerifyTwoFactorUseCase(userRepo, tokens, loginThrottleRepo, twoFactorRepo, loginChallengeRepo, sessionRepo, loginThrottlePolicy, refreshTokenTTL),
+			refreshSession.NewRefreshSessionUseCase(userRepo, tokens, sessionRepo, refreshTokenTTL),
+			requestPasswordReset.NewRequestPasswordResetUseCase(userRepo, passwordResetRepo, mailer, errorReporter, passwordResetTTL),
+			confirmPasswordReset.NewConfirmPasswordResetUseCase(userRepo, passwordResetRepo, hasher, passwordHistorySize, passwordPolicy),
+		),
+		User: userHandler.NewHandler(
+			createUser.NewCreateUserUseCase(userRepo, hasher, sendEmailVerification, passwordPolicy, errorReporter),
+			getByIdUser.NewGetByIdUserUseCase(userRepo),
+			updateUser.NewUpdateUserUseCase(userRepo, sendEmailVerification, errorReporter),
+			changePasswordUser.NewChangePasswordUserUseCase(userRepo, hasher, passwordHistorySize, passwordPolicy),
+			sendEmailVerification,
+			verifyEmail.NewVerifyEmailUserUseCase(userRepo, emailVerificationRepo),
+		),
+		Category: categoryHandler.NewHandler(
+			createCategory.NewCreateCategoryUseCase(categoryRepo, userRepo),
+			getByIdCategory.NewGetByIdCategoryUseCase(categoryRepo, userRepo),
+			listCategoryUseCase,
+			updateCategory.NewUpdateCategoryUseCase(categoryRepo, userRepo),
+			deleteCategory.NewDeleteCategoryUseCase(unitOfWork, userRepo),
+			restoreCategory.NewRestoreCategoryUseCase(categoryRepo, userRepo),
+			purgeCategory.NewPurgeCategoryUseCase(unitOfWork, userRepo),
+		),
+		Product: productHandler.NewHandler(
+			createProduct.NewCreateProductUseCase(unitOfWork),
+			getByIdProduct.NewGetByIdProductUseCase(productRepo, userRepo),
+			listProductUseCase,
+			searchProduct.NewSearchProductUseCase(productRepo, userRepo),
+			updateProduct.NewUpdateProductUseCase(unitOfWork),
+			deleteProduct.NewDeleteProductUseCase(productRepo, userRepo),
+			restoreProduct.NewRestoreProductUseCase(productRepo, categoryRepo, userRepo),
+			purgeProduct.NewPurgeProductUseCase(productRepo, userRepo),
+		),
+		Admin: adminHandler.NewHandler(
+			listUsers.NewListUsersUseCase(userRepo),
+			listUserCategories.NewListUserCategoriesUseCase(listCategoryUseCase),
+			listUserProducts.NewListUserProductsUseCase(listProductUseCase),
+			deactivateUser.NewDeactivateUserUseCase(userRepo),
+			changeRole.NewChangeRoleUseCase(userRepo),
+			unlockAccount.NewUnlockAccountUseCase(userRepo, loginThrottleRepo),
+			listLockoutEvents.NewListLockoutEventsUseCase(loginThrottleRepo),
+		),
+		ApiKey: apiKeyHandler.NewHandler(
+			createApiKey.NewCreateApiKeyUseCase(apiKeyRepo, userRepo),
+			listApiKeys.NewListApiKeysUseCase(apiKeyRepo),
+			renameApiKey.NewRenameApiKeyUseCase(apiKeyRepo),
+			revokeApiKey.NewRevokeApiKeyUseCase(apiKeyRepo),
+		),
+		TwoFactor: twoFactorHandler.NewHandler(
+			enrollTwoFactor.NewEnrollTwoFactorUseCase(userRepo, twoFactorRepo, getEnv("TOTP_ISSUER", "areteacademy")),
+			confirmTwoFactor.NewConfirmTwoFactorUseCase(twoFactorRepo),
+			disableTwoFactor.NewDisableTwoFactorUseCase(userRepo, twoFactorRepo, hasher),
+		),
+		Session: sessionHandler.NewHandler(
+			listSessions.NewListSessionsUseCase(userRepo, sessionRepo),
+			revokeSession.NewRevokeSessionUseCase(sessionRepo),
+			revokeAllSessions.NewRevokeAllSessionsUseCase(userRepo, sessionRepo),
+		),
+	}
+
+	server := &http.Server{
+		Addr:              addr,
+		Handler:           middleware.NewClientIP(trustForwardedFor)(router.New(handlers, auth, scoped)),
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	go jwtKeys.Watch(ctx, jwtKeysReloadInterval, func(err error) {
+		log.Printf("reload jwt keys: %v", err)
+	})
+
+	go func() {
+		log.Printf("listening on %s", addr)
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Fatalf("listen: %v", err)
+		}
+	}()
+
+	<-ctx.Done()
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		log.Printf("shutdown: %v", err)
+	}
+}
+
+// newPasswordHasher hashes with PASSWORD_HASH_ALGORITHM and keeps verifying
+// the other algorithm, so switching rehashes users as they log in.
+func newPasswordHasher() (*security.CompositePasswordHasher, error) {
+	bcryptCost, err := strconv.Atoi(getEnv("BCRYPT_COST", strconv.Itoa(bcrypt.DefaultCost)))
+	if err != nil {
+		return nil, fmt.Errorf("parse BCRYPT_COST: %w", err)
+	}
+
+	bcryptHasher, err := security.NewBcryptPasswordHasherWithCost(bcryptCost)
+	if err != nil {
+		return nil, err
+	}
+
+	params := security.DefaultArgon2idParams()
+
+	memory, err := strconv.ParseUint(getEnv("ARGON2_MEMORY_KIB", strconv.FormatUint(uint64(params.Memory), 10)), 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("parse ARGON2_MEMORY_KIB: %w", err)
+	}
+
+	iterations, err := strconv.ParseUint(getEnv("ARGON2_ITERATIONS", strconv.FormatUint(uint64(params.Iterations), 10)), 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("parse ARGON2_ITERATIONS: %w", err)
+	}
+
+	parallelism, err := strconv.ParseUint(getEnv("ARGON2_PARALLELISM", strconv.FormatUint(uint64(params.Parallelism), 10)), 10, 8)
+	if err != nil {
+		return nil, fmt.Errorf("parse ARGON2_PARALLELISM: %w", err)
+	}
+
+	params.Memory = uint32(memory)
+	params.Iterations = uint32(iterations)
+	params.Parallelism = uint8(parallelism)
+
+	argon2idHasher, err := security.NewArgon2idPasswordHasher(params)
+	if err != nil {
+		return nil, err
+	}
+
+	switch algorithm := getEnv("PASSWORD_HASH_ALGORITHM", "bcrypt"); algorithm {
+	case "bcrypt":
+		return security.NewCompositePasswordHasher(bcryptHasher, argon2idHasher), nil
+	case "argon2id":
+		return security.NewCompositePasswordHasher(argon2idHasher, bcryptHasher), nil
+	default:
+		return nil, fmt.Errorf("unknown PASSWORD_HASH_ALGORITHM %q", algorithm)
+	}
+}
+
+func newPasswordPolicy() (domain.PasswordPolicy, error) {
+	policy := domain.DefaultPasswordPolicy()
+
+	var err error
+
+	policy.MinLength, err = strconv.Atoi(getEnv("PASSWORD_MIN_LENGTH", strconv.Itoa(policy.MinLength)))
+	if err != nil {
+		return policy, fmt.Errorf("parse PASSWORD_MIN_LENGTH: %w", err)
+	}
+
+	policy.MaxLength, err = strconv.Atoi(getEnv("PASSWORD_MAX_LENGTH", strconv.Itoa(policy.MaxLength)))
+	if err != nil {
+		return policy, fmt.Errorf("parse PASSWORD_MAX_LENGTH: %w", err)
+	}
+
+	policy.RequireDigit, err = strconv.ParseBool(getEnv("PASSWORD_REQUIRE_DIGIT", strconv.FormatBool(policy.RequireDigit)))
+	if err != nil {
+		return policy, fmt.Errorf("parse PASSWORD_REQUIRE_DIGIT: %w", err)
+	}
+
+	policy.ForbidPersonalInfo, err = strconv.ParseBool(getEnv("PASSWORD_FORBID_PERSONAL_INFO", strconv.FormatBool(policy.ForbidPersonalInfo)))
+	if err != nil {
+		return policy, fmt.Errorf("parse PASSWORD_FORBID_PERSONAL_INFO: %w", err)
+	}
+
+	if path := os.Getenv("PASSWORD_BLOCKLIST_FILE"); path != "" {
+		policy.Blocklist, err = security.LoadPasswordBlocklist(path)
+		if err != nil {
+			return policy, fmt.Errorf("load PASSWORD_BLOCKLIST_FILE: %w", err)
+		}
+	}
+
+	return policy, policy.Check()
+}
+
+func getEnv(key, fallback string) string {
+	if value, ok := os.LookupEnv(key); ok && value != "" {
+		return value
+	}
+
+	return fallback
+}
@@ -0,0 +1,216 @@
+package domain
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// ApiKeyPrefix starts every API key, which is how they are told apart from
+// access tokens and how leaked keys can be spotted by secret scanners.
+const ApiKeyPrefix = "ak_"
+
+const (
+	apiKeyNameMaxLength = 100
+	apiKeyHintLength    = 6
+	// apiKeyLastUsedPrecision limits how often using a key writes its last
+	// use, since a busy script would otherwise write on every request.
+	apiKeyLastUsedPrecision = time.Minute
+)
+
+var (
+	ErrApiKeyUserIdIsRequired  = NewError(ErrorKindValidation, "api_key.user_id_required", "user_id", "api key user id is required")
+	ErrApiKeyIdIsRequired      = NewError(ErrorKindValidation, "api_key.id_required", "id", "api key id is required")
+	ErrApiKeyNameIsRequired    = NewError(ErrorKindValidation, "api_key.name_required", "name", "api key name is required")
+	ErrApiKeyNameTooLong       = NewError(ErrorKindValidation, "api_key.name_too_long", "name", "api key name must have at most 100 characters").withParams(map[string]any{"max": apiKeyNameMaxLength})
+	ErrApiKeyScopesAreRequired = NewError(ErrorKindValidation, "api_key.scopes_required", "scopes", "api key scopes are required")
+	ErrApiKeyScopeInvalid      = NewError(ErrorKindValidation, "api_key.scope_invalid", "scopes", "api key scope invalid")
+	ErrApiKeyScopeRepeated     = NewError(ErrorKindValidation, "api_key.scope_repeated", "scopes", "api key scopes must not repeat")
+	ErrApiKeyExpiryInvalid     = NewError(ErrorKindValidation, "api_key.expiry_invalid", "expires_at", "api key expiry must be in the future")
+	ErrApiKeyNotFound          = NewError(ErrorKindNotFound, "api_key.not_found", "", "api key not found")
+	ErrApiKeyRevoked           = NewError(ErrorKindConflict, "api_key.revoked", "", "api key revoked")
+	ErrApiKeyScopeDenied       = NewError(ErrorKindForbidden, "api_key.scope_denied", "", "api key does not grant access to this resource")
+)
+
+type ApiKeyScope string
+
+const (
+	ApiKeyScopeCategoriesRead  ApiKeyScope = "categories:read"
+	ApiKeyScopeCategoriesWrite ApiKeyScope = "categories:write"
+	ApiKeyScopeProductsRead    ApiKeyScope = "products:read"
+	ApiKeyScopeProductsWrite   ApiKeyScope = "products:write"
+)
+
+// ApiKeyScopes are the scopes a key can be granted. A write scope does not
+// imply the matching read scope.
+var ApiKeyScopes = []ApiKeyScope{
+	ApiKeyScopeCategoriesRead,
+	ApiKeyScopeCategoriesWrite,
+	ApiKeyScopeProductsRead,
+	ApiKeyScopeProductsWrite,
+}
+
+// ApiKey lets machine clients call the API on behalf of a user without
+// logging in. Only the hash of the key is kept, along with a hint of its
+// first characters so the user can tell keys apart.
+type ApiKey struct {
+	ID         string
+	UserId     string
+	Name       string
+	Hint       string
+	KeyHash    string
+	Scopes     []ApiKeyScope
+	ExpiresAt  *time.Time
+	LastUsedAt *time.Time
+	RevokedAt  *time.Time
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+type ApiKeyRepository interface {
+	Save(ctx context.Context, key *ApiKey) error
+	Update(ctx context.Context, key *ApiKey) error
+	GetByKeyHash(ctx context.Context, keyHash string) (*ApiKey, error)
+	GetByIdAndUserId(ctx context.Context, id, userId string) (*ApiKey, error)
+	// ListByUserId returns the user's keys, revoked ones included, newest
+	// first.
+	ListByUserId(ctx context.Context, userId string) ([]*ApiKey, error)
+	// TouchLastUsed only writes the last use, so it does not race with the
+	// user renaming or revoking the key.
+	TouchLastUsed(ctx context.Context, id string, usedAt time.Time) error
+}
+
+// NewApiKey returns the key to store and the secret to show the user, which
+// is not kept anywhere else.
+func NewApiKey(userId, name string, scopes []string, expiresAt *time.Time, now time.Time) (*ApiKey, string, error) {
+	var violations []error
+
+	if userId == "" {
+		violations = append(violations, ErrApiKeyUserIdIsRequired)
+	}
+
+	name, err := validateApiKeyName(name)
+	violations = append(violations, err)
+
+	parsedScopes, err := parseApiKeyScopes(scopes)
+	violations = append(violations, err)
+
+	if expiresAt != nil && !expiresAt.After(now) {
+		violations = append(violations, ErrApiKeyExpiryInvalid)
+	}
+
+	if err := NewValidationError(violations...); err != nil {
+		return nil, "", err
+	}
+
+	token, err := newSecretToken()
+	if err != nil {
+		return nil, "", err
+	}
+
+	secret := ApiKeyPrefix + token
+
+	return &ApiKey{
+		ID:        uuid.NewString(),
+		UserId:    userId,
+		Name:      name,
+		Hint:      secret[:len(ApiKeyPrefix)+apiKeyHintLength],
+		KeyHash:   HashSecretToken(secret),
+		Scopes:    parsedScopes,
+		ExpiresAt: expiresAt,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}, secret, nil
+}
+
+// IsApiKey reports whether token is shaped like an API key rather than an
+// access token.
+func IsApiKey(token string) bool {
+	return strings.HasPrefix(token, ApiKeyPrefix)
+}
+
+func validateApiKeyName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+
+	if name == "" {
+		return "", ErrApiKeyNameIsRequired
+	}
+
+	if utf8.RuneCountInString(name) > apiKeyNameMaxLength {
+		return "", ErrApiKeyNameTooLong
+	}
+
+	return name, nil
+}
+
+func parseApiKeyScopes(scopes []string) ([]ApiKeyScope, error) {
+	if len(scopes) == 0 {
+		return nil, ErrApiKeyScopesAreRequired
+	}
+
+	parsed := make([]ApiKeyScope, 0, len(scopes))
+	for _, s := range scopes {
+		scope := ApiKeyScope(strings.ToLower(strings.TrimSpace(s)))
+
+		if !slices.Contains(ApiKeyScopes, scope) {
+			return nil, ErrApiKeyScopeInvalid
+		}
+
+		if slices.Contains(parsed, scope) {
+			return nil, ErrApiKeyScopeRepeated
+		}
+
+		parsed = append(parsed, scope)
+	}
+
+	return parsed, nil
+}
+
+func (k *ApiKey) IsRevoked() bool {
+	return k.RevokedAt != nil
+}
+
+func (k *ApiKey) IsUsable(now time.Time) bool {
+	return !k.IsRevoked() && (k.ExpiresAt == nil || now.Before(*k.ExpiresAt))
+}
+
+func (k *ApiKey) Allows(scope ApiKeyScope) bool {
+	return slices.Contains(k.Scopes, scope)
+}
+
+func (k *ApiKey) Rename(name string, now time.Time) error {
+	if k.IsRevoked() {
+		return ErrApiKeyRevoked
+	}
+
+	name, err := validateApiKeyName(name)
+	if err != nil {
+		return err
+	}
+
+	k.Name = name
+	k.UpdatedAt = now
+
+	return nil
+}
+
+// Revoke does nothing when the key is already revoked, so the first
+// revocation time is kept.
+func (k *ApiKey) Revoke(now time.Time) {
+	if k.IsRevoked() {
+		return
+	}
+
+	k.RevokedAt = &now
+	k.UpdatedAt = now
+}
+
+// NeedsTouch reports whether the last use recorded is stale enough to be
+// written again.
+func (k *ApiKey) NeedsTouch(now time.Time) bool {
+	return k.LastUsedAt == nil || now.Sub(*k.LastUsedAt) >= apiKeyLastUsedPrecision
+}
@@ -0,0 +1,26 @@
+package domain
+
+import (
+	"time"
+)
+
+var (
+	ErrAuthEmailIsRequired    = NewError(ErrorKindValidation, "auth.email_required", "email", "email is required")
+	ErrAuthPasswordIsRequired = NewError(ErrorKindValidation, "auth.password_required", "password", "password is required")
+	ErrAuthInvalidCredentials = NewError(ErrorKindUnauthorized, "auth.invalid_credentials", "", "invalid credentials")
+	ErrAuthTokenInvalid       = NewError(ErrorKindUnauthorized, "auth.token_invalid", "", "invalid token")
+)
+
+type TokenClaims struct {
+	UserId       string
+	SessionId    string
+	TokenVersion int
+	Role         Role
+	IssuedAt     time.Time
+	ExpiresAt    time.Time
+}
+
+type TokenService interface {
+	Generate(user *User, sessionId string) (string, *TokenClaims, error)
+	Validate(token string) (*TokenClaims, error)
+}
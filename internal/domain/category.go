@@ -1,21 +1,27 @@
 package domain
 
 import (
-	"errors"
+	"context"
 	"time"
 
 	"github.com/google/uuid"
 )
 
 var (
-	ErrCategoryUserIdIsRequired = errors.New("user id is required")
-	ErrCategoryNameIsRequired   = errors.New("name is required")
-	ErrCategoryStatusIsRequired = errors.New("status is required")
-	ErrCategoryStatusInvalid    = errors.New("status invalid")
-	ErrCategoryUserNotFound     = errors.New("user not found")
-	ErrCategoryIdIsRequired     = errors.New("id is required")
-	ErrCategoryNotFound         = errors.New("category not found")
-	ErrCategoryUserNotOwner     = errors.New("user not owner")
+	ErrCategoryUserIdIsRequired = NewError(ErrorKindValidation, "category.user_id_required", "user_id", "user id is required")
+	ErrCategoryNameIsRequired   = NewError(ErrorKindValidation, "category.name_required", "name", "name is required")
+	ErrCategoryStatusIsRequired = NewError(ErrorKindValidation, "category.status_required", "status", "status is required")
+	ErrCategoryStatusInvalid    = NewError(ErrorKindValidation, "category.status_invalid", "status", "status invalid")
+	ErrCategoryUserNotFound     = NewError(ErrorKindNotFound, "category.user_not_found", "", "user not found")
+	ErrCategoryIdIsRequired     = NewError(ErrorKindValidation, "category.id_required", "id", "id is required")
+	ErrCategoryNotFound         = NewError(ErrorKindNotFound, "category.not_found", "", "category not found")
+	ErrCategoryUserNotOwner     = NewError(ErrorKindForbidden, "category.not_owner", "", "user not owner")
+
+	ErrCategoryDeleteStrategyInvalid    = NewError(ErrorKindValidation, "category.delete_strategy_invalid", "strategy", "delete strategy invalid")
+	ErrCategoryHasProducts              = NewError(ErrorKindConflict, "category.has_products", "", "category has products")
+	ErrCategoryReassignIdIsRequired     = NewError(ErrorKindValidation, "category.reassign_id_required", "reassign_to", "reassign category id is required")
+	ErrCategoryReassignToSameCategory   = NewError(ErrorKindValidation, "category.reassign_to_same", "reassign_to", "reassign category must differ from the deleted category")
+	ErrCategoryReassignCategoryNotFound = NewError(ErrorKindNotFound, "category.reassign_not_found", "reassign_to", "reassign category not found")
 )
 
 type CategoryStatus string
@@ -27,6 +33,7 @@ type Category struct {
 	Status    string
 	CreatedAt time.Time
 	UpdatedAt time.Time
+	DeletedAt *time.Time
 }
 
 const (
@@ -34,34 +41,69 @@ const (
 	CategoryStatusInactive CategoryStatus = "INACTIVE"
 )
 
+// CategoryDeleteStrategy decides what happens to the products of a category
+// being deleted.
+type CategoryDeleteStrategy string
+
+const (
+	CategoryDeleteStrategyReject   CategoryDeleteStrategy = "REJECT"
+	CategoryDeleteStrategyCascade  CategoryDeleteStrategy = "CASCADE"
+	CategoryDeleteStrategyReassign CategoryDeleteStrategy = "REASSIGN"
+)
+
 type CategoryRepository interface {
-	Save(category *Category) error
-	Update(category *Category) error
-	GetById(id string) (*Category, error)
-	GetByIdAndUserId(id, userId string) (*Category, error)
-	ListByUserId(userId string) ([]*Category, error)
-	Count() (int, error)
+	Save(ctx context.Context, category *Category) error
+	Update(ctx context.Context, category *Category) error
+	GetById(ctx context.Context, id string) (*Category, error)
+	GetByIdAndUserId(ctx context.Context, id, userId string) (*Category, error)
+	GetDeletedByIdAndUserId(ctx context.Context, id, userId string) (*Category, error)
+	ListByUserId(ctx context.Context, userId string, page PageRequest) (Page[*Category], error)
+	Count(ctx context.Context) (int, error)
+	Delete(ctx context.Context, category *Category) error
+	Restore(ctx context.Context, category *Category) error
+	Purge(ctx context.Context, id string) error
 }
 
 func isValidCategoryStatus(status CategoryStatus) bool {
 	return status == CategoryStatusActive || status == CategoryStatusInactive
 }
 
-func NewCategory(userId string, name string, status CategoryStatus) (*Category, error) {
+// NewCategoryDeleteStrategy falls back to rejecting the deletion when no
+// strategy is informed, so products are never removed by accident.
+func NewCategoryDeleteStrategy(strategy string) (CategoryDeleteStrategy, error) {
+	switch s := CategoryDeleteStrategy(strategy); s {
+	case "":
+		return CategoryDeleteStrategyReject, nil
+	case CategoryDeleteStrategyReject, CategoryDeleteStrategyCascade, CategoryDeleteStrategyReassign:
+		return s, nil
+	default:
+		return "", ErrCategoryDeleteStrategyInvalid
+	}
+}
+
+func validCategory(userId, name string, status CategoryStatus) []error {
+	var violations []error
+
 	if userId == "" {
-		return nil, ErrCategoryUserIdIsRequired
+		violations = append(violations, ErrCategoryUserIdIsRequired)
 	}
 
 	if name == "" {
-		return nil, ErrCategoryNameIsRequired
+		violations = append(violations, ErrCategoryNameIsRequired)
 	}
 
 	if status == "" {
-		return nil, ErrCategoryStatusIsRequired
+		violations = append(violations, ErrCategoryStatusIsRequired)
+	} else if !isValidCategoryStatus(status) {
+		violations = append(violations, ErrCategoryStatusInvalid)
 	}
 
-	if !isValidCategoryStatus(status) {
-		return nil, ErrCategoryStatusInvalid
+	return violations
+}
+
+func NewCategory(userId string, name string, status CategoryStatus) (*Category, error) {
+	if err := NewValidationError(validCategory(userId, name, status)...); err != nil {
+		return nil, err
 	}
 
 	now := time.Now()
@@ -77,33 +119,53 @@ func NewCategory(userId string, name string, status CategoryStatus) (*Category,
 }
 
 func UpdateCategory(id, userId, name string, status CategoryStatus) (*Category, error) {
+	var violations []error
 	if id == "" {
-		return nil, ErrCategoryIdIsRequired
+		violations = append(violations, ErrCategoryIdIsRequired)
 	}
 
-	if userId == "" {
-		return nil, ErrCategoryUserIdIsRequired
-	}
-
-	if name == "" {
-		return nil, ErrCategoryNameIsRequired
-	}
-
-	if status == "" {
-		return nil, ErrCategoryStatusIsRequired
-	}
-
-	if !isValidCategoryStatus(status) {
-		return nil, ErrCategoryStatusInvalid
+	if err := NewValidationError(append(violations, validCategory(userId, name, status)...)...); err != nil {
+		return nil, err
 	}
 
 	now := time.Now()
 
 	return &Category{
-		ID:        uuid.NewString(),
+		ID:        id,
 		UserId:    userId,
 		Name:      name,
 		Status:    string(status),
 		UpdatedAt: now,
 	}, nil
 }
+
+func (c *Category) IsDeleted() bool {
+	return c.DeletedAt != nil
+}
+
+func (c *Category) Delete() {
+	now := time.Now()
+
+	c.DeletedAt = &now
+	c.UpdatedAt = now
+}
+
+func (c *Category) Cursor(sort Sort) Cursor {
+	cursor := Cursor{Sort: sort, ID: c.ID}
+
+	switch sort.Field {
+	case SortByName:
+		cursor.Text = c.Name
+	case SortByUpdatedAt:
+		cursor.Time = c.UpdatedAt
+	default:
+		cursor.Time = c.CreatedAt
+	}
+
+	return cursor
+}
+
+func (c *Category) Restore() {
+	c.DeletedAt = nil
+	c.UpdatedAt = time.Now()
+}
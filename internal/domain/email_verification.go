@@ -0,0 +1,66 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DefaultEmailVerificationTokenTTL is how long a verification token can be
+// used after it is issued.
+const DefaultEmailVerificationTokenTTL = 24 * time.Hour
+
+var (
+	ErrEmailVerificationTokenIsRequired = NewError(ErrorKindValidation, "email_verification.token_required", "token", "verification token is required")
+	ErrEmailVerificationTokenInvalid    = NewError(ErrorKindValidation, "email_verification.token_invalid", "token", "verification token invalid or expired")
+	ErrEmailVerificationTokenNotFound   = errors.New("verification token not found")
+	ErrEmailVerificationNotNeeded       = NewError(ErrorKindConflict, "email_verification.not_needed", "", "email already verified")
+)
+
+// EmailVerificationToken proves the user owns Email. Like reset tokens, only
+// the hash of the mailed secret is kept.
+type EmailVerificationToken struct {
+	ID        string
+	UserId    string
+	Email     string
+	TokenHash string
+	ExpiresAt time.Time
+	UsedAt    *time.Time
+	CreatedAt time.Time
+}
+
+type EmailVerificationTokenRepository interface {
+	Save(ctx context.Context, token *EmailVerificationToken) error
+	GetByTokenHash(ctx context.Context, tokenHash string) (*EmailVerificationToken, error)
+	// MarkUsed fails with ErrEmailVerificationTokenInvalid when the token was
+	// already used.
+	MarkUsed(ctx context.Context, id string, usedAt time.Time) error
+	// InvalidateByUserId marks every unused token of the user as used.
+	InvalidateByUserId(ctx context.Context, userId string, usedAt time.Time) error
+}
+
+// NewEmailVerificationToken returns the token to store and the secret to
+// mail to email.
+func NewEmailVerificationToken(userId, email string, ttl time.Duration) (*EmailVerificationToken, string, error) {
+	secret, err := newSecretToken()
+	if err != nil {
+		return nil, "", err
+	}
+
+	now := time.Now()
+
+	return &EmailVerificationToken{
+		ID:        uuid.NewString(),
+		UserId:    userId,
+		Email:     email,
+		TokenHash: HashSecretToken(secret),
+		ExpiresAt: now.Add(ttl),
+		CreatedAt: now,
+	}, secret, nil
+}
+
+func (t *EmailVerificationToken) IsUsable(now time.Time) bool {
+	return t.UsedAt == nil && now.Before(t.ExpiresAt)
+}
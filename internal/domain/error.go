@@ -0,0 +1,154 @@
+package domain
+
+import (
+	"errors"
+	"slices"
+	"strings"
+)
+
+// ErrorKind says what went wrong in terms a client can act on, and decides
+// the HTTP status an error is answered with.
+type ErrorKind string
+
+const (
+	ErrorKindValidation   ErrorKind = "validation"
+	ErrorKindUnauthorized ErrorKind = "unauthorized"
+	ErrorKindForbidden    ErrorKind = "forbidden"
+	ErrorKindNotFound     ErrorKind = "not_found"
+	ErrorKindConflict     ErrorKind = "conflict"
+	ErrorKindRateLimited  ErrorKind = "rate_limited"
+	ErrorKindUnavailable  ErrorKind = "unavailable"
+)
+
+// Error is an error clients may be told about. Code is stable and unique,
+// so clients can match on it rather than on Message, and Field names the
+// input at fault, when there is one. Message is in English; the delivery
+// layer looks Code up to show it in the client's locale, filling in Params.
+//
+// Errors that only callers inside the application handle, such as lookups
+// use cases turn into something else or invalid configuration, stay plain
+// errors and are answered as internal errors.
+type Error struct {
+	Kind    ErrorKind
+	Code    string
+	Field   string
+	Message string
+	Params  map[string]any
+}
+
+func NewError(kind ErrorKind, code, field, message string) *Error {
+	return &Error{
+		Kind:    kind,
+		Code:    code,
+		Field:   field,
+		Message: message,
+	}
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// Is matches errors with the same code, so a copy carrying more detail
+// still matches the sentinel it came from.
+func (e *Error) Is(target error) bool {
+	other, ok := target.(*Error)
+	return ok && other.Code == e.Code
+}
+
+// withMessage returns a copy of e with a more detailed message.
+func (e *Error) withMessage(message string) *Error {
+	copied := *e
+	copied.Message = message
+	return &copied
+}
+
+// withParams returns a copy of e carrying the values its message mentions.
+func (e *Error) withParams(params map[string]any) *Error {
+	copied := *e
+	copied.Params = params
+	return &copied
+}
+
+var ErrValidationFailed = NewError(ErrorKindValidation, "validation.failed", "", "validation failed")
+
+// ValidationError holds every violation found in an input, so it can be
+// fixed in one go. Constructors report every field that is missing or
+// invalid, not only the first. errors.Is matches it against
+// ErrValidationFailed and against each violation.
+type ValidationError struct {
+	Violations []error
+}
+
+// NewValidationError returns nil when there are no violations. Nil errors
+// are skipped, nested ValidationErrors are flattened and violations already
+// present are dropped.
+func NewValidationError(violations ...error) error {
+	var flat []error
+	for _, violation := range violations {
+		var nested *ValidationError
+		if errors.As(violation, &nested) {
+			flat = appendViolations(flat, nested.Violations...)
+			continue
+		}
+
+		flat = appendViolations(flat, violation)
+	}
+
+	if len(flat) == 0 {
+		return nil
+	}
+
+	return &ValidationError{Violations: flat}
+}
+
+func appendViolations(violations []error, more ...error) []error {
+	for _, violation := range more {
+		if violation == nil || slices.ContainsFunc(violations, func(other error) bool { return errors.Is(other, violation) }) {
+			continue
+		}
+
+		violations = append(violations, violation)
+	}
+
+	return violations
+}
+
+func (e *ValidationError) Error() string {
+	messages := make([]string, 0, len(e.Violations))
+	for _, violation := range e.Violations {
+		messages = append(messages, violation.Error())
+	}
+
+	return strings.Join(messages, "; ")
+}
+
+func (e *ValidationError) Unwrap() []error {
+	return append([]error{ErrValidationFailed}, e.Violations...)
+}
+
+// FieldErrors returns each field violation err carries, one per broken
+// rule, or nil when err is not a validation error.
+func FieldErrors(err error) []*Error {
+	var validation *ValidationError
+	if errors.As(err, &validation) {
+		var fieldErrors []*Error
+		for _, violation := range validation.Violations {
+			fieldErrors = append(fieldErrors, FieldErrors(violation)...)
+		}
+
+		return fieldErrors
+	}
+
+	var policy *PasswordPolicyError
+	if errors.As(err, &policy) {
+		return policy.fieldErrors()
+	}
+
+	var domainErr *Error
+	if errors.As(err, &domainErr) && domainErr.Kind == ErrorKindValidation {
+		return []*Error{domainErr}
+	}
+
+	return nil
+}
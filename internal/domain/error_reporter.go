@@ -0,0 +1,10 @@
+package domain
+
+import "context"
+
+// ErrorReporter receives failures a use case does not return because the
+// change they follow is already saved, such as a mail that could not be
+// sent for an account that was created.
+type ErrorReporter interface {
+	Report(ctx context.Context, err error)
+}
@@ -0,0 +1,41 @@
+package domain
+
+import (
+	"slices"
+	"strings"
+)
+
+// Locale is a BCP 47 language tag messages can be shown in.
+type Locale string
+
+const (
+	LocaleEnglish          Locale = "en"
+	LocalePortugueseBrazil Locale = "pt-BR"
+
+	// DefaultLocale is used when neither the user nor the request asks for
+	// a supported locale.
+	DefaultLocale = LocaleEnglish
+)
+
+var ErrLocaleInvalid = NewError(ErrorKindValidation, "user.locale_invalid", "locale", "locale not supported")
+
+// SupportedLocales lists the locales there are messages for.
+var SupportedLocales = []Locale{LocaleEnglish, LocalePortugueseBrazil}
+
+// NewLocale matches locale against the supported ones regardless of case.
+// An empty locale means no preference.
+func NewLocale(locale string) (Locale, error) {
+	locale = strings.TrimSpace(locale)
+	if locale == "" {
+		return "", nil
+	}
+
+	index := slices.IndexFunc(SupportedLocales, func(supported Locale) bool {
+		return strings.EqualFold(string(supported), locale)
+	})
+	if index < 0 {
+		return "", ErrLocaleInvalid
+	}
+
+	return SupportedLocales[index], nil
+}
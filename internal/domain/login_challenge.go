@@ -0,0 +1,66 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// LoginChallengeTTL is how long a user has to enter their two-factor
+	// code after giving the right password.
+	LoginChallengeTTL = 5 * time.Minute
+	// loginChallengeMaxAttempts is how many wrong codes a challenge takes
+	// before the user has to give their password again.
+	loginChallengeMaxAttempts = 5
+)
+
+var (
+	ErrLoginChallengeIsRequired = NewError(ErrorKindValidation, "login_challenge.token_required", "challenge_token", "challenge token is required")
+	ErrLoginChallengeInvalid    = NewError(ErrorKindValidation, "login_challenge.token_invalid", "challenge_token", "challenge token invalid or expired")
+	ErrLoginChallengeNotFound   = errors.New("challenge token not found")
+)
+
+// LoginChallenge is issued instead of an access token when the password was
+// right but the account also needs a two-factor code. Only the hash of the
+// token handed to the client is kept.
+type LoginChallenge struct {
+	ID        string
+	UserId    string
+	TokenHash string
+	Attempts  int
+	ExpiresAt time.Time
+	CreatedAt time.Time
+}
+
+type LoginChallengeRepository interface {
+	Save(ctx context.Context, challenge *LoginChallenge) error
+	GetByTokenHash(ctx context.Context, tokenHash string) (*LoginChallenge, error)
+	RecordFailure(ctx context.Context, id string) error
+	// Delete fails with ErrLoginChallengeInvalid when the challenge is
+	// already gone, so it cannot be completed twice.
+	Delete(ctx context.Context, id string) error
+}
+
+// NewLoginChallenge returns the challenge to store and the token to hand to
+// the client, which is not kept anywhere else.
+func NewLoginChallenge(userId string, now time.Time) (*LoginChallenge, string, error) {
+	secret, err := newSecretToken()
+	if err != nil {
+		return nil, "", err
+	}
+
+	return &LoginChallenge{
+		ID:        uuid.NewString(),
+		UserId:    userId,
+		TokenHash: HashSecretToken(secret),
+		ExpiresAt: now.Add(LoginChallengeTTL),
+		CreatedAt: now,
+	}, secret, nil
+}
+
+func (c *LoginChallenge) IsUsable(now time.Time) bool {
+	return c.Attempts < loginChallengeMaxAttempts && now.Before(c.ExpiresAt)
+}
@@ -0,0 +1,199 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrLoginThrottled             = NewError(ErrorKindRateLimited, "auth.login_throttled", "", "too many failed login attempts, try again later")
+	ErrLoginThrottleNotFound      = errors.New("login throttle not found")
+	ErrLoginThrottlePolicyInvalid = errors.New("login throttle policy invalid")
+)
+
+type LoginThrottleScope string
+
+const (
+	LoginThrottleScopeAccount LoginThrottleScope = "account"
+	LoginThrottleScopeIP      LoginThrottleScope = "ip"
+)
+
+type LockoutEventKind string
+
+const (
+	LockoutEventLocked   LockoutEventKind = "locked"
+	LockoutEventUnlocked LockoutEventKind = "unlocked"
+)
+
+// LoginThrottlePolicy decides how failed logins slow down further attempts.
+// The first FreeFailures failures cost nothing, the following ones double
+// the wait from BaseDelay up to MaxDelay, and reaching the lockout
+// threshold of the scope blocks it for LockoutDuration.
+type LoginThrottlePolicy struct {
+	FreeFailures            int
+	BaseDelay               time.Duration
+	MaxDelay                time.Duration
+	AccountLockoutThreshold int
+	IPLockoutThreshold      int
+	LockoutDuration         time.Duration
+	// FailureWindow forgets failures when none happened for this long.
+	FailureWindow time.Duration
+}
+
+func DefaultLoginThrottlePolicy() LoginThrottlePolicy {
+	return LoginThrottlePolicy{
+		FreeFailures:            3,
+		BaseDelay:               time.Second,
+		MaxDelay:                30 * time.Second,
+		AccountLockoutThreshold: 10,
+		IPLockoutThreshold:      100,
+		LockoutDuration:         15 * time.Minute,
+		FailureWindow:           15 * time.Minute,
+	}
+}
+
+func (p LoginThrottlePolicy) Validate() error {
+	if p.FreeFailures < 0 || p.BaseDelay < 0 || p.MaxDelay < p.BaseDelay {
+		return ErrLoginThrottlePolicyInvalid
+	}
+
+	if p.AccountLockoutThreshold <= 0 || p.IPLockoutThreshold <= 0 {
+		return ErrLoginThrottlePolicyInvalid
+	}
+
+	if p.LockoutDuration <= 0 || p.FailureWindow <= 0 {
+		return ErrLoginThrottlePolicyInvalid
+	}
+
+	return nil
+}
+
+func (p LoginThrottlePolicy) LockoutThreshold(scope LoginThrottleScope) int {
+	if scope == LoginThrottleScopeIP {
+		return p.IPLockoutThreshold
+	}
+
+	return p.AccountLockoutThreshold
+}
+
+// LoginThrottle counts recent failed logins for one account email or one
+// client IP. Accounts are keyed by normalised email rather than user id, so
+// unknown emails are throttled the same way and reveal nothing.
+type LoginThrottle struct {
+	Scope         LoginThrottleScope
+	Key           string
+	Failures      int
+	LastFailureAt time.Time
+	LockedUntil   *time.Time
+}
+
+type LockoutEvent struct {
+	ID          string
+	Kind        LockoutEventKind
+	Scope       LoginThrottleScope
+	Key         string
+	Failures    int
+	LockedUntil *time.Time
+	CreatedAt   time.Time
+}
+
+type LoginThrottleRepository interface {
+	Get(ctx context.Context, scope LoginThrottleScope, key string) (*LoginThrottle, error)
+	// Save inserts the throttle or replaces the one with the same scope and
+	// key.
+	Save(ctx context.Context, throttle *LoginThrottle) error
+	// RecordFailure applies LoginThrottle.RecordFailure to the stored
+	// throttle, creating it when missing, as one atomic step so concurrent
+	// attempts cannot overwrite each other's count. It returns the throttle
+	// as stored and whether this failure started a lockout.
+	RecordFailure(ctx context.Context, scope LoginThrottleScope, key string, now time.Time, policy LoginThrottlePolicy) (*LoginThrottle, bool, error)
+	Delete(ctx context.Context, scope LoginThrottleScope, key string) error
+	SaveEvent(ctx context.Context, event *LockoutEvent) error
+	// ListEvents returns the most recent events first.
+	ListEvents(ctx context.Context, limit int) ([]*LockoutEvent, error)
+}
+
+func NewLoginThrottle(scope LoginThrottleScope, key string) *LoginThrottle {
+	return &LoginThrottle{
+		Scope: scope,
+		Key:   key,
+	}
+}
+
+// RetryAt is the earliest time another attempt is accepted. It is the zero
+// time when attempts are not being slowed down.
+func (t *LoginThrottle) RetryAt(policy LoginThrottlePolicy) time.Time {
+	if t.LockedUntil != nil {
+		return *t.LockedUntil
+	}
+
+	excess := t.Failures - policy.FreeFailures
+	if excess <= 0 {
+		return time.Time{}
+	}
+
+	delay := policy.BaseDelay
+	for i := 1; i < excess && delay < policy.MaxDelay; i++ {
+		delay *= 2
+	}
+
+	if delay > policy.MaxDelay {
+		delay = policy.MaxDelay
+	}
+
+	return t.LastFailureAt.Add(delay)
+}
+
+func (t *LoginThrottle) Allows(now time.Time, policy LoginThrottlePolicy) bool {
+	t.expire(now, policy)
+
+	return !now.Before(t.RetryAt(policy))
+}
+
+func (t *LoginThrottle) IsLocked(now time.Time) bool {
+	return t.LockedUntil != nil && now.Before(*t.LockedUntil)
+}
+
+// RecordFailure counts a failed attempt and reports whether it started a
+// lockout.
+func (t *LoginThrottle) RecordFailure(now time.Time, policy LoginThrottlePolicy) bool {
+	t.expire(now, policy)
+
+	t.Failures++
+	t.LastFailureAt = now
+
+	if t.LockedUntil == nil && t.Failures >= policy.LockoutThreshold(t.Scope) {
+		lockedUntil := now.Add(policy.LockoutDuration)
+		t.LockedUntil = &lockedUntil
+		return true
+	}
+
+	return false
+}
+
+// expire lifts a lockout that ran out and forgets failures that are older
+// than the policy window.
+func (t *LoginThrottle) expire(now time.Time, policy LoginThrottlePolicy) {
+	lockExpired := t.LockedUntil != nil && !now.Before(*t.LockedUntil)
+	stale := t.LockedUntil == nil && now.Sub(t.LastFailureAt) >= policy.FailureWindow
+
+	if lockExpired || stale {
+		t.Failures = 0
+		t.LockedUntil = nil
+	}
+}
+
+func NewLockoutEvent(kind LockoutEventKind, throttle *LoginThrottle, now time.Time) *LockoutEvent {
+	return &LockoutEvent{
+		ID:          uuid.NewString(),
+		Kind:        kind,
+		Scope:       throttle.Scope,
+		Key:         throttle.Key,
+		Failures:    throttle.Failures,
+		LockedUntil: throttle.LockedUntil,
+		CreatedAt:   now,
+	}
+}
@@ -0,0 +1,225 @@
+package domain
+
+import (
+	"cmp"
+	"encoding/base64"
+	"encoding/json"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	DefaultPageSize = 20
+	MaxPageSize     = 100
+)
+
+var (
+	ErrPageSizeInvalid    = NewError(ErrorKindValidation, "page.size_invalid", "limit", "page size must be between 1 and 100").withParams(map[string]any{"min": 1, "max": MaxPageSize})
+	ErrPageCursorInvalid  = NewError(ErrorKindValidation, "page.cursor_invalid", "cursor", "cursor invalid")
+	ErrPageCursorConflict = NewError(ErrorKindValidation, "page.cursor_conflict", "cursor", "after and before cursors cannot be used together")
+)
+
+// Cursor points at a row in a listing ordered by Sort and then by id. Only
+// the value of the sort field is kept. Clients only ever see it encoded, so
+// the fields can change without breaking them.
+type Cursor struct {
+	Sort   Sort      `json:"sort"`
+	Time   time.Time `json:"time,omitzero"`
+	Text   string    `json:"text,omitempty"`
+	Number int       `json:"number,omitempty"`
+	ID     string    `json:"id"`
+}
+
+func (c Cursor) Encode() string {
+	raw, _ := json.Marshal(c)
+
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func DecodeCursor(encoded string) (*Cursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, ErrPageCursorInvalid
+	}
+
+	var cursor Cursor
+	if err := json.Unmarshal(raw, &cursor); err != nil {
+		return nil, ErrPageCursorInvalid
+	}
+
+	if cursor.ID == "" {
+		return nil, ErrPageCursorInvalid
+	}
+
+	return &cursor, nil
+}
+
+// Value returns the sort field value in the type the field is stored with.
+func (c Cursor) Value() any {
+	switch c.Sort.Field {
+	case SortByName:
+		return c.Text
+	case SortByPrice:
+		return c.Number
+	default:
+		return c.Time
+	}
+}
+
+// Compare orders c and other by the sort field value and then by id, always
+// ascending; the direction is applied by whoever walks the listing.
+func (c Cursor) Compare(other Cursor) int {
+	var result int
+
+	switch c.Sort.Field {
+	case SortByName:
+		result = strings.Compare(c.Text, other.Text)
+	case SortByPrice:
+		result = cmp.Compare(c.Number, other.Number)
+	default:
+		result = c.Time.Compare(other.Time)
+	}
+
+	if result != 0 {
+		return result
+	}
+
+	return strings.Compare(c.ID, other.ID)
+}
+
+// PageRequest asks for Limit rows after the After cursor or, when Before is
+// set, the Limit rows right before it. Both nil means the first page.
+type PageRequest struct {
+	Limit  int
+	Sort   Sort
+	After  *Cursor
+	Before *Cursor
+}
+
+// NewPageRequest rejects cursors issued for a different sort, since their
+// position means nothing in another ordering.
+func NewPageRequest(limit int, after, before string, sort Sort) (PageRequest, error) {
+	if limit == 0 {
+		limit = DefaultPageSize
+	}
+
+	if limit < 1 || limit > MaxPageSize {
+		return PageRequest{}, ErrPageSizeInvalid
+	}
+
+	if after != "" && before != "" {
+		return PageRequest{}, ErrPageCursorConflict
+	}
+
+	afterCursor, err := decodePageCursor(after, sort)
+	if err != nil {
+		return PageRequest{}, err
+	}
+
+	beforeCursor, err := decodePageCursor(before, sort)
+	if err != nil {
+		return PageRequest{}, err
+	}
+
+	return PageRequest{
+		Limit:  limit,
+		Sort:   sort,
+		After:  afterCursor,
+		Before: beforeCursor,
+	}, nil
+}
+
+func decodePageCursor(encoded string, sort Sort) (*Cursor, error) {
+	if encoded == "" {
+		return nil, nil
+	}
+
+	cursor, err := DecodeCursor(encoded)
+	if err != nil {
+		return nil, err
+	}
+
+	if cursor.Sort != sort {
+		return nil, ErrPageCursorInvalid
+	}
+
+	return cursor, nil
+}
+
+// IsBackward reports whether the page is walked towards the start of the
+// listing.
+func (p PageRequest) IsBackward() bool {
+	return p.Before != nil
+}
+
+// FetchAscending reports whether rows must be fetched in ascending order,
+// which is the sort direction flipped when walking backward.
+func (p PageRequest) FetchAscending() bool {
+	return (p.Sort.Direction == SortAscending) != p.IsBackward()
+}
+
+type Page[T any] struct {
+	Items    []T
+	Next     *Cursor
+	Previous *Cursor
+}
+
+// NextCursor returns the encoded cursor of the next page, or "" on the last.
+func (p Page[T]) NextCursor() string {
+	if p.Next == nil {
+		return ""
+	}
+
+	return p.Next.Encode()
+}
+
+// PreviousCursor returns the encoded cursor of the previous page, or "" on
+// the first.
+func (p Page[T]) PreviousCursor() string {
+	if p.Previous == nil {
+		return ""
+	}
+
+	return p.Previous.Encode()
+}
+
+// NewPage builds a page from rows fetched in the direction of request. Rows
+// hold at most Limit+1 items; the extra one only signals another page exists.
+func NewPage[T any](rows []T, request PageRequest, cursorOf func(T, Sort) Cursor) Page[T] {
+	hasMore := len(rows) > request.Limit
+	if hasMore {
+		rows = rows[:request.Limit]
+	}
+
+	if request.IsBackward() {
+		slices.Reverse(rows)
+	}
+
+	page := Page[T]{Items: rows}
+	if len(rows) == 0 {
+		return page
+	}
+
+	first := cursorOf(rows[0], request.Sort)
+	last := cursorOf(rows[len(rows)-1], request.Sort)
+
+	if request.IsBackward() {
+		page.Next = &last
+		if hasMore {
+			page.Previous = &first
+		}
+
+		return page
+	}
+
+	if hasMore {
+		page.Next = &last
+	}
+
+	if request.After != nil {
+		page.Previous = &first
+	}
+
+	return page
+}
@@ -0,0 +1,210 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+var ErrPasswordPolicyInvalid = errors.New("password policy invalid")
+
+type PasswordRule string
+
+const (
+	PasswordRuleMinLength     PasswordRule = "min_length"
+	PasswordRuleMaxLength     PasswordRule = "max_length"
+	PasswordRuleLowercase     PasswordRule = "lowercase"
+	PasswordRuleUppercase     PasswordRule = "uppercase"
+	PasswordRuleDigit         PasswordRule = "digit"
+	PasswordRuleSpecial       PasswordRule = "special"
+	PasswordRuleContainsName  PasswordRule = "contains_name"
+	PasswordRuleContainsEmail PasswordRule = "contains_email"
+	PasswordRuleBlocklisted   PasswordRule = "blocklisted"
+)
+
+// personalInfoMinLength is the shortest part of a name or email that a
+// password may not contain; shorter parts match too many passwords.
+const personalInfoMinLength = 3
+
+type PasswordViolation struct {
+	Rule    PasswordRule
+	Message string
+	// Params are the values Message mentions, such as the minimum length.
+	Params map[string]any
+}
+
+// PasswordPolicyError lists every rule a password broke. It wraps
+// ErrUserPasswordInvalid, with the rules broken as its message.
+type PasswordPolicyError struct {
+	Violations []PasswordViolation
+}
+
+func (e *PasswordPolicyError) Error() string {
+	messages := make([]string, 0, len(e.Violations))
+	for _, violation := range e.Violations {
+		messages = append(messages, violation.Message)
+	}
+
+	return ErrUserPasswordInvalid.Error() + ": " + strings.Join(messages, "; ")
+}
+
+func (e *PasswordPolicyError) Unwrap() error {
+	return ErrUserPasswordInvalid.withMessage(e.Error())
+}
+
+// fieldErrors returns an error per rule broken, coded after the rule.
+func (e *PasswordPolicyError) fieldErrors() []*Error {
+	fieldErrors := make([]*Error, 0, len(e.Violations))
+	for _, violation := range e.Violations {
+		fieldErrors = append(fieldErrors, NewError(
+			ErrorKindValidation,
+			ErrUserPasswordInvalid.Code+"."+string(violation.Rule),
+			ErrUserPasswordInvalid.Field,
+			violation.Message,
+		).withParams(violation.Params))
+	}
+
+	return fieldErrors
+}
+
+// PasswordBlocklist holds common or breached passwords, compared without
+// regard to case.
+type PasswordBlocklist map[string]struct{}
+
+func NewPasswordBlocklist(passwords []string) PasswordBlocklist {
+	blocklist := make(PasswordBlocklist, len(passwords))
+	for _, password := range passwords {
+		blocklist[strings.ToLower(password)] = struct{}{}
+	}
+
+	return blocklist
+}
+
+func (b PasswordBlocklist) Contains(password string) bool {
+	_, found := b[strings.ToLower(password)]
+	return found
+}
+
+// PasswordPolicy holds the rules new passwords must follow. MinLength counts
+// characters, while MaxLength counts bytes because bcrypt ignores anything
+// past 72 bytes.
+type PasswordPolicy struct {
+	MinLength          int
+	MaxLength          int
+	RequireLowercase   bool
+	RequireUppercase   bool
+	RequireDigit       bool
+	RequireSpecial     bool
+	ForbidPersonalInfo bool
+	Blocklist          PasswordBlocklist
+}
+
+func DefaultPasswordPolicy() PasswordPolicy {
+	return PasswordPolicy{
+		MinLength:          8,
+		MaxLength:          72,
+		RequireLowercase:   true,
+		RequireUppercase:   true,
+		RequireSpecial:     true,
+		ForbidPersonalInfo: true,
+	}
+}
+
+// Check reports whether the policy itself makes sense.
+func (p PasswordPolicy) Check() error {
+	if p.MinLength < 1 || p.MaxLength < p.MinLength {
+		return ErrPasswordPolicyInvalid
+	}
+
+	return nil
+}
+
+// Validate checks password against every rule. name and email are the
+// user's, and are only used when ForbidPersonalInfo is set.
+func (p PasswordPolicy) Validate(password, name, email string) error {
+	if password == "" {
+		return ErrUserPasswordIsRequired
+	}
+
+	var violations []PasswordViolation
+	violate := func(rule PasswordRule, message string, params map[string]any) {
+		violations = append(violations, PasswordViolation{
+			Rule:    rule,
+			Message: message,
+			Params:  params,
+		})
+	}
+
+	if utf8.RuneCountInString(password) < p.MinLength {
+		violate(PasswordRuleMinLength, fmt.Sprintf("must have at least %d characters", p.MinLength), map[string]any{"min": p.MinLength})
+	}
+
+	if p.MaxLength > 0 && len(password) > p.MaxLength {
+		violate(PasswordRuleMaxLength, fmt.Sprintf("must have at most %d bytes", p.MaxLength), map[string]any{"max": p.MaxLength})
+	}
+
+	var hasLower, hasUpper, hasDigit, hasSpecial bool
+	for _, char := range password {
+		switch {
+		case unicode.IsLower(char):
+			hasLower = true
+		case unicode.IsUpper(char):
+			hasUpper = true
+		case unicode.IsDigit(char):
+			hasDigit = true
+		case unicode.IsPunct(char) || unicode.IsSymbol(char):
+			hasSpecial = true
+		}
+	}
+
+	if p.RequireLowercase && !hasLower {
+		violate(PasswordRuleLowercase, "must contain a lowercase letter", nil)
+	}
+
+	if p.RequireUppercase && !hasUpper {
+		violate(PasswordRuleUppercase, "must contain an uppercase letter", nil)
+	}
+
+	if p.RequireDigit && !hasDigit {
+		violate(PasswordRuleDigit, "must contain a digit", nil)
+	}
+
+	if p.RequireSpecial && !hasSpecial {
+		violate(PasswordRuleSpecial, "must contain a special character", nil)
+	}
+
+	if p.ForbidPersonalInfo {
+		lowered := strings.ToLower(password)
+
+		if containsAny(lowered, strings.Fields(strings.ToLower(name))) {
+			violate(PasswordRuleContainsName, "must not contain your name", nil)
+		}
+
+		local, _, _ := strings.Cut(NormalizeEmail(email), "@")
+		if containsAny(lowered, []string{local}) {
+			violate(PasswordRuleContainsEmail, "must not contain your email", nil)
+		}
+	}
+
+	if p.Blocklist.Contains(password) {
+		violate(PasswordRuleBlocklisted, "is too common", nil)
+	}
+
+	if len(violations) > 0 {
+		return &PasswordPolicyError{Violations: violations}
+	}
+
+	return nil
+}
+
+func containsAny(password string, parts []string) bool {
+	for _, part := range parts {
+		if utf8.RuneCountInString(part) >= personalInfoMinLength && strings.Contains(password, part) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,84 @@
+package domain
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DefaultPasswordResetTokenTTL is how long a reset token can be used after
+// it is issued.
+const DefaultPasswordResetTokenTTL = 30 * time.Minute
+
+var (
+	ErrPasswordResetTokenIsRequired = NewError(ErrorKindValidation, "password_reset.token_required", "token", "reset token is required")
+	ErrPasswordResetTokenInvalid    = NewError(ErrorKindValidation, "password_reset.token_invalid", "token", "reset token invalid or expired")
+	ErrPasswordResetTokenNotFound   = errors.New("reset token not found")
+)
+
+// PasswordResetToken only keeps the hash of the secret mailed to the user,
+// so a leaked table cannot be used to reset passwords.
+type PasswordResetToken struct {
+	ID        string
+	UserId    string
+	TokenHash string
+	ExpiresAt time.Time
+	UsedAt    *time.Time
+	CreatedAt time.Time
+}
+
+type PasswordResetTokenRepository interface {
+	Save(ctx context.Context, token *PasswordResetToken) error
+	GetByTokenHash(ctx context.Context, tokenHash string) (*PasswordResetToken, error)
+	// MarkUsed fails with ErrPasswordResetTokenInvalid when the token was
+	// already used, so two concurrent resets cannot both succeed.
+	MarkUsed(ctx context.Context, id string, usedAt time.Time) error
+	// InvalidateByUserId marks every unused token of the user as used.
+	InvalidateByUserId(ctx context.Context, userId string, usedAt time.Time) error
+}
+
+// NewPasswordResetToken returns the token to store and the secret to mail,
+// which is not kept anywhere else.
+func NewPasswordResetToken(userId string, ttl time.Duration) (*PasswordResetToken, string, error) {
+	secret, err := newSecretToken()
+	if err != nil {
+		return nil, "", err
+	}
+
+	now := time.Now()
+
+	return &PasswordResetToken{
+		ID:        uuid.NewString(),
+		UserId:    userId,
+		TokenHash: HashSecretToken(secret),
+		ExpiresAt: now.Add(ttl),
+		CreatedAt: now,
+	}, secret, nil
+}
+
+func newSecretToken() (string, error) {
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+// HashSecretToken hashes a random token for storage. Tokens carry enough
+// entropy that a fast unsalted hash is enough, unlike passwords.
+func HashSecretToken(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+
+	return hex.EncodeToString(sum[:])
+}
+
+func (t *PasswordResetToken) IsUsable(now time.Time) bool {
+	return t.UsedAt == nil && now.Before(t.ExpiresAt)
+}
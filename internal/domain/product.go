@@ -1,26 +1,26 @@
 package domain
 
 import (
-	"errors"
+	"context"
 	"time"
 
 	"github.com/google/uuid"
 )
 
 var (
-	ErrProductIdIsRequired          = errors.New("id is required")
-	ErrProductNotFound              = errors.New("product not found")
-	ErrProductUserNotOwner          = errors.New("user not owner")
-	ErrProductUserIdIsRequired      = errors.New("user id is required")
-	ErrProductCategoryIdIsRequired  = errors.New("category id is required")
-	ErrProductNameIsRequired        = errors.New("name is required")
-	ErrProductDescriptionIsRequired = errors.New("description is required")
-	ErrProductStatusIsRequired      = errors.New("status is required")
-	ErrProductStatusInvalid         = errors.New("status invalid")
-	ErrProductPriceInvalid          = errors.New("invalid price")
-	ErrProductUserNotFound          = errors.New("user not found")
-	ErrProductCategoryNotFound      = errors.New("category not found")
-	ErrProductCategoryUserNotOwner  = errors.New("category user not owner")
+	ErrProductIdIsRequired          = NewError(ErrorKindValidation, "product.id_required", "id", "id is required")
+	ErrProductNotFound              = NewError(ErrorKindNotFound, "product.not_found", "", "product not found")
+	ErrProductUserNotOwner          = NewError(ErrorKindForbidden, "product.not_owner", "", "user not owner")
+	ErrProductUserIdIsRequired      = NewError(ErrorKindValidation, "product.user_id_required", "user_id", "user id is required")
+	ErrProductCategoryIdIsRequired  = NewError(ErrorKindValidation, "product.category_id_required", "category_id", "category id is required")
+	ErrProductNameIsRequired        = NewError(ErrorKindValidation, "product.name_required", "name", "name is required")
+	ErrProductDescriptionIsRequired = NewError(ErrorKindValidation, "product.description_required", "description", "description is required")
+	ErrProductStatusIsRequired      = NewError(ErrorKindValidation, "product.status_required", "status", "status is required")
+	ErrProductStatusInvalid         = NewError(ErrorKindValidation, "product.status_invalid", "status", "status invalid")
+	ErrProductPriceInvalid          = NewError(ErrorKindValidation, "product.price_invalid", "price", "invalid price")
+	ErrProductUserNotFound          = NewError(ErrorKindNotFound, "product.user_not_found", "", "user not found")
+	ErrProductCategoryNotFound      = NewError(ErrorKindNotFound, "product.category_not_found", "category_id", "category not found")
+	ErrProductCategoryUserNotOwner  = NewError(ErrorKindForbidden, "product.category_not_owner", "category_id", "category user not owner")
 )
 
 type Product struct {
@@ -33,15 +33,27 @@ type Product struct {
 	Price       int
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
+	DeletedAt   *time.Time
 }
 
 type ProductRepository interface {
-	Save(product *Product) error
-	Update(product *Product) error
-	GetById(id string) (*Product, error)
-	GetByIdAndUserId(id, userId string) (*Product, error)
-	ListByUserId(userId string) ([]*Product, error)
-	Count() (int, error)
+	Save(ctx context.Context, product *Product) error
+	Update(ctx context.Context, product *Product) error
+	GetById(ctx context.Context, id string) (*Product, error)
+	GetByIdAndUserId(ctx context.Context, id, userId string) (*Product, error)
+	GetDeletedByIdAndUserId(ctx context.Context, id, userId string) (*Product, error)
+	ListByUserId(ctx context.Context, userId string, criteria ProductCriteria) (Page[*Product], error)
+	Search(ctx context.Context, userId string, query ProductSearchQuery) ([]ProductSearchResult, error)
+	Count(ctx context.Context) (int, error)
+	CountByCategoryId(ctx context.Context, categoryId string) (int, error)
+	Delete(ctx context.Context, product *Product) error
+	DeleteByCategoryId(ctx context.Context, categoryId string, deletedAt time.Time) (int, error)
+	ReassignCategory(ctx context.Context, fromCategoryId, toCategoryId string, updatedAt time.Time) (int, error)
+	Restore(ctx context.Context, product *Product) error
+	Purge(ctx context.Context, id string) error
+	// PurgeByCategoryId permanently removes the soft deleted products of a
+	// category, leaving the others alone.
+	PurgeByCategoryId(ctx context.Context, categoryId string) (int, error)
 }
 
 type ProductStatus string
@@ -63,35 +75,35 @@ func validEntity(
 	status ProductStatus,
 	price int,
 ) error {
+	var violations []error
+
 	if userId == "" {
-		return ErrProductUserIdIsRequired
+		violations = append(violations, ErrProductUserIdIsRequired)
 	}
 
 	if categoryId == "" {
-		return ErrProductCategoryIdIsRequired
+		violations = append(violations, ErrProductCategoryIdIsRequired)
 	}
 
 	if name == "" {
-		return ErrProductNameIsRequired
+		violations = append(violations, ErrProductNameIsRequired)
 	}
 
 	if description == "" {
-		return ErrProductDescriptionIsRequired
+		violations = append(violations, ErrProductDescriptionIsRequired)
 	}
 
 	if status == "" {
-		return ErrProductStatusIsRequired
-	}
-
-	if !isValidProductStatus(status) {
-		return ErrProductStatusInvalid
+		violations = append(violations, ErrProductStatusIsRequired)
+	} else if !isValidProductStatus(status) {
+		violations = append(violations, ErrProductStatusInvalid)
 	}
 
 	if price <= 0 {
-		return ErrProductPriceInvalid
+		violations = append(violations, ErrProductPriceInvalid)
 	}
 
-	return nil
+	return NewValidationError(violations...)
 }
 
 func NewProduct(
@@ -159,3 +171,36 @@ func (p *Product) UpdateProduct(
 
 	return nil
 }
+
+func (p *Product) IsDeleted() bool {
+	return p.DeletedAt != nil
+}
+
+func (p *Product) Delete() {
+	now := time.Now()
+
+	p.DeletedAt = &now
+	p.UpdatedAt = now
+}
+
+func (p *Product) Cursor(sort Sort) Cursor {
+	cursor := Cursor{Sort: sort, ID: p.ID}
+
+	switch sort.Field {
+	case SortByName:
+		cursor.Text = p.Name
+	case SortByPrice:
+		cursor.Number = p.Price
+	case SortByUpdatedAt:
+		cursor.Time = p.UpdatedAt
+	default:
+		cursor.Time = p.CreatedAt
+	}
+
+	return cursor
+}
+
+func (p *Product) Restore() {
+	p.DeletedAt = nil
+	p.UpdatedAt = time.Now()
+}
@@ -0,0 +1,120 @@
+package domain
+
+import (
+	"time"
+)
+
+var (
+	ErrProductPriceRangeInvalid = NewError(ErrorKindValidation, "product.price_range_invalid", "price", "price range invalid")
+	ErrProductDateRangeInvalid  = NewError(ErrorKindValidation, "product.date_range_invalid", "", "date range invalid")
+)
+
+// ProductSortFields lists the fields a product listing can be sorted by.
+var ProductSortFields = []SortField{
+	SortByName,
+	SortByPrice,
+	SortByCreatedAt,
+	SortByUpdatedAt,
+}
+
+// TimeRange matches instants between From and To, both inclusive. A nil
+// bound leaves that side open.
+type TimeRange struct {
+	From *time.Time
+	To   *time.Time
+}
+
+func (r TimeRange) isValid() bool {
+	return r.From == nil || r.To == nil || !r.From.After(*r.To)
+}
+
+func (r TimeRange) Contains(t time.Time) bool {
+	if r.From != nil && t.Before(*r.From) {
+		return false
+	}
+
+	if r.To != nil && t.After(*r.To) {
+		return false
+	}
+
+	return true
+}
+
+// ProductFilter narrows a product listing. Zero values do not filter.
+type ProductFilter struct {
+	Status     ProductStatus
+	CategoryId string
+	MinPrice   *int
+	MaxPrice   *int
+	Created    TimeRange
+	Updated    TimeRange
+}
+
+func NewProductFilter(
+	status ProductStatus,
+	categoryId string,
+	minPrice,
+	maxPrice *int,
+	created,
+	updated TimeRange,
+) (ProductFilter, error) {
+	var violations []error
+
+	if status != "" && !isValidProductStatus(status) {
+		violations = append(violations, ErrProductStatusInvalid)
+	}
+
+	if (minPrice != nil && *minPrice < 0) || (maxPrice != nil && *maxPrice < 0) {
+		violations = append(violations, ErrProductPriceRangeInvalid)
+	}
+
+	if minPrice != nil && maxPrice != nil && *minPrice > *maxPrice {
+		violations = append(violations, ErrProductPriceRangeInvalid)
+	}
+
+	if !created.isValid() || !updated.isValid() {
+		violations = append(violations, ErrProductDateRangeInvalid)
+	}
+
+	if err := NewValidationError(violations...); err != nil {
+		return ProductFilter{}, err
+	}
+
+	return ProductFilter{
+		Status:     status,
+		CategoryId: categoryId,
+		MinPrice:   minPrice,
+		MaxPrice:   maxPrice,
+		Created:    created,
+		Updated:    updated,
+	}, nil
+}
+
+// Matches is the reference interpretation of the filter; repositories that
+// translate it to a query must select exactly the same products.
+func (f ProductFilter) Matches(p *Product) bool {
+	if f.Status != "" && p.Status != string(f.Status) {
+		return false
+	}
+
+	if f.CategoryId != "" && p.CategoryId != f.CategoryId {
+		return false
+	}
+
+	if f.MinPrice != nil && p.Price < *f.MinPrice {
+		return false
+	}
+
+	if f.MaxPrice != nil && p.Price > *f.MaxPrice {
+		return false
+	}
+
+	return f.Created.Contains(p.CreatedAt) && f.Updated.Contains(p.UpdatedAt)
+}
+
+// ProductCriteria selects which of a user's products are listed, in which
+// order, and which page of them.
+type ProductCriteria struct {
+	Filter ProductFilter
+	Page   PageRequest
+}
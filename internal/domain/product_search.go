@@ -0,0 +1,80 @@
+package domain
+
+import (
+	"html"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+const (
+	SearchHighlightStart = "<mark>"
+	SearchHighlightEnd   = "</mark>"
+
+	// SearchMatchStart and SearchMatchEnd delimit the matches in a fragment
+	// cut from product text. They are control characters, so they pass
+	// through HTML escaping unchanged.
+	SearchMatchStart = "\x02"
+	SearchMatchEnd   = "\x03"
+)
+
+var searchHighlighter = strings.NewReplacer(
+	SearchMatchStart, SearchHighlightStart,
+	SearchMatchEnd, SearchHighlightEnd,
+)
+
+var (
+	ErrProductSearchQueryIsRequired = NewError(ErrorKindValidation, "product.search_query_required", "q", "search query is required")
+	ErrProductSearchUnavailable     = NewError(ErrorKindUnavailable, "product.search_unavailable", "", "product search unavailable")
+)
+
+// ProductSearchQuery holds the normalised terms of a search. Every term must
+// match the start of a word in the product name or description.
+type ProductSearchQuery struct {
+	Terms []string
+	Limit int
+}
+
+func NewProductSearchQuery(text string, limit int) (ProductSearchQuery, error) {
+	terms := searchTerms(text)
+	if len(terms) == 0 {
+		return ProductSearchQuery{}, ErrProductSearchQueryIsRequired
+	}
+
+	if limit == 0 {
+		limit = DefaultPageSize
+	}
+
+	if limit < 1 || limit > MaxPageSize {
+		return ProductSearchQuery{}, ErrPageSizeInvalid
+	}
+
+	return ProductSearchQuery{Terms: terms, Limit: limit}, nil
+}
+
+// searchTerms splits text into lowercase words, dropping punctuation so it
+// can never be read as search syntax.
+func searchTerms(text string) []string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	slices.Sort(words)
+
+	return slices.Compact(words)
+}
+
+// HighlightSnippet escapes a fragment delimited with SearchMatchStart and
+// SearchMatchEnd as HTML and only then marks the matches, so markup in
+// product text reaches the client as text.
+func HighlightSnippet(fragment string) string {
+	return searchHighlighter.Replace(html.EscapeString(fragment))
+}
+
+// ProductSearchResult is a matching product with its relevance, higher
+// meaning more relevant, and an HTML fragment with the matches highlighted.
+type ProductSearchResult struct {
+	Product *Product
+	Rank    float64
+	Snippet string
+}
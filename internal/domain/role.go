@@ -0,0 +1,78 @@
+package domain
+
+import (
+	"slices"
+)
+
+var (
+	ErrRoleInvalid      = NewError(ErrorKindValidation, "role.invalid", "role", "role invalid")
+	ErrPermissionDenied = NewError(ErrorKindForbidden, "role.permission_denied", "", "permission denied")
+	// ErrActorIsSubject keeps administrators from locking themselves out by
+	// deactivating or demoting their own account.
+	ErrActorIsSubject = NewError(ErrorKindConflict, "role.actor_is_subject", "", "cannot change your own account")
+)
+
+type Role string
+
+const (
+	RoleUser  Role = "USER"
+	RoleAdmin Role = "ADMIN"
+)
+
+type Permission string
+
+const (
+	PermissionUsersRead       Permission = "users:read"
+	PermissionUsersDeactivate Permission = "users:deactivate"
+	PermissionUsersUnlock     Permission = "users:unlock"
+	PermissionRolesManage     Permission = "roles:manage"
+	PermissionCatalogReadAny  Permission = "catalog:read_any"
+	PermissionLockoutsRead    Permission = "lockouts:read"
+)
+
+// rolePermissions grants permissions on top of what every user may do with
+// their own account and catalogue.
+var rolePermissions = map[Role][]Permission{
+	RoleUser: {},
+	RoleAdmin: {
+		PermissionUsersRead,
+		PermissionUsersDeactivate,
+		PermissionUsersUnlock,
+		PermissionRolesManage,
+		PermissionCatalogReadAny,
+		PermissionLockoutsRead,
+	},
+}
+
+func NewRole(role string) (Role, error) {
+	r := Role(role)
+	if !r.IsValid() {
+		return "", ErrRoleInvalid
+	}
+
+	return r, nil
+}
+
+func (r Role) IsValid() bool {
+	_, ok := rolePermissions[r]
+	return ok
+}
+
+func (r Role) Can(permission Permission) bool {
+	return slices.Contains(rolePermissions[r], permission)
+}
+
+// Actor is the authenticated user a use case acts on behalf of, with the
+// role the request was authenticated with.
+type Actor struct {
+	UserId string
+	Role   Role
+}
+
+func (a Actor) Authorize(permission Permission) error {
+	if a.UserId == "" || !a.Role.Can(permission) {
+		return ErrPermissionDenied
+	}
+
+	return nil
+}
@@ -0,0 +1,148 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DefaultRefreshTokenTTL is how long a session lasts without being
+// refreshed.
+const DefaultRefreshTokenTTL = 30 * 24 * time.Hour
+
+const sessionDeviceMaxLength = 255
+
+var (
+	ErrSessionIdIsRequired     = NewError(ErrorKindValidation, "session.id_required", "id", "session id is required")
+	ErrSessionUserIdIsRequired = NewError(ErrorKindValidation, "session.user_id_required", "user_id", "session user id is required")
+	ErrSessionNotFound         = NewError(ErrorKindNotFound, "session.not_found", "", "session not found")
+	ErrRefreshTokenIsRequired  = NewError(ErrorKindValidation, "refresh_token.required", "refresh_token", "refresh token is required")
+	ErrRefreshTokenInvalid     = NewError(ErrorKindUnauthorized, "refresh_token.invalid", "", "refresh token invalid or expired")
+	ErrRefreshTokenNotFound    = errors.New("refresh token not found")
+	ErrRefreshTokenReused      = NewError(ErrorKindUnauthorized, "refresh_token.reused", "", "refresh token already used, session revoked")
+)
+
+// Session is a login on one device. Access tokens name the session they
+// were issued for, so revoking it locks them out on the next request, and
+// the session is kept going by exchanging refresh tokens.
+type Session struct {
+	ID     string
+	UserId string
+	// TokenVersion is the user's token version when they logged in, so
+	// revoking the user's tokens also ends their sessions.
+	TokenVersion int
+	Device       string
+	IP           string
+	LastUsedAt   time.Time
+	ExpiresAt    time.Time
+	RevokedAt    *time.Time
+	CreatedAt    time.Time
+}
+
+// RefreshToken can be exchanged once for a new access token and the next
+// refresh token of its session. Rotated tokens are kept so a stolen one
+// being replayed can be noticed. Only the hash is kept.
+type RefreshToken struct {
+	ID        string
+	SessionId string
+	TokenHash string
+	RotatedAt *time.Time
+	CreatedAt time.Time
+}
+
+type SessionRepository interface {
+	// Create stores a new session along with its first refresh token.
+	Create(ctx context.Context, session *Session, token *RefreshToken) error
+	GetById(ctx context.Context, id string) (*Session, error)
+	GetByIdAndUserId(ctx context.Context, id, userId string) (*Session, error)
+	GetRefreshToken(ctx context.Context, tokenHash string) (*RefreshToken, error)
+	// Rotate marks current as rotated, stores next and saves the session's
+	// last use. It fails with ErrRefreshTokenReused when current was already
+	// rotated, so two concurrent refreshes cannot both succeed, and with
+	// ErrRefreshTokenInvalid when the session was revoked meanwhile.
+	Rotate(ctx context.Context, session *Session, current, next *RefreshToken) error
+	// ListActiveByUserId returns the sessions neither revoked nor expired at
+	// now, most recently used first.
+	ListActiveByUserId(ctx context.Context, userId string, now time.Time) ([]*Session, error)
+	// Revoke keeps the first revocation time when the session is already
+	// revoked.
+	Revoke(ctx context.Context, id string, revokedAt time.Time) error
+	RevokeAllByUserId(ctx context.Context, userId string, revokedAt time.Time) error
+}
+
+// NewSession returns the session to store, its first refresh token and the
+// secret to hand to the client, which is not kept anywhere else.
+func NewSession(user *User, device, ip string, ttl time.Duration, now time.Time) (*Session, *RefreshToken, string, error) {
+	if user == nil || user.ID == "" {
+		return nil, nil, "", ErrSessionUserIdIsRequired
+	}
+
+	if runes := []rune(device); len(runes) > sessionDeviceMaxLength {
+		device = string(runes[:sessionDeviceMaxLength])
+	}
+
+	session := &Session{
+		ID:           uuid.NewString(),
+		UserId:       user.ID,
+		TokenVersion: user.TokenVersion,
+		Device:       device,
+		IP:           ip,
+		LastUsedAt:   now,
+		ExpiresAt:    now.Add(ttl),
+		CreatedAt:    now,
+	}
+
+	token, secret, err := NewRefreshToken(session.ID, now)
+	if err != nil {
+		return nil, nil, "", err
+	}
+
+	return session, token, secret, nil
+}
+
+// NewRefreshToken returns the token to store and the secret to hand to the
+// client.
+func NewRefreshToken(sessionId string, now time.Time) (*RefreshToken, string, error) {
+	secret, err := newSecretToken()
+	if err != nil {
+		return nil, "", err
+	}
+
+	return &RefreshToken{
+		ID:        uuid.NewString(),
+		SessionId: sessionId,
+		TokenHash: HashSecretToken(secret),
+		CreatedAt: now,
+	}, secret, nil
+}
+
+func (s *Session) IsRevoked() bool {
+	return s.RevokedAt != nil
+}
+
+func (s *Session) IsActive(now time.Time) bool {
+	return !s.IsRevoked() && now.Before(s.ExpiresAt)
+}
+
+// BelongsTo reports whether the session was started by user and survived
+// every revocation of their tokens since.
+func (s *Session) BelongsTo(user *User) bool {
+	return user != nil && s.UserId == user.ID && s.TokenVersion == user.TokenVersion
+}
+
+// Refresh records a use of the session from ip and pushes its expiry back
+// by ttl.
+func (s *Session) Refresh(ip string, ttl time.Duration, now time.Time) {
+	if ip != "" {
+		s.IP = ip
+	}
+
+	s.LastUsedAt = now
+	s.ExpiresAt = now.Add(ttl)
+}
+
+func (t *RefreshToken) IsRotated() bool {
+	return t.RotatedAt != nil
+}
@@ -0,0 +1,59 @@
+package domain
+
+import (
+	"slices"
+)
+
+var (
+	ErrSortFieldInvalid     = NewError(ErrorKindValidation, "sort.field_invalid", "sort", "sort field invalid")
+	ErrSortDirectionInvalid = NewError(ErrorKindValidation, "sort.direction_invalid", "order", "sort direction invalid")
+)
+
+type SortField string
+
+const (
+	SortByCreatedAt SortField = "created_at"
+	SortByUpdatedAt SortField = "updated_at"
+	SortByName      SortField = "name"
+	SortByPrice     SortField = "price"
+)
+
+type SortDirection string
+
+const (
+	SortAscending  SortDirection = "ASC"
+	SortDescending SortDirection = "DESC"
+)
+
+// Sort orders a listing by Field and then by id, so rows sharing the same
+// value always come back in the same order.
+type Sort struct {
+	Field     SortField     `json:"field"`
+	Direction SortDirection `json:"direction"`
+}
+
+var DefaultSort = Sort{Field: SortByCreatedAt, Direction: SortAscending}
+
+// NewSort validates field against the fields the listing accepts. Empty
+// values fall back to DefaultSort.
+func NewSort(field, direction string, allowed ...SortField) (Sort, error) {
+	sort := DefaultSort
+
+	if field != "" {
+		sort.Field = SortField(field)
+	}
+
+	if direction != "" {
+		sort.Direction = SortDirection(direction)
+	}
+
+	if !slices.Contains(allowed, sort.Field) {
+		return Sort{}, ErrSortFieldInvalid
+	}
+
+	if sort.Direction != SortAscending && sort.Direction != SortDescending {
+		return Sort{}, ErrSortDirectionInvalid
+	}
+
+	return sort, nil
+}
@@ -0,0 +1,16 @@
+package domain
+
+import "context"
+
+// Repositories exposes the repositories bound to a single unit of work.
+type Repositories interface {
+	Users() UserRepository
+	Categories() CategoryRepository
+	Products() ProductRepository
+}
+
+// UnitOfWork runs fn against repositories sharing one transaction. The
+// changes are committed when fn returns nil and discarded otherwise.
+type UnitOfWork interface {
+	Do(ctx context.Context, fn func(repos Repositories) error) error
+}
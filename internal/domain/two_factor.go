@@ -0,0 +1,237 @@
+package domain
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// The TOTP parameters are the RFC 6238 defaults, which are the only ones
+// most authenticator apps support.
+const (
+	TotpDigits = 6
+	TotpPeriod = 30 * time.Second
+	// totpSkew is how many periods before and after the current one are
+	// accepted, for clocks that drifted a little.
+	totpSkew       = 1
+	totpSecretSize = 20
+
+	RecoveryCodeCount  = 10
+	recoveryCodeLength = 10
+)
+
+var (
+	ErrTwoFactorAlreadyEnabled = NewError(ErrorKindConflict, "two_factor.already_enabled", "", "two-factor authentication is already enabled")
+	ErrTwoFactorNotEnrolled    = NewError(ErrorKindConflict, "two_factor.not_enrolled", "", "two-factor authentication is not awaiting confirmation")
+	ErrTwoFactorNotEnabled     = NewError(ErrorKindConflict, "two_factor.not_enabled", "", "two-factor authentication is not enabled")
+	ErrTwoFactorNotFound       = errors.New("two-factor authentication not found")
+	ErrTwoFactorCodeIsRequired = NewError(ErrorKindValidation, "two_factor.code_required", "code", "two-factor code is required")
+	ErrTwoFactorCodeInvalid    = NewError(ErrorKindValidation, "two_factor.code_invalid", "code", "two-factor code invalid")
+)
+
+var totpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// TwoFactor is a user's TOTP enrolment. It is pending until the user proves
+// their authenticator works by confirming a code. The secret is kept in
+// clear since codes cannot be checked against a hash of it.
+type TwoFactor struct {
+	UserId string
+	Secret string
+	// LastUsedStep is the time step of the last code accepted, so a code
+	// cannot be used twice.
+	LastUsedStep int64
+	EnabledAt    *time.Time
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// RecoveryCode stands in for a TOTP code once, for users who lost their
+// authenticator. Only its hash is kept.
+type RecoveryCode struct {
+	ID        string
+	UserId    string
+	CodeHash  string
+	UsedAt    *time.Time
+	CreatedAt time.Time
+}
+
+type TwoFactorRepository interface {
+	// Save replaces any enrolment the user already has.
+	Save(ctx context.Context, twoFactor *TwoFactor) error
+	GetByUserId(ctx context.Context, userId string) (*TwoFactor, error)
+	// Enable stores the enrolment as enabled along with its recovery codes,
+	// replacing any codes issued before.
+	Enable(ctx context.Context, twoFactor *TwoFactor, codes []*RecoveryCode) error
+	// Delete removes the enrolment and its recovery codes.
+	Delete(ctx context.Context, userId string) error
+	// ClaimStep fails with ErrTwoFactorCodeInvalid when a code of step or a
+	// later one was already accepted, so two requests cannot both use the
+	// same code.
+	ClaimStep(ctx context.Context, userId string, step int64) error
+	// UseRecoveryCode fails with ErrTwoFactorCodeInvalid when the user has
+	// no unused code with that hash.
+	UseRecoveryCode(ctx context.Context, userId, codeHash string, usedAt time.Time) error
+}
+
+func NewTwoFactor(userId string, now time.Time) (*TwoFactor, error) {
+	if userId == "" {
+		return nil, ErrUserIdIsRequired
+	}
+
+	raw := make([]byte, totpSecretSize)
+	if _, err := rand.Read(raw); err != nil {
+		return nil, err
+	}
+
+	return &TwoFactor{
+		UserId:    userId,
+		Secret:    totpEncoding.EncodeToString(raw),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}, nil
+}
+
+func (t *TwoFactor) IsEnabled() bool {
+	return t.EnabledAt != nil
+}
+
+// ProvisioningURI returns the otpauth:// URI authenticator apps read from a
+// QR code.
+func (t *TwoFactor) ProvisioningURI(issuer, account string) string {
+	query := url.Values{}
+	query.Set("secret", t.Secret)
+	query.Set("issuer", issuer)
+	query.Set("algorithm", "SHA1")
+	query.Set("digits", fmt.Sprint(TotpDigits))
+	query.Set("period", fmt.Sprint(int(TotpPeriod.Seconds())))
+
+	label := url.PathEscape(issuer + ":" + account)
+
+	return "otpauth://totp/" + label + "?" + query.Encode()
+}
+
+// MatchCode returns the time step of the code when it is valid around now
+// and newer than the last code accepted.
+func (t *TwoFactor) MatchCode(code string, now time.Time) (int64, bool) {
+	secret, err := totpEncoding.DecodeString(t.Secret)
+	if err != nil || len(code) != TotpDigits {
+		return 0, false
+	}
+
+	current := now.Unix() / int64(TotpPeriod.Seconds())
+
+	for step := current - totpSkew; step <= current+totpSkew; step++ {
+		if step <= t.LastUsedStep {
+			continue
+		}
+
+		if subtle.ConstantTimeCompare([]byte(totpCode(secret, step)), []byte(code)) == 1 {
+			return step, true
+		}
+	}
+
+	return 0, false
+}
+
+// CodeAt returns the code an authenticator app shows at the given time.
+func (t *TwoFactor) CodeAt(at time.Time) (string, error) {
+	secret, err := totpEncoding.DecodeString(t.Secret)
+	if err != nil {
+		return "", err
+	}
+
+	return totpCode(secret, at.Unix()/int64(TotpPeriod.Seconds())), nil
+}
+
+// Enable marks the enrolment confirmed with the code of step.
+func (t *TwoFactor) Enable(step int64, now time.Time) {
+	t.LastUsedStep = step
+	t.EnabledAt = &now
+	t.UpdatedAt = now
+}
+
+// totpCode is the HOTP value of RFC 4226 for the counter step.
+func totpCode(secret []byte, step int64) string {
+	counter := make([]byte, 8)
+	binary.BigEndian.PutUint64(counter, uint64(step))
+
+	mac := hmac.New(sha1.New, secret)
+	mac.Write(counter)
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	mod := uint32(1)
+	for range TotpDigits {
+		mod *= 10
+	}
+
+	return fmt.Sprintf("%0*d", TotpDigits, value%mod)
+}
+
+// NewRecoveryCodes returns the codes to store and the codes to show the
+// user, which are not kept anywhere else.
+func NewRecoveryCodes(userId string, now time.Time) ([]*RecoveryCode, []string) {
+	codes := make([]*RecoveryCode, 0, RecoveryCodeCount)
+	plain := make([]string, 0, RecoveryCodeCount)
+
+	for range RecoveryCodeCount {
+		raw := strings.ToLower(rand.Text()[:recoveryCodeLength])
+		code := raw[:recoveryCodeLength/2] + "-" + raw[recoveryCodeLength/2:]
+
+		codes = append(codes, &RecoveryCode{
+			ID:        uuid.NewString(),
+			UserId:    userId,
+			CodeHash:  HashRecoveryCode(code),
+			CreatedAt: now,
+		})
+		plain = append(plain, code)
+	}
+
+	return codes, plain
+}
+
+// HashRecoveryCode hashes a recovery code the way it was typed, ignoring
+// case, spaces and dashes.
+func HashRecoveryCode(code string) string {
+	normalized := strings.NewReplacer("-", "", " ", "").Replace(strings.ToLower(code))
+
+	return HashSecretToken(normalized)
+}
+
+// VerifyTwoFactorCode accepts either a current TOTP code or an unused
+// recovery code, and records it as used.
+func VerifyTwoFactorCode(ctx context.Context, repo TwoFactorRepository, twoFactor *TwoFactor, code string, now time.Time) error {
+	code = strings.TrimSpace(code)
+	if code == "" {
+		return ErrTwoFactorCodeIsRequired
+	}
+
+	if len(code) == TotpDigits {
+		step, ok := twoFactor.MatchCode(code, now)
+		if !ok {
+			return ErrTwoFactorCodeInvalid
+		}
+
+		if err := repo.ClaimStep(ctx, twoFactor.UserId, step); err != nil {
+			return err
+		}
+
+		twoFactor.LastUsedStep = step
+		return nil
+	}
+
+	return repo.UseRecoveryCode(ctx, twoFactor.UserId, HashRecoveryCode(code), now)
+}
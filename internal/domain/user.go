@@ -1,61 +1,115 @@
 package domain
 
 import (
-	"errors"
+	"context"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 )
 
 var (
-	ErrUserNameIsRequired     = errors.New("name is required")
-	ErrUserEmailIsRequired    = errors.New("email is required")
-	ErrUserEmailInvalid       = errors.New("email invalid")
-	ErrUserPasswordIsRequired = errors.New("password is required")
-	ErrUserPasswordInvalid    = errors.New("password invalid")
-	ErrUserNotFound           = errors.New("user not found")
-	ErrUserIdIsRequired       = errors.New("id is required")
+	ErrUserNameIsRequired     = NewError(ErrorKindValidation, "user.name_required", "name", "name is required")
+	ErrUserEmailIsRequired    = NewError(ErrorKindValidation, "user.email_required", "email", "email is required")
+	ErrUserEmailInvalid       = NewError(ErrorKindValidation, "user.email_invalid", "email", "email invalid")
+	ErrUserPasswordIsRequired = NewError(ErrorKindValidation, "user.password_required", "password", "password is required")
+	ErrUserPasswordInvalid    = NewError(ErrorKindValidation, "user.password_invalid", "password", "password invalid")
+	ErrUserNotFound           = NewError(ErrorKindNotFound, "user.not_found", "", "user not found")
+	ErrUserIdIsRequired       = NewError(ErrorKindValidation, "user.id_required", "id", "id is required")
+	ErrUserEmailAlreadyInUse  = NewError(ErrorKindConflict, "user.email_in_use", "email", "email already in use")
+
+	ErrUserCurrentPasswordInvalid = NewError(ErrorKindValidation, "user.current_password_invalid", "current_password", "current password invalid")
+	ErrUserPasswordReused         = NewError(ErrorKindValidation, "user.password_reused", "password", "password was used recently")
+	ErrUserEmailNotVerified       = NewError(ErrorKindForbidden, "user.email_not_verified", "", "email not verified")
+	ErrUserDeactivated            = NewError(ErrorKindForbidden, "user.deactivated", "", "account deactivated")
+	ErrUserAlreadyDeactivated     = NewError(ErrorKindConflict, "user.already_deactivated", "", "account already deactivated")
 )
 
+// DefaultPasswordHistorySize is how many of the latest passwords, counting
+// the current one, a new password must differ from.
+const DefaultPasswordHistorySize = 5
+
 type User struct {
-	ID        string
-	Name      string
-	Email     string
-	Password  string
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	ID              string
+	Name            string
+	Email           string
+	PendingEmail    string
+	EmailVerifiedAt *time.Time
+	Password        string
+	PasswordHistory []string
+	TokenVersion    int
+	Role            Role
+	// Locale is the language the user wants messages in; empty when they
+	// have no preference.
+	Locale        Locale
+	DeactivatedAt *time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
 }
 
 type UserRepository interface {
-	Save(user *User) error
-	Update(user *User) error
-	GetById(id string) (*User, error)
-	Count() (int, error)
+	Save(ctx context.Context, user *User) error
+	Update(ctx context.Context, user *User) error
+	GetById(ctx context.Context, id string) (*User, error)
+	GetByEmail(ctx context.Context, email string) (*User, error)
+	// List returns the users matching filter, deactivated ones included.
+	List(ctx context.Context, filter UserFilter, page PageRequest) (Page[*User], error)
+	Count(ctx context.Context) (int, error)
+}
+
+// UserSortFields are the fields the user listing can be sorted by.
+var UserSortFields = []SortField{SortByCreatedAt, SortByName}
+
+// UserFilter narrows the user listing. Text matches part of the name or the
+// email regardless of case; empty fields match every user.
+type UserFilter struct {
+	Text string
+	Role Role
 }
 
 type UserPasswordHasher interface {
 	Hash(password string) (string, error)
+	// Verify reports whether password matches hashedPassword. It only fails
+	// when hashedPassword cannot be read.
+	Verify(hashedPassword, password string) (bool, error)
+	// NeedsRehash reports whether hashedPassword was made with another
+	// algorithm or other parameters than Hash uses now.
+	NeedsRehash(hashedPassword string) bool
 }
 
-func NewUser(name, email, password string) (*User, error) {
+// NormalizeEmail trims and lowercases email. Emails are stored and compared
+// in this form, so two spellings of the same address cannot coexist.
+func NormalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// validProfile checks a name and an email already normalised.
+func validProfile(name, email string) []error {
+	var violations []error
+
 	if name == "" {
-		return nil, ErrUserNameIsRequired
+		violations = append(violations, ErrUserNameIsRequired)
 	}
 
 	if email == "" {
-		return nil, ErrUserEmailIsRequired
+		violations = append(violations, ErrUserEmailIsRequired)
+	} else if !isValidEmail(email) {
+		violations = append(violations, ErrUserEmailInvalid)
 	}
 
-	if !isValidEmail(email) {
-		return nil, ErrUserEmailInvalid
-	}
+	return violations
+}
 
+func NewUser(name, email, password string) (*User, error) {
+	email = NormalizeEmail(email)
+
+	violations := validProfile(name, email)
 	if password == "" {
-		return nil, ErrUserPasswordIsRequired
+		violations = append(violations, ErrUserPasswordIsRequired)
 	}
 
-	if !isValidPassword(password) {
-		return nil, ErrUserPasswordInvalid
+	if err := NewValidationError(violations...); err != nil {
+		return nil, err
 	}
 
 	now := time.Now()
@@ -65,32 +119,140 @@ func NewUser(name, email, password string) (*User, error) {
 		Name:      name,
 		Email:     email,
 		Password:  password,
+		Role:      RoleUser,
 		CreatedAt: now,
 		UpdatedAt: now,
 	}, nil
 }
 
-func UpdateUser(id, name, email string) (*User, error) {
+func UpdateUser(id, name, email, locale string) (*User, error) {
+	email = NormalizeEmail(email)
+
+	var violations []error
 	if id == "" {
-		return nil, ErrUserIdIsRequired
+		violations = append(violations, ErrUserIdIsRequired)
 	}
 
-	if name == "" {
-		return nil, ErrUserNameIsRequired
-	}
+	violations = append(violations, validProfile(name, email)...)
 
-	if email == "" {
-		return nil, ErrUserEmailIsRequired
+	preferred, err := NewLocale(locale)
+	if err != nil {
+		violations = append(violations, err)
 	}
 
-	if !isValidEmail(email) {
-		return nil, ErrUserEmailInvalid
+	if err := NewValidationError(violations...); err != nil {
+		return nil, err
 	}
 
 	return &User{
 		ID:        id,
 		Name:      name,
 		Email:     email,
+		Locale:    preferred,
 		UpdatedAt: time.Now(),
 	}, nil
 }
+
+// RecentPasswords returns the hashes of the current password and of the
+// previous ones, newest first, up to historySize in total.
+func (u *User) RecentPasswords(historySize int) []string {
+	recent := append([]string{u.Password}, u.PasswordHistory...)
+
+	return recent[:min(len(recent), max(historySize, 0))]
+}
+
+// ChangePassword replaces the password hash, keeping the replaced one in the
+// history, and revokes every token issued so far.
+func (u *User) ChangePassword(hashedPassword string, historySize int) {
+	u.PasswordHistory = u.RecentPasswords(historySize - 1)
+	u.Password = hashedPassword
+	u.UpdatedAt = time.Now()
+	u.RevokeTokens()
+}
+
+// RevokeTokens invalidates every token issued for the user, since tokens
+// carry the version they were issued for.
+func (u *User) RevokeTokens() {
+	u.TokenVersion++
+}
+
+func (u *User) IsEmailVerified() bool {
+	return u.EmailVerifiedAt != nil
+}
+
+// EmailToVerify returns the address awaiting confirmation, if any.
+func (u *User) EmailToVerify() (string, bool) {
+	if u.PendingEmail != "" {
+		return u.PendingEmail, true
+	}
+
+	return u.Email, !u.IsEmailVerified()
+}
+
+// ChangeEmail keeps the current address until the new one is confirmed.
+// Changing back to the current address drops the pending one.
+func (u *User) ChangeEmail(email string) {
+	if email == u.Email {
+		u.PendingEmail = ""
+		return
+	}
+
+	u.PendingEmail = email
+}
+
+// ConfirmEmail marks email as verified, making it the user's address when it
+// was the pending one.
+func (u *User) ConfirmEmail(email string, at time.Time) {
+	u.Email = email
+	u.PendingEmail = ""
+	u.EmailVerifiedAt = &at
+	u.UpdatedAt = at
+}
+
+func (u *User) IsActive() bool {
+	return u.DeactivatedAt == nil
+}
+
+// Deactivate blocks the user from logging in again and revokes the tokens
+// already issued.
+func (u *User) Deactivate(at time.Time) error {
+	if !u.IsActive() {
+		return ErrUserAlreadyDeactivated
+	}
+
+	u.DeactivatedAt = &at
+	u.UpdatedAt = at
+	u.RevokeTokens()
+
+	return nil
+}
+
+// ChangeRole revokes the tokens already issued, since they carry the role.
+func (u *User) ChangeRole(role Role) error {
+	if !role.IsValid() {
+		return ErrRoleInvalid
+	}
+
+	if role == u.Role {
+		return nil
+	}
+
+	u.Role = role
+	u.UpdatedAt = time.Now()
+	u.RevokeTokens()
+
+	return nil
+}
+
+func (u *User) Cursor(sort Sort) Cursor {
+	cursor := Cursor{Sort: sort, ID: u.ID}
+
+	switch sort.Field {
+	case SortByName:
+		cursor.Text = u.Name
+	default:
+		cursor.Time = u.CreatedAt
+	}
+
+	return cursor
+}
@@ -2,7 +2,6 @@ package domain
 
 import (
 	"regexp"
-	"unicode"
 )
 
 var (
@@ -12,24 +11,3 @@ var (
 func isValidEmail(email string) bool {
 	return emailRegex.MatchString(email)
 }
-
-func isValidPassword(password string) bool {
-	if len(password) < 8 {
-		return false
-	}
-
-	var hasLower, hasUpper, hasSpacial bool
-
-	for _, char := range password {
-		switch {
-		case unicode.IsLower(char):
-			hasLower = true
-		case unicode.IsUpper(char):
-			hasUpper = true
-		case unicode.IsPunct(char) || unicode.IsSymbol(char):
-			hasSpacial = true
-		}
-	}
-
-	return hasLower && hasUpper && hasSpacial
-}
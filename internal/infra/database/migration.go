@@ -0,0 +1,99 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// SchemaVersion is the schema version this binary expects. Bump it
+// whenever Migrate changes the database layout.
+const SchemaVersion = 18
+
+var ErrSchemaVersionMismatch = errors.New("schema version mismatch")
+
+type SchemaMigrationGorm struct {
+	Version   int       `gorm:"primaryKey;autoIncrement:false"`
+	AppliedAt time.Time `gorm:"not null"`
+}
+
+func (SchemaMigrationGorm) TableName() string {
+	return "schema_migrations"
+}
+
+func recordSchemaVersion(db *gorm.DB, version int) error {
+	if err := db.AutoMigrate(&SchemaMigrationGorm{}); err != nil {
+		return err
+	}
+
+	return db.
+		Where(SchemaMigrationGorm{Version: version}).
+		Attrs(SchemaMigrationGorm{AppliedAt: time.Now()}).
+		FirstOrCreate(&SchemaMigrationGorm{}).
+		Error
+}
+
+func CurrentSchemaVersion(ctx context.Context, db *gorm.DB) (int, error) {
+	var version int
+
+	err := db.WithContext(ctx).
+		Model(&SchemaMigrationGorm{}).
+		Select("COALESCE(MAX(version), 0)").
+		Scan(&version).
+		Error
+	if err != nil {
+		return 0, err
+	}
+
+	return version, nil
+}
+
+type PingChecker struct {
+	db *gorm.DB
+}
+
+func NewPingChecker(db *gorm.DB) *PingChecker {
+	return &PingChecker{db: db}
+}
+
+func (c *PingChecker) Name() string {
+	return "database"
+}
+
+func (c *PingChecker) Check(ctx context.Context) error {
+	sqlDB, err := c.db.DB()
+	if err != nil {
+		return err
+	}
+
+	return sqlDB.PingContext(ctx)
+}
+
+type MigrationChecker struct {
+	db       *gorm.DB
+	expected int
+}
+
+func NewMigrationChecker(db *gorm.DB, expected int) *MigrationChecker {
+	return &MigrationChecker{db: db, expected: expected}
+}
+
+func (c *MigrationChecker) Name() string {
+	return "migrations"
+}
+
+func (c *MigrationChecker) Check(ctx context.Context) error {
+	version, err := CurrentSchemaVersion(ctx, c.db)
+	if err != nil {
+		return err
+	}
+
+	if version != c.expected {
+		return fmt.Errorf("%w: expected %d, found %d", ErrSchemaVersionMismatch, c.expected, version)
+	}
+
+	return nil
+}
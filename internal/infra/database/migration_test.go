@@ -0,0 +1,89 @@
+package database
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func makeSut(t *testing.T) *gorm.DB {
+	db, err := NewSqlite(":memory:")
+	require.NoError(t, err)
+
+	return db
+}
+
+func TestMigrate_ShouldRecordSchemaVersion(t *testing.T) {
+	db := makeSut(t)
+
+	require.NoError(t, Migrate(db))
+	require.NoError(t, Migrate(db))
+
+	version, err := CurrentSchemaVersion(context.Background(), db)
+
+	require.NoError(t, err)
+	assert.Equal(t, SchemaVersion, version)
+}
+
+func TestPingChecker_ShouldSucceed_WhenDatabaseIsOpen(t *testing.T) {
+	db := makeSut(t)
+
+	assert.NoError(t, NewPingChecker(db).Check(context.Background()))
+}
+
+func TestPingChecker_ShouldFail_WhenDatabaseIsClosed(t *testing.T) {
+	db := makeSut(t)
+
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	require.NoError(t, sqlDB.Close())
+
+	assert.Error(t, NewPingChecker(db).Check(context.Background()))
+}
+
+func TestMigrationChecker_ShouldSucceed_WhenVersionMatches(t *testing.T) {
+	db := makeSut(t)
+	require.NoError(t, Migrate(db))
+
+	assert.NoError(t, NewMigrationChecker(db, SchemaVersion).Check(context.Background()))
+}
+
+func TestMigrationChecker_ShouldFail_WhenVersionDiffers(t *testing.T) {
+	db := makeSut(t)
+	require.NoError(t, Migrate(db))
+
+	err := NewMigrationChecker(db, SchemaVersion+1).Check(context.Background())
+
+	assert.ErrorIs(t, err, ErrSchemaVersionMismatch)
+}
+
+func TestMigrationChecker_ShouldFail_WhenDatabaseIsNotMigrated(t *testing.T) {
+	db := makeSut(t)
+
+	assert.Error(t, NewMigrationChecker(db, SchemaVersion).Check(context.Background()))
+}
+
+func TestMigrate_ShouldRewriteProductTimestampsInUtc(t *testing.T) {
+	db := makeSut(t)
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	sqlDB.SetMaxOpenConns(1)
+
+	require.NoError(t, Migrate(db))
+	require.NoError(t, db.Exec(`INSERT INTO products (id, user_id, category_id, name, description, status, price, created_at, updated_at)
+		VALUES ('product-01', 'user-01', 'cat-01', 'Mouse', '', 'ACTIVE', 100, '2026-10-17 18:32:21.5-03:00', '2026-10-17 21:32:21.25+00:00')`).Error)
+
+	require.NoError(t, Migrate(db))
+
+	var row struct {
+		CreatedAt string
+		UpdatedAt string
+	}
+	require.NoError(t, db.Raw(`SELECT CAST(created_at AS TEXT) AS created_at, CAST(updated_at AS TEXT) AS updated_at FROM products`).Scan(&row).Error)
+
+	assert.Equal(t, "2026-10-17 21:32:21.500+00:00", row.CreatedAt)
+	assert.Equal(t, "2026-10-17 21:32:21.25+00:00", row.UpdatedAt)
+}
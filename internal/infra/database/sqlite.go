@@ -0,0 +1,86 @@
+package database
+
+import (
+	"github.com/areteacademy/internal/infra/mail"
+	"github.com/areteacademy/internal/infra/repository/apikey"
+	"github.com/areteacademy/internal/infra/repository/category"
+	"github.com/areteacademy/internal/infra/repository/emailverification"
+	"github.com/areteacademy/internal/infra/repository/loginchallenge"
+	"github.com/areteacademy/internal/infra/repository/loginthrottle"
+	"github.com/areteacademy/internal/infra/repository/pagination"
+	"github.com/areteacademy/internal/infra/repository/passwordreset"
+	"github.com/areteacademy/internal/infra/repository/product"
+	"github.com/areteacademy/internal/infra/repository/session"
+	"github.com/areteacademy/internal/infra/repository/twofactor"
+	"github.com/areteacademy/internal/infra/repository/user"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func NewSqlite(path string) (*gorm.DB, error) {
+	db, err := gorm.Open(sqlite.Open(path), &gorm.Config{})
+	if err != nil {
+		return nil, err
+	}
+
+	return db, nil
+}
+
+func Migrate(db *gorm.DB) error {
+	err := db.AutoMigrate(
+		&user.UserGorm{},
+		&category.CategoryGorm{},
+		&product.ProductGorm{},
+		&passwordreset.PasswordResetTokenGorm{},
+		&emailverification.EmailVerificationTokenGorm{},
+		&loginthrottle.LoginThrottleGorm{},
+		&loginthrottle.LockoutEventGorm{},
+		&mail.OutboxMessageGorm{},
+		&apikey.ApiKeyGorm{},
+		&twofactor.TwoFactorGorm{},
+		&twofactor.RecoveryCodeGorm{},
+		&loginchallenge.LoginChallengeGorm{},
+		&session.SessionGorm{},
+		&session.RefreshTokenGorm{},
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := user.MigrateEmails(db); err != nil {
+		return err
+	}
+
+	// Listings compare these as text, so they must all be in UTC.
+	if err := pagination.MigrateTimestamps(db, "users", "created_at", "updated_at"); err != nil {
+		return err
+	}
+
+	if err := pagination.MigrateTimestamps(db, "categories", "created_at", "updated_at", "deleted_at"); err != nil {
+		return err
+	}
+
+	if err := pagination.MigrateTimestamps(db, "products", "created_at", "updated_at", "deleted_at"); err != nil {
+		return err
+	}
+
+	// Login throttles compare these as text when counting a failure.
+	if err := pagination.MigrateTimestamps(db, "login_throttles", "last_failure_at", "locked_until"); err != nil {
+		return err
+	}
+
+	// Sessions are listed by comparing expires_at as text.
+	if err := pagination.MigrateTimestamps(db, "sessions", "last_used_at", "expires_at", "revoked_at", "created_at"); err != nil {
+		return err
+	}
+
+	if err := pagination.MigrateTimestamps(db, "refresh_tokens", "rotated_at", "created_at"); err != nil {
+		return err
+	}
+
+	if err := product.MigrateSearch(db); err != nil {
+		return err
+	}
+
+	return recordSchemaVersion(db, SchemaVersion)
+}
@@ -0,0 +1,77 @@
+package health
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+type Status string
+
+const (
+	StatusOk       Status = "ok"
+	StatusDegraded Status = "degraded"
+)
+
+type Checker interface {
+	Name() string
+	Check(ctx context.Context) error
+}
+
+type ComponentReport struct {
+	Name    string
+	Status  Status
+	Latency time.Duration
+	Error   string
+}
+
+type Report struct {
+	Status     Status
+	Components []ComponentReport
+}
+
+// Run executes every checker concurrently and reports the application as
+// degraded when any of them fails.
+func Run(ctx context.Context, checkers ...Checker) Report {
+	components := make([]ComponentReport, len(checkers))
+
+	var wg sync.WaitGroup
+	for i, checker := range checkers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			components[i] = runChecker(ctx, checker)
+		}()
+	}
+	wg.Wait()
+
+	status := StatusOk
+	for _, component := range components {
+		if component.Status != StatusOk {
+			status = StatusDegraded
+		}
+	}
+
+	return Report{
+		Status:     status,
+		Components: components,
+	}
+}
+
+func runChecker(ctx context.Context, checker Checker) ComponentReport {
+	start := time.Now()
+	err := checker.Check(ctx)
+
+	report := ComponentReport{
+		Name:    checker.Name(),
+		Status:  StatusOk,
+		Latency: time.Since(start),
+	}
+
+	if err != nil {
+		report.Status = StatusDegraded
+		report.Error = err.Error()
+	}
+
+	return report
+}
@@ -0,0 +1,47 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeChecker struct {
+	name string
+	err  error
+}
+
+func (c fakeChecker) Name() string                    { return c.name }
+func (c fakeChecker) Check(ctx context.Context) error { return c.err }
+
+func TestRun_ShouldReportOk_WhenEveryCheckerPasses(t *testing.T) {
+	report := Run(
+		context.Background(),
+		fakeChecker{name: "database"},
+		fakeChecker{name: "migrations"},
+	)
+
+	assert.Equal(t, StatusOk, report.Status)
+	require.Len(t, report.Components, 2)
+	assert.Equal(t, "database", report.Components[0].Name)
+	assert.Equal(t, StatusOk, report.Components[0].Status)
+	assert.Equal(t, "migrations", report.Components[1].Name)
+	assert.Equal(t, StatusOk, report.Components[1].Status)
+}
+
+func TestRun_ShouldReportDegraded_WhenAnyCheckerFails(t *testing.T) {
+	report := Run(
+		context.Background(),
+		fakeChecker{name: "database"},
+		fakeChecker{name: "migrations", err: errors.New("schema version mismatch")},
+	)
+
+	assert.Equal(t, StatusDegraded, report.Status)
+	require.Len(t, report.Components, 2)
+	assert.Equal(t, StatusOk, report.Components[0].Status)
+	assert.Equal(t, StatusDegraded, report.Components[1].Status)
+	assert.Equal(t, "schema version mismatch", report.Components[1].Error)
+}
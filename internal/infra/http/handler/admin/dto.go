@@ -0,0 +1,73 @@
+package admin
+
+import "time"
+
+type ChangeRoleRequest struct {
+	Role string `json:"role"`
+}
+
+type UserResponse struct {
+	ID            string     `json:"id"`
+	Name          string     `json:"name"`
+	Email         string     `json:"email"`
+	PendingEmail  string     `json:"pending_email,omitempty"`
+	EmailVerified bool       `json:"email_verified"`
+	Role          string     `json:"role"`
+	Active        bool       `json:"active"`
+	DeactivatedAt *time.Time `json:"deactivated_at,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+}
+
+type ListUserResponse struct {
+	Items          []UserResponse `json:"items"`
+	NextCursor     string         `json:"next_cursor,omitempty"`
+	PreviousCursor string         `json:"previous_cursor,omitempty"`
+}
+
+type CategoryResponse struct {
+	ID        string    `json:"id"`
+	UserId    string    `json:"user_id"`
+	Name      string    `json:"name"`
+	Status    string    `json:"status"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type ListCategoryResponse struct {
+	Items          []CategoryResponse `json:"items"`
+	NextCursor     string             `json:"next_cursor,omitempty"`
+	PreviousCursor string             `json:"previous_cursor,omitempty"`
+}
+
+type ProductResponse struct {
+	ID          string    `json:"id"`
+	UserId      string    `json:"user_id"`
+	CategoryId  string    `json:"category_id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	Status      string    `json:"status"`
+	Price       int       `json:"price"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+type ListProductResponse struct {
+	Items          []ProductResponse `json:"items"`
+	NextCursor     string            `json:"next_cursor,omitempty"`
+	PreviousCursor string            `json:"previous_cursor,omitempty"`
+}
+
+type LockoutEventResponse struct {
+	ID          string     `json:"id"`
+	Kind        string     `json:"kind"`
+	Scope       string     `json:"scope"`
+	Key         string     `json:"key"`
+	Failures    int        `json:"failures"`
+	LockedUntil *time.Time `json:"locked_until,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+type ListLockoutEventResponse struct {
+	Items []LockoutEventResponse `json:"items"`
+}
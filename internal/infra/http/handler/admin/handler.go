@@ -0,0 +1,299 @@
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/areteacademy/internal/domain"
+	"github.com/areteacademy/internal/infra/http/middleware"
+	"github.com/areteacademy/internal/infra/http/request"
+	"github.com/areteacademy/internal/infra/http/response"
+	changeRole "github.com/areteacademy/internal/usecase/admin/changerole"
+	deactivateUser "github.com/areteacademy/internal/usecase/admin/deactivateuser"
+	listUserCategories "github.com/areteacademy/internal/usecase/admin/listcategories"
+	listLockoutEvents "github.com/areteacademy/internal/usecase/admin/listlockoutevents"
+	listUserProducts "github.com/areteacademy/internal/usecase/admin/listproducts"
+	listUsers "github.com/areteacademy/internal/usecase/admin/listusers"
+	unlockAccount "github.com/areteacademy/internal/usecase/auth/unlockaccount"
+)
+
+// Handler serves the administration routes. Whether the caller may use them
+// is decided by the use cases, from the role in the caller's token.
+type Handler struct {
+	listUsersUseCase         listUsers.ListUsersUseCase
+	listCategoriesUseCase    listUserCategories.ListUserCategoriesUseCase
+	listProductsUseCase      listUserProducts.ListUserProductsUseCase
+	deactivateUseCase        deactivateUser.DeactivateUserUseCase
+	changeRoleUseCase        changeRole.ChangeRoleUseCase
+	unlockUseCase            unlockAccount.UnlockAccountUseCase
+	listLockoutEventsUseCase listLockoutEvents.ListLockoutEventsUseCase
+}
+
+func NewHandler(
+	listUsersUseCase listUsers.ListUsersUseCase,
+	listCategoriesUseCase listUserCategories.ListUserCategoriesUseCase,
+	listProductsUseCase listUserProducts.ListUserProductsUseCase,
+	deactivateUseCase deactivateUser.DeactivateUserUseCase,
+	changeRoleUseCase changeRole.ChangeRoleUseCase,
+	unlockUseCase unlockAccount.UnlockAccountUseCase,
+	listLockoutEventsUseCase listLockoutEvents.ListLockoutEventsUseCase,
+) *Handler {
+	return &Handler{
+		listUsersUseCase:         listUsersUseCase,
+		listCategoriesUseCase:    listCategoriesUseCase,
+		listProductsUseCase:      listProductsUseCase,
+		deactivateUseCase:        deactivateUseCase,
+		changeRoleUseCase:        changeRoleUseCase,
+		unlockUseCase:            unlockUseCase,
+		listLockoutEventsUseCase: listLockoutEventsUseCase,
+	}
+}
+
+func (h *Handler) ListUsers(w http.ResponseWriter, r *http.Request) {
+	actor, ok := middleware.ActorFromContext(r.Context())
+	if !ok {
+		response.Error(w, r, response.ErrUnauthorized)
+		return
+	}
+
+	page, err := request.ParsePage(r)
+	if err != nil {
+		response.Error(w, r, err)
+		return
+	}
+
+	query := r.URL.Query()
+
+	output, err := h.listUsersUseCase.Perform(r.Context(), listUsers.ListUsersInput{
+		Actor:     actor,
+		Search:    query.Get("q"),
+		Role:      query.Get("role"),
+		SortBy:    query.Get("sort_by"),
+		SortOrder: query.Get("sort_order"),
+		Limit:     page.Limit,
+		After:     page.After,
+		Before:    page.Before,
+	})
+	if err != nil {
+		response.Error(w, r, err)
+		return
+	}
+
+	users := make([]UserResponse, 0, len(output.Items))
+	for _, u := range output.Items {
+		users = append(users, UserResponse{
+			ID:            u.ID,
+			Name:          u.Name,
+			Email:         u.Email,
+			PendingEmail:  u.PendingEmail,
+			EmailVerified: u.EmailVerified,
+			Role:          u.Role,
+			Active:        u.Active,
+			DeactivatedAt: u.DeactivatedAt,
+			CreatedAt:     u.CreatedAt,
+			UpdatedAt:     u.UpdatedAt,
+		})
+	}
+
+	response.JSON(w, http.StatusOK, ListUserResponse{
+		Items:          users,
+		NextCursor:     output.NextCursor,
+		PreviousCursor: output.PreviousCursor,
+	})
+}
+
+func (h *Handler) ListUserCategories(w http.ResponseWriter, r *http.Request) {
+	actor, ok := middleware.ActorFromContext(r.Context())
+	if !ok {
+		response.Error(w, r, response.ErrUnauthorized)
+		return
+	}
+
+	page, err := request.ParsePage(r)
+	if err != nil {
+		response.Error(w, r, err)
+		return
+	}
+
+	output, err := h.listCategoriesUseCase.Perform(r.Context(), listUserCategories.ListUserCategoriesInput{
+		Actor:  actor,
+		UserId: r.PathValue("id"),
+		Limit:  page.Limit,
+		After:  page.After,
+		Before: page.Before,
+	})
+	if err != nil {
+		response.Error(w, r, err)
+		return
+	}
+
+	categories := make([]CategoryResponse, 0, len(output.Items))
+	for _, c := range output.Items {
+		categories = append(categories, CategoryResponse{
+			ID:        c.ID,
+			UserId:    c.UserId,
+			Name:      c.Name,
+			Status:    c.Status,
+			CreatedAt: c.CreatedAt,
+			UpdatedAt: c.UpdatedAt,
+		})
+	}
+
+	response.JSON(w, http.StatusOK, ListCategoryResponse{
+		Items:          categories,
+		NextCursor:     output.NextCursor,
+		PreviousCursor: output.PreviousCursor,
+	})
+}
+
+func (h *Handler) ListUserProducts(w http.ResponseWriter, r *http.Request) {
+	actor, ok := middleware.ActorFromContext(r.Context())
+	if !ok {
+		response.Error(w, r, response.ErrUnauthorized)
+		return
+	}
+
+	page, err := request.ParsePage(r)
+	if err != nil {
+		response.Error(w, r, err)
+		return
+	}
+
+	output, err := h.listProductsUseCase.Perform(r.Context(), listUserProducts.ListUserProductsInput{
+		Actor:  actor,
+		UserId: r.PathValue("id"),
+		Status: r.URL.Query().Get("status"),
+		Limit:  page.Limit,
+		After:  page.After,
+		Before: page.Before,
+	})
+	if err != nil {
+		response.Error(w, r, err)
+		return
+	}
+
+	products := make([]ProductResponse, 0, len(output.Items))
+	for _, p := range output.Items {
+		products = append(products, ProductResponse{
+			ID:          p.ID,
+			UserId:      p.UserId,
+			CategoryId:  p.CategoryId,
+			Name:        p.Name,
+			Description: p.Description,
+			Status:      p.Status,
+			Price:       p.Price,
+			CreatedAt:   p.CreatedAt,
+			UpdatedAt:   p.UpdatedAt,
+		})
+	}
+
+	response.JSON(w, http.StatusOK, ListProductResponse{
+		Items:          products,
+		NextCursor:     output.NextCursor,
+		PreviousCursor: output.PreviousCursor,
+	})
+}
+
+func (h *Handler) DeactivateUser(w http.ResponseWriter, r *http.Request) {
+	actor, ok := middleware.ActorFromContext(r.Context())
+	if !ok {
+		response.Error(w, r, response.ErrUnauthorized)
+		return
+	}
+
+	err := h.deactivateUseCase.Perform(r.Context(), deactivateUser.DeactivateUserInput{
+		Actor:  actor,
+		UserId: r.PathValue("id"),
+	})
+	if err != nil {
+		response.Error(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) ChangeRole(w http.ResponseWriter, r *http.Request) {
+	actor, ok := middleware.ActorFromContext(r.Context())
+	if !ok {
+		response.Error(w, r, response.ErrUnauthorized)
+		return
+	}
+
+	var body ChangeRoleRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		response.Error(w, r, response.ErrInvalidBody)
+		return
+	}
+
+	err := h.changeRoleUseCase.Perform(r.Context(), changeRole.ChangeRoleInput{
+		Actor:  actor,
+		UserId: r.PathValue("id"),
+		Role:   body.Role,
+	})
+	if err != nil {
+		response.Error(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) UnlockUser(w http.ResponseWriter, r *http.Request) {
+	actor, ok := middleware.ActorFromContext(r.Context())
+	if !ok {
+		response.Error(w, r, response.ErrUnauthorized)
+		return
+	}
+
+	err := h.unlockUseCase.Perform(r.Context(), unlockAccount.UnlockAccountInput{
+		Actor:  actor,
+		UserId: r.PathValue("id"),
+	})
+	if err != nil {
+		response.Error(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) ListLockoutEvents(w http.ResponseWriter, r *http.Request) {
+	actor, ok := middleware.ActorFromContext(r.Context())
+	if !ok {
+		response.Error(w, r, response.ErrUnauthorized)
+		return
+	}
+
+	limit, err := request.OptionalInt(r, "limit", domain.ErrPageSizeInvalid)
+	if err != nil {
+		response.Error(w, r, err)
+		return
+	}
+
+	input := listLockoutEvents.ListLockoutEventsInput{Actor: actor}
+	if limit != nil {
+		input.Limit = *limit
+	}
+
+	output, err := h.listLockoutEventsUseCase.Perform(r.Context(), input)
+	if err != nil {
+		response.Error(w, r, err)
+		return
+	}
+
+	events := make([]LockoutEventResponse, 0, len(output.Items))
+	for _, e := range output.Items {
+		events = append(events, LockoutEventResponse{
+			ID:          e.ID,
+			Kind:        e.Kind,
+			Scope:       e.Scope,
+			Key:         e.Key,
+			Failures:    e.Failures,
+			LockedUntil: e.LockedUntil,
+			CreatedAt:   e.CreatedAt,
+		})
+	}
+
+	response.JSON(w, http.StatusOK, ListLockoutEventResponse{Items: events})
+}
@@ -0,0 +1,279 @@
+package admin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+	"github.com/areteacademy/internal/infra/http/middleware"
+	categoryRepo "github.com/areteacademy/internal/infra/repository/category"
+	throttleRepo "github.com/areteacademy/internal/infra/repository/loginthrottle"
+	productRepo "github.com/areteacademy/internal/infra/repository/product"
+	userRepo "github.com/areteacademy/internal/infra/repository/user"
+	changeRole "github.com/areteacademy/internal/usecase/admin/changerole"
+	deactivateUser "github.com/areteacademy/internal/usecase/admin/deactivateuser"
+	listUserCategories "github.com/areteacademy/internal/usecase/admin/listcategories"
+	listLockoutEvents "github.com/areteacademy/internal/usecase/admin/listlockoutevents"
+	listUserProducts "github.com/areteacademy/internal/usecase/admin/listproducts"
+	listUsers "github.com/areteacademy/internal/usecase/admin/listusers"
+	unlockAccount "github.com/areteacademy/internal/usecase/auth/unlockaccount"
+	listCategory "github.com/areteacademy/internal/usecase/category/listbyuserid"
+	listProduct "github.com/areteacademy/internal/usecase/product/listbyuserid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type SUT struct {
+	Handler      *Handler
+	UserRepo     *userRepo.InMemoryUserRepository
+	CategoryRepo *categoryRepo.InMemoryCategoryRepository
+	ThrottleRepo *throttleRepo.InMemoryLoginThrottleRepository
+	Admin        *domain.User
+	User         *domain.User
+}
+
+func makeSut(t *testing.T) SUT {
+	userRepo := userRepo.NewInMemoryUserRepository()
+	categoryRepo := categoryRepo.NewInMemoryCategoryRepository()
+	productRepo := productRepo.NewInMemoryProductRepository()
+	throttleRepo := throttleRepo.NewInMemoryLoginThrottleRepository()
+	handler := NewHandler(
+		listUsers.NewListUsersUseCase(userRepo),
+		listUserCategories.NewListUserCategoriesUseCase(listCategory.NewListByUserIdCategoryUseCase(categoryRepo, userRepo)),
+		listUserProducts.NewListUserProductsUseCase(listProduct.NewListByUserIdProductUseCase(productRepo, userRepo)),
+		deactivateUser.NewDeactivateUserUseCase(userRepo),
+		changeRole.NewChangeRoleUseCase(userRepo),
+		unlockAccount.NewUnlockAccountUseCase(userRepo, throttleRepo),
+		listLockoutEvents.NewListLockoutEventsUseCase(throttleRepo),
+	)
+
+	now := time.Now()
+	admin := &domain.User{
+		ID:        "654321",
+		Name:      "Admin",
+		Email:     "admin@gmail.com",
+		Role:      domain.RoleAdmin,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	user := &domain.User{
+		ID:        "123456",
+		Name:      "Daniel",
+		Email:     "daniel@gmail.com",
+		Role:      domain.RoleUser,
+		CreatedAt: now.Add(time.Minute),
+		UpdatedAt: now.Add(time.Minute),
+	}
+
+	require.NoError(t, userRepo.Save(context.Background(), admin))
+	require.NoError(t, userRepo.Save(context.Background(), user))
+	require.NoError(t, categoryRepo.Save(context.Background(), &domain.Category{
+		ID:        "cat-01",
+		UserId:    user.ID,
+		Name:      "Categoria",
+		Status:    string(domain.CategoryStatusActive),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}))
+
+	return SUT{
+		Handler:      handler,
+		UserRepo:     userRepo,
+		CategoryRepo: categoryRepo,
+		ThrottleRepo: throttleRepo,
+		Admin:        admin,
+		User:         user,
+	}
+}
+
+func authenticated(r *http.Request, user *domain.User) *http.Request {
+	return r.WithContext(middleware.WithActor(r.Context(), domain.Actor{UserId: user.ID, Role: user.Role}))
+}
+
+func TestAdminHandler_ShouldReturnForbidden_WhenCallerIsNotAdmin(t *testing.T) {
+	testCases := []struct {
+		name    string
+		request func(sut SUT) *http.Request
+		serve   func(h *Handler, w http.ResponseWriter, r *http.Request)
+	}{
+		{
+			name: "List Users",
+			request: func(sut SUT) *http.Request {
+				return httptest.NewRequest(http.MethodGet, "/admin/users", nil)
+			},
+			serve: (*Handler).ListUsers,
+		},
+		{
+			name: "List User Categories",
+			request: func(sut SUT) *http.Request {
+				req := httptest.NewRequest(http.MethodGet, "/admin/users/654321/categories", nil)
+				req.SetPathValue("id", sut.Admin.ID)
+				return req
+			},
+			serve: (*Handler).ListUserCategories,
+		},
+		{
+			name: "Deactivate User",
+			request: func(sut SUT) *http.Request {
+				req := httptest.NewRequest(http.MethodPost, "/admin/users/654321/deactivate", nil)
+				req.SetPathValue("id", sut.Admin.ID)
+				return req
+			},
+			serve: (*Handler).DeactivateUser,
+		},
+		{
+			name: "List Lockout Events",
+			request: func(sut SUT) *http.Request {
+				return httptest.NewRequest(http.MethodGet, "/admin/lockouts", nil)
+			},
+			serve: (*Handler).ListLockoutEvents,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sut := makeSut(t)
+			rec := httptest.NewRecorder()
+
+			tc.serve(sut.Handler, rec, authenticated(tc.request(sut), sut.User))
+
+			assert.Equal(t, http.StatusForbidden, rec.Code)
+		})
+	}
+}
+
+func TestAdminHandler_ListUsers_ShouldSearchUsers(t *testing.T) {
+	sut := makeSut(t)
+
+	req := authenticated(httptest.NewRequest(http.MethodGet, "/admin/users?q=daniel&role=USER", nil), sut.Admin)
+	rec := httptest.NewRecorder()
+
+	sut.Handler.ListUsers(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var response ListUserResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&response))
+	require.Len(t, response.Items, 1)
+	assert.Equal(t, sut.User.ID, response.Items[0].ID)
+	assert.Equal(t, "USER", response.Items[0].Role)
+	assert.True(t, response.Items[0].Active)
+}
+
+func TestAdminHandler_ListUsers_ShouldReturnBadRequest_WhenRoleIsInvalid(t *testing.T) {
+	sut := makeSut(t)
+
+	req := authenticated(httptest.NewRequest(http.MethodGet, "/admin/users?role=ROOT", nil), sut.Admin)
+	rec := httptest.NewRecorder()
+
+	sut.Handler.ListUsers(rec, req)
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestAdminHandler_ListUserCategories_ShouldReturnAnotherUsersCategories(t *testing.T) {
+	sut := makeSut(t)
+
+	req := authenticated(httptest.NewRequest(http.MethodGet, "/admin/users/123456/categories", nil), sut.Admin)
+	req.SetPathValue("id", sut.User.ID)
+	rec := httptest.NewRecorder()
+
+	sut.Handler.ListUserCategories(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var response ListCategoryResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&response))
+	require.Len(t, response.Items, 1)
+	assert.Equal(t, "cat-01", response.Items[0].ID)
+}
+
+func TestAdminHandler_ListUserProducts_ShouldReturnNotFound_WhenUserHasNoProducts(t *testing.T) {
+	sut := makeSut(t)
+
+	req := authenticated(httptest.NewRequest(http.MethodGet, "/admin/users/123456/products", nil), sut.Admin)
+	req.SetPathValue("id", sut.User.ID)
+	rec := httptest.NewRecorder()
+
+	sut.Handler.ListUserProducts(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestAdminHandler_DeactivateUser_ShouldReturnNoContent(t *testing.T) {
+	sut := makeSut(t)
+
+	req := authenticated(httptest.NewRequest(http.MethodPost, "/admin/users/123456/deactivate", nil), sut.Admin)
+	req.SetPathValue("id", sut.User.ID)
+	rec := httptest.NewRecorder()
+
+	sut.Handler.DeactivateUser(rec, req)
+
+	require.Equal(t, http.StatusNoContent, rec.Code)
+	assert.False(t, sut.User.IsActive())
+}
+
+func TestAdminHandler_DeactivateUser_ShouldReturnConflict_WhenDeactivatingSelf(t *testing.T) {
+	sut := makeSut(t)
+
+	req := authenticated(httptest.NewRequest(http.MethodPost, "/admin/users/654321/deactivate", nil), sut.Admin)
+	req.SetPathValue("id", sut.Admin.ID)
+	rec := httptest.NewRecorder()
+
+	sut.Handler.DeactivateUser(rec, req)
+
+	assert.Equal(t, http.StatusConflict, rec.Code)
+	assert.True(t, sut.Admin.IsActive())
+}
+
+func TestAdminHandler_ChangeRole_ShouldPromoteUser(t *testing.T) {
+	sut := makeSut(t)
+
+	body, _ := json.Marshal(ChangeRoleRequest{Role: "ADMIN"})
+	req := authenticated(httptest.NewRequest(http.MethodPut, "/admin/users/123456/role", bytes.NewReader(body)), sut.Admin)
+	req.SetPathValue("id", sut.User.ID)
+	rec := httptest.NewRecorder()
+
+	sut.Handler.ChangeRole(rec, req)
+
+	require.Equal(t, http.StatusNoContent, rec.Code)
+	assert.Equal(t, domain.RoleAdmin, sut.User.Role)
+}
+
+func TestAdminHandler_UnlockUser_ShouldClearLockoutAndListEvent(t *testing.T) {
+	sut := makeSut(t)
+
+	policy := domain.DefaultLoginThrottlePolicy()
+	throttle := domain.NewLoginThrottle(domain.LoginThrottleScopeAccount, sut.User.Email)
+	for i := 0; i < policy.AccountLockoutThreshold; i++ {
+		throttle.RecordFailure(time.Now(), policy)
+	}
+	require.NoError(t, sut.ThrottleRepo.Save(context.Background(), throttle))
+
+	unlockReq := authenticated(httptest.NewRequest(http.MethodPost, "/admin/users/123456/unlock", nil), sut.Admin)
+	unlockReq.SetPathValue("id", sut.User.ID)
+	unlockRec := httptest.NewRecorder()
+
+	sut.Handler.UnlockUser(unlockRec, unlockReq)
+
+	require.Equal(t, http.StatusNoContent, unlockRec.Code)
+	assert.Nil(t, sut.ThrottleRepo.Throttle(domain.LoginThrottleScopeAccount, sut.User.Email))
+
+	listReq := authenticated(httptest.NewRequest(http.MethodGet, "/admin/lockouts?limit=5", nil), sut.Admin)
+	listRec := httptest.NewRecorder()
+
+	sut.Handler.ListLockoutEvents(listRec, listReq)
+
+	require.Equal(t, http.StatusOK, listRec.Code)
+
+	var response ListLockoutEventResponse
+	require.NoError(t, json.NewDecoder(listRec.Body).Decode(&response))
+	require.Len(t, response.Items, 1)
+	assert.Equal(t, "unlocked", response.Items[0].Kind)
+	assert.Equal(t, sut.User.Email, response.Items[0].Key)
+}
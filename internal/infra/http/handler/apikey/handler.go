@@ -0,0 +1,150 @@
+package apikey
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/areteacademy/internal/infra/http/middleware"
+	"github.com/areteacademy/internal/infra/http/response"
+	createApiKey "github.com/areteacademy/internal/usecase/apikey/create"
+	listApiKeys "github.com/areteacademy/internal/usecase/apikey/list"
+	renameApiKey "github.com/areteacademy/internal/usecase/apikey/rename"
+	revokeApiKey "github.com/areteacademy/internal/usecase/apikey/revoke"
+)
+
+type Handler struct {
+	createUseCase createApiKey.CreateApiKeyUseCase
+	listUseCase   listApiKeys.ListApiKeysUseCase
+	renameUseCase renameApiKey.RenameApiKeyUseCase
+	revokeUseCase revokeApiKey.RevokeApiKeyUseCase
+}
+
+func NewHandler(
+	createUseCase createApiKey.CreateApiKeyUseCase,
+	listUseCase listApiKeys.ListApiKeysUseCase,
+	renameUseCase renameApiKey.RenameApiKeyUseCase,
+	revokeUseCase revokeApiKey.RevokeApiKeyUseCase,
+) *Handler {
+	return &Handler{
+		createUseCase: createUseCase,
+		listUseCase:   listUseCase,
+		renameUseCase: renameUseCase,
+		revokeUseCase: revokeUseCase,
+	}
+}
+
+func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
+	userId, ok := middleware.UserIdFromContext(r.Context())
+	if !ok {
+		response.Error(w, r, response.ErrUnauthorized)
+		return
+	}
+
+	var body CreateApiKeyRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		response.Error(w, r, response.ErrInvalidBody)
+		return
+	}
+
+	output, err := h.createUseCase.Perform(r.Context(), createApiKey.CreateApiKeyInput{
+		UserId:    userId,
+		Name:      body.Name,
+		Scopes:    body.Scopes,
+		ExpiresAt: body.ExpiresAt,
+	})
+	if err != nil {
+		response.Error(w, r, err)
+		return
+	}
+
+	response.JSON(w, http.StatusCreated, CreateApiKeyResponse{
+		ID:        output.ID,
+		Name:      output.Name,
+		Hint:      output.Hint,
+		Scopes:    output.Scopes,
+		Key:       output.Key,
+		ExpiresAt: output.ExpiresAt,
+		CreatedAt: output.CreatedAt,
+	})
+}
+
+func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	userId, ok := middleware.UserIdFromContext(r.Context())
+	if !ok {
+		response.Error(w, r, response.ErrUnauthorized)
+		return
+	}
+
+	output, err := h.listUseCase.Perform(r.Context(), listApiKeys.ListApiKeysInput{UserId: userId})
+	if err != nil {
+		response.Error(w, r, err)
+		return
+	}
+
+	keys := make([]ApiKeyResponse, 0, len(output.Items))
+	for _, k := range output.Items {
+		keys = append(keys, ApiKeyResponse{
+			ID:         k.ID,
+			Name:       k.Name,
+			Hint:       k.Hint,
+			Scopes:     k.Scopes,
+			Revoked:    k.Revoked,
+			ExpiresAt:  k.ExpiresAt,
+			LastUsedAt: k.LastUsedAt,
+			RevokedAt:  k.RevokedAt,
+			CreatedAt:  k.CreatedAt,
+			UpdatedAt:  k.UpdatedAt,
+		})
+	}
+
+	response.JSON(w, http.StatusOK, ListApiKeyResponse{Items: keys})
+}
+
+func (h *Handler) Rename(w http.ResponseWriter, r *http.Request) {
+	userId, ok := middleware.UserIdFromContext(r.Context())
+	if !ok {
+		response.Error(w, r, response.ErrUnauthorized)
+		return
+	}
+
+	var body RenameApiKeyRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		response.Error(w, r, response.ErrInvalidBody)
+		return
+	}
+
+	output, err := h.renameUseCase.Perform(r.Context(), renameApiKey.RenameApiKeyInput{
+		ID:     r.PathValue("id"),
+		UserId: userId,
+		Name:   body.Name,
+	})
+	if err != nil {
+		response.Error(w, r, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, RenameApiKeyResponse{
+		ID:        output.ID,
+		Name:      output.Name,
+		UpdatedAt: output.UpdatedAt,
+	})
+}
+
+func (h *Handler) Revoke(w http.ResponseWriter, r *http.Request) {
+	userId, ok := middleware.UserIdFromContext(r.Context())
+	if !ok {
+		response.Error(w, r, response.ErrUnauthorized)
+		return
+	}
+
+	err := h.revokeUseCase.Perform(r.Context(), revokeApiKey.RevokeApiKeyInput{
+		ID:     r.PathValue("id"),
+		UserId: userId,
+	})
+	if err != nil {
+		response.Error(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
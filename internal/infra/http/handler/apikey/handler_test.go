@@ -0,0 +1,184 @@
+package apikey
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+	"github.com/areteacademy/internal/infra/http/middleware"
+	apiKeyRepo "github.com/areteacademy/internal/infra/repository/apikey"
+	userRepo "github.com/areteacademy/internal/infra/repository/user"
+	createApiKey "github.com/areteacademy/internal/usecase/apikey/create"
+	listApiKeys "github.com/areteacademy/internal/usecase/apikey/list"
+	renameApiKey "github.com/areteacademy/internal/usecase/apikey/rename"
+	revokeApiKey "github.com/areteacademy/internal/usecase/apikey/revoke"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type SUT struct {
+	Handler    *Handler
+	ApiKeyRepo *apiKeyRepo.InMemoryApiKeyRepository
+	UserRepo   *userRepo.InMemoryUserRepository
+	User       *domain.User
+	Key        *domain.ApiKey
+}
+
+func makeSut(t *testing.T) SUT {
+	apiKeyRepo := apiKeyRepo.NewInMemoryApiKeyRepository()
+	userRepo := userRepo.NewInMemoryUserRepository()
+	handler := NewHandler(
+		createApiKey.NewCreateApiKeyUseCase(apiKeyRepo, userRepo),
+		listApiKeys.NewListApiKeysUseCase(apiKeyRepo),
+		renameApiKey.NewRenameApiKeyUseCase(apiKeyRepo),
+		revokeApiKey.NewRevokeApiKeyUseCase(apiKeyRepo),
+	)
+
+	now := time.Now()
+	user := &domain.User{
+		ID:        "123456",
+		Name:      "Daniel",
+		Email:     "daniel@gmail.com",
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	key, _, err := domain.NewApiKey(user.ID, "CI", []string{"products:read"}, nil, now)
+	require.NoError(t, err)
+
+	require.NoError(t, userRepo.Save(context.Background(), user))
+	require.NoError(t, apiKeyRepo.Save(context.Background(), key))
+
+	return SUT{
+		Handler:    handler,
+		ApiKeyRepo: apiKeyRepo,
+		UserRepo:   userRepo,
+		User:       user,
+		Key:        key,
+	}
+}
+
+func authenticated(r *http.Request, userId string) *http.Request {
+	return r.WithContext(middleware.WithUserId(r.Context(), userId))
+}
+
+func TestApiKeyHandler_Create_ShouldReturnCreatedWithKey(t *testing.T) {
+	sut := makeSut(t)
+
+	body, _ := json.Marshal(CreateApiKeyRequest{Name: "Deploy", Scopes: []string{"products:write"}})
+	req := authenticated(httptest.NewRequest(http.MethodPost, "/user/api-keys", bytes.NewReader(body)), sut.User.ID)
+	rec := httptest.NewRecorder()
+
+	sut.Handler.Create(rec, req)
+
+	require.Equal(t, http.StatusCreated, rec.Code)
+
+	var response CreateApiKeyResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&response))
+	assert.NotEmpty(t, response.ID)
+	assert.True(t, domain.IsApiKey(response.Key))
+	assert.Equal(t, []string{"products:write"}, response.Scopes)
+}
+
+func TestApiKeyHandler_Create_ShouldReturnBadRequest_WhenScopeIsInvalid(t *testing.T) {
+	sut := makeSut(t)
+
+	body, _ := json.Marshal(CreateApiKeyRequest{Name: "Deploy", Scopes: []string{"users:read"}})
+	req := authenticated(httptest.NewRequest(http.MethodPost, "/user/api-keys", bytes.NewReader(body)), sut.User.ID)
+	rec := httptest.NewRecorder()
+
+	sut.Handler.Create(rec, req)
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestApiKeyHandler_List_ShouldNotExposeKeys(t *testing.T) {
+	sut := makeSut(t)
+
+	req := authenticated(httptest.NewRequest(http.MethodGet, "/user/api-keys", nil), sut.User.ID)
+	rec := httptest.NewRecorder()
+
+	sut.Handler.List(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.NotContains(t, rec.Body.String(), sut.Key.KeyHash)
+
+	var response ListApiKeyResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&response))
+	require.Len(t, response.Items, 1)
+	assert.Equal(t, sut.Key.ID, response.Items[0].ID)
+	assert.Equal(t, sut.Key.Hint, response.Items[0].Hint)
+}
+
+func TestApiKeyHandler_Rename_ShouldReturnOk(t *testing.T) {
+	sut := makeSut(t)
+
+	body, _ := json.Marshal(RenameApiKeyRequest{Name: "Deploy"})
+	req := authenticated(httptest.NewRequest(http.MethodPatch, "/user/api-keys/"+sut.Key.ID, bytes.NewReader(body)), sut.User.ID)
+	req.SetPathValue("id", sut.Key.ID)
+	rec := httptest.NewRecorder()
+
+	sut.Handler.Rename(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var response RenameApiKeyResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&response))
+	assert.Equal(t, "Deploy", response.Name)
+}
+
+func TestApiKeyHandler_Rename_ShouldReturnConflict_WhenKeyIsRevoked(t *testing.T) {
+	sut := makeSut(t)
+	sut.Key.Revoke(time.Now())
+
+	body, _ := json.Marshal(RenameApiKeyRequest{Name: "Deploy"})
+	req := authenticated(httptest.NewRequest(http.MethodPatch, "/user/api-keys/"+sut.Key.ID, bytes.NewReader(body)), sut.User.ID)
+	req.SetPathValue("id", sut.Key.ID)
+	rec := httptest.NewRecorder()
+
+	sut.Handler.Rename(rec, req)
+
+	assert.Equal(t, http.StatusConflict, rec.Code)
+}
+
+func TestApiKeyHandler_Revoke_ShouldReturnNoContent(t *testing.T) {
+	sut := makeSut(t)
+
+	req := authenticated(httptest.NewRequest(http.MethodDelete, "/user/api-keys/"+sut.Key.ID, nil), sut.User.ID)
+	req.SetPathValue("id", sut.Key.ID)
+	rec := httptest.NewRecorder()
+
+	sut.Handler.Revoke(rec, req)
+
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	assert.True(t, sut.Key.IsRevoked())
+}
+
+func TestApiKeyHandler_Revoke_ShouldReturnNotFound_WhenKeyBelongsToAnotherUser(t *testing.T) {
+	sut := makeSut(t)
+
+	req := authenticated(httptest.NewRequest(http.MethodDelete, "/user/api-keys/"+sut.Key.ID, nil), "654321")
+	req.SetPathValue("id", sut.Key.ID)
+	rec := httptest.NewRecorder()
+
+	sut.Handler.Revoke(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	assert.False(t, sut.Key.IsRevoked())
+}
+
+func TestApiKeyHandler_ShouldReturnUnauthorized_WhenUnauthenticated(t *testing.T) {
+	sut := makeSut(t)
+
+	req := httptest.NewRequest(http.MethodGet, "/user/api-keys", nil)
+	rec := httptest.NewRecorder()
+
+	sut.Handler.List(rec, req)
+
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+}
@@ -0,0 +1,157 @@
+package auth
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/areteacademy/internal/infra/http/middleware"
+	"github.com/areteacademy/internal/infra/http/response"
+	confirmPasswordReset "github.com/areteacademy/internal/usecase/auth/confirmpasswordreset"
+	login "github.com/areteacademy/internal/usecase/auth/login"
+	refreshSession "github.com/areteacademy/internal/usecase/auth/refreshsession"
+	requestPasswordReset "github.com/areteacademy/internal/usecase/auth/requestpasswordreset"
+	verifyTwoFactor "github.com/areteacademy/internal/usecase/auth/verifytwofactor"
+)
+
+type Handler struct {
+	loginUseCase                login.LoginUseCase
+	verifyTwoFactorUseCase      verifyTwoFactor.VerifyTwoFactorUseCase
+	refreshSessionUseCase       refreshSession.RefreshSessionUseCase
+	requestPasswordResetUseCase requestPasswordReset.RequestPasswordResetUseCase
+	confirmPasswordResetUseCase confirmPasswordReset.ConfirmPasswordResetUseCase
+}
+
+func NewHandler(
+	loginUseCase login.LoginUseCase,
+	verifyTwoFactorUseCase verifyTwoFactor.VerifyTwoFactorUseCase,
+	refreshSessionUseCase refreshSession.RefreshSessionUseCase,
+	requestPasswordResetUseCase requestPasswordReset.RequestPasswordResetUseCase,
+	confirmPasswordResetUseCase confirmPasswordReset.ConfirmPasswordResetUseCase,
+) *Handler {
+	return &Handler{
+		loginUseCase:                loginUseCase,
+		verifyTwoFactorUseCase:      verifyTwoFactorUseCase,
+		refreshSessionUseCase:       refreshSessionUseCase,
+		requestPasswordResetUseCase: requestPasswordResetUseCase,
+		confirmPasswordResetUseCase: confirmPasswordResetUseCase,
+	}
+}
+
+func (h *Handler) Login(w http.ResponseWriter, r *http.Request) {
+	var body LoginRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		response.Error(w, r, response.ErrInvalidBody)
+		return
+	}
+
+	output, err := h.loginUseCase.Perform(r.Context(), login.LoginInput{
+		Email:    body.Email,
+		Password: body.Password,
+		IP:       middleware.ClientIPFromContext(r.Context()),
+		Device:   r.UserAgent(),
+	})
+	if err != nil {
+		response.Error(w, r, err)
+		return
+	}
+
+	if output.ChallengeToken != "" {
+		response.JSON(w, http.StatusOK, LoginResponse{
+			TwoFactorRequired: true,
+			ChallengeToken:    output.ChallengeToken,
+		})
+		return
+	}
+
+	response.JSON(w, http.StatusOK, LoginResponse{
+		Token:        output.Token,
+		RefreshToken: output.RefreshToken,
+	})
+}
+
+// VerifyTwoFactor completes a login that answered with a challenge token.
+func (h *Handler) VerifyTwoFactor(w http.ResponseWriter, r *http.Request) {
+	var body VerifyTwoFactorRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		response.Error(w, r, response.ErrInvalidBody)
+		return
+	}
+
+	output, err := h.verifyTwoFactorUseCase.Perform(r.Context(), verifyTwoFactor.VerifyTwoFactorInput{
+		ChallengeToken: body.ChallengeToken,
+		Code:           body.Code,
+		IP:             middleware.ClientIPFromContext(r.Context()),
+		Device:         r.UserAgent(),
+	})
+	if err != nil {
+		response.Error(w, r, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, LoginResponse{
+		Token:        output.Token,
+		RefreshToken: output.RefreshToken,
+	})
+}
+
+// Refresh answers with a new refresh token as well, since the one sent
+// cannot be used again.
+func (h *Handler) Refresh(w http.ResponseWriter, r *http.Request) {
+	var body RefreshRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		response.Error(w, r, response.ErrInvalidBody)
+		return
+	}
+
+	output, err := h.refreshSessionUseCase.Perform(r.Context(), refreshSession.RefreshSessionInput{
+		RefreshToken: body.RefreshToken,
+		IP:           middleware.ClientIPFromContext(r.Context()),
+	})
+	if err != nil {
+		response.Error(w, r, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, LoginResponse{
+		Token:        output.Token,
+		RefreshToken: output.RefreshToken,
+	})
+}
+
+// RequestPasswordReset answers 202 whether or not the email is registered.
+func (h *Handler) RequestPasswordReset(w http.ResponseWriter, r *http.Request) {
+	var body RequestPasswordResetRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		response.Error(w, r, response.ErrInvalidBody)
+		return
+	}
+
+	err := h.requestPasswordResetUseCase.Perform(r.Context(), requestPasswordReset.RequestPasswordResetInput{
+		Email: body.Email,
+	})
+	if err != nil {
+		response.Error(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (h *Handler) ConfirmPasswordReset(w http.ResponseWriter, r *http.Request) {
+	var body ConfirmPasswordResetRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		response.Error(w, r, response.ErrInvalidBody)
+		return
+	}
+
+	err := h.confirmPasswordResetUseCase.Perform(r.Context(), confirmPasswordReset.ConfirmPasswordResetInput{
+		Token:       body.Token,
+		NewPassword: body.NewPassword,
+	})
+	if err != nil {
+		response.Error(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
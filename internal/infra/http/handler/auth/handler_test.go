@@ -0,0 +1,293 @@
+package auth
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+	"github.com/areteacademy/internal/infra/mail"
+	"github.com/areteacademy/internal/infra/report"
+	loginChallengeRepo "github.com/areteacademy/internal/infra/repository/loginchallenge"
+	loginThrottleRepo "github.com/areteacademy/internal/infra/repository/loginthrottle"
+	passwordResetRepo "github.com/areteacademy/internal/infra/repository/passwordreset"
+	sessionRepo "github.com/areteacademy/internal/infra/repository/session"
+	twoFactorRepo "github.com/areteacademy/internal/infra/repository/twofactor"
+	repo "github.com/areteacademy/internal/infra/repository/user"
+	security "github.com/areteacademy/internal/infra/security"
+	confirmPasswordReset "github.com/areteacademy/internal/usecase/auth/confirmpasswordreset"
+	login "github.com/areteacademy/internal/usecase/auth/login"
+	refreshSession "github.com/areteacademy/internal/usecase/auth/refreshsession"
+	requestPasswordReset "github.com/areteacademy/internal/usecase/auth/requestpasswordreset"
+	verifyTwoFactor "github.com/areteacademy/internal/usecase/auth/verifytwofactor"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type SUT struct {
+	Handler       *Handler
+	Repo          *repo.InMemoryUserRepository
+	TwoFactorRepo *twoFactorRepo.InMemoryTwoFactorRepository
+	SessionRepo   *sessionRepo.InMemorySessionRepository
+	Mailer        *mail.InMemoryMailer
+}
+
+func makeSut(t *testing.T) SUT {
+	repo := repo.NewInMemoryUserRepository()
+	hasher := security.NewBcryptPasswordHasher()
+	keys, err := security.NewEphemeralJwtKeySet()
+	require.NoError(t, err)
+
+	tokens, err := security.NewJwtTokenService(security.JwtConfig{
+		Keys:       keys,
+		Expiration: time.Hour,
+	})
+	require.NoError(t, err)
+
+	hash, err := hasher.Hash("@Daniel123")
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(context.Background(), &domain.User{
+		ID:       "123456",
+		Name:     "Daniel",
+		Email:    "daniel@gmail.com",
+		Password: hash,
+	}))
+
+	resetRepo := passwordResetRepo.NewInMemoryPasswordResetTokenRepository()
+	twoFactorRepo := twoFactorRepo.NewInMemoryTwoFactorRepository()
+	challengeRepo := loginChallengeRepo.NewInMemoryLoginChallengeRepository()
+	sessionRepo := sessionRepo.NewInMemorySessionRepository()
+	throttleRepo := loginThrottleRepo.NewInMemoryLoginThrottleRepository()
+	mailer := mail.NewInMemoryMailer()
+
+	return SUT{
+		Handler: NewHandler(
+			login.NewLoginUseCase(repo, hasher, tokens, throttleRepo, twoFactorRepo, challengeRepo, sessionRepo, domain.DefaultLoginThrottlePolicy(), time.Hour),
+			verifyTwoFactor.NewVerifyTwoFactorUseCase(repo, tokens, throttleRepo, twoFactorRepo, challengeRepo, sessionRepo, domain.DefaultLoginThrottlePolicy(), time.Hour),
+			refreshSession.NewRefreshSessionUseCase(repo, tokens, sessionRepo, time.Hour),
+			requestPasswordReset.NewRequestPasswordResetUseCase(repo, resetRepo, mailer, report.NewInMemoryErrorReporter(), time.Hour),
+			confirmPasswordReset.NewConfirmPasswordResetUseCase(repo, resetRepo, hasher, domain.DefaultPasswordHistorySize, domain.DefaultPasswordPolicy()),
+		),
+		Repo:          repo,
+		TwoFactorRepo: twoFactorRepo,
+		SessionRepo:   sessionRepo,
+		Mailer:        mailer,
+	}
+}
+
+func TestAuthHandler_Login_ShouldReturnToken(t *testing.T) {
+	sut := makeSut(t)
+
+	body, _ := json.Marshal(LoginRequest{Email: "daniel@gmail.com", Password: "@Daniel123"})
+	req := httptest.NewRequest(http.MethodPost, "/login", bytes.NewReader(body))
+	req.Header.Set("User-Agent", "Firefox")
+	rec := httptest.NewRecorder()
+
+	sut.Handler.Login(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var response LoginResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&response))
+	assert.NotEmpty(t, response.Token)
+	assert.NotEmpty(t, response.RefreshToken)
+
+	sessions := sut.SessionRepo.ByUserId("123456")
+	require.Len(t, sessions, 1)
+	assert.Equal(t, "Firefox", sessions[0].Device)
+}
+
+func logIn(t *testing.T, sut SUT) LoginResponse {
+	body, _ := json.Marshal(LoginRequest{Email: "daniel@gmail.com", Password: "@Daniel123"})
+	req := httptest.NewRequest(http.MethodPost, "/login", bytes.NewReader(body))
+	rec := httptest.NewRecorder()
+
+	sut.Handler.Login(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var response LoginResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&response))
+
+	return response
+}
+
+func refresh(sut SUT, refreshToken string) *httptest.ResponseRecorder {
+	body, _ := json.Marshal(RefreshRequest{RefreshToken: refreshToken})
+	req := httptest.NewRequest(http.MethodPost, "/token/refresh", bytes.NewReader(body))
+	rec := httptest.NewRecorder()
+
+	sut.Handler.Refresh(rec, req)
+
+	return rec
+}
+
+func TestAuthHandler_Refresh_ShouldReturnNewTokens(t *testing.T) {
+	sut := makeSut(t)
+	loggedIn := logIn(t, sut)
+
+	rec := refresh(sut, loggedIn.RefreshToken)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var response LoginResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&response))
+	assert.NotEmpty(t, response.Token)
+	assert.NotEmpty(t, response.RefreshToken)
+	assert.NotEqual(t, loggedIn.RefreshToken, response.RefreshToken)
+}
+
+func TestAuthHandler_Refresh_ShouldReturnUnauthorized_WhenRefreshTokenIsReused(t *testing.T) {
+	sut := makeSut(t)
+	loggedIn := logIn(t, sut)
+
+	require.Equal(t, http.StatusOK, refresh(sut, loggedIn.RefreshToken).Code)
+
+	rec := refresh(sut, loggedIn.RefreshToken)
+
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	assert.True(t, sut.SessionRepo.ByUserId("123456")[0].IsRevoked())
+}
+
+func TestAuthHandler_Refresh_ShouldReturnBadRequest_WhenRefreshTokenIsMissing(t *testing.T) {
+	sut := makeSut(t)
+
+	rec := refresh(sut, "")
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestAuthHandler_Login_ShouldReturnUnauthorized_WhenCredentialsAreInvalid(t *testing.T) {
+	sut := makeSut(t)
+
+	body, _ := json.Marshal(LoginRequest{Email: "daniel@gmail.com", Password: "@Wrong1234"})
+	req := httptest.NewRequest(http.MethodPost, "/login", bytes.NewReader(body))
+	rec := httptest.NewRecorder()
+
+	sut.Handler.Login(rec, req)
+
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+}
+
+func TestAuthHandler_Login_ShouldReturnTooManyRequests_WhenAccountIsLocked(t *testing.T) {
+	sut := makeSut(t)
+
+	var rec *httptest.ResponseRecorder
+	for i := 0; i <= domain.DefaultLoginThrottlePolicy().FreeFailures+1; i++ {
+		body, _ := json.Marshal(LoginRequest{Email: "daniel@gmail.com", Password: "@Wrong1234"})
+		req := httptest.NewRequest(http.MethodPost, "/login", bytes.NewReader(body))
+		rec = httptest.NewRecorder()
+
+		sut.Handler.Login(rec, req)
+	}
+
+	assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+}
+
+func TestAuthHandler_Login_ShouldRequireTwoFactorCode_WhenEnabled(t *testing.T) {
+	sut := makeSut(t)
+
+	now := time.Now()
+	twoFactor, err := domain.NewTwoFactor("123456", now)
+	require.NoError(t, err)
+	twoFactor.Enable(0, now)
+	require.NoError(t, sut.TwoFactorRepo.Save(context.Background(), twoFactor))
+
+	body, _ := json.Marshal(LoginRequest{Email: "daniel@gmail.com", Password: "@Daniel123"})
+	req := httptest.NewRequest(http.MethodPost, "/login", bytes.NewReader(body))
+	rec := httptest.NewRecorder()
+
+	sut.Handler.Login(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var challenge LoginResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&challenge))
+	assert.Empty(t, challenge.Token)
+	assert.True(t, challenge.TwoFactorRequired)
+
+	body, _ = json.Marshal(VerifyTwoFactorRequest{ChallengeToken: challenge.ChallengeToken, Code: "000000x"})
+	req = httptest.NewRequest(http.MethodPost, "/login/2fa", bytes.NewReader(body))
+	rec = httptest.NewRecorder()
+
+	sut.Handler.VerifyTwoFactor(rec, req)
+
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	code, err := twoFactor.CodeAt(time.Now())
+	require.NoError(t, err)
+
+	body, _ = json.Marshal(VerifyTwoFactorRequest{ChallengeToken: challenge.ChallengeToken, Code: code})
+	req = httptest.NewRequest(http.MethodPost, "/login/2fa", bytes.NewReader(body))
+	rec = httptest.NewRecorder()
+
+	sut.Handler.VerifyTwoFactor(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var response LoginResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&response))
+	assert.NotEmpty(t, response.Token)
+	assert.NotEmpty(t, response.RefreshToken)
+}
+
+func TestAuthHandler_PasswordReset_ShouldLetUserLoginWithNewPassword(t *testing.T) {
+	sut := makeSut(t)
+
+	body, _ := json.Marshal(RequestPasswordResetRequest{Email: "daniel@gmail.com"})
+	req := httptest.NewRequest(http.MethodPost, "/password/forgot", bytes.NewReader(body))
+	rec := httptest.NewRecorder()
+
+	sut.Handler.RequestPasswordReset(rec, req)
+
+	require.Equal(t, http.StatusAccepted, rec.Code)
+	require.NotNil(t, sut.Mailer.Last())
+
+	lines := strings.Split(strings.TrimSpace(sut.Mailer.Last().Body), "\n")
+	token := lines[len(lines)-1]
+
+	body, _ = json.Marshal(ConfirmPasswordResetRequest{Token: token, NewPassword: "@Secret456"})
+	req = httptest.NewRequest(http.MethodPost, "/password/reset", bytes.NewReader(body))
+	rec = httptest.NewRecorder()
+
+	sut.Handler.ConfirmPasswordReset(rec, req)
+
+	require.Equal(t, http.StatusNoContent, rec.Code)
+
+	body, _ = json.Marshal(LoginRequest{Email: "daniel@gmail.com", Password: "@Secret456"})
+	req = httptest.NewRequest(http.MethodPost, "/login", bytes.NewReader(body))
+	rec = httptest.NewRecorder()
+
+	sut.Handler.Login(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestAuthHandler_RequestPasswordReset_ShouldReturnAccepted_WhenEmailIsUnknown(t *testing.T) {
+	sut := makeSut(t)
+
+	body, _ := json.Marshal(RequestPasswordResetRequest{Email: "maria@gmail.com"})
+	req := httptest.NewRequest(http.MethodPost, "/password/forgot", bytes.NewReader(body))
+	rec := httptest.NewRecorder()
+
+	sut.Handler.RequestPasswordReset(rec, req)
+
+	assert.Equal(t, http.StatusAccepted, rec.Code)
+	assert.Empty(t, sut.Mailer.Sent)
+}
+
+func TestAuthHandler_ConfirmPasswordReset_ShouldReturnBadRequest_WhenTokenIsInvalid(t *testing.T) {
+	sut := makeSut(t)
+
+	body, _ := json.Marshal(ConfirmPasswordResetRequest{Token: "invalid", NewPassword: "@Secret456"})
+	req := httptest.NewRequest(http.MethodPost, "/password/reset", bytes.NewReader(body))
+	rec := httptest.NewRecorder()
+
+	sut.Handler.ConfirmPasswordReset(rec, req)
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
@@ -0,0 +1,31 @@
+package category
+
+import "time"
+
+type CategoryRequest struct {
+	Name   string `json:"name"`
+	Status string `json:"status"`
+}
+
+type CategoryResponse struct {
+	ID        string    `json:"id"`
+	UserId    string    `json:"user_id"`
+	Name      string    `json:"name"`
+	Status    string    `json:"status"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type ListCategoryResponse struct {
+	Items          []CategoryResponse `json:"items"`
+	NextCursor     string             `json:"next_cursor,omitempty"`
+	PreviousCursor string             `json:"previous_cursor,omitempty"`
+}
+
+type DeleteCategoryResponse struct {
+	ID               string    `json:"id"`
+	DeletedAt        time.Time `json:"deleted_at"`
+	Strategy         string    `json:"strategy"`
+	AffectedProducts int       `json:"affected_products"`
+	ReassignedTo     string    `json:"reassigned_to,omitempty"`
+}
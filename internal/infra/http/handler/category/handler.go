@@ -0,0 +1,258 @@
+package category
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/areteacademy/internal/infra/http/middleware"
+	"github.com/areteacademy/internal/infra/http/request"
+	"github.com/areteacademy/internal/infra/http/response"
+	createCategory "github.com/areteacademy/internal/usecase/category/create"
+	deleteCategory "github.com/areteacademy/internal/usecase/category/delete"
+	getByIdCategory "github.com/areteacademy/internal/usecase/category/getbyid"
+	listCategory "github.com/areteacademy/internal/usecase/category/listbyuserid"
+	purgeCategory "github.com/areteacademy/internal/usecase/category/purge"
+	restoreCategory "github.com/areteacademy/internal/usecase/category/restore"
+	updateCategory "github.com/areteacademy/internal/usecase/category/update"
+)
+
+type Handler struct {
+	createUseCase  createCategory.CreateCategoryUseCase
+	getByIdUseCase getByIdCategory.GetByIdCategoryUseCase
+	listUseCase    listCategory.ListByUserIdCategoryUseCase
+	updateUseCase  updateCategory.UpdateCategoryUseCase
+	deleteUseCase  deleteCategory.DeleteCategoryUseCase
+	restoreUseCase restoreCategory.RestoreCategoryUseCase
+	purgeUseCase   purgeCategory.PurgeCategoryUseCase
+}
+
+func NewHandler(
+	createUseCase createCategory.CreateCategoryUseCase,
+	getByIdUseCase getByIdCategory.GetByIdCategoryUseCase,
+	listUseCase listCategory.ListByUserIdCategoryUseCase,
+	updateUseCase updateCategory.UpdateCategoryUseCase,
+	deleteUseCase deleteCategory.DeleteCategoryUseCase,
+	restoreUseCase restoreCategory.RestoreCategoryUseCase,
+	purgeUseCase purgeCategory.PurgeCategoryUseCase,
+) *Handler {
+	return &Handler{
+		createUseCase:  createUseCase,
+		getByIdUseCase: getByIdUseCase,
+		listUseCase:    listUseCase,
+		updateUseCase:  updateUseCase,
+		deleteUseCase:  deleteUseCase,
+		restoreUseCase: restoreUseCase,
+		purgeUseCase:   purgeUseCase,
+	}
+}
+
+func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
+	userId, ok := middleware.UserIdFromContext(r.Context())
+	if !ok {
+		response.Error(w, r, response.ErrUnauthorized)
+		return
+	}
+
+	var body CategoryRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		response.Error(w, r, response.ErrInvalidBody)
+		return
+	}
+
+	output, err := h.createUseCase.Perform(r.Context(), createCategory.CreateCategoryInput{
+		UserId: userId,
+		Name:   body.Name,
+		Status: body.Status,
+	})
+	if err != nil {
+		response.Error(w, r, err)
+		return
+	}
+
+	response.JSON(w, http.StatusCreated, CategoryResponse{
+		ID:        output.ID,
+		UserId:    output.UserId,
+		Name:      output.Name,
+		Status:    output.Status,
+		CreatedAt: output.CreatedAt,
+		UpdatedAt: output.UpdatedAt,
+	})
+}
+
+func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	userId, ok := middleware.UserIdFromContext(r.Context())
+	if !ok {
+		response.Error(w, r, response.ErrUnauthorized)
+		return
+	}
+
+	page, err := request.ParsePage(r)
+	if err != nil {
+		response.Error(w, r, err)
+		return
+	}
+
+	output, err := h.listUseCase.Perform(r.Context(), listCategory.ListByUserIdCategoryInput{
+		UserId: userId,
+		Limit:  page.Limit,
+		After:  page.After,
+		Before: page.Before,
+	})
+	if err != nil {
+		response.Error(w, r, err)
+		return
+	}
+
+	categories := make([]CategoryResponse, 0, len(output.Items))
+	for _, c := range output.Items {
+		categories = append(categories, CategoryResponse{
+			ID:        c.ID,
+			UserId:    c.UserId,
+			Name:      c.Name,
+			Status:    c.Status,
+			CreatedAt: c.CreatedAt,
+			UpdatedAt: c.UpdatedAt,
+		})
+	}
+
+	response.JSON(w, http.StatusOK, ListCategoryResponse{
+		Items:          categories,
+		NextCursor:     output.NextCursor,
+		PreviousCursor: output.PreviousCursor,
+	})
+}
+
+func (h *Handler) GetById(w http.ResponseWriter, r *http.Request) {
+	userId, ok := middleware.UserIdFromContext(r.Context())
+	if !ok {
+		response.Error(w, r, response.ErrUnauthorized)
+		return
+	}
+
+	output, err := h.getByIdUseCase.Perform(r.Context(), getByIdCategory.GetByIdCategoryInput{
+		ID:     r.PathValue("id"),
+		UserId: userId,
+	})
+	if err != nil {
+		response.Error(w, r, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, CategoryResponse{
+		ID:        output.ID,
+		UserId:    output.UserId,
+		Name:      output.Name,
+		Status:    output.Status,
+		CreatedAt: output.CreatedAt,
+		UpdatedAt: output.UpdatedAt,
+	})
+}
+
+func (h *Handler) Update(w http.ResponseWriter, r *http.Request) {
+	userId, ok := middleware.UserIdFromContext(r.Context())
+	if !ok {
+		response.Error(w, r, response.ErrUnauthorized)
+		return
+	}
+
+	var body CategoryRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		response.Error(w, r, response.ErrInvalidBody)
+		return
+	}
+
+	output, err := h.updateUseCase.Perform(r.Context(), updateCategory.UpdateCategoryInput{
+		ID:     r.PathValue("id"),
+		UserId: userId,
+		Name:   body.Name,
+		Status: body.Status,
+	})
+	if err != nil {
+		response.Error(w, r, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, CategoryResponse{
+		ID:        output.ID,
+		UserId:    output.UserId,
+		Name:      output.Name,
+		Status:    output.Status,
+		CreatedAt: output.CreatedAt,
+		UpdatedAt: output.UpdatedAt,
+	})
+}
+
+func (h *Handler) Delete(w http.ResponseWriter, r *http.Request) {
+	userId, ok := middleware.UserIdFromContext(r.Context())
+	if !ok {
+		response.Error(w, r, response.ErrUnauthorized)
+		return
+	}
+
+	query := r.URL.Query()
+
+	output, err := h.deleteUseCase.Perform(r.Context(), deleteCategory.DeleteCategoryInput{
+		ID:         r.PathValue("id"),
+		UserId:     userId,
+		Strategy:   strings.ToUpper(query.Get("strategy")),
+		ReassignTo: query.Get("reassign_to"),
+	})
+	if err != nil {
+		response.Error(w, r, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, DeleteCategoryResponse{
+		ID:               output.ID,
+		DeletedAt:        output.DeletedAt,
+		Strategy:         output.Strategy,
+		AffectedProducts: output.AffectedProducts,
+		ReassignedTo:     output.ReassignedTo,
+	})
+}
+
+func (h *Handler) Restore(w http.ResponseWriter, r *http.Request) {
+	userId, ok := middleware.UserIdFromContext(r.Context())
+	if !ok {
+		response.Error(w, r, response.ErrUnauthorized)
+		return
+	}
+
+	output, err := h.restoreUseCase.Perform(r.Context(), restoreCategory.RestoreCategoryInput{
+		ID:     r.PathValue("id"),
+		UserId: userId,
+	})
+	if err != nil {
+		response.Error(w, r, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, CategoryResponse{
+		ID:        output.ID,
+		UserId:    output.UserId,
+		Name:      output.Name,
+		Status:    output.Status,
+		CreatedAt: output.CreatedAt,
+		UpdatedAt: output.UpdatedAt,
+	})
+}
+
+func (h *Handler) Purge(w http.ResponseWriter, r *http.Request) {
+	userId, ok := middleware.UserIdFromContext(r.Context())
+	if !ok {
+		response.Error(w, r, response.ErrUnauthorized)
+		return
+	}
+
+	err := h.purgeUseCase.Perform(r.Context(), purgeCategory.PurgeCategoryInput{
+		ID:     r.PathValue("id"),
+		UserId: userId,
+	})
+	if err != nil {
+		response.Error(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
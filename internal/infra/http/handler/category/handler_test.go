@@ -0,0 +1,291 @@
+package category
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+	"github.com/areteacademy/internal/infra/http/middleware"
+	categoryRepo "github.com/areteacademy/internal/infra/repository/category"
+	productRepo "github.com/areteacademy/internal/infra/repository/product"
+	"github.com/areteacademy/internal/infra/repository/transaction"
+	userRepo "github.com/areteacademy/internal/infra/repository/user"
+	createCategory "github.com/areteacademy/internal/usecase/category/create"
+	deleteCategory "github.com/areteacademy/internal/usecase/category/delete"
+	getByIdCategory "github.com/areteacademy/internal/usecase/category/getbyid"
+	listCategory "github.com/areteacademy/internal/usecase/category/listbyuserid"
+	purgeCategory "github.com/areteacademy/internal/usecase/category/purge"
+	restoreCategory "github.com/areteacademy/internal/usecase/category/restore"
+	updateCategory "github.com/areteacademy/internal/usecase/category/update"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type SUT struct {
+	Handler      *Handler
+	CategoryRepo *categoryRepo.InMemoryCategoryRepository
+	ProductRepo  *productRepo.InMemoryProductRepository
+	UserRepo     *userRepo.InMemoryUserRepository
+	User         *domain.User
+	Category     *domain.Category
+}
+
+func makeSut() SUT {
+	categoryRepo := categoryRepo.NewInMemoryCategoryRepository()
+	productRepo := productRepo.NewInMemoryProductRepository()
+	userRepo := userRepo.NewInMemoryUserRepository()
+	unitOfWork := transaction.NewInMemoryUnitOfWork(userRepo, categoryRepo, productRepo)
+	handler := NewHandler(
+		createCategory.NewCreateCategoryUseCase(categoryRepo, userRepo),
+		getByIdCategory.NewGetByIdCategoryUseCase(categoryRepo, userRepo),
+		listCategory.NewListByUserIdCategoryUseCase(categoryRepo, userRepo),
+		updateCategory.NewUpdateCategoryUseCase(categoryRepo, userRepo),
+		deleteCategory.NewDeleteCategoryUseCase(unitOfWork, userRepo),
+		restoreCategory.NewRestoreCategoryUseCase(categoryRepo, userRepo),
+		purgeCategory.NewPurgeCategoryUseCase(unitOfWork, userRepo),
+	)
+
+	now := time.Now()
+	user := &domain.User{
+		ID:        "123456",
+		Name:      "Daniel",
+		Email:     "daniel@gmail.com",
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	category := &domain.Category{
+		ID:        "cat-01",
+		UserId:    user.ID,
+		Name:      "Categoria",
+		Status:    string(domain.CategoryStatusActive),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	return SUT{
+		Handler:      handler,
+		CategoryRepo: categoryRepo,
+		ProductRepo:  productRepo,
+		UserRepo:     userRepo,
+		User:         user,
+		Category:     category,
+	}
+}
+
+func seedDefaultData(sut SUT) {
+	sut.UserRepo.Save(context.Background(), sut.User)
+	sut.CategoryRepo.Save(context.Background(), sut.Category)
+}
+
+func authenticated(r *http.Request, userId string) *http.Request {
+	return r.WithContext(middleware.WithUserId(r.Context(), userId))
+}
+
+func TestCategoryHandler_Create_ShouldReturnCreated(t *testing.T) {
+	sut := makeSut()
+	seedDefaultData(sut)
+
+	body, _ := json.Marshal(CategoryRequest{Name: "Nova", Status: "ACTIVE"})
+	req := authenticated(httptest.NewRequest(http.MethodPost, "/category", bytes.NewReader(body)), sut.User.ID)
+	rec := httptest.NewRecorder()
+
+	sut.Handler.Create(rec, req)
+
+	require.Equal(t, http.StatusCreated, rec.Code)
+
+	var response CategoryResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&response))
+	assert.NotEmpty(t, response.ID)
+	assert.Equal(t, sut.User.ID, response.UserId)
+	assert.Equal(t, "Nova", response.Name)
+}
+
+func TestCategoryHandler_Create_ShouldReturnBadRequest_WhenStatusIsInvalid(t *testing.T) {
+	sut := makeSut()
+	seedDefaultData(sut)
+
+	body, _ := json.Marshal(CategoryRequest{Name: "Nova", Status: "DELETED"})
+	req := authenticated(httptest.NewRequest(http.MethodPost, "/category", bytes.NewReader(body)), sut.User.ID)
+	rec := httptest.NewRecorder()
+
+	sut.Handler.Create(rec, req)
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestCategoryHandler_List_ShouldReturnUserCategories(t *testing.T) {
+	sut := makeSut()
+	seedDefaultData(sut)
+
+	req := authenticated(httptest.NewRequest(http.MethodGet, "/category", nil), sut.User.ID)
+	rec := httptest.NewRecorder()
+
+	sut.Handler.List(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var response ListCategoryResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&response))
+	require.Len(t, response.Items, 1)
+	assert.Equal(t, sut.Category.ID, response.Items[0].ID)
+	assert.Empty(t, response.NextCursor)
+}
+
+func TestCategoryHandler_List_ShouldReturnBadRequest_WhenLimitInvalid(t *testing.T) {
+	sut := makeSut()
+	seedDefaultData(sut)
+
+	req := authenticated(httptest.NewRequest(http.MethodGet, "/category?limit=abc", nil), sut.User.ID)
+	rec := httptest.NewRecorder()
+
+	sut.Handler.List(rec, req)
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestCategoryHandler_GetById_ShouldReturnCategory(t *testing.T) {
+	sut := makeSut()
+	seedDefaultData(sut)
+
+	req := httptest.NewRequest(http.MethodGet, "/category/"+sut.Category.ID, nil)
+	req.SetPathValue("id", sut.Category.ID)
+	rec := httptest.NewRecorder()
+
+	sut.Handler.GetById(rec, authenticated(req, sut.User.ID))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var response CategoryResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&response))
+	assert.Equal(t, sut.Category.ID, response.ID)
+	assert.Equal(t, sut.Category.Name, response.Name)
+}
+
+func TestCategoryHandler_GetById_ShouldReturnNotFound_WhenCategoryDoesNotExist(t *testing.T) {
+	sut := makeSut()
+	seedDefaultData(sut)
+
+	req := httptest.NewRequest(http.MethodGet, "/category/not-found", nil)
+	req.SetPathValue("id", "not-found")
+	rec := httptest.NewRecorder()
+
+	sut.Handler.GetById(rec, authenticated(req, sut.User.ID))
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestCategoryHandler_Update_ShouldReturnUpdatedCategory(t *testing.T) {
+	sut := makeSut()
+	seedDefaultData(sut)
+
+	body, _ := json.Marshal(CategoryRequest{Name: "Editada", Status: "INACTIVE"})
+	req := httptest.NewRequest(http.MethodPut, "/category/"+sut.Category.ID, bytes.NewReader(body))
+	req.SetPathValue("id", sut.Category.ID)
+	rec := httptest.NewRecorder()
+
+	sut.Handler.Update(rec, authenticated(req, sut.User.ID))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var response CategoryResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&response))
+	assert.Equal(t, sut.Category.ID, response.ID)
+	assert.Equal(t, "Editada", response.Name)
+	assert.Equal(t, "INACTIVE", response.Status)
+}
+
+func TestCategoryHandler_Update_ShouldReturnForbidden_WhenUserIsNotOwner(t *testing.T) {
+	sut := makeSut()
+	seedDefaultData(sut)
+	sut.UserRepo.Save(context.Background(), &domain.User{ID: "other", Name: "Other", Email: "other@gmail.com"})
+
+	body, _ := json.Marshal(CategoryRequest{Name: "Editada", Status: "ACTIVE"})
+	req := httptest.NewRequest(http.MethodPut, "/category/"+sut.Category.ID, bytes.NewReader(body))
+	req.SetPathValue("id", sut.Category.ID)
+	rec := httptest.NewRecorder()
+
+	sut.Handler.Update(rec, authenticated(req, "other"))
+
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+}
+
+func TestCategoryHandler_Delete_ShouldSoftDeleteCategory(t *testing.T) {
+	sut := makeSut()
+	seedDefaultData(sut)
+
+	req := httptest.NewRequest(http.MethodDelete, "/category/"+sut.Category.ID, nil)
+	req.SetPathValue("id", sut.Category.ID)
+	rec := httptest.NewRecorder()
+
+	sut.Handler.Delete(rec, authenticated(req, sut.User.ID))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var response DeleteCategoryResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&response))
+	assert.Equal(t, sut.Category.ID, response.ID)
+	assert.False(t, response.DeletedAt.IsZero())
+}
+
+func TestCategoryHandler_Delete_ShouldReturnConflict_WhenCategoryHasProducts(t *testing.T) {
+	sut := makeSut()
+	seedDefaultData(sut)
+
+	now := time.Now()
+	_ = sut.ProductRepo.Save(context.Background(), &domain.Product{
+		ID:          "product-01",
+		UserId:      sut.User.ID,
+		CategoryId:  sut.Category.ID,
+		Name:        "Notebook",
+		Description: "Notebook para dev",
+		Status:      string(domain.ProductStatusActive),
+		Price:       5000,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	})
+
+	req := httptest.NewRequest(http.MethodDelete, "/category/"+sut.Category.ID+"?strategy=reject", nil)
+	req.SetPathValue("id", sut.Category.ID)
+	rec := httptest.NewRecorder()
+
+	sut.Handler.Delete(rec, authenticated(req, sut.User.ID))
+
+	assert.Equal(t, http.StatusConflict, rec.Code)
+}
+
+func TestCategoryHandler_Restore_ShouldReturnRestoredCategory(t *testing.T) {
+	sut := makeSut()
+	sut.Category.Delete()
+	seedDefaultData(sut)
+
+	req := httptest.NewRequest(http.MethodPost, "/category/"+sut.Category.ID+"/restore", nil)
+	req.SetPathValue("id", sut.Category.ID)
+	rec := httptest.NewRecorder()
+
+	sut.Handler.Restore(rec, authenticated(req, sut.User.ID))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var response CategoryResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&response))
+	assert.Equal(t, sut.Category.ID, response.ID)
+}
+
+func TestCategoryHandler_Purge_ShouldReturnNoContent(t *testing.T) {
+	sut := makeSut()
+	sut.Category.Delete()
+	seedDefaultData(sut)
+
+	req := httptest.NewRequest(http.MethodDelete, "/category/"+sut.Category.ID+"/purge", nil)
+	req.SetPathValue("id", sut.Category.ID)
+	rec := httptest.NewRecorder()
+
+	sut.Handler.Purge(rec, authenticated(req, sut.User.ID))
+
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+}
@@ -0,0 +1,15 @@
+package health
+
+type StatusResponse struct {
+	Status string `json:"status"`
+}
+
+type ComponentResponse struct {
+	Status    string  `json:"status"`
+	LatencyMs float64 `json:"latency_ms"`
+}
+
+type ReadinessResponse struct {
+	Status     string                       `json:"status"`
+	Components map[string]ComponentResponse `json:"components"`
+}
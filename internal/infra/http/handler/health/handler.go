@@ -0,0 +1,62 @@
+package health
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/areteacademy/internal/infra/health"
+	"github.com/areteacademy/internal/infra/http/response"
+)
+
+const readinessTimeout = 2 * time.Second
+
+type Handler struct {
+	checkers []health.Checker
+}
+
+func NewHandler(checkers ...health.Checker) *Handler {
+	return &Handler{
+		checkers: checkers,
+	}
+}
+
+func (h *Handler) Health(w http.ResponseWriter, r *http.Request) {
+	response.JSON(w, http.StatusOK, StatusResponse{Status: string(health.StatusOk)})
+}
+
+func (h *Handler) Live(w http.ResponseWriter, r *http.Request) {
+	response.JSON(w, http.StatusOK, StatusResponse{Status: string(health.StatusOk)})
+}
+
+func (h *Handler) Ready(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), readinessTimeout)
+	defer cancel()
+
+	report := health.Run(ctx, h.checkers...)
+
+	// The endpoint is unauthenticated, so why a check failed is only logged,
+	// never answered.
+	components := make(map[string]ComponentResponse, len(report.Components))
+	for _, c := range report.Components {
+		if c.Error != "" {
+			log.Printf("readiness check %s failed: %s", c.Name, c.Error)
+		}
+
+		components[c.Name] = ComponentResponse{
+			Status:    string(c.Status),
+			LatencyMs: float64(c.Latency.Microseconds()) / 1000,
+		}
+	}
+
+	status := http.StatusOK
+	if report.Status != health.StatusOk {
+		status = http.StatusServiceUnavailable
+	}
+
+	response.JSON(w, status, ReadinessResponse{
+		Status:     string(report.Status),
+		Components: components,
+	})
+}
@@ -0,0 +1,64 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeChecker struct {
+	name string
+	err  error
+}
+
+func (c fakeChecker) Name() string                    { return c.name }
+func (c fakeChecker) Check(ctx context.Context) error { return c.err }
+
+func TestHealthHandler_Health_ShouldReturnOk(t *testing.T) {
+	handler := NewHandler()
+
+	rec := httptest.NewRecorder()
+	handler.Health(rec, httptest.NewRequest(http.MethodGet, "/health", nil))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"status":"ok"}`, rec.Body.String())
+}
+
+func TestHealthHandler_Ready_ShouldReturnOk_WhenDependenciesAreHealthy(t *testing.T) {
+	handler := NewHandler(fakeChecker{name: "database"}, fakeChecker{name: "migrations"})
+
+	rec := httptest.NewRecorder()
+	handler.Ready(rec, httptest.NewRequest(http.MethodGet, "/health/ready", nil))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var response ReadinessResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&response))
+	assert.Equal(t, "ok", response.Status)
+	assert.Equal(t, "ok", response.Components["database"].Status)
+	assert.Equal(t, "ok", response.Components["migrations"].Status)
+}
+
+func TestHealthHandler_Ready_ShouldReturnServiceUnavailable_WhenDependencyIsDegraded(t *testing.T) {
+	handler := NewHandler(
+		fakeChecker{name: "database", err: errors.New("database is closed")},
+		fakeChecker{name: "migrations"},
+	)
+
+	rec := httptest.NewRecorder()
+	handler.Ready(rec, httptest.NewRequest(http.MethodGet, "/health/ready", nil))
+
+	require.Equal(t, http.StatusServiceUnavailable, rec.Code)
+
+	var response ReadinessResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&response))
+	assert.Equal(t, "degraded", response.Status)
+	assert.Equal(t, "degraded", response.Components["database"].Status)
+	assert.NotContains(t, rec.Body.String(), "database is closed")
+}
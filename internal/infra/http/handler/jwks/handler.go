@@ -0,0 +1,46 @@
+package jwks
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/areteacademy/internal/infra/http/response"
+	security "github.com/areteacademy/internal/infra/security"
+)
+
+// cacheMaxAge is short enough that verifiers see a new key well before it
+// starts signing, as long as keys are added ahead of their activation.
+const cacheMaxAge = "max-age=300"
+
+type Handler struct {
+	keys *security.JwtKeySet
+}
+
+func NewHandler(keys *security.JwtKeySet) *Handler {
+	return &Handler{
+		keys: keys,
+	}
+}
+
+// Keys publishes the public keys tokens may be signed with, including the
+// ones that have not started signing yet.
+func (h *Handler) Keys(w http.ResponseWriter, r *http.Request) {
+	published := h.keys.PublicKeys(time.Now())
+
+	keys := make([]KeyResponse, 0, len(published))
+	for _, key := range published {
+		keys = append(keys, KeyResponse{
+			Kty: key.Kty,
+			Kid: key.Kid,
+			Use: key.Use,
+			Alg: key.Alg,
+			N:   key.N,
+			E:   key.E,
+			Crv: key.Crv,
+			X:   key.X,
+		})
+	}
+
+	w.Header().Set("Cache-Control", cacheMaxAge)
+	response.JSON(w, http.StatusOK, KeySetResponse{Keys: keys})
+}
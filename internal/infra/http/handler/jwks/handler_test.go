@@ -0,0 +1,45 @@
+package jwks
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	security "github.com/areteacademy/internal/infra/security"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJwksHandler_Keys_ShouldPublishCurrentAndUpcomingKeys(t *testing.T) {
+	current, err := security.GenerateJwtKey("current", time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	upcoming, err := security.GenerateJwtKey("upcoming", time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	keys, err := security.NewJwtKeySet(time.Hour, current, upcoming)
+	require.NoError(t, err)
+
+	handler := NewHandler(keys)
+
+	rec := httptest.NewRecorder()
+	handler.Keys(rec, httptest.NewRequest(http.MethodGet, "/.well-known/jwks.json", nil))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "max-age=300", rec.Header().Get("Cache-Control"))
+
+	var response KeySetResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&response))
+	require.Len(t, response.Keys, 2)
+	assert.Equal(t, "current", response.Keys[0].Kid)
+	assert.Equal(t, "upcoming", response.Keys[1].Kid)
+
+	for _, key := range response.Keys {
+		assert.Equal(t, "OKP", key.Kty)
+		assert.Equal(t, "Ed25519", key.Crv)
+		assert.Equal(t, "EdDSA", key.Alg)
+		assert.Equal(t, "sig", key.Use)
+		assert.NotEmpty(t, key.X)
+		assert.Empty(t, key.N)
+	}
+}
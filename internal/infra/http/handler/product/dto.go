@@ -0,0 +1,44 @@
+package product
+
+import "time"
+
+type ProductRequest struct {
+	CategoryId  string `json:"category_id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Status      string `json:"status"`
+	Price       int    `json:"price"`
+}
+
+type ProductResponse struct {
+	ID          string    `json:"id"`
+	UserId      string    `json:"user_id"`
+	CategoryId  string    `json:"category_id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	Status      string    `json:"status"`
+	Price       int       `json:"price"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+type ListProductResponse struct {
+	Items          []ProductResponse `json:"items"`
+	NextCursor     string            `json:"next_cursor,omitempty"`
+	PreviousCursor string            `json:"previous_cursor,omitempty"`
+}
+
+type SearchProductItemResponse struct {
+	ProductResponse
+	Snippet string  `json:"snippet"`
+	Rank    float64 `json:"rank"`
+}
+
+type SearchProductResponse struct {
+	Items []SearchProductItemResponse `json:"items"`
+}
+
+type DeleteProductResponse struct {
+	ID        string    `json:"id"`
+	DeletedAt time.Time `json:"deleted_at"`
+}
@@ -0,0 +1,371 @@
+package product
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/areteacademy/internal/domain"
+	"github.com/areteacademy/internal/infra/http/middleware"
+	"github.com/areteacademy/internal/infra/http/request"
+	"github.com/areteacademy/internal/infra/http/response"
+	createProduct "github.com/areteacademy/internal/usecase/product/create"
+	deleteProduct "github.com/areteacademy/internal/usecase/product/delete"
+	getByIdProduct "github.com/areteacademy/internal/usecase/product/getbyid"
+	listProduct "github.com/areteacademy/internal/usecase/product/listbyuserid"
+	purgeProduct "github.com/areteacademy/internal/usecase/product/purge"
+	restoreProduct "github.com/areteacademy/internal/usecase/product/restore"
+	searchProduct "github.com/areteacademy/internal/usecase/product/search"
+	updateProduct "github.com/areteacademy/internal/usecase/product/update"
+)
+
+type Handler struct {
+	createUseCase  createProduct.CreateProductUseCase
+	getByIdUseCase getByIdProduct.GetByIdProductUseCase
+	listUseCase    listProduct.ListByUserIdProductUseCase
+	searchUseCase  searchProduct.SearchProductUseCase
+	updateUseCase  updateProduct.UpdateProductUseCase
+	deleteUseCase  deleteProduct.DeleteProductUseCase
+	restoreUseCase restoreProduct.RestoreProductUseCase
+	purgeUseCase   purgeProduct.PurgeProductUseCase
+}
+
+func NewHandler(
+	createUseCase createProduct.CreateProductUseCase,
+	getByIdUseCase getByIdProduct.GetByIdProductUseCase,
+	listUseCase listProduct.ListByUserIdProductUseCase,
+	searchUseCase searchProduct.SearchProductUseCase,
+	updateUseCase updateProduct.UpdateProductUseCase,
+	deleteUseCase deleteProduct.DeleteProductUseCase,
+	restoreUseCase restoreProduct.RestoreProductUseCase,
+	purgeUseCase purgeProduct.PurgeProductUseCase,
+) *Handler {
+	return &Handler{
+		createUseCase:  createUseCase,
+		getByIdUseCase: getByIdUseCase,
+		listUseCase:    listUseCase,
+		searchUseCase:  searchUseCase,
+		updateUseCase:  updateUseCase,
+		deleteUseCase:  deleteUseCase,
+		restoreUseCase: restoreUseCase,
+		purgeUseCase:   purgeUseCase,
+	}
+}
+
+func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
+	userId, ok := middleware.UserIdFromContext(r.Context())
+	if !ok {
+		response.Error(w, r, response.ErrUnauthorized)
+		return
+	}
+
+	var body ProductRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		response.Error(w, r, response.ErrInvalidBody)
+		return
+	}
+
+	output, err := h.createUseCase.Perform(r.Context(), createProduct.CreateProductInput{
+		UserId:      userId,
+		CategoryId:  body.CategoryId,
+		Name:        body.Name,
+		Description: body.Description,
+		Status:      body.Status,
+		Price:       body.Price,
+	})
+	if err != nil {
+		response.Error(w, r, err)
+		return
+	}
+
+	response.JSON(w, http.StatusCreated, ProductResponse{
+		ID:          output.ID,
+		UserId:      output.UserId,
+		CategoryId:  output.CategoryId,
+		Name:        output.Name,
+		Description: output.Description,
+		Status:      output.Status,
+		Price:       output.Price,
+		CreatedAt:   output.CreatedAt,
+		UpdatedAt:   output.UpdatedAt,
+	})
+}
+
+func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	userId, ok := middleware.UserIdFromContext(r.Context())
+	if !ok {
+		response.Error(w, r, response.ErrUnauthorized)
+		return
+	}
+
+	input, err := parseListInput(r, userId)
+	if err != nil {
+		response.Error(w, r, err)
+		return
+	}
+
+	output, err := h.listUseCase.Perform(r.Context(), input)
+	if err != nil {
+		response.Error(w, r, err)
+		return
+	}
+
+	products := make([]ProductResponse, 0, len(output.Items))
+	for _, p := range output.Items {
+		products = append(products, ProductResponse{
+			ID:          p.ID,
+			UserId:      p.UserId,
+			CategoryId:  p.CategoryId,
+			Name:        p.Name,
+			Description: p.Description,
+			Status:      p.Status,
+			Price:       p.Price,
+			CreatedAt:   p.CreatedAt,
+			UpdatedAt:   p.UpdatedAt,
+		})
+	}
+
+	response.JSON(w, http.StatusOK, ListProductResponse{
+		Items:          products,
+		NextCursor:     output.NextCursor,
+		PreviousCursor: output.PreviousCursor,
+	})
+}
+
+func (h *Handler) Search(w http.ResponseWriter, r *http.Request) {
+	userId, ok := middleware.UserIdFromContext(r.Context())
+	if !ok {
+		response.Error(w, r, response.ErrUnauthorized)
+		return
+	}
+
+	limit, err := request.OptionalInt(r, "limit", domain.ErrPageSizeInvalid)
+	if err != nil {
+		response.Error(w, r, err)
+		return
+	}
+
+	input := searchProduct.SearchProductInput{
+		UserId: userId,
+		Query:  r.URL.Query().Get("q"),
+	}
+
+	if limit != nil {
+		input.Limit = *limit
+	}
+
+	output, err := h.searchUseCase.Perform(r.Context(), input)
+	if err != nil {
+		response.Error(w, r, err)
+		return
+	}
+
+	products := make([]SearchProductItemResponse, 0, len(output.Items))
+	for _, p := range output.Items {
+		products = append(products, SearchProductItemResponse{
+			ProductResponse: ProductResponse{
+				ID:          p.ID,
+				UserId:      p.UserId,
+				CategoryId:  p.CategoryId,
+				Name:        p.Name,
+				Description: p.Description,
+				Status:      p.Status,
+				Price:       p.Price,
+				CreatedAt:   p.CreatedAt,
+				UpdatedAt:   p.UpdatedAt,
+			},
+			Snippet: p.Snippet,
+			Rank:    p.Rank,
+		})
+	}
+
+	response.JSON(w, http.StatusOK, SearchProductResponse{Items: products})
+}
+
+func (h *Handler) GetById(w http.ResponseWriter, r *http.Request) {
+	userId, ok := middleware.UserIdFromContext(r.Context())
+	if !ok {
+		response.Error(w, r, response.ErrUnauthorized)
+		return
+	}
+
+	output, err := h.getByIdUseCase.Perform(r.Context(), getByIdProduct.GetByIdProductInput{
+		ID:     r.PathValue("id"),
+		UserId: userId,
+	})
+	if err != nil {
+		response.Error(w, r, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, ProductResponse{
+		ID:          output.ID,
+		UserId:      output.UserId,
+		CategoryId:  output.CategoryId,
+		Name:        output.Name,
+		Description: output.Description,
+		Status:      output.Status,
+		Price:       output.Price,
+		CreatedAt:   output.CreatedAt,
+		UpdatedAt:   output.UpdatedAt,
+	})
+}
+
+func (h *Handler) Update(w http.ResponseWriter, r *http.Request) {
+	userId, ok := middleware.UserIdFromContext(r.Context())
+	if !ok {
+		response.Error(w, r, response.ErrUnauthorized)
+		return
+	}
+
+	var body ProductRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		response.Error(w, r, response.ErrInvalidBody)
+		return
+	}
+
+	output, err := h.updateUseCase.Perform(r.Context(), updateProduct.UpdateProductInput{
+		ID:          r.PathValue("id"),
+		UserId:      userId,
+		CategoryId:  body.CategoryId,
+		Name:        body.Name,
+		Description: body.Description,
+		Status:      body.Status,
+		Price:       body.Price,
+	})
+	if err != nil {
+		response.Error(w, r, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, ProductResponse{
+		ID:          output.ID,
+		UserId:      output.UserId,
+		CategoryId:  output.CategoryId,
+		Name:        output.Name,
+		Description: output.Description,
+		Status:      output.Status,
+		Price:       output.Price,
+		CreatedAt:   output.CreatedAt,
+		UpdatedAt:   output.UpdatedAt,
+	})
+}
+
+func (h *Handler) Delete(w http.ResponseWriter, r *http.Request) {
+	userId, ok := middleware.UserIdFromContext(r.Context())
+	if !ok {
+		response.Error(w, r, response.ErrUnauthorized)
+		return
+	}
+
+	output, err := h.deleteUseCase.Perform(r.Context(), deleteProduct.DeleteProductInput{
+		ID:     r.PathValue("id"),
+		UserId: userId,
+	})
+	if err != nil {
+		response.Error(w, r, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, DeleteProductResponse{
+		ID:        output.ID,
+		DeletedAt: output.DeletedAt,
+	})
+}
+
+func (h *Handler) Restore(w http.ResponseWriter, r *http.Request) {
+	userId, ok := middleware.UserIdFromContext(r.Context())
+	if !ok {
+		response.Error(w, r, response.ErrUnauthorized)
+		return
+	}
+
+	output, err := h.restoreUseCase.Perform(r.Context(), restoreProduct.RestoreProductInput{
+		ID:     r.PathValue("id"),
+		UserId: userId,
+	})
+	if err != nil {
+		response.Error(w, r, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, ProductResponse{
+		ID:          output.ID,
+		UserId:      output.UserId,
+		CategoryId:  output.CategoryId,
+		Name:        output.Name,
+		Description: output.Description,
+		Status:      output.Status,
+		Price:       output.Price,
+		CreatedAt:   output.CreatedAt,
+		UpdatedAt:   output.UpdatedAt,
+	})
+}
+
+func (h *Handler) Purge(w http.ResponseWriter, r *http.Request) {
+	userId, ok := middleware.UserIdFromContext(r.Context())
+	if !ok {
+		response.Error(w, r, response.ErrUnauthorized)
+		return
+	}
+
+	err := h.purgeUseCase.Perform(r.Context(), purgeProduct.PurgeProductInput{
+		ID:     r.PathValue("id"),
+		UserId: userId,
+	})
+	if err != nil {
+		response.Error(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// parseListInput reads the filter, sort and page query parameters of GET
+// /product.
+func parseListInput(r *http.Request, userId string) (listProduct.ListByUserIdProductInput, error) {
+	query := r.URL.Query()
+
+	input := listProduct.ListByUserIdProductInput{
+		UserId:     userId,
+		Status:     strings.ToUpper(query.Get("status")),
+		CategoryId: query.Get("category_id"),
+		SortBy:     strings.ToLower(query.Get("sort")),
+		SortOrder:  strings.ToUpper(query.Get("order")),
+	}
+
+	page, err := request.ParsePage(r)
+	if err != nil {
+		return input, err
+	}
+
+	input.Limit = page.Limit
+	input.After = page.After
+	input.Before = page.Before
+
+	if input.MinPrice, err = request.OptionalInt(r, "min_price", domain.ErrProductPriceRangeInvalid); err != nil {
+		return input, err
+	}
+
+	if input.MaxPrice, err = request.OptionalInt(r, "max_price", domain.ErrProductPriceRangeInvalid); err != nil {
+		return input, err
+	}
+
+	if input.CreatedFrom, err = request.OptionalTime(r, "created_from", domain.ErrProductDateRangeInvalid); err != nil {
+		return input, err
+	}
+
+	if input.CreatedTo, err = request.OptionalTime(r, "created_to", domain.ErrProductDateRangeInvalid); err != nil {
+		return input, err
+	}
+
+	if input.UpdatedFrom, err = request.OptionalTime(r, "updated_from", domain.ErrProductDateRangeInvalid); err != nil {
+		return input, err
+	}
+
+	if input.UpdatedTo, err = request.OptionalTime(r, "updated_to", domain.ErrProductDateRangeInvalid); err != nil {
+		return input, err
+	}
+
+	return input, nil
+}
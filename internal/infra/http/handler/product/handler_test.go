@@ -0,0 +1,412 @@
+package product
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+	"github.com/areteacademy/internal/infra/http/middleware"
+	categoryRepo "github.com/areteacademy/internal/infra/repository/category"
+	productRepo "github.com/areteacademy/internal/infra/repository/product"
+	"github.com/areteacademy/internal/infra/repository/transaction"
+	userRepo "github.com/areteacademy/internal/infra/repository/user"
+	createProduct "github.com/areteacademy/internal/usecase/product/create"
+	deleteProduct "github.com/areteacademy/internal/usecase/product/delete"
+	getByIdProduct "github.com/areteacademy/internal/usecase/product/getbyid"
+	listProduct "github.com/areteacademy/internal/usecase/product/listbyuserid"
+	purgeProduct "github.com/areteacademy/internal/usecase/product/purge"
+	restoreProduct "github.com/areteacademy/internal/usecase/product/restore"
+	searchProduct "github.com/areteacademy/internal/usecase/product/search"
+	updateProduct "github.com/areteacademy/internal/usecase/product/update"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type SUT struct {
+	Handler      *Handler
+	ProductRepo  *productRepo.InMemoryProductRepository
+	CategoryRepo *categoryRepo.InMemoryCategoryRepository
+	UserRepo     *userRepo.InMemoryUserRepository
+	User         *domain.User
+	Category     *domain.Category
+	Product      *domain.Product
+}
+
+func makeSut() SUT {
+	productRepo := productRepo.NewInMemoryProductRepository()
+	categoryRepo := categoryRepo.NewInMemoryCategoryRepository()
+	userRepo := userRepo.NewInMemoryUserRepository()
+	unitOfWork := transaction.NewInMemoryUnitOfWork(userRepo, categoryRepo, productRepo)
+	handler := NewHandler(
+		createProduct.NewCreateProductUseCase(unitOfWork),
+		getByIdProduct.NewGetByIdProductUseCase(productRepo, userRepo),
+		listProduct.NewListByUserIdProductUseCase(productRepo, userRepo),
+		searchProduct.NewSearchProductUseCase(productRepo, userRepo),
+		updateProduct.NewUpdateProductUseCase(unitOfWork),
+		deleteProduct.NewDeleteProductUseCase(productRepo, userRepo),
+		restoreProduct.NewRestoreProductUseCase(productRepo, categoryRepo, userRepo),
+		purgeProduct.NewPurgeProductUseCase(productRepo, userRepo),
+	)
+
+	now := time.Now()
+	user := &domain.User{
+		ID:        "123456",
+		Name:      "Daniel",
+		Email:     "daniel@gmail.com",
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	category := &domain.Category{
+		ID:        "cat-01",
+		UserId:    user.ID,
+		Name:      "Categoria",
+		Status:    string(domain.CategoryStatusActive),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	product := &domain.Product{
+		ID:          "prod-01",
+		UserId:      user.ID,
+		CategoryId:  category.ID,
+		Name:        "Notebook",
+		Description: "Notebook para dev",
+		Status:      string(domain.ProductStatusActive),
+		Price:       5000,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+
+	return SUT{
+		Handler:      handler,
+		ProductRepo:  productRepo,
+		CategoryRepo: categoryRepo,
+		UserRepo:     userRepo,
+		User:         user,
+		Category:     category,
+		Product:      product,
+	}
+}
+
+func seedDefaultData(sut SUT) {
+	sut.UserRepo.Save(context.Background(), sut.User)
+	sut.CategoryRepo.Save(context.Background(), sut.Category)
+	sut.ProductRepo.Save(context.Background(), sut.Product)
+}
+
+func authenticated(r *http.Request, userId string) *http.Request {
+	return r.WithContext(middleware.WithUserId(r.Context(), userId))
+}
+
+func TestProductHandler_Create_ShouldReturnCreated(t *testing.T) {
+	sut := makeSut()
+	seedDefaultData(sut)
+
+	body, _ := json.Marshal(ProductRequest{
+		CategoryId:  sut.Category.ID,
+		Name:        "Mouse",
+		Description: "Mouse sem fio",
+		Status:      "ACTIVE",
+		Price:       150,
+	})
+	req := authenticated(httptest.NewRequest(http.MethodPost, "/product", bytes.NewReader(body)), sut.User.ID)
+	rec := httptest.NewRecorder()
+
+	sut.Handler.Create(rec, req)
+
+	require.Equal(t, http.StatusCreated, rec.Code)
+
+	var response ProductResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&response))
+	assert.NotEmpty(t, response.ID)
+	assert.Equal(t, sut.Category.ID, response.CategoryId)
+	assert.Equal(t, 150, response.Price)
+}
+
+func TestProductHandler_Create_ShouldReturnNotFound_WhenCategoryDoesNotExist(t *testing.T) {
+	sut := makeSut()
+	seedDefaultData(sut)
+
+	body, _ := json.Marshal(ProductRequest{
+		CategoryId:  "not-found",
+		Name:        "Mouse",
+		Description: "Mouse sem fio",
+		Status:      "ACTIVE",
+		Price:       150,
+	})
+	req := authenticated(httptest.NewRequest(http.MethodPost, "/product", bytes.NewReader(body)), sut.User.ID)
+	rec := httptest.NewRecorder()
+
+	sut.Handler.Create(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestProductHandler_List_ShouldReturnUserProducts(t *testing.T) {
+	sut := makeSut()
+	seedDefaultData(sut)
+
+	req := authenticated(httptest.NewRequest(http.MethodGet, "/product", nil), sut.User.ID)
+	rec := httptest.NewRecorder()
+
+	sut.Handler.List(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var response ListProductResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&response))
+	require.Len(t, response.Items, 1)
+	assert.Equal(t, sut.Product.ID, response.Items[0].ID)
+}
+
+func TestProductHandler_List_ShouldReturnNextCursor_WhenMoreProductsExist(t *testing.T) {
+	sut := makeSut()
+	seedDefaultData(sut)
+
+	second := *sut.Product
+	second.ID = "product-02"
+	second.CreatedAt = sut.Product.CreatedAt.Add(time.Minute)
+	require.NoError(t, sut.ProductRepo.Save(context.Background(), &second))
+
+	req := authenticated(httptest.NewRequest(http.MethodGet, "/product?limit=1", nil), sut.User.ID)
+	rec := httptest.NewRecorder()
+
+	sut.Handler.List(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var first ListProductResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&first))
+	require.Len(t, first.Items, 1)
+	assert.Equal(t, sut.Product.ID, first.Items[0].ID)
+	require.NotEmpty(t, first.NextCursor)
+
+	req = authenticated(httptest.NewRequest(http.MethodGet, "/product?limit=1&after="+first.NextCursor, nil), sut.User.ID)
+	rec = httptest.NewRecorder()
+
+	sut.Handler.List(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var next ListProductResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&next))
+	require.Len(t, next.Items, 1)
+	assert.Equal(t, second.ID, next.Items[0].ID)
+	assert.Empty(t, next.NextCursor)
+	assert.NotEmpty(t, next.PreviousCursor)
+}
+
+func TestProductHandler_List_ShouldApplyFilterAndSortFromQuery(t *testing.T) {
+	sut := makeSut()
+	seedDefaultData(sut)
+
+	cheaper := *sut.Product
+	cheaper.ID = "product-02"
+	cheaper.Price = sut.Product.Price / 2
+	require.NoError(t, sut.ProductRepo.Save(context.Background(), &cheaper))
+
+	inactive := *sut.Product
+	inactive.ID = "product-03"
+	inactive.Status = string(domain.ProductStatusInactive)
+	require.NoError(t, sut.ProductRepo.Save(context.Background(), &inactive))
+
+	req := authenticated(httptest.NewRequest(http.MethodGet, "/product?status=active&sort=price&order=asc", nil), sut.User.ID)
+	rec := httptest.NewRecorder()
+
+	sut.Handler.List(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var response ListProductResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&response))
+	require.Len(t, response.Items, 2)
+	assert.Equal(t, cheaper.ID, response.Items[0].ID)
+	assert.Equal(t, sut.Product.ID, response.Items[1].ID)
+}
+
+func TestProductHandler_List_ShouldReturnBadRequest_WhenQueryInvalid(t *testing.T) {
+	testCases := []struct {
+		name  string
+		query string
+	}{
+		{name: "Min price not a number", query: "min_price=cheap"},
+		{name: "Created from not a date", query: "created_from=yesterday"},
+		{name: "Unknown sort field", query: "sort=description"},
+		{name: "Unknown order", query: "order=sideways"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sut := makeSut()
+			seedDefaultData(sut)
+
+			req := authenticated(httptest.NewRequest(http.MethodGet, "/product?"+tc.query, nil), sut.User.ID)
+			rec := httptest.NewRecorder()
+
+			sut.Handler.List(rec, req)
+
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+		})
+	}
+}
+
+func TestProductHandler_Search_ShouldReturnHighlightedMatches(t *testing.T) {
+	sut := makeSut()
+	seedDefaultData(sut)
+
+	req := authenticated(httptest.NewRequest(http.MethodGet, "/product/search?q=note", nil), sut.User.ID)
+	rec := httptest.NewRecorder()
+
+	sut.Handler.Search(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var response SearchProductResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&response))
+	require.Len(t, response.Items, 1)
+	assert.Equal(t, sut.Product.ID, response.Items[0].ID)
+	assert.Equal(t, "<mark>Notebook</mark>", response.Items[0].Snippet)
+}
+
+func TestProductHandler_Search_ShouldReturnBadRequest_WhenQueryInvalid(t *testing.T) {
+	testCases := []struct {
+		name  string
+		query string
+	}{
+		{name: "Missing query", query: ""},
+		{name: "Blank query", query: "q=%20%2A"},
+		{name: "Limit not a number", query: "q=note&limit=many"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sut := makeSut()
+			seedDefaultData(sut)
+
+			req := authenticated(httptest.NewRequest(http.MethodGet, "/product/search?"+tc.query, nil), sut.User.ID)
+			rec := httptest.NewRecorder()
+
+			sut.Handler.Search(rec, req)
+
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+		})
+	}
+}
+
+func TestProductHandler_GetById_ShouldReturnProduct(t *testing.T) {
+	sut := makeSut()
+	seedDefaultData(sut)
+
+	req := httptest.NewRequest(http.MethodGet, "/product/"+sut.Product.ID, nil)
+	req.SetPathValue("id", sut.Product.ID)
+	rec := httptest.NewRecorder()
+
+	sut.Handler.GetById(rec, authenticated(req, sut.User.ID))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var response ProductResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&response))
+	assert.Equal(t, sut.Product.ID, response.ID)
+	assert.Equal(t, sut.Product.Name, response.Name)
+}
+
+func TestProductHandler_Update_ShouldReturnUpdatedProduct(t *testing.T) {
+	sut := makeSut()
+	seedDefaultData(sut)
+
+	body, _ := json.Marshal(ProductRequest{
+		CategoryId:  sut.Category.ID,
+		Name:        "Notebook editado",
+		Description: sut.Product.Description,
+		Status:      "INACTIVE",
+		Price:       4500,
+	})
+	req := httptest.NewRequest(http.MethodPut, "/product/"+sut.Product.ID, bytes.NewReader(body))
+	req.SetPathValue("id", sut.Product.ID)
+	rec := httptest.NewRecorder()
+
+	sut.Handler.Update(rec, authenticated(req, sut.User.ID))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var response ProductResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&response))
+	assert.Equal(t, "Notebook editado", response.Name)
+	assert.Equal(t, "INACTIVE", response.Status)
+	assert.Equal(t, 4500, response.Price)
+}
+
+func TestProductHandler_Update_ShouldReturnBadRequest_WhenPriceIsInvalid(t *testing.T) {
+	sut := makeSut()
+	seedDefaultData(sut)
+
+	body, _ := json.Marshal(ProductRequest{
+		CategoryId:  sut.Category.ID,
+		Name:        sut.Product.Name,
+		Description: sut.Product.Description,
+		Status:      sut.Product.Status,
+		Price:       0,
+	})
+	req := httptest.NewRequest(http.MethodPut, "/product/"+sut.Product.ID, bytes.NewReader(body))
+	req.SetPathValue("id", sut.Product.ID)
+	rec := httptest.NewRecorder()
+
+	sut.Handler.Update(rec, authenticated(req, sut.User.ID))
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestProductHandler_Delete_ShouldSoftDeleteProduct(t *testing.T) {
+	sut := makeSut()
+	seedDefaultData(sut)
+
+	req := httptest.NewRequest(http.MethodDelete, "/product/"+sut.Product.ID, nil)
+	req.SetPathValue("id", sut.Product.ID)
+	rec := httptest.NewRecorder()
+
+	sut.Handler.Delete(rec, authenticated(req, sut.User.ID))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var response DeleteProductResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&response))
+	assert.Equal(t, sut.Product.ID, response.ID)
+	assert.False(t, response.DeletedAt.IsZero())
+}
+
+func TestProductHandler_Restore_ShouldReturnRestoredProduct(t *testing.T) {
+	sut := makeSut()
+	sut.Product.Delete()
+	seedDefaultData(sut)
+
+	req := httptest.NewRequest(http.MethodPost, "/product/"+sut.Product.ID+"/restore", nil)
+	req.SetPathValue("id", sut.Product.ID)
+	rec := httptest.NewRecorder()
+
+	sut.Handler.Restore(rec, authenticated(req, sut.User.ID))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var response ProductResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&response))
+	assert.Equal(t, sut.Product.ID, response.ID)
+}
+
+func TestProductHandler_Purge_ShouldReturnNoContent(t *testing.T) {
+	sut := makeSut()
+	sut.Product.Delete()
+	seedDefaultData(sut)
+
+	req := httptest.NewRequest(http.MethodDelete, "/product/"+sut.Product.ID+"/purge", nil)
+	req.SetPathValue("id", sut.Product.ID)
+	rec := httptest.NewRecorder()
+
+	sut.Handler.Purge(rec, authenticated(req, sut.User.ID))
+
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+}
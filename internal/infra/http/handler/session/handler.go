@@ -0,0 +1,114 @@
+package session
+
+import (
+	"net/http"
+
+	"github.com/areteacademy/internal/domain"
+	"github.com/areteacademy/internal/infra/http/middleware"
+	"github.com/areteacademy/internal/infra/http/response"
+	listSessions "github.com/areteacademy/internal/usecase/session/list"
+	revokeSession "github.com/areteacademy/internal/usecase/session/revoke"
+	revokeAllSessions "github.com/areteacademy/internal/usecase/session/revokeall"
+)
+
+type Handler struct {
+	listUseCase      listSessions.ListSessionsUseCase
+	revokeUseCase    revokeSession.RevokeSessionUseCase
+	revokeAllUseCase revokeAllSessions.RevokeAllSessionsUseCase
+}
+
+func NewHandler(
+	listUseCase listSessions.ListSessionsUseCase,
+	revokeUseCase revokeSession.RevokeSessionUseCase,
+	revokeAllUseCase revokeAllSessions.RevokeAllSessionsUseCase,
+) *Handler {
+	return &Handler{
+		listUseCase:      listUseCase,
+		revokeUseCase:    revokeUseCase,
+		revokeAllUseCase: revokeAllUseCase,
+	}
+}
+
+func (h *Handler) List(w http.ResponseWriter, r *http.Request) {
+	userId, ok := middleware.UserIdFromContext(r.Context())
+	if !ok {
+		response.Error(w, r, response.ErrUnauthorized)
+		return
+	}
+
+	sessionId, _ := middleware.SessionIdFromContext(r.Context())
+
+	output, err := h.listUseCase.Perform(r.Context(), listSessions.ListSessionsInput{
+		UserId:           userId,
+		CurrentSessionId: sessionId,
+	})
+	if err != nil {
+		response.Error(w, r, err)
+		return
+	}
+
+	sessions := make([]SessionResponse, 0, len(output.Items))
+	for _, s := range output.Items {
+		sessions = append(sessions, SessionResponse{
+			ID:         s.ID,
+			Device:     s.Device,
+			IP:         s.IP,
+			Current:    s.Current,
+			LastUsedAt: s.LastUsedAt,
+			ExpiresAt:  s.ExpiresAt,
+			CreatedAt:  s.CreatedAt,
+		})
+	}
+
+	response.JSON(w, http.StatusOK, ListSessionResponse{Items: sessions})
+}
+
+func (h *Handler) Revoke(w http.ResponseWriter, r *http.Request) {
+	h.revoke(w, r, r.PathValue("id"))
+}
+
+// Logout revokes the session the request was made from.
+func (h *Handler) Logout(w http.ResponseWriter, r *http.Request) {
+	sessionId, ok := middleware.SessionIdFromContext(r.Context())
+	if !ok {
+		response.Error(w, r, domain.ErrSessionIdIsRequired)
+		return
+	}
+
+	h.revoke(w, r, sessionId)
+}
+
+func (h *Handler) RevokeAll(w http.ResponseWriter, r *http.Request) {
+	userId, ok := middleware.UserIdFromContext(r.Context())
+	if !ok {
+		response.Error(w, r, response.ErrUnauthorized)
+		return
+	}
+
+	err := h.revokeAllUseCase.Perform(r.Context(), revokeAllSessions.RevokeAllSessionsInput{UserId: userId})
+	if err != nil {
+		response.Error(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) revoke(w http.ResponseWriter, r *http.Request, sessionId string) {
+	userId, ok := middleware.UserIdFromContext(r.Context())
+	if !ok {
+		response.Error(w, r, response.ErrUnauthorized)
+		return
+	}
+
+	err := h.revokeUseCase.Perform(r.Context(), revokeSession.RevokeSessionInput{
+		ID:     sessionId,
+		UserId: userId,
+	})
+	if err != nil {
+		response.Error(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
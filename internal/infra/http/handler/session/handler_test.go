@@ -0,0 +1,169 @@
+package session
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+	"github.com/areteacademy/internal/infra/http/middleware"
+	sessionRepo "github.com/areteacademy/internal/infra/repository/session"
+	userRepo "github.com/areteacademy/internal/infra/repository/user"
+	listSessions "github.com/areteacademy/internal/usecase/session/list"
+	revokeSession "github.com/areteacademy/internal/usecase/session/revoke"
+	revokeAllSessions "github.com/areteacademy/internal/usecase/session/revokeall"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type SUT struct {
+	Handler     *Handler
+	SessionRepo *sessionRepo.InMemorySessionRepository
+	User        *domain.User
+	Current     *domain.Session
+	Other       *domain.Session
+}
+
+func makeSut(t *testing.T) SUT {
+	sessionRepo := sessionRepo.NewInMemorySessionRepository()
+	userRepo := userRepo.NewInMemoryUserRepository()
+	handler := NewHandler(
+		listSessions.NewListSessionsUseCase(userRepo, sessionRepo),
+		revokeSession.NewRevokeSessionUseCase(sessionRepo),
+		revokeAllSessions.NewRevokeAllSessionsUseCase(userRepo, sessionRepo),
+	)
+
+	now := time.Now()
+	user := &domain.User{
+		ID:        "123456",
+		Name:      "Daniel",
+		Email:     "daniel@gmail.com",
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	require.NoError(t, userRepo.Save(context.Background(), user))
+
+	sessions := make([]*domain.Session, 2)
+	for i, device := range []string{"Firefox", "Chrome"} {
+		session, token, _, err := domain.NewSession(user, device, "192.0.2.1", time.Hour, now.Add(-time.Duration(i)*time.Minute))
+		require.NoError(t, err)
+		require.NoError(t, sessionRepo.Create(context.Background(), session, token))
+		sessions[i] = session
+	}
+
+	return SUT{
+		Handler:     handler,
+		SessionRepo: sessionRepo,
+		User:        user,
+		Current:     sessions[0],
+		Other:       sessions[1],
+	}
+}
+
+func authenticated(r *http.Request, userId, sessionId string) *http.Request {
+	return r.WithContext(middleware.WithSessionId(middleware.WithUserId(r.Context(), userId), sessionId))
+}
+
+func TestSessionHandler_List_ShouldMarkCurrentSession(t *testing.T) {
+	sut := makeSut(t)
+
+	req := authenticated(httptest.NewRequest(http.MethodGet, "/user/sessions", nil), sut.User.ID, sut.Current.ID)
+	rec := httptest.NewRecorder()
+
+	sut.Handler.List(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var response ListSessionResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&response))
+	require.Len(t, response.Items, 2)
+	assert.Equal(t, sut.Current.ID, response.Items[0].ID)
+	assert.True(t, response.Items[0].Current)
+	assert.Equal(t, "Firefox", response.Items[0].Device)
+	assert.False(t, response.Items[1].Current)
+}
+
+func TestSessionHandler_Revoke_ShouldReturnNoContent(t *testing.T) {
+	sut := makeSut(t)
+
+	req := authenticated(httptest.NewRequest(http.MethodDelete, "/user/sessions/"+sut.Other.ID, nil), sut.User.ID, sut.Current.ID)
+	req.SetPathValue("id", sut.Other.ID)
+	rec := httptest.NewRecorder()
+
+	sut.Handler.Revoke(rec, req)
+
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	assert.True(t, sut.Other.IsRevoked())
+	assert.False(t, sut.Current.IsRevoked())
+}
+
+func TestSessionHandler_Revoke_ShouldReturnNotFound_WhenSessionBelongsToAnotherUser(t *testing.T) {
+	sut := makeSut(t)
+
+	req := authenticated(httptest.NewRequest(http.MethodDelete, "/user/sessions/"+sut.Other.ID, nil), "654321", "")
+	req.SetPathValue("id", sut.Other.ID)
+	rec := httptest.NewRecorder()
+
+	sut.Handler.Revoke(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	assert.False(t, sut.Other.IsRevoked())
+}
+
+func TestSessionHandler_Logout_ShouldRevokeCurrentSession(t *testing.T) {
+	sut := makeSut(t)
+
+	req := authenticated(httptest.NewRequest(http.MethodPost, "/logout", nil), sut.User.ID, sut.Current.ID)
+	rec := httptest.NewRecorder()
+
+	sut.Handler.Logout(rec, req)
+
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	assert.True(t, sut.Current.IsRevoked())
+	assert.False(t, sut.Other.IsRevoked())
+}
+
+func TestSessionHandler_Logout_ShouldReturnBadRequest_WithoutSession(t *testing.T) {
+	sut := makeSut(t)
+
+	req := authenticated(httptest.NewRequest(http.MethodPost, "/logout", nil), sut.User.ID, "")
+	rec := httptest.NewRecorder()
+
+	sut.Handler.Logout(rec, req)
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestSessionHandler_RevokeAll_ShouldRevokeEverySession(t *testing.T) {
+	sut := makeSut(t)
+
+	req := authenticated(httptest.NewRequest(http.MethodDelete, "/user/sessions", nil), sut.User.ID, sut.Current.ID)
+	rec := httptest.NewRecorder()
+
+	sut.Handler.RevokeAll(rec, req)
+
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	assert.True(t, sut.Current.IsRevoked())
+	assert.True(t, sut.Other.IsRevoked())
+}
+
+func TestSessionHandler_ShouldReturnUnauthorized_WithoutUser(t *testing.T) {
+	sut := makeSut(t)
+
+	for name, handle := range map[string]http.HandlerFunc{
+		"List":      sut.Handler.List,
+		"Revoke":    sut.Handler.Revoke,
+		"RevokeAll": sut.Handler.RevokeAll,
+	} {
+		t.Run(name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+
+			handle(rec, httptest.NewRequest(http.MethodGet, "/user/sessions", nil))
+
+			assert.Equal(t, http.StatusUnauthorized, rec.Code)
+		})
+	}
+}
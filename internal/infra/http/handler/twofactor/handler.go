@@ -0,0 +1,102 @@
+package twofactor
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/areteacademy/internal/infra/http/middleware"
+	"github.com/areteacademy/internal/infra/http/response"
+	confirmTwoFactor "github.com/areteacademy/internal/usecase/twofactor/confirm"
+	disableTwoFactor "github.com/areteacademy/internal/usecase/twofactor/disable"
+	enrollTwoFactor "github.com/areteacademy/internal/usecase/twofactor/enroll"
+)
+
+type Handler struct {
+	enrollUseCase  enrollTwoFactor.EnrollTwoFactorUseCase
+	confirmUseCase confirmTwoFactor.ConfirmTwoFactorUseCase
+	disableUseCase disableTwoFactor.DisableTwoFactorUseCase
+}
+
+func NewHandler(
+	enrollUseCase enrollTwoFactor.EnrollTwoFactorUseCase,
+	confirmUseCase confirmTwoFactor.ConfirmTwoFactorUseCase,
+	disableUseCase disableTwoFactor.DisableTwoFactorUseCase,
+) *Handler {
+	return &Handler{
+		enrollUseCase:  enrollUseCase,
+		confirmUseCase: confirmUseCase,
+		disableUseCase: disableUseCase,
+	}
+}
+
+func (h *Handler) Enroll(w http.ResponseWriter, r *http.Request) {
+	userId, ok := middleware.UserIdFromContext(r.Context())
+	if !ok {
+		response.Error(w, r, response.ErrUnauthorized)
+		return
+	}
+
+	output, err := h.enrollUseCase.Perform(r.Context(), enrollTwoFactor.EnrollTwoFactorInput{UserId: userId})
+	if err != nil {
+		response.Error(w, r, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, EnrollTwoFactorResponse{
+		Secret:          output.Secret,
+		ProvisioningURI: output.ProvisioningURI,
+	})
+}
+
+func (h *Handler) Confirm(w http.ResponseWriter, r *http.Request) {
+	userId, ok := middleware.UserIdFromContext(r.Context())
+	if !ok {
+		response.Error(w, r, response.ErrUnauthorized)
+		return
+	}
+
+	var body ConfirmTwoFactorRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		response.Error(w, r, response.ErrInvalidBody)
+		return
+	}
+
+	output, err := h.confirmUseCase.Perform(r.Context(), confirmTwoFactor.ConfirmTwoFactorInput{
+		UserId: userId,
+		Code:   body.Code,
+	})
+	if err != nil {
+		response.Error(w, r, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, ConfirmTwoFactorResponse{
+		RecoveryCodes: output.RecoveryCodes,
+	})
+}
+
+func (h *Handler) Disable(w http.ResponseWriter, r *http.Request) {
+	userId, ok := middleware.UserIdFromContext(r.Context())
+	if !ok {
+		response.Error(w, r, response.ErrUnauthorized)
+		return
+	}
+
+	var body DisableTwoFactorRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		response.Error(w, r, response.ErrInvalidBody)
+		return
+	}
+
+	err := h.disableUseCase.Perform(r.Context(), disableTwoFactor.DisableTwoFactorInput{
+		UserId:   userId,
+		Password: body.Password,
+		Code:     body.Code,
+	})
+	if err != nil {
+		response.Error(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,145 @@
+package twofactor
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+	"github.com/areteacademy/internal/infra/http/middleware"
+	twoFactorRepo "github.com/areteacademy/internal/infra/repository/twofactor"
+	userRepo "github.com/areteacademy/internal/infra/repository/user"
+	security "github.com/areteacademy/internal/infra/security"
+	confirmTwoFactor "github.com/areteacademy/internal/usecase/twofactor/confirm"
+	disableTwoFactor "github.com/areteacademy/internal/usecase/twofactor/disable"
+	enrollTwoFactor "github.com/areteacademy/internal/usecase/twofactor/enroll"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const password = "@Daniel123"
+
+type SUT struct {
+	Handler       *Handler
+	TwoFactorRepo *twoFactorRepo.InMemoryTwoFactorRepository
+	User          *domain.User
+}
+
+func makeSut(t *testing.T) SUT {
+	userRepo := userRepo.NewInMemoryUserRepository()
+	twoFactorRepo := twoFactorRepo.NewInMemoryTwoFactorRepository()
+	hasher := security.NewBcryptPasswordHasher()
+	handler := NewHandler(
+		enrollTwoFactor.NewEnrollTwoFactorUseCase(userRepo, twoFactorRepo, "areteacademy"),
+		confirmTwoFactor.NewConfirmTwoFactorUseCase(twoFactorRepo),
+		disableTwoFactor.NewDisableTwoFactorUseCase(userRepo, twoFactorRepo, hasher),
+	)
+
+	hash, err := hasher.Hash(password)
+	require.NoError(t, err)
+
+	now := time.Now()
+	user := &domain.User{
+		ID:        "123456",
+		Name:      "Daniel",
+		Email:     "daniel@gmail.com",
+		Password:  hash,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	require.NoError(t, userRepo.Save(context.Background(), user))
+
+	return SUT{
+		Handler:       handler,
+		TwoFactorRepo: twoFactorRepo,
+		User:          user,
+	}
+}
+
+func authenticated(r *http.Request, userId string) *http.Request {
+	return r.WithContext(middleware.WithUserId(r.Context(), userId))
+}
+
+func TestTwoFactorHandler_ShouldEnrollConfirmAndDisable(t *testing.T) {
+	sut := makeSut(t)
+
+	req := authenticated(httptest.NewRequest(http.MethodPost, "/user/2fa", nil), sut.User.ID)
+	rec := httptest.NewRecorder()
+
+	sut.Handler.Enroll(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var enrolment EnrollTwoFactorResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&enrolment))
+	assert.Contains(t, enrolment.ProvisioningURI, "otpauth://totp/")
+
+	code, err := (&domain.TwoFactor{Secret: enrolment.Secret}).CodeAt(time.Now())
+	require.NoError(t, err)
+
+	body, _ := json.Marshal(ConfirmTwoFactorRequest{Code: code})
+	req = authenticated(httptest.NewRequest(http.MethodPost, "/user/2fa/confirm", bytes.NewReader(body)), sut.User.ID)
+	rec = httptest.NewRecorder()
+
+	sut.Handler.Confirm(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var confirmation ConfirmTwoFactorResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&confirmation))
+	require.Len(t, confirmation.RecoveryCodes, domain.RecoveryCodeCount)
+
+	body, _ = json.Marshal(DisableTwoFactorRequest{Password: password, Code: confirmation.RecoveryCodes[0]})
+	req = authenticated(httptest.NewRequest(http.MethodPost, "/user/2fa/disable", bytes.NewReader(body)), sut.User.ID)
+	rec = httptest.NewRecorder()
+
+	sut.Handler.Disable(rec, req)
+
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+}
+
+func TestTwoFactorHandler_Confirm_ShouldReturnConflict_WhenNotEnrolled(t *testing.T) {
+	sut := makeSut(t)
+
+	body, _ := json.Marshal(ConfirmTwoFactorRequest{Code: "123456"})
+	req := authenticated(httptest.NewRequest(http.MethodPost, "/user/2fa/confirm", bytes.NewReader(body)), sut.User.ID)
+	rec := httptest.NewRecorder()
+
+	sut.Handler.Confirm(rec, req)
+
+	assert.Equal(t, http.StatusConflict, rec.Code)
+}
+
+func TestTwoFactorHandler_Disable_ShouldReturnBadRequest_WhenPasswordIsWrong(t *testing.T) {
+	sut := makeSut(t)
+
+	now := time.Now()
+	require.NoError(t, sut.TwoFactorRepo.Save(context.Background(), &domain.TwoFactor{UserId: sut.User.ID, EnabledAt: &now}))
+
+	body, _ := json.Marshal(DisableTwoFactorRequest{Password: "@Wrong1234", Code: "123456"})
+	req := authenticated(httptest.NewRequest(http.MethodPost, "/user/2fa/disable", bytes.NewReader(body)), sut.User.ID)
+	rec := httptest.NewRecorder()
+
+	sut.Handler.Disable(rec, req)
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	stored, err := sut.TwoFactorRepo.GetByUserId(context.Background(), sut.User.ID)
+	require.NoError(t, err)
+	assert.NotNil(t, stored)
+}
+
+func TestTwoFactorHandler_ShouldReturnUnauthorized_WhenUnauthenticated(t *testing.T) {
+	sut := makeSut(t)
+
+	req := httptest.NewRequest(http.MethodPost, "/user/2fa", nil)
+	rec := httptest.NewRecorder()
+
+	sut.Handler.Enroll(rec, req)
+
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+}
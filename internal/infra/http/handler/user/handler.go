@@ -0,0 +1,194 @@
+package user
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/areteacademy/internal/infra/http/middleware"
+	"github.com/areteacademy/internal/infra/http/response"
+	changePasswordUser "github.com/areteacademy/internal/usecase/user/changepassword"
+	createUser "github.com/areteacademy/internal/usecase/user/create"
+	getByIdUser "github.com/areteacademy/internal/usecase/user/getbyid"
+	sendVerification "github.com/areteacademy/internal/usecase/user/sendverification"
+	updateUser "github.com/areteacademy/internal/usecase/user/update"
+	verifyEmail "github.com/areteacademy/internal/usecase/user/verifyemail"
+)
+
+type Handler struct {
+	createUseCase         createUser.CreateUserUseCase
+	getByIdUseCase        getByIdUser.GetByIdUserUseCase
+	updateUseCase         updateUser.UpdateUserUseCase
+	changePasswordUseCase changePasswordUser.ChangePasswordUserUseCase
+	sendVerification      sendVerification.SendEmailVerificationUserUseCase
+	verifyEmailUseCase    verifyEmail.VerifyEmailUserUseCase
+}
+
+func NewHandler(
+	createUseCase createUser.CreateUserUseCase,
+	getByIdUseCase getByIdUser.GetByIdUserUseCase,
+	updateUseCase updateUser.UpdateUserUseCase,
+	changePasswordUseCase changePasswordUser.ChangePasswordUserUseCase,
+	sendVerificationUseCase sendVerification.SendEmailVerificationUserUseCase,
+	verifyEmailUseCase verifyEmail.VerifyEmailUserUseCase,
+) *Handler {
+	return &Handler{
+		createUseCase:         createUseCase,
+		getByIdUseCase:        getByIdUseCase,
+		updateUseCase:         updateUseCase,
+		changePasswordUseCase: changePasswordUseCase,
+		sendVerification:      sendVerificationUseCase,
+		verifyEmailUseCase:    verifyEmailUseCase,
+	}
+}
+
+func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
+	var body CreateUserRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		response.Error(w, r, response.ErrInvalidBody)
+		return
+	}
+
+	output, err := h.createUseCase.Perform(r.Context(), &createUser.CreateUserInput{
+		Name:     body.Name,
+		Email:    body.Email,
+		Password: body.Password,
+	})
+	if err != nil {
+		response.Error(w, r, err)
+		return
+	}
+
+	response.JSON(w, http.StatusCreated, UserResponse{
+		ID:            output.ID,
+		Name:          output.Name,
+		Email:         output.Email,
+		EmailVerified: output.EmailVerified,
+		CreatedAt:     output.CreatedAt,
+		UpdatedAt:     output.UpdatedAt,
+	})
+}
+
+func (h *Handler) Get(w http.ResponseWriter, r *http.Request) {
+	userId, ok := middleware.UserIdFromContext(r.Context())
+	if !ok {
+		response.Error(w, r, response.ErrUnauthorized)
+		return
+	}
+
+	output, err := h.getByIdUseCase.Perform(r.Context(), userId)
+	if err != nil {
+		response.Error(w, r, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, UserResponse{
+		ID:            output.ID,
+		Name:          output.Name,
+		Email:         output.Email,
+		PendingEmail:  output.PendingEmail,
+		EmailVerified: output.EmailVerified,
+		Role:          output.Role,
+		Locale:        output.Locale,
+		CreatedAt:     output.CreatedAt,
+		UpdatedAt:     output.UpdatedAt,
+	})
+}
+
+func (h *Handler) Update(w http.ResponseWriter, r *http.Request) {
+	userId, ok := middleware.UserIdFromContext(r.Context())
+	if !ok {
+		response.Error(w, r, response.ErrUnauthorized)
+		return
+	}
+
+	var body UpdateUserRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		response.Error(w, r, response.ErrInvalidBody)
+		return
+	}
+
+	output, err := h.updateUseCase.Perform(r.Context(), updateUser.UpdateUserInput{
+		ID:     userId,
+		Name:   body.Name,
+		Email:  body.Email,
+		Locale: body.Locale,
+	})
+	if err != nil {
+		response.Error(w, r, err)
+		return
+	}
+
+	response.JSON(w, http.StatusOK, UserResponse{
+		ID:            output.ID,
+		Name:          output.Name,
+		Email:         output.Email,
+		PendingEmail:  output.PendingEmail,
+		EmailVerified: output.EmailVerified,
+		Locale:        output.Locale,
+		CreatedAt:     output.CreatedAt,
+		UpdatedAt:     output.UpdatedAt,
+	})
+}
+
+func (h *Handler) ChangePassword(w http.ResponseWriter, r *http.Request) {
+	userId, ok := middleware.UserIdFromContext(r.Context())
+	if !ok {
+		response.Error(w, r, response.ErrUnauthorized)
+		return
+	}
+
+	var body ChangePasswordRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		response.Error(w, r, response.ErrInvalidBody)
+		return
+	}
+
+	err := h.changePasswordUseCase.Perform(r.Context(), changePasswordUser.ChangePasswordUserInput{
+		ID:              userId,
+		CurrentPassword: body.CurrentPassword,
+		NewPassword:     body.NewPassword,
+	})
+	if err != nil {
+		response.Error(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) SendEmailVerification(w http.ResponseWriter, r *http.Request) {
+	userId, ok := middleware.UserIdFromContext(r.Context())
+	if !ok {
+		response.Error(w, r, response.ErrUnauthorized)
+		return
+	}
+
+	err := h.sendVerification.Perform(r.Context(), sendVerification.SendEmailVerificationUserInput{
+		ID: userId,
+	})
+	if err != nil {
+		response.Error(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// VerifyEmail needs no authentication: the mailed token is the proof.
+func (h *Handler) VerifyEmail(w http.ResponseWriter, r *http.Request) {
+	var body VerifyEmailRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		response.Error(w, r, response.ErrInvalidBody)
+		return
+	}
+
+	err := h.verifyEmailUseCase.Perform(r.Context(), verifyEmail.VerifyEmailUserInput{
+		Token: body.Token,
+	})
+	if err != nil {
+		response.Error(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,352 @@
+package user
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+	"github.com/areteacademy/internal/infra/http/middleware"
+	"github.com/areteacademy/internal/infra/http/response"
+	"github.com/areteacademy/internal/infra/mail"
+	"github.com/areteacademy/internal/infra/report"
+	emailVerificationRepo "github.com/areteacademy/internal/infra/repository/emailverification"
+	repo "github.com/areteacademy/internal/infra/repository/user"
+	security "github.com/areteacademy/internal/infra/security"
+	changePasswordUser "github.com/areteacademy/internal/usecase/user/changepassword"
+	createUser "github.com/areteacademy/internal/usecase/user/create"
+	getByIdUser "github.com/areteacademy/internal/usecase/user/getbyid"
+	sendVerification "github.com/areteacademy/internal/usecase/user/sendverification"
+	updateUser "github.com/areteacademy/internal/usecase/user/update"
+	verifyEmail "github.com/areteacademy/internal/usecase/user/verifyemail"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type SUT struct {
+	Handler *Handler
+	Repo    *repo.InMemoryUserRepository
+	Mailer  *mail.InMemoryMailer
+	User    *domain.User
+}
+
+func makeSut() SUT {
+	repo := repo.NewInMemoryUserRepository()
+	tokenRepo := emailVerificationRepo.NewInMemoryEmailVerificationTokenRepository()
+	mailer := mail.NewInMemoryMailer()
+	send := sendVerification.NewSendEmailVerificationUserUseCase(repo, tokenRepo, mailer, time.Hour)
+	reporter := report.NewInMemoryErrorReporter()
+	handler := NewHandler(
+		createUser.NewCreateUserUseCase(repo, security.NewBcryptPasswordHasher(), send, domain.DefaultPasswordPolicy(), reporter),
+		getByIdUser.NewGetByIdUserUseCase(repo),
+		updateUser.NewUpdateUserUseCase(repo, send, reporter),
+		changePasswordUser.NewChangePasswordUserUseCase(repo, security.NewBcryptPasswordHasher(), domain.DefaultPasswordHistorySize, domain.DefaultPasswordPolicy()),
+		send,
+		verifyEmail.NewVerifyEmailUserUseCase(repo, tokenRepo),
+	)
+
+	now := time.Now()
+
+	user := &domain.User{
+		ID:        "123456",
+		Name:      "Daniel",
+		Email:     "daniel@gmail.com",
+		Role:      domain.RoleUser,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	return SUT{
+		Handler: handler,
+		Repo:    repo,
+		Mailer:  mailer,
+		User:    user,
+	}
+}
+
+func authenticated(r *http.Request, userId string) *http.Request {
+	return r.WithContext(middleware.WithUserId(r.Context(), userId))
+}
+
+func TestUserHandler_Create_ShouldReturnCreated(t *testing.T) {
+	sut := makeSut()
+
+	body, _ := json.Marshal(CreateUserRequest{
+		Name:     "Daniel",
+		Email:    "daniel@gmail.com",
+		Password: "@Secret123",
+	})
+	req := httptest.NewRequest(http.MethodPost, "/user", bytes.NewReader(body))
+	rec := httptest.NewRecorder()
+
+	sut.Handler.Create(rec, req)
+
+	require.Equal(t, http.StatusCreated, rec.Code)
+
+	var response UserResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&response))
+	assert.NotEmpty(t, response.ID)
+	assert.Equal(t, "Daniel", response.Name)
+	assert.Equal(t, "daniel@gmail.com", response.Email)
+}
+
+func TestUserHandler_Create_ShouldReturnBadRequest_WhenBodyIsInvalid(t *testing.T) {
+	testCases := []struct {
+		name string
+		body string
+	}{
+		{name: "Malformed JSON", body: "{"},
+		{name: "Invalid Email", body: `{"name":"Daniel","email":"daniel","password":"@Secret123"}`},
+		{name: "Weak Password", body: `{"name":"Daniel","email":"daniel@gmail.com","password":"daniel"}`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sut := makeSut()
+
+			req := httptest.NewRequest(http.MethodPost, "/user", bytes.NewBufferString(tc.body))
+			rec := httptest.NewRecorder()
+
+			sut.Handler.Create(rec, req)
+
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+		})
+	}
+}
+
+func TestUserHandler_Create_ShouldListPasswordViolations(t *testing.T) {
+	sut := makeSut()
+
+	body, _ := json.Marshal(CreateUserRequest{
+		Name:     "Daniel",
+		Email:    "daniel@gmail.com",
+		Password: "@daniel1",
+	})
+	req := httptest.NewRequest(http.MethodPost, "/user", bytes.NewReader(body))
+	rec := httptest.NewRecorder()
+
+	sut.Handler.Create(rec, req)
+
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	var problem response.Problem
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&problem))
+	assert.Equal(t, "validation.failed", problem.Code)
+	assert.Equal(t, []response.ProblemError{
+		{Code: "user.password_invalid.uppercase", Field: "password", Detail: "must contain an uppercase letter"},
+		{Code: "user.password_invalid.contains_name", Field: "password", Detail: "must not contain your name"},
+		{Code: "user.password_invalid.contains_email", Field: "password", Detail: "must not contain your email"},
+	}, problem.Errors)
+}
+
+func TestUserHandler_Create_ShouldListEveryInvalidField(t *testing.T) {
+	sut := makeSut()
+
+	body, _ := json.Marshal(CreateUserRequest{
+		Email:    "daniel",
+		Password: "secret",
+	})
+	req := httptest.NewRequest(http.MethodPost, "/user", bytes.NewReader(body))
+	rec := httptest.NewRecorder()
+
+	sut.Handler.Create(rec, req)
+
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	var problem response.Problem
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&problem))
+
+	fields := make(map[string][]string)
+	for _, fieldErr := range problem.Errors {
+		fields[fieldErr.Field] = append(fields[fieldErr.Field], fieldErr.Code)
+	}
+
+	assert.Equal(t, []string{"user.name_required"}, fields["name"])
+	assert.Equal(t, []string{"user.email_invalid"}, fields["email"])
+	assert.Contains(t, fields["password"], "user.password_invalid.min_length")
+	assert.Contains(t, fields["password"], "user.password_invalid.uppercase")
+}
+
+func TestUserHandler_Create_ShouldReturnConflict_WhenEmailAlreadyInUse(t *testing.T) {
+	sut := makeSut()
+	require.NoError(t, sut.Repo.Save(context.Background(), sut.User))
+
+	body, _ := json.Marshal(CreateUserRequest{
+		Name:     "Outro Daniel",
+		Email:    "Daniel@Gmail.com",
+		Password: "@Secret123",
+	})
+	req := httptest.NewRequest(http.MethodPost, "/user", bytes.NewReader(body))
+	rec := httptest.NewRecorder()
+
+	sut.Handler.Create(rec, req)
+
+	assert.Equal(t, http.StatusConflict, rec.Code)
+}
+
+func TestUserHandler_Get_ShouldReturnAuthenticatedUser(t *testing.T) {
+	sut := makeSut()
+	require.NoError(t, sut.Repo.Save(context.Background(), sut.User))
+
+	req := authenticated(httptest.NewRequest(http.MethodGet, "/user", nil), sut.User.ID)
+	rec := httptest.NewRecorder()
+
+	sut.Handler.Get(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var response UserResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&response))
+	assert.Equal(t, sut.User.ID, response.ID)
+	assert.Equal(t, sut.User.Email, response.Email)
+	assert.Equal(t, "USER", response.Role)
+}
+
+func TestUserHandler_Get_ShouldReturnUnauthorized_WhenUserIdIsMissing(t *testing.T) {
+	sut := makeSut()
+
+	req := httptest.NewRequest(http.MethodGet, "/user", nil)
+	rec := httptest.NewRecorder()
+
+	sut.Handler.Get(rec, req)
+
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+}
+
+func TestUserHandler_Get_ShouldReturnNotFound_WhenUserDoesNotExist(t *testing.T) {
+	sut := makeSut()
+
+	req := authenticated(httptest.NewRequest(http.MethodGet, "/user", nil), "not-found")
+	rec := httptest.NewRecorder()
+
+	sut.Handler.Get(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestUserHandler_Update_ShouldReturnUpdatedUser(t *testing.T) {
+	sut := makeSut()
+	require.NoError(t, sut.Repo.Save(context.Background(), sut.User))
+
+	body, _ := json.Marshal(UpdateUserRequest{
+		Name:  "Daniel Editado",
+		Email: "daniel.editado@gmail.com",
+	})
+	req := authenticated(httptest.NewRequest(http.MethodPut, "/user", bytes.NewReader(body)), sut.User.ID)
+	rec := httptest.NewRecorder()
+
+	sut.Handler.Update(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var response UserResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&response))
+	assert.Equal(t, "Daniel Editado", response.Name)
+	assert.Equal(t, "daniel@gmail.com", response.Email)
+	assert.Equal(t, "daniel.editado@gmail.com", response.PendingEmail)
+}
+
+func TestUserHandler_ChangePassword_ShouldReturnNoContent(t *testing.T) {
+	sut := makeSut()
+	hash, err := security.NewBcryptPasswordHasher().Hash("@Secret123")
+	require.NoError(t, err)
+	sut.User.Password = hash
+	require.NoError(t, sut.Repo.Save(context.Background(), sut.User))
+
+	body, _ := json.Marshal(ChangePasswordRequest{
+		CurrentPassword: "@Secret123",
+		NewPassword:     "@Secret456",
+	})
+	req := authenticated(httptest.NewRequest(http.MethodPut, "/user/password", bytes.NewReader(body)), sut.User.ID)
+	rec := httptest.NewRecorder()
+
+	sut.Handler.ChangePassword(rec, req)
+
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	assert.Equal(t, 1, sut.User.TokenVersion)
+}
+
+func TestUserHandler_ChangePassword_ShouldReturnBadRequest_WhenCurrentPasswordIsWrong(t *testing.T) {
+	sut := makeSut()
+	hash, err := security.NewBcryptPasswordHasher().Hash("@Secret123")
+	require.NoError(t, err)
+	sut.User.Password = hash
+	require.NoError(t, sut.Repo.Save(context.Background(), sut.User))
+
+	body, _ := json.Marshal(ChangePasswordRequest{
+		CurrentPassword: "@Wrong123",
+		NewPassword:     "@Secret456",
+	})
+	req := authenticated(httptest.NewRequest(http.MethodPut, "/user/password", bytes.NewReader(body)), sut.User.ID)
+	rec := httptest.NewRecorder()
+
+	sut.Handler.ChangePassword(rec, req)
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Equal(t, 0, sut.User.TokenVersion)
+}
+
+func TestUserHandler_VerifyEmail_ShouldConfirmMailedToken(t *testing.T) {
+	sut := makeSut()
+	require.NoError(t, sut.Repo.Save(context.Background(), sut.User))
+
+	req := authenticated(httptest.NewRequest(http.MethodPost, "/user/email/verification", nil), sut.User.ID)
+	rec := httptest.NewRecorder()
+
+	sut.Handler.SendEmailVerification(rec, req)
+
+	require.Equal(t, http.StatusAccepted, rec.Code)
+	require.NotNil(t, sut.Mailer.Last())
+
+	lines := strings.Split(strings.TrimSpace(sut.Mailer.Last().Body), "\n")
+	token := lines[len(lines)-1]
+
+	body, _ := json.Marshal(VerifyEmailRequest{Token: token})
+	req = httptest.NewRequest(http.MethodPost, "/user/email/verify", bytes.NewReader(body))
+	rec = httptest.NewRecorder()
+
+	sut.Handler.VerifyEmail(rec, req)
+
+	require.Equal(t, http.StatusNoContent, rec.Code)
+
+	req = authenticated(httptest.NewRequest(http.MethodGet, "/user", nil), sut.User.ID)
+	rec = httptest.NewRecorder()
+
+	sut.Handler.Get(rec, req)
+
+	var response UserResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&response))
+	assert.True(t, response.EmailVerified)
+}
+
+func TestUserHandler_SendEmailVerification_ShouldReturnConflict_WhenAlreadyVerified(t *testing.T) {
+	sut := makeSut()
+	verifiedAt := time.Now()
+	sut.User.EmailVerifiedAt = &verifiedAt
+	require.NoError(t, sut.Repo.Save(context.Background(), sut.User))
+
+	req := authenticated(httptest.NewRequest(http.MethodPost, "/user/email/verification", nil), sut.User.ID)
+	rec := httptest.NewRecorder()
+
+	sut.Handler.SendEmailVerification(rec, req)
+
+	assert.Equal(t, http.StatusConflict, rec.Code)
+	assert.Empty(t, sut.Mailer.Sent)
+}
+
+func TestUserHandler_VerifyEmail_ShouldReturnBadRequest_WhenTokenIsInvalid(t *testing.T) {
+	sut := makeSut()
+
+	body, _ := json.Marshal(VerifyEmailRequest{Token: "invalid"})
+	req := httptest.NewRequest(http.MethodPost, "/user/email/verify", bytes.NewReader(body))
+	rec := httptest.NewRecorder()
+
+	sut.Handler.VerifyEmail(rec, req)
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
@@ -0,0 +1,106 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/areteacademy/internal/domain"
+	"github.com/areteacademy/internal/infra/http/request"
+	"github.com/areteacademy/internal/infra/http/response"
+	authenticate "github.com/areteacademy/internal/usecase/auth/authenticate"
+)
+
+type contextKey string
+
+const (
+	userIdKey    contextKey = "user_id"
+	roleKey      contextKey = "role"
+	sessionIdKey contextKey = "session_id"
+)
+
+func WithUserId(ctx context.Context, userId string) context.Context {
+	return context.WithValue(ctx, userIdKey, userId)
+}
+
+func UserIdFromContext(ctx context.Context) (string, bool) {
+	userId, ok := ctx.Value(userIdKey).(string)
+	return userId, ok && userId != ""
+}
+
+func WithActor(ctx context.Context, actor domain.Actor) context.Context {
+	return context.WithValue(WithUserId(ctx, actor.UserId), roleKey, actor.Role)
+}
+
+// ActorFromContext returns the authenticated user with the role their
+// token was issued for.
+func ActorFromContext(ctx context.Context) (domain.Actor, bool) {
+	userId, ok := UserIdFromContext(ctx)
+	if !ok {
+		return domain.Actor{}, false
+	}
+
+	role, _ := ctx.Value(roleKey).(domain.Role)
+
+	return domain.Actor{UserId: userId, Role: role}, true
+}
+
+func WithSessionId(ctx context.Context, sessionId string) context.Context {
+	return context.WithValue(ctx, sessionIdKey, sessionId)
+}
+
+// SessionIdFromContext returns the session the access token was issued
+// for. Requests made with an API key have none.
+func SessionIdFromContext(ctx context.Context) (string, bool) {
+	sessionId, ok := ctx.Value(sessionIdKey).(string)
+	return sessionId, ok && sessionId != ""
+}
+
+// NewAuthenticate only accepts access tokens, for routes API keys must not
+// reach such as account and key management.
+func NewAuthenticate(authenticateUseCase authenticate.AuthenticateUseCase) func(http.Handler) http.Handler {
+	return newAuthenticate(authenticateUseCase, false, "")
+}
+
+// NewAuthenticateScoped also accepts API keys that grant scope, and rejects
+// users who have not verified their email when requireVerifiedEmail is set.
+func NewAuthenticateScoped(authenticateUseCase authenticate.AuthenticateUseCase, requireVerifiedEmail bool, scope domain.ApiKeyScope) func(http.Handler) http.Handler {
+	return newAuthenticate(authenticateUseCase, requireVerifiedEmail, scope)
+}
+
+func newAuthenticate(authenticateUseCase authenticate.AuthenticateUseCase, requireVerifiedEmail bool, scope domain.ApiKeyScope) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			token, ok := bearerToken(r)
+			if !ok {
+				response.Error(w, r, response.ErrUnauthorized)
+				return
+			}
+
+			output, err := authenticateUseCase.Perform(r.Context(), authenticate.AuthenticateInput{
+				Token:                token,
+				RequireVerifiedEmail: requireVerifiedEmail,
+				Scope:                scope,
+			})
+			if err != nil {
+				response.Error(w, r, err)
+				return
+			}
+
+			actor := domain.Actor{UserId: output.UserId, Role: output.Role}
+			ctx := WithSessionId(WithActor(r.Context(), actor), output.SessionId)
+			ctx = request.WithLocale(ctx, output.Locale)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+func bearerToken(r *http.Request) (string, bool) {
+	scheme, token, found := strings.Cut(r.Header.Get("Authorization"), " ")
+	if !found || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+
+	token = strings.TrimSpace(token)
+	return token, token != ""
+}
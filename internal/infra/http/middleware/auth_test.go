@@ -0,0 +1,155 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+	apiKeyRepo "github.com/areteacademy/internal/infra/repository/apikey"
+	sessionRepo "github.com/areteacademy/internal/infra/repository/session"
+	repo "github.com/areteacademy/internal/infra/repository/user"
+	security "github.com/areteacademy/internal/infra/security"
+	authenticate "github.com/areteacademy/internal/usecase/auth/authenticate"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type SUT struct {
+	Tokens   *security.JwtTokenService
+	ApiKeys  *apiKeyRepo.InMemoryApiKeyRepository
+	Sessions *sessionRepo.InMemorySessionRepository
+	UseCase  authenticate.AuthenticateUseCase
+	Next     http.Handler
+}
+
+func makeSut(t *testing.T) SUT {
+	keys, err := security.NewEphemeralJwtKeySet()
+	require.NoError(t, err)
+
+	tokens, err := security.NewJwtTokenService(security.JwtConfig{
+		Keys:       keys,
+		Expiration: time.Hour,
+	})
+	require.NoError(t, err)
+
+	users := repo.NewInMemoryUserRepository()
+	require.NoError(t, users.Save(context.Background(), &domain.User{ID: "user-01", Role: domain.RoleAdmin}))
+
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		actor, _ := ActorFromContext(r.Context())
+		sessionId, _ := SessionIdFromContext(r.Context())
+		_, _ = w.Write([]byte(actor.UserId + " " + string(actor.Role) + " " + sessionId))
+	})
+
+	apiKeys := apiKeyRepo.NewInMemoryApiKeyRepository()
+	sessions := sessionRepo.NewInMemorySessionRepository()
+
+	return SUT{
+		Tokens:   tokens,
+		ApiKeys:  apiKeys,
+		Sessions: sessions,
+		UseCase:  authenticate.NewAuthenticateUseCase(users, tokens, apiKeys, sessions),
+		Next:     next,
+	}
+}
+
+func TestAuthenticate_ShouldResolveActorFromBearerToken(t *testing.T) {
+	sut := makeSut(t)
+	handler := NewAuthenticate(sut.UseCase)(sut.Next)
+
+	user := &domain.User{ID: "user-01", Role: domain.RoleAdmin}
+	session, refreshToken, _, err := domain.NewSession(user, "", "", time.Hour, time.Now())
+	require.NoError(t, err)
+	require.NoError(t, sut.Sessions.Create(context.Background(), session, refreshToken))
+
+	token, _, err := sut.Tokens.Generate(user, session.ID)
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodGet, "/user", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	rec := httptest.NewRecorder()
+
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "user-01 ADMIN "+session.ID, rec.Body.String())
+}
+
+func TestAuthenticate_ShouldReturnUnauthorized(t *testing.T) {
+	testCases := []struct {
+		name          string
+		authorization string
+	}{
+		{name: "Missing Header", authorization: ""},
+		{name: "Wrong Scheme", authorization: "Basic dXNlcjpwYXNz"},
+		{name: "Empty Token", authorization: "Bearer "},
+		{name: "Invalid Token", authorization: "Bearer invalid"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sut := makeSut(t)
+			handler := NewAuthenticate(sut.UseCase)(sut.Next)
+
+			req := httptest.NewRequest(http.MethodGet, "/user", nil)
+			if tc.authorization != "" {
+				req.Header.Set("Authorization", tc.authorization)
+			}
+			rec := httptest.NewRecorder()
+
+			handler.ServeHTTP(rec, req)
+
+			assert.Equal(t, http.StatusUnauthorized, rec.Code)
+		})
+	}
+}
+
+func TestAuthenticateScoped_ShouldAcceptApiKeyOnlyForItsScope(t *testing.T) {
+	sut := makeSut(t)
+
+	key, secret, err := domain.NewApiKey("user-01", "CI", []string{"products:read"}, nil, time.Now())
+	require.NoError(t, err)
+	require.NoError(t, sut.ApiKeys.Save(context.Background(), key))
+
+	testCases := []struct {
+		name         string
+		handler      http.Handler
+		expectedCode int
+		expectedBody string
+	}{
+		{
+			name:         "Granted Scope",
+			handler:      NewAuthenticateScoped(sut.UseCase, false, domain.ApiKeyScopeProductsRead)(sut.Next),
+			expectedCode: http.StatusOK,
+			expectedBody: "user-01  ",
+		},
+		{
+			name:         "Other Scope",
+			handler:      NewAuthenticateScoped(sut.UseCase, false, domain.ApiKeyScopeProductsWrite)(sut.Next),
+			expectedCode: http.StatusForbidden,
+		},
+		{
+			name:         "Token Only Route",
+			handler:      NewAuthenticate(sut.UseCase)(sut.Next),
+			expectedCode: http.StatusForbidden,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/product", nil)
+			req.Header.Set("Authorization", "Bearer "+secret)
+			rec := httptest.NewRecorder()
+
+			tc.handler.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.expectedCode, rec.Code)
+			if tc.expectedBody != "" {
+				assert.Equal(t, tc.expectedBody, rec.Body.String())
+			}
+		})
+	}
+}
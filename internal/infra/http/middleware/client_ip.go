@@ -0,0 +1,62 @@
+package middleware
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strings"
+)
+
+const clientIPKey contextKey = "client_ip"
+
+func WithClientIP(ctx context.Context, ip string) context.Context {
+	return context.WithValue(ctx, clientIPKey, ip)
+}
+
+func ClientIPFromContext(ctx context.Context) string {
+	ip, _ := ctx.Value(clientIPKey).(string)
+	return ip
+}
+
+// NewClientIP stores the client address in the request context. Only set
+// trustForwardedFor when the service runs behind a proxy that appends to
+// X-Forwarded-For; the last entry, written by that proxy, is used.
+func NewClientIP(trustForwardedFor bool) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ip := remoteIP(r)
+
+			if trustForwardedFor {
+				if forwarded, ok := forwardedIP(r); ok {
+					ip = forwarded
+				}
+			}
+
+			next.ServeHTTP(w, r.WithContext(WithClientIP(r.Context(), ip)))
+		})
+	}
+}
+
+func remoteIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+
+	return host
+}
+
+func forwardedIP(r *http.Request) (string, bool) {
+	values := r.Header.Values("X-Forwarded-For")
+	if len(values) == 0 {
+		return "", false
+	}
+
+	entries := strings.Split(values[len(values)-1], ",")
+	ip := strings.TrimSpace(entries[len(entries)-1])
+	if net.ParseIP(ip) == nil {
+		return "", false
+	}
+
+	return ip, true
+}
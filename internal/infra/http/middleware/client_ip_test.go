@@ -0,0 +1,43 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientIP_ShouldResolveAddress(t *testing.T) {
+	testCases := []struct {
+		name              string
+		trustForwardedFor bool
+		forwardedFor      []string
+		expected          string
+	}{
+		{name: "Remote Address", expected: "192.0.2.1"},
+		{name: "Untrusted Header", forwardedFor: []string{"203.0.113.9"}, expected: "192.0.2.1"},
+		{name: "Trusted Header", trustForwardedFor: true, forwardedFor: []string{"203.0.113.9"}, expected: "203.0.113.9"},
+		{name: "Last Proxy Entry", trustForwardedFor: true, forwardedFor: []string{"10.0.0.1", "198.51.100.7, 203.0.113.9"}, expected: "203.0.113.9"},
+		{name: "Malformed Header", trustForwardedFor: true, forwardedFor: []string{"not-an-ip"}, expected: "192.0.2.1"},
+		{name: "Missing Header", trustForwardedFor: true, expected: "192.0.2.1"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(ClientIPFromContext(r.Context())))
+			})
+
+			req := httptest.NewRequest(http.MethodPost, "/login", nil)
+			for _, value := range tc.forwardedFor {
+				req.Header.Add("X-Forwarded-For", value)
+			}
+			rec := httptest.NewRecorder()
+
+			NewClientIP(tc.trustForwardedFor)(next).ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.expected, rec.Body.String())
+		})
+	}
+}
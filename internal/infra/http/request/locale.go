@@ -0,0 +1,57 @@
+package request
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/areteacademy/internal/domain"
+	"golang.org/x/text/language"
+)
+
+type contextKey string
+
+const localeKey contextKey = "locale"
+
+// localeMatcher falls back to its first tag, so DefaultLocale goes first.
+var localeMatcher, matchedLocales = newLocaleMatcher()
+
+func newLocaleMatcher() (language.Matcher, []domain.Locale) {
+	locales := []domain.Locale{domain.DefaultLocale}
+	for _, locale := range domain.SupportedLocales {
+		if locale != domain.DefaultLocale {
+			locales = append(locales, locale)
+		}
+	}
+
+	tags := make([]language.Tag, 0, len(locales))
+	for _, locale := range locales {
+		tags = append(tags, language.MustParse(string(locale)))
+	}
+
+	return language.NewMatcher(tags), locales
+}
+
+// WithLocale records the locale the authenticated user prefers.
+func WithLocale(ctx context.Context, locale domain.Locale) context.Context {
+	return context.WithValue(ctx, localeKey, locale)
+}
+
+// Locale returns the locale to answer r in: the one the user prefers when
+// they set one, and else the closest supported match for Accept-Language.
+func Locale(r *http.Request) domain.Locale {
+	if locale, ok := r.Context().Value(localeKey).(domain.Locale); ok && locale != "" {
+		return locale
+	}
+
+	tags, _, err := language.ParseAcceptLanguage(r.Header.Get("Accept-Language"))
+	if err != nil || len(tags) == 0 {
+		return domain.DefaultLocale
+	}
+
+	_, index, confidence := localeMatcher.Match(tags...)
+	if confidence == language.No {
+		return domain.DefaultLocale
+	}
+
+	return matchedLocales[index]
+}
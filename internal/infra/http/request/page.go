@@ -0,0 +1,34 @@
+package request
+
+import (
+	"net/http"
+
+	"github.com/areteacademy/internal/domain"
+)
+
+// Page holds the cursor pagination query parameters shared by the listings.
+type Page struct {
+	Limit  int
+	After  string
+	Before string
+}
+
+func ParsePage(r *http.Request) (Page, error) {
+	query := r.URL.Query()
+
+	page := Page{
+		After:  query.Get("after"),
+		Before: query.Get("before"),
+	}
+
+	limit, err := OptionalInt(r, "limit", domain.ErrPageSizeInvalid)
+	if err != nil {
+		return Page{}, err
+	}
+
+	if limit != nil {
+		page.Limit = *limit
+	}
+
+	return page, nil
+}
@@ -0,0 +1,39 @@
+package request
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// OptionalInt reads an integer query parameter, returning nil when it is
+// absent and invalid when it cannot be parsed.
+func OptionalInt(r *http.Request, key string, invalid error) (*int, error) {
+	raw := r.URL.Query().Get(key)
+	if raw == "" {
+		return nil, nil
+	}
+
+	value, err := strconv.Atoi(raw)
+	if err != nil {
+		return nil, invalid
+	}
+
+	return &value, nil
+}
+
+// OptionalTime reads an RFC 3339 query parameter, returning nil when it is
+// absent and invalid when it cannot be parsed.
+func OptionalTime(r *http.Request, key string, invalid error) (*time.Time, error) {
+	raw := r.URL.Query().Get(key)
+	if raw == "" {
+		return nil, nil
+	}
+
+	value, err := time.Parse(time.RFC3339, raw)
+	if err != nil {
+		return nil, invalid
+	}
+
+	return &value, nil
+}
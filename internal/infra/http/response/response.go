@@ -0,0 +1,126 @@
+package response
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/areteacademy/internal/domain"
+	"github.com/areteacademy/internal/infra/http/request"
+	"github.com/areteacademy/internal/infra/i18n"
+)
+
+const (
+	problemContentType = "application/problem+json"
+	problemTypeDefault = "about:blank"
+)
+
+var (
+	ErrInvalidBody    = domain.NewError(domain.ErrorKindValidation, "request.body_invalid", "", "invalid request body")
+	ErrUnauthorized   = domain.NewError(domain.ErrorKindUnauthorized, "auth.unauthorized", "", "unauthorized")
+	ErrInternalServer = errors.New("internal server error")
+)
+
+// Problem is an RFC 7807 problem details body. Code and Field are extension
+// members clients can match on instead of Detail, and Errors lists every
+// field violation of a validation problem.
+type Problem struct {
+	Type   string         `json:"type"`
+	Title  string         `json:"title"`
+	Status int            `json:"status"`
+	Detail string         `json:"detail"`
+	Code   string         `json:"code"`
+	Field  string         `json:"field,omitempty"`
+	Errors []ProblemError `json:"errors,omitempty"`
+}
+
+type ProblemError struct {
+	Code   string `json:"code"`
+	Field  string `json:"field,omitempty"`
+	Detail string `json:"detail"`
+}
+
+var kindStatuses = map[domain.ErrorKind]int{
+	domain.ErrorKindValidation:   http.StatusBadRequest,
+	domain.ErrorKindUnauthorized: http.StatusUnauthorized,
+	domain.ErrorKindForbidden:    http.StatusForbidden,
+	domain.ErrorKindNotFound:     http.StatusNotFound,
+	domain.ErrorKindConflict:     http.StatusConflict,
+	domain.ErrorKindRateLimited:  http.StatusTooManyRequests,
+	domain.ErrorKindUnavailable:  http.StatusServiceUnavailable,
+}
+
+func JSON(w http.ResponseWriter, status int, body any) {
+	write(w, status, "application/json", body)
+}
+
+// Error answers with the problem err describes, in the locale r asks for.
+// Only the message of a domain.Error reaches the client, never the errors
+// wrapping or wrapped by it, so database and driver messages stay on the
+// server.
+func Error(w http.ResponseWriter, r *http.Request, err error) {
+	locale := request.Locale(r)
+	problem := ProblemFromError(err, locale)
+
+	w.Header().Set("Content-Language", string(locale))
+	write(w, problem.Status, problemContentType, problem)
+}
+
+func ProblemFromError(err error, locale domain.Locale) Problem {
+	var domainErr *domain.Error
+	if !errors.As(err, &domainErr) {
+		return Problem{
+			Type:   problemTypeDefault,
+			Title:  http.StatusText(http.StatusInternalServerError),
+			Status: http.StatusInternalServerError,
+			Detail: i18n.Translate(locale, i18n.InternalCode, nil, ErrInternalServer.Error()),
+			Code:   i18n.InternalCode,
+		}
+	}
+
+	status := StatusFromError(domainErr)
+
+	problem := Problem{
+		Type:   problemTypeDefault,
+		Title:  http.StatusText(status),
+		Status: status,
+		Detail: i18n.TranslateError(locale, domainErr),
+		Code:   domainErr.Code,
+		Field:  domainErr.Field,
+	}
+
+	for _, fieldErr := range domain.FieldErrors(err) {
+		problem.Errors = append(problem.Errors, ProblemError{
+			Code:   fieldErr.Code,
+			Field:  fieldErr.Field,
+			Detail: i18n.TranslateError(locale, fieldErr),
+		})
+	}
+
+	return problem
+}
+
+func StatusFromError(err error) int {
+	var domainErr *domain.Error
+	if !errors.As(err, &domainErr) {
+		return http.StatusInternalServerError
+	}
+
+	status, ok := kindStatuses[domainErr.Kind]
+	if !ok {
+		return http.StatusInternalServerError
+	}
+
+	return status
+}
+
+func write(w http.ResponseWriter, status int, contentType string, body any) {
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(status)
+
+	if body == nil {
+		return
+	}
+
+	_ = json.NewEncoder(w).Encode(body)
+}
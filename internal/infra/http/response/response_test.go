@@ -0,0 +1,166 @@
+package response
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/areteacademy/internal/domain"
+	"github.com/areteacademy/internal/infra/http/request"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestError_ShouldWriteProblemDetails(t *testing.T) {
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/category", nil)
+
+	Error(rec, req, fmt.Errorf("create category: %w", domain.ErrCategoryNameIsRequired))
+
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Equal(t, "application/problem+json", rec.Header().Get("Content-Type"))
+	assert.Equal(t, "en", rec.Header().Get("Content-Language"))
+
+	var problem Problem
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&problem))
+	assert.Equal(t, Problem{
+		Type:   "about:blank",
+		Title:  "Bad Request",
+		Status: http.StatusBadRequest,
+		Detail: "name is required",
+		Code:   "category.name_required",
+		Field:  "name",
+		Errors: []ProblemError{
+			{Code: "category.name_required", Field: "name", Detail: "name is required"},
+		},
+	}, problem)
+}
+
+func TestProblemFromError_ShouldListEveryViolation(t *testing.T) {
+	err := domain.NewValidationError(domain.ErrProductNameIsRequired, domain.ErrProductPriceInvalid)
+
+	problem := ProblemFromError(err, domain.LocaleEnglish)
+
+	assert.Equal(t, http.StatusBadRequest, problem.Status)
+	assert.Equal(t, "validation.failed", problem.Code)
+	assert.Equal(t, []ProblemError{
+		{Code: "product.name_required", Field: "name", Detail: "name is required"},
+		{Code: "product.price_invalid", Field: "price", Detail: "price must be greater than zero"},
+	}, problem.Errors)
+}
+
+func TestProblemFromError_ShouldMapKindToStatus(t *testing.T) {
+	testCases := []struct {
+		err            error
+		expectedStatus int
+	}{
+		{err: domain.ErrProductPriceInvalid, expectedStatus: http.StatusBadRequest},
+		{err: domain.ErrAuthTokenInvalid, expectedStatus: http.StatusUnauthorized},
+		{err: domain.ErrProductUserNotOwner, expectedStatus: http.StatusForbidden},
+		{err: domain.ErrProductNotFound, expectedStatus: http.StatusNotFound},
+		{err: domain.ErrCategoryHasProducts, expectedStatus: http.StatusConflict},
+		{err: domain.ErrLoginThrottled, expectedStatus: http.StatusTooManyRequests},
+		{err: domain.ErrProductSearchUnavailable, expectedStatus: http.StatusServiceUnavailable},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.err.Error(), func(t *testing.T) {
+			problem := ProblemFromError(tc.err, domain.LocaleEnglish)
+
+			assert.Equal(t, tc.expectedStatus, problem.Status)
+			assert.Equal(t, http.StatusText(tc.expectedStatus), problem.Title)
+		})
+	}
+}
+
+func TestProblemFromError_ShouldTellApartErrorsWithTheSameMessage(t *testing.T) {
+	category := ProblemFromError(domain.ErrCategoryUserNotFound, domain.LocaleEnglish)
+	product := ProblemFromError(domain.ErrProductUserNotFound, domain.LocaleEnglish)
+
+	assert.Equal(t, category.Detail, product.Detail)
+	assert.Equal(t, "category.user_not_found", category.Code)
+	assert.Equal(t, "product.user_not_found", product.Code)
+}
+
+func TestProblemFromError_ShouldNotLeakUnderlyingErrors(t *testing.T) {
+	testCases := []struct {
+		name           string
+		err            error
+		expectedStatus int
+		expectedDetail string
+	}{
+		{
+			name:           "Plain Error",
+			err:            errors.New("UNIQUE constraint failed: users.email"),
+			expectedStatus: http.StatusInternalServerError,
+			expectedDetail: "internal server error",
+		},
+		{
+			name:           "Domain Error Wrapping Driver Error",
+			err:            fmt.Errorf("%w: %w", domain.ErrUserEmailAlreadyInUse, errors.New("UNIQUE constraint failed: users.email")),
+			expectedStatus: http.StatusConflict,
+			expectedDetail: "email already in use",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			problem := ProblemFromError(tc.err, domain.LocaleEnglish)
+
+			assert.Equal(t, tc.expectedStatus, problem.Status)
+			assert.Equal(t, tc.expectedDetail, problem.Detail)
+			assert.NotContains(t, problem.Detail, "UNIQUE")
+		})
+	}
+}
+
+func TestError_ShouldAnswerInTheLocaleAsked(t *testing.T) {
+	testCases := []struct {
+		name           string
+		acceptLanguage string
+		preferred      domain.Locale
+		expectedLocale string
+		expectedDetail string
+	}{
+		{name: "No Preference", expectedLocale: "en", expectedDetail: "product not found"},
+		{name: "Accept-Language", acceptLanguage: "pt-BR,pt;q=0.9,en;q=0.8", expectedLocale: "pt-BR", expectedDetail: "produto não encontrado"},
+		{name: "Closest Match", acceptLanguage: "pt-PT", expectedLocale: "pt-BR", expectedDetail: "produto não encontrado"},
+		{name: "Unsupported", acceptLanguage: "ja", expectedLocale: "en", expectedDetail: "product not found"},
+		{name: "Profile Preference", acceptLanguage: "en", preferred: domain.LocalePortugueseBrazil, expectedLocale: "pt-BR", expectedDetail: "produto não encontrado"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/product/123", nil)
+			req.Header.Set("Accept-Language", tc.acceptLanguage)
+			if tc.preferred != "" {
+				req = req.WithContext(request.WithLocale(req.Context(), tc.preferred))
+			}
+
+			rec := httptest.NewRecorder()
+			Error(rec, req, domain.ErrProductNotFound)
+
+			var problem Problem
+			require.NoError(t, json.NewDecoder(rec.Body).Decode(&problem))
+			assert.Equal(t, tc.expectedLocale, rec.Header().Get("Content-Language"))
+			assert.Equal(t, tc.expectedDetail, problem.Detail)
+		})
+	}
+}
+
+func TestProblemFromError_ShouldFillInMessageParams(t *testing.T) {
+	policy := domain.DefaultPasswordPolicy()
+	policy.MinLength = 12
+
+	problem := ProblemFromError(policy.Validate("S3cret!", "Daniel", "daniel@gmail.com"), domain.LocalePortugueseBrazil)
+
+	require.NotEmpty(t, problem.Errors)
+	assert.Equal(t, ProblemError{
+		Code:   "user.password_invalid.min_length",
+		Field:  "password",
+		Detail: "deve ter pelo menos 12 caracteres",
+	}, problem.Errors[0])
+}
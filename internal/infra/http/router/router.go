@@ -0,0 +1,100 @@
+package router
+
+import (
+	"net/http"
+
+	"github.com/areteacademy/internal/domain"
+	adminHandler "github.com/areteacademy/internal/infra/http/handler/admin"
+	apiKeyHandler "github.com/areteacademy/internal/infra/http/handler/apikey"
+	authHandler "github.com/areteacademy/internal/infra/http/handler/auth"
+	categoryHandler "github.com/areteacademy/internal/infra/http/handler/category"
+	healthHandler "github.com/areteacademy/internal/infra/http/handler/health"
+	jwksHandler "github.com/areteacademy/internal/infra/http/handler/jwks"
+	productHandler "github.com/areteacademy/internal/infra/http/handler/product"
+	sessionHandler "github.com/areteacademy/internal/infra/http/handler/session"
+	twoFactorHandler "github.com/areteacademy/internal/infra/http/handler/twofactor"
+	userHandler "github.com/areteacademy/internal/infra/http/handler/user"
+)
+
+type Handlers struct {
+	Health    *healthHandler.Handler
+	Jwks      *jwksHandler.Handler
+	Auth      *authHandler.Handler
+	User      *userHandler.Handler
+	Category  *categoryHandler.Handler
+	Product   *productHandler.Handler
+	Admin     *adminHandler.Handler
+	ApiKey    *apiKeyHandler.Handler
+	TwoFactor *twoFactorHandler.Handler
+	Session   *sessionHandler.Handler
+}
+
+type Middleware func(http.Handler) http.Handler
+
+// New registers the routes. Category and product routes go through
+// scoped, which also accepts API keys granting the given scope and may
+// require a verified email. Admin routes only need auth, since their use
+// cases check the caller's role, and API keys never reach account routes.
+func New(handlers Handlers, auth Middleware, scoped func(scope domain.ApiKeyScope) Middleware) http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("GET /health", handlers.Health.Health)
+	mux.HandleFunc("GET /health/live", handlers.Health.Live)
+	mux.HandleFunc("GET /health/ready", handlers.Health.Ready)
+
+	mux.HandleFunc("GET /.well-known/jwks.json", handlers.Jwks.Keys)
+
+	mux.HandleFunc("POST /login", handlers.Auth.Login)
+	mux.HandleFunc("POST /login/2fa", handlers.Auth.VerifyTwoFactor)
+	mux.HandleFunc("POST /token/refresh", handlers.Auth.Refresh)
+	mux.Handle("POST /logout", auth(http.HandlerFunc(handlers.Session.Logout)))
+	mux.HandleFunc("POST /password/forgot", handlers.Auth.RequestPasswordReset)
+	mux.HandleFunc("POST /password/reset", handlers.Auth.ConfirmPasswordReset)
+
+	mux.HandleFunc("POST /user", handlers.User.Create)
+	mux.Handle("GET /user", auth(http.HandlerFunc(handlers.User.Get)))
+	mux.Handle("PUT /user", auth(http.HandlerFunc(handlers.User.Update)))
+	mux.Handle("PUT /user/password", auth(http.HandlerFunc(handlers.User.ChangePassword)))
+	mux.Handle("POST /user/email/verification", auth(http.HandlerFunc(handlers.User.SendEmailVerification)))
+	mux.HandleFunc("POST /user/email/verify", handlers.User.VerifyEmail)
+
+	mux.Handle("POST /user/2fa", auth(http.HandlerFunc(handlers.TwoFactor.Enroll)))
+	mux.Handle("POST /user/2fa/confirm", auth(http.HandlerFunc(handlers.TwoFactor.Confirm)))
+	mux.Handle("POST /user/2fa/disable", auth(http.HandlerFunc(handlers.TwoFactor.Disable)))
+
+	mux.Handle("GET /user/sessions", auth(http.HandlerFunc(handlers.Session.List)))
+	mux.Handle("DELETE /user/sessions", auth(http.HandlerFunc(handlers.Session.RevokeAll)))
+	mux.Handle("DELETE /user/sessions/{id}", auth(http.HandlerFunc(handlers.Session.Revoke)))
+
+	mux.Handle("POST /user/api-keys", auth(http.HandlerFunc(handlers.ApiKey.Create)))
+	mux.Handle("GET /user/api-keys", auth(http.HandlerFunc(handlers.ApiKey.List)))
+	mux.Handle("PATCH /user/api-keys/{id}", auth(http.HandlerFunc(handlers.ApiKey.Rename)))
+	mux.Handle("DELETE /user/api-keys/{id}", auth(http.HandlerFunc(handlers.ApiKey.Revoke)))
+
+	mux.Handle("POST /category", scoped(domain.ApiKeyScopeCategoriesWrite)(http.HandlerFunc(handlers.Category.Create)))
+	mux.Handle("GET /category", scoped(domain.ApiKeyScopeCategoriesRead)(http.HandlerFunc(handlers.Category.List)))
+	mux.Handle("GET /category/{id}", scoped(domain.ApiKeyScopeCategoriesRead)(http.HandlerFunc(handlers.Category.GetById)))
+	mux.Handle("PUT /category/{id}", scoped(domain.ApiKeyScopeCategoriesWrite)(http.HandlerFunc(handlers.Category.Update)))
+	mux.Handle("DELETE /category/{id}", scoped(domain.ApiKeyScopeCategoriesWrite)(http.HandlerFunc(handlers.Category.Delete)))
+	mux.Handle("POST /category/{id}/restore", scoped(domain.ApiKeyScopeCategoriesWrite)(http.HandlerFunc(handlers.Category.Restore)))
+	mux.Handle("DELETE /category/{id}/purge", scoped(domain.ApiKeyScopeCategoriesWrite)(http.HandlerFunc(handlers.Category.Purge)))
+
+	mux.Handle("POST /product", scoped(domain.ApiKeyScopeProductsWrite)(http.HandlerFunc(handlers.Product.Create)))
+	mux.Handle("GET /product", scoped(domain.ApiKeyScopeProductsRead)(http.HandlerFunc(handlers.Product.List)))
+	mux.Handle("GET /product/search", scoped(domain.ApiKeyScopeProductsRead)(http.HandlerFunc(handlers.Product.Search)))
+	mux.Handle("GET /product/{id}", scoped(domain.ApiKeyScopeProductsRead)(http.HandlerFunc(handlers.Product.GetById)))
+	mux.Handle("PUT /product/{id}", scoped(domain.ApiKeyScopeProductsWrite)(http.HandlerFunc(handlers.Product.Update)))
+	mux.Handle("DELETE /product/{id}", scoped(domain.ApiKeyScopeProductsWrite)(http.HandlerFunc(handlers.Product.Delete)))
+	mux.Handle("POST /product/{id}/restore", scoped(domain.ApiKeyScopeProductsWrite)(http.HandlerFunc(handlers.Product.Restore)))
+	mux.Handle("DELETE /product/{id}/purge", scoped(domain.ApiKeyScopeProductsWrite)(http.HandlerFunc(handlers.Product.Purge)))
+
+	mux.Handle("GET /admin/users", auth(http.HandlerFunc(handlers.Admin.ListUsers)))
+	mux.Handle("GET /admin/users/{id}/categories", auth(http.HandlerFunc(handlers.Admin.ListUserCategories)))
+	mux.Handle("GET /admin/users/{id}/products", auth(http.HandlerFunc(handlers.Admin.ListUserProducts)))
+	mux.Handle("POST /admin/users/{id}/deactivate", auth(http.HandlerFunc(handlers.Admin.DeactivateUser)))
+	mux.Handle("PUT /admin/users/{id}/role", auth(http.HandlerFunc(handlers.Admin.ChangeRole)))
+	mux.Handle("POST /admin/users/{id}/unlock", auth(http.HandlerFunc(handlers.Admin.UnlockUser)))
+	mux.Handle("GET /admin/lockouts", auth(http.HandlerFunc(handlers.Admin.ListLockoutEvents)))
+
+	return mux
+}
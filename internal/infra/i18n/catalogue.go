@@ -0,0 +1,93 @@
+package i18n
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/areteacademy/internal/domain"
+)
+
+// InternalCode is the code of the message shown for errors clients are not
+// told about.
+const InternalCode = "internal"
+
+// messages holds a JSON file per locale, named after it, mapping error codes
+// to messages. {name} in a message is replaced by the error's param name.
+//
+//go:embed messages/*.json
+var messages embed.FS
+
+// Catalogue maps each locale to its messages by error code.
+type Catalogue map[domain.Locale]map[string]string
+
+var catalogue = mustLoadCatalogue()
+
+func mustLoadCatalogue() Catalogue {
+	catalogue, err := LoadCatalogue()
+	if err != nil {
+		panic(err)
+	}
+
+	return catalogue
+}
+
+// LoadCatalogue reads the messages of every supported locale.
+func LoadCatalogue() (Catalogue, error) {
+	catalogue := make(Catalogue, len(domain.SupportedLocales))
+
+	for _, locale := range domain.SupportedLocales {
+		raw, err := messages.ReadFile(path.Join("messages", string(locale)+".json"))
+		if err != nil {
+			return nil, fmt.Errorf("load %s messages: %w", locale, err)
+		}
+
+		var entries map[string]string
+		if err := json.Unmarshal(raw, &entries); err != nil {
+			return nil, fmt.Errorf("load %s messages: %w", locale, err)
+		}
+
+		catalogue[locale] = entries
+	}
+
+	return catalogue, nil
+}
+
+// Translate renders the message for code in locale, falling back to
+// DefaultLocale and then to fallback when no locale has one.
+func Translate(locale domain.Locale, code string, params map[string]any, fallback string) string {
+	return catalogue.Translate(locale, code, params, fallback)
+}
+
+func (c Catalogue) Translate(locale domain.Locale, code string, params map[string]any, fallback string) string {
+	message, ok := c[locale][code]
+	if !ok {
+		message, ok = c[domain.DefaultLocale][code]
+	}
+
+	if !ok {
+		return fallback
+	}
+
+	return render(message, params)
+}
+
+// TranslateError renders the message of err in locale.
+func TranslateError(locale domain.Locale, err *domain.Error) string {
+	return Translate(locale, err.Code, err.Params, err.Message)
+}
+
+func render(message string, params map[string]any) string {
+	if len(params) == 0 {
+		return message
+	}
+
+	replacements := make([]string, 0, 2*len(params))
+	for name, value := range params {
+		replacements = append(replacements, "{"+name+"}", fmt.Sprint(value))
+	}
+
+	return strings.NewReplacer(replacements...).Replace(message)
+}
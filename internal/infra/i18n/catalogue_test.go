@@ -0,0 +1,86 @@
+package i18n
+
+import (
+	"regexp"
+	"slices"
+	"testing"
+
+	"github.com/areteacademy/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var placeholder = regexp.MustCompile(`\{\w+\}`)
+
+func TestLoadCatalogue_ShouldTranslateEveryCodeInEveryLocale(t *testing.T) {
+	catalogue, err := LoadCatalogue()
+	require.NoError(t, err)
+
+	reference := catalogue[domain.DefaultLocale]
+	require.NotEmpty(t, reference)
+
+	for _, locale := range domain.SupportedLocales {
+		for code, message := range reference {
+			translated, ok := catalogue[locale][code]
+			if !assert.True(t, ok, "%s has no message for %s", locale, code) {
+				continue
+			}
+
+			expected := placeholder.FindAllString(message, -1)
+			actual := placeholder.FindAllString(translated, -1)
+			slices.Sort(expected)
+			slices.Sort(actual)
+			assert.Equal(t, expected, actual, "%s message for %s", locale, code)
+		}
+
+		assert.Len(t, catalogue[locale], len(reference), "%s has messages for unknown codes", locale)
+	}
+}
+
+func TestLoadCatalogue_ShouldTranslateEveryPasswordRule(t *testing.T) {
+	policy := domain.PasswordPolicy{
+		MinLength:          20,
+		MaxLength:          20,
+		RequireLowercase:   true,
+		RequireUppercase:   true,
+		RequireDigit:       true,
+		RequireSpecial:     true,
+		ForbidPersonalInfo: true,
+		Blocklist:          domain.NewPasswordBlocklist([]string{"danielxx"}),
+	}
+
+	fieldErrors := domain.FieldErrors(policy.Validate("danielxx", "Daniel", "danielxx@gmail.com"))
+	require.NotEmpty(t, fieldErrors)
+
+	for _, fieldErr := range fieldErrors {
+		_, ok := catalogue[domain.DefaultLocale][fieldErr.Code]
+		assert.True(t, ok, "no message for %s", fieldErr.Code)
+	}
+}
+
+func TestCatalogue_Translate(t *testing.T) {
+	catalogue := Catalogue{
+		domain.LocaleEnglish:          {"page.size_invalid": "page size must be between {min} and {max}", "only.english": "english"},
+		domain.LocalePortugueseBrazil: {"page.size_invalid": "o tamanho da página deve estar entre {min} e {max}"},
+	}
+
+	testCases := []struct {
+		name     string
+		locale   domain.Locale
+		code     string
+		expected string
+	}{
+		{name: "Locale Asked", locale: domain.LocalePortugueseBrazil, code: "page.size_invalid", expected: "o tamanho da página deve estar entre 1 e 100"},
+		{name: "Default Locale", locale: domain.LocaleEnglish, code: "page.size_invalid", expected: "page size must be between 1 and 100"},
+		{name: "Missing In Locale", locale: domain.LocalePortugueseBrazil, code: "only.english", expected: "english"},
+		{name: "Unknown Code", locale: domain.LocalePortugueseBrazil, code: "unknown", expected: "fallback"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			message := catalogue.Translate(tc.locale, tc.code, map[string]any{"min": 1, "max": 100}, "fallback")
+
+			assert.Equal(t, tc.expected, message)
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package mail
+
+import (
+	"context"
+	"errors"
+
+	"github.com/areteacademy/internal/domain"
+)
+
+var ErrSimulatedFailureMailer = errors.New("mail delivery error")
+
+type InMemoryMailer struct {
+	FailOnSend bool
+	Sent       []domain.MailMessage
+}
+
+func NewInMemoryMailer() *InMemoryMailer {
+	return &InMemoryMailer{}
+}
+
+func (m *InMemoryMailer) Send(ctx context.Context, message domain.MailMessage) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if m.FailOnSend {
+		return ErrSimulatedFailureMailer
+	}
+
+	m.Sent = append(m.Sent, message)
+	return nil
+}
+
+// Last returns the latest message sent, or nil when nothing was sent.
+func (m *InMemoryMailer) Last() *domain.MailMessage {
+	if len(m.Sent) == 0 {
+		return nil
+	}
+
+	return &m.Sent[len(m.Sent)-1]
+}
+
+var _ domain.Mailer = (*InMemoryMailer)(nil)
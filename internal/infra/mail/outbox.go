@@ -0,0 +1,70 @@
+package mail
+
+import (
+	"context"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type OutboxMessageGorm struct {
+	ID        string    `gorm:"primaryKey"`
+	To        string    `gorm:"index;not null"`
+	Subject   string    `gorm:"not null"`
+	Body      string    `gorm:"not null"`
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+}
+
+func (OutboxMessageGorm) TableName() string {
+	return "mail_outbox"
+}
+
+// OutboxMailer stands in for SMTP: it stores every message in the
+// mail_outbox table, where it can be read back instead of delivered.
+type OutboxMailer struct {
+	db *gorm.DB
+}
+
+func NewOutboxMailer(db *gorm.DB) *OutboxMailer {
+	return &OutboxMailer{db: db}
+}
+
+func (m *OutboxMailer) Send(ctx context.Context, message domain.MailMessage) error {
+	return m.db.WithContext(ctx).Create(&OutboxMessageGorm{
+		ID:      uuid.NewString(),
+		To:      message.To,
+		Subject: message.Subject,
+		Body:    message.Body,
+	}).Error
+}
+
+// Messages returns the messages sent to an address, oldest first.
+func (m *OutboxMailer) Messages(ctx context.Context, to string) ([]domain.MailMessage, error) {
+	var models []OutboxMessageGorm
+
+	err := m.db.
+		WithContext(ctx).
+		Where("\"to\" = ?", to).
+		Order("created_at ASC").
+		Order("id ASC").
+		Find(&models).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	messages := make([]domain.MailMessage, 0, len(models))
+	for _, model := range models {
+		messages = append(messages, domain.MailMessage{
+			To:      model.To,
+			Subject: model.Subject,
+			Body:    model.Body,
+		})
+	}
+
+	return messages, nil
+}
+
+var _ domain.Mailer = (*OutboxMailer)(nil)
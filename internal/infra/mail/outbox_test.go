@@ -0,0 +1,38 @@
+package mail
+
+import (
+	"context"
+	"testing"
+
+	"github.com/areteacademy/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func makeSut(t *testing.T) *OutboxMailer {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&OutboxMessageGorm{}))
+
+	return NewOutboxMailer(db)
+}
+
+func TestOutboxMailer_ShouldStoreSentMessagesPerRecipient(t *testing.T) {
+	sut := makeSut(t)
+
+	first := domain.MailMessage{To: "daniel@gmail.com", Subject: "First", Body: "Body 1"}
+	second := domain.MailMessage{To: "daniel@gmail.com", Subject: "Second", Body: "Body 2"}
+	other := domain.MailMessage{To: "maria@gmail.com", Subject: "Other", Body: "Body 3"}
+
+	require.NoError(t, sut.Send(context.Background(), first))
+	require.NoError(t, sut.Send(context.Background(), other))
+	require.NoError(t, sut.Send(context.Background(), second))
+
+	messages, err := sut.Messages(context.Background(), "daniel@gmail.com")
+
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []domain.MailMessage{first, second}, messages)
+}
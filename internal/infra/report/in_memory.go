@@ -0,0 +1,15 @@
+package report
+
+import "context"
+
+type InMemoryErrorReporter struct {
+	Reported []error
+}
+
+func NewInMemoryErrorReporter() *InMemoryErrorReporter {
+	return &InMemoryErrorReporter{}
+}
+
+func (r *InMemoryErrorReporter) Report(ctx context.Context, err error) {
+	r.Reported = append(r.Reported, err)
+}
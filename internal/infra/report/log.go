@@ -0,0 +1,24 @@
+package report
+
+import (
+	"context"
+	"log"
+)
+
+type LogErrorReporter struct {
+	logger *log.Logger
+}
+
+// NewLogErrorReporter writes reports to logger, or to the standard logger
+// when logger is nil.
+func NewLogErrorReporter(logger *log.Logger) *LogErrorReporter {
+	if logger == nil {
+		logger = log.Default()
+	}
+
+	return &LogErrorReporter{logger: logger}
+}
+
+func (r *LogErrorReporter) Report(ctx context.Context, err error) {
+	r.logger.Printf("%v", err)
+}
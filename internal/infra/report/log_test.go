@@ -0,0 +1,23 @@
+package report
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogErrorReporter_Report_ShouldWriteErrorToLogger(t *testing.T) {
+	// Arrange
+	var out bytes.Buffer
+	reporter := NewLogErrorReporter(log.New(&out, "", 0))
+
+	// Act
+	reporter.Report(context.Background(), errors.New("send email verification to user 1: mail delivery error"))
+
+	// Assert
+	assert.Equal(t, "send email verification to user 1: mail delivery error\n", out.String())
+}
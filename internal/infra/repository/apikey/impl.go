@@ -0,0 +1,126 @@
+package apikey
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+	"gorm.io/gorm"
+)
+
+var ErrRepoApiKeyIsNil = errors.New("api key is nil")
+
+type GormApiKeyRepository struct {
+	db *gorm.DB
+}
+
+func NewGormApiKeyRepository(db *gorm.DB) *GormApiKeyRepository {
+	return &GormApiKeyRepository{db: db}
+}
+
+func (r *GormApiKeyRepository) Save(ctx context.Context, key *domain.ApiKey) error {
+	if key == nil {
+		return ErrRepoApiKeyIsNil
+	}
+
+	return r.db.WithContext(ctx).Create(ToRepository(key)).Error
+}
+
+// Update writes what the user can change. The last use is left alone, so a
+// rename does not overwrite a more recent use with the one it loaded.
+func (r *GormApiKeyRepository) Update(ctx context.Context, key *domain.ApiKey) error {
+	if key == nil {
+		return ErrRepoApiKeyIsNil
+	}
+
+	result := r.db.
+		WithContext(ctx).
+		Model(&ApiKeyGorm{}).
+		Where("id = ?", key.ID).
+		Updates(map[string]any{
+			"name":       key.Name,
+			"revoked_at": key.RevokedAt,
+			"updated_at": key.UpdatedAt,
+		})
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrApiKeyNotFound
+	}
+
+	return nil
+}
+
+func (r *GormApiKeyRepository) GetByKeyHash(ctx context.Context, keyHash string) (*domain.ApiKey, error) {
+	var model ApiKeyGorm
+
+	err := r.db.WithContext(ctx).First(&model, "key_hash = ?", keyHash).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrApiKeyNotFound
+		}
+		return nil, err
+	}
+
+	return model.ToDomain(), nil
+}
+
+func (r *GormApiKeyRepository) GetByIdAndUserId(ctx context.Context, id, userId string) (*domain.ApiKey, error) {
+	var model ApiKeyGorm
+
+	err := r.db.WithContext(ctx).First(&model, "id = ? AND user_id = ?", id, userId).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrApiKeyNotFound
+		}
+		return nil, err
+	}
+
+	return model.ToDomain(), nil
+}
+
+func (r *GormApiKeyRepository) ListByUserId(ctx context.Context, userId string) ([]*domain.ApiKey, error) {
+	var models []ApiKeyGorm
+
+	err := r.db.
+		WithContext(ctx).
+		Where("user_id = ?", userId).
+		Order("created_at DESC").
+		Order("id DESC").
+		Find(&models).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]*domain.ApiKey, 0, len(models))
+	for i := range models {
+		keys = append(keys, models[i].ToDomain())
+	}
+
+	return keys, nil
+}
+
+func (r *GormApiKeyRepository) TouchLastUsed(ctx context.Context, id string, usedAt time.Time) error {
+	result := r.db.
+		WithContext(ctx).
+		Model(&ApiKeyGorm{}).
+		Where("id = ?", id).
+		UpdateColumn("last_used_at", usedAt)
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrApiKeyNotFound
+	}
+
+	return nil
+}
+
+var _ domain.ApiKeyRepository = (*GormApiKeyRepository)(nil)
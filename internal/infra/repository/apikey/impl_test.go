@@ -0,0 +1,112 @@
+package apikey
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+type SUT struct {
+	Repository *GormApiKeyRepository
+	Key        *domain.ApiKey
+	Secret     string
+}
+
+func makeSut(t *testing.T) SUT {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&ApiKeyGorm{}))
+
+	key, secret, err := domain.NewApiKey("user-123", "CI", []string{"products:read"}, nil, time.Now())
+	require.NoError(t, err)
+
+	return SUT{
+		Repository: NewGormApiKeyRepository(db),
+		Key:        key,
+		Secret:     secret,
+	}
+}
+
+func TestApiKeyRepository_Save_ShouldPersistOnlyTheHash(t *testing.T) {
+	sut := makeSut(t)
+
+	require.NoError(t, sut.Repository.Save(context.Background(), sut.Key))
+
+	stored, err := sut.Repository.GetByKeyHash(context.Background(), domain.HashSecretToken(sut.Secret))
+
+	require.NoError(t, err)
+	assert.Equal(t, sut.Key.ID, stored.ID)
+	assert.Equal(t, []domain.ApiKeyScope{domain.ApiKeyScopeProductsRead}, stored.Scopes)
+	assert.NotEqual(t, sut.Secret, stored.KeyHash)
+	assert.Equal(t, sut.Secret[:len(stored.Hint)], stored.Hint)
+}
+
+func TestApiKeyRepository_Get_ShouldReturnNotFound(t *testing.T) {
+	sut := makeSut(t)
+	require.NoError(t, sut.Repository.Save(context.Background(), sut.Key))
+
+	_, hashErr := sut.Repository.GetByKeyHash(context.Background(), "unknown")
+	_, ownerErr := sut.Repository.GetByIdAndUserId(context.Background(), sut.Key.ID, "user-456")
+
+	assert.ErrorIs(t, hashErr, domain.ErrApiKeyNotFound)
+	assert.ErrorIs(t, ownerErr, domain.ErrApiKeyNotFound)
+}
+
+func TestApiKeyRepository_Update_ShouldKeepLastUse(t *testing.T) {
+	sut := makeSut(t)
+	require.NoError(t, sut.Repository.Save(context.Background(), sut.Key))
+
+	loaded, err := sut.Repository.GetByIdAndUserId(context.Background(), sut.Key.ID, sut.Key.UserId)
+	require.NoError(t, err)
+
+	usedAt := time.Now().Add(time.Minute)
+	require.NoError(t, sut.Repository.TouchLastUsed(context.Background(), sut.Key.ID, usedAt))
+
+	now := time.Now()
+	require.NoError(t, loaded.Rename("Deploy", now))
+	loaded.Revoke(now)
+
+	require.NoError(t, sut.Repository.Update(context.Background(), loaded))
+
+	stored, err := sut.Repository.GetByIdAndUserId(context.Background(), sut.Key.ID, sut.Key.UserId)
+	require.NoError(t, err)
+	assert.Equal(t, "Deploy", stored.Name)
+	assert.True(t, stored.IsRevoked())
+	require.NotNil(t, stored.LastUsedAt)
+	assert.Equal(t, usedAt.Unix(), stored.LastUsedAt.Unix())
+}
+
+func TestApiKeyRepository_ListByUserId_ShouldReturnNewestFirst(t *testing.T) {
+	sut := makeSut(t)
+	require.NoError(t, sut.Repository.Save(context.Background(), sut.Key))
+
+	newer, _, err := domain.NewApiKey("user-123", "Deploy", []string{"products:write"}, nil, time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	require.NoError(t, sut.Repository.Save(context.Background(), newer))
+
+	other, _, err := domain.NewApiKey("user-456", "Other", []string{"products:read"}, nil, time.Now())
+	require.NoError(t, err)
+	require.NoError(t, sut.Repository.Save(context.Background(), other))
+
+	keys, err := sut.Repository.ListByUserId(context.Background(), "user-123")
+
+	require.NoError(t, err)
+	require.Len(t, keys, 2)
+	assert.Equal(t, newer.ID, keys[0].ID)
+	assert.Equal(t, sut.Key.ID, keys[1].ID)
+}
+
+func TestApiKeyRepository_TouchLastUsed_ShouldReturnNotFound_WhenKeyIsUnknown(t *testing.T) {
+	sut := makeSut(t)
+
+	err := sut.Repository.TouchLastUsed(context.Background(), "unknown", time.Now())
+
+	assert.ErrorIs(t, err, domain.ErrApiKeyNotFound)
+}
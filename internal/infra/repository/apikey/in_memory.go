@@ -0,0 +1,140 @@
+package apikey
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+)
+
+var ErrSimulatedFailureRepoApiKey = errors.New("database error")
+
+type InMemoryApiKeyRepository struct {
+	FailOnSave   bool
+	FailOnUpdate bool
+	FailOnGet    bool
+	FailOnList   bool
+	FailOnTouch  bool
+	keys         map[string]*domain.ApiKey
+}
+
+func NewInMemoryApiKeyRepository() *InMemoryApiKeyRepository {
+	return &InMemoryApiKeyRepository{
+		keys: make(map[string]*domain.ApiKey),
+	}
+}
+
+func (r *InMemoryApiKeyRepository) Save(ctx context.Context, key *domain.ApiKey) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if r.FailOnSave {
+		return ErrSimulatedFailureRepoApiKey
+	}
+
+	r.keys[key.ID] = key
+	return nil
+}
+
+func (r *InMemoryApiKeyRepository) Update(ctx context.Context, key *domain.ApiKey) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if r.FailOnUpdate {
+		return ErrSimulatedFailureRepoApiKey
+	}
+
+	if _, exists := r.keys[key.ID]; !exists {
+		return domain.ErrApiKeyNotFound
+	}
+
+	r.keys[key.ID] = key
+	return nil
+}
+
+func (r *InMemoryApiKeyRepository) GetByKeyHash(ctx context.Context, keyHash string) (*domain.ApiKey, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	if r.FailOnGet {
+		return nil, ErrSimulatedFailureRepoApiKey
+	}
+
+	for _, k := range r.keys {
+		if k.KeyHash == keyHash {
+			return k, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (r *InMemoryApiKeyRepository) GetByIdAndUserId(ctx context.Context, id, userId string) (*domain.ApiKey, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	if r.FailOnGet {
+		return nil, ErrSimulatedFailureRepoApiKey
+	}
+
+	key, exists := r.keys[id]
+	if !exists || key.UserId != userId {
+		return nil, nil
+	}
+
+	return key, nil
+}
+
+func (r *InMemoryApiKeyRepository) ListByUserId(ctx context.Context, userId string) ([]*domain.ApiKey, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	if r.FailOnList {
+		return nil, ErrSimulatedFailureRepoApiKey
+	}
+
+	keys := []*domain.ApiKey{}
+	for _, k := range r.keys {
+		if k.UserId == userId {
+			keys = append(keys, k)
+		}
+	}
+
+	slices.SortFunc(keys, func(a, b *domain.ApiKey) int {
+		if c := b.CreatedAt.Compare(a.CreatedAt); c != 0 {
+			return c
+		}
+
+		return cmp.Compare(b.ID, a.ID)
+	})
+
+	return keys, nil
+}
+
+func (r *InMemoryApiKeyRepository) TouchLastUsed(ctx context.Context, id string, usedAt time.Time) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if r.FailOnTouch {
+		return ErrSimulatedFailureRepoApiKey
+	}
+
+	key, exists := r.keys[id]
+	if !exists {
+		return domain.ErrApiKeyNotFound
+	}
+
+	key.LastUsedAt = &usedAt
+	return nil
+}
+
+var _ domain.ApiKeyRepository = (*InMemoryApiKeyRepository)(nil)
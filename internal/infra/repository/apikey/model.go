@@ -0,0 +1,57 @@
+package apikey
+
+import (
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+)
+
+type ApiKeyGorm struct {
+	ID         string               `gorm:"primaryKey"`
+	UserId     string               `gorm:"index;not null"`
+	Name       string               `gorm:"not null"`
+	Hint       string               `gorm:"not null"`
+	KeyHash    string               `gorm:"uniqueIndex;not null"`
+	Scopes     []domain.ApiKeyScope `gorm:"serializer:json;not null"`
+	ExpiresAt  *time.Time
+	LastUsedAt *time.Time
+	RevokedAt  *time.Time
+	CreatedAt  time.Time `gorm:"autoCreateTime"`
+	UpdatedAt  time.Time `gorm:"autoUpdateTime"`
+}
+
+func (ApiKeyGorm) TableName() string {
+	return "api_keys"
+}
+
+func (k *ApiKeyGorm) ToDomain() *domain.ApiKey {
+	return &domain.ApiKey{
+		ID:         k.ID,
+		UserId:     k.UserId,
+		Name:       k.Name,
+		Hint:       k.Hint,
+		KeyHash:    k.KeyHash,
+		Scopes:     k.Scopes,
+		ExpiresAt:  k.ExpiresAt,
+		LastUsedAt: k.LastUsedAt,
+		RevokedAt:  k.RevokedAt,
+		CreatedAt:  k.CreatedAt,
+		UpdatedAt:  k.UpdatedAt,
+	}
+}
+
+func ToRepository(k *domain.ApiKey) *ApiKeyGorm {
+	return &ApiKeyGorm{
+		ID:         k.ID,
+		UserId:     k.UserId,
+		Name:       k.Name,
+		Hint:       k.Hint,
+		KeyHash:    k.KeyHash,
+		Scopes:     k.Scopes,
+		ExpiresAt:  k.ExpiresAt,
+		LastUsedAt: k.LastUsedAt,
+		RevokedAt:  k.RevokedAt,
+		CreatedAt:  k.CreatedAt,
+		UpdatedAt:  k.UpdatedAt,
+	}
+}
@@ -1,9 +1,11 @@
 package category
 
 import (
+	"context"
 	"errors"
 
 	"github.com/areteacademy/internal/domain"
+	"github.com/areteacademy/internal/infra/repository/pagination"
 	"gorm.io/gorm"
 )
 
@@ -14,24 +16,24 @@ type GormCategoryRepository struct {
 }
 
 func NewGormCategoryRepository(db *gorm.DB) *GormCategoryRepository {
-	return &GormCategoryRepository{db: db}
+	return &GormCategoryRepository{db: pagination.Timestamps(db)}
 }
 
-func (r *GormCategoryRepository) Save(category *domain.Category) error {
+func (r *GormCategoryRepository) Save(ctx context.Context, category *domain.Category) error {
 	if category == nil {
 		return ErrRepositoryCategoryNil
 	}
 
 	model := ToRepository(category)
 
-	if err := r.db.Create(model).Error; err != nil {
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func (r *GormCategoryRepository) Update(category *domain.Category) error {
+func (r *GormCategoryRepository) Update(ctx context.Context, category *domain.Category) error {
 	if category == nil {
 		return ErrRepositoryCategoryNil
 	}
@@ -39,6 +41,7 @@ func (r *GormCategoryRepository) Update(category *domain.Category) error {
 	model := ToRepository(category)
 
 	result := r.db.
+		WithContext(ctx).
 		Model(&CategoryGorm{}).
 		Where("id = ?", category.ID).
 		Updates(model)
@@ -54,10 +57,10 @@ func (r *GormCategoryRepository) Update(category *domain.Category) error {
 	return nil
 }
 
-func (r *GormCategoryRepository) GetById(id string) (*domain.Category, error) {
+func (r *GormCategoryRepository) GetById(ctx context.Context, id string) (*domain.Category, error) {
 	var model CategoryGorm
 
-	err := r.db.First(&model, "id = ?", id).Error
+	err := r.db.WithContext(ctx).First(&model, "id = ?", id).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, domain.ErrCategoryNotFound
@@ -69,10 +72,10 @@ func (r *GormCategoryRepository) GetById(id string) (*domain.Category, error) {
 	return model.ToDomain(), nil
 }
 
-func (r *GormCategoryRepository) GetByIdAndUserId(id, userId string) (*domain.Category, error) {
+func (r *GormCategoryRepository) GetByIdAndUserId(ctx context.Context, id, userId string) (*domain.Category, error) {
 	var model CategoryGorm
 
-	err := r.db.First(&model, "id = ? AND user_id = ?", id, userId).Error
+	err := r.db.WithContext(ctx).First(&model, "id = ? AND user_id = ?", id, userId).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, domain.ErrCategoryNotFound
@@ -84,29 +87,126 @@ func (r *GormCategoryRepository) GetByIdAndUserId(id, userId string) (*domain.Ca
 	return model.ToDomain(), nil
 }
 
-func (r *GormCategoryRepository) ListByUserId(userId string) ([]*domain.Category, error) {
-	var models []CategoryGorm
+func (r *GormCategoryRepository) GetDeletedByIdAndUserId(ctx context.Context, id, userId string) (*domain.Category, error) {
+	var model CategoryGorm
+
+	err := r.db.
+		WithContext(ctx).
+		Unscoped().
+		First(&model, "id = ? AND user_id = ? AND deleted_at IS NOT NULL", id, userId).
+		Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrCategoryNotFound
+		}
 
-	if err := r.db.Where("user_id = ?", userId).Find(&models).Error; err != nil {
 		return nil, err
 	}
 
+	return model.ToDomain(), nil
+}
+
+func (r *GormCategoryRepository) ListByUserId(
+	ctx context.Context,
+	userId string,
+	page domain.PageRequest,
+) (domain.Page[*domain.Category], error) {
+	var models []CategoryGorm
+
+	err := r.db.
+		WithContext(ctx).
+		Where("user_id = ?", userId).
+		Scopes(pagination.Scope(page)).
+		Find(&models).
+		Error
+	if err != nil {
+		return domain.Page[*domain.Category]{}, err
+	}
+
 	categories := make([]*domain.Category, 0, len(models))
 	for i := range models {
 		categories = append(categories, models[i].ToDomain())
 	}
 
-	return categories, nil
+	return domain.NewPage(categories, page, (*domain.Category).Cursor), nil
 }
 
-func (r *GormCategoryRepository) Count() (int, error) {
+func (r *GormCategoryRepository) Count(ctx context.Context) (int, error) {
 	var count int64
 
-	if err := r.db.Model(&CategoryGorm{}).Count(&count).Error; err != nil {
+	if err := r.db.WithContext(ctx).Model(&CategoryGorm{}).Count(&count).Error; err != nil {
 		return 0, err
 	}
 
 	return int(count), nil
 }
 
+func (r *GormCategoryRepository) Delete(ctx context.Context, category *domain.Category) error {
+	if category == nil {
+		return ErrRepositoryCategoryNil
+	}
+
+	result := r.db.
+		WithContext(ctx).
+		Model(&CategoryGorm{}).
+		Where("id = ?", category.ID).
+		Updates(map[string]any{
+			"deleted_at": toRepositoryDeletedAt(category.DeletedAt),
+			"updated_at": pagination.Timestamp(category.UpdatedAt),
+		})
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrCategoryNotFound
+	}
+
+	return nil
+}
+
+func (r *GormCategoryRepository) Restore(ctx context.Context, category *domain.Category) error {
+	if category == nil {
+		return ErrRepositoryCategoryNil
+	}
+
+	result := r.db.
+		WithContext(ctx).
+		Unscoped().
+		Model(&CategoryGorm{}).
+		Where("id = ? AND deleted_at IS NOT NULL", category.ID).
+		Updates(map[string]any{
+			"deleted_at": nil,
+			"updated_at": pagination.Timestamp(category.UpdatedAt),
+		})
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrCategoryNotFound
+	}
+
+	return nil
+}
+
+func (r *GormCategoryRepository) Purge(ctx context.Context, id string) error {
+	result := r.db.
+		WithContext(ctx).
+		Unscoped().
+		Delete(&CategoryGorm{}, "id = ?", id)
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrCategoryNotFound
+	}
+
+	return nil
+}
+
 var _ domain.CategoryRepository = (*GormCategoryRepository)(nil)
@@ -1,6 +1,7 @@
 package category
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -17,6 +18,8 @@ type SUT struct {
 	Category   *domain.Category
 }
 
+var firstPage = domain.PageRequest{Limit: domain.DefaultPageSize, Sort: domain.DefaultSort}
+
 func makeSut(t *testing.T) SUT {
 	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
 
@@ -46,8 +49,8 @@ func makeSut(t *testing.T) SUT {
 func TestCategoryRepository_Save_ShouldPersistCategory(t *testing.T) {
 	sut := makeSut(t)
 
-	require.NoError(t, sut.Repository.Save(sut.Category))
-	getCategory, err := sut.Repository.GetById(sut.Category.ID)
+	require.NoError(t, sut.Repository.Save(context.Background(), sut.Category))
+	getCategory, err := sut.Repository.GetById(context.Background(), sut.Category.ID)
 
 	require.NoError(t, err)
 	require.NotNil(t, getCategory)
@@ -62,7 +65,7 @@ func TestCategoryRepository_Save_ShouldPersistCategory(t *testing.T) {
 func TestCategoryRepository_Save_ShouldReturnError_WhenCategoryIsNil(t *testing.T) {
 	sut := makeSut(t)
 
-	err := sut.Repository.Save(nil)
+	err := sut.Repository.Save(context.Background(), nil)
 
 	require.Error(t, err)
 	assert.ErrorIs(t, err, ErrRepositoryCategoryNil)
@@ -71,7 +74,7 @@ func TestCategoryRepository_Save_ShouldReturnError_WhenCategoryIsNil(t *testing.
 func TestCategoryRepository_Update_ShouldUpdateCategory(t *testing.T) {
 	sut := makeSut(t)
 
-	require.NoError(t, sut.Repository.Save(sut.Category))
+	require.NoError(t, sut.Repository.Save(context.Background(), sut.Category))
 
 	expected := struct {
 		Name   string
@@ -84,8 +87,8 @@ func TestCategoryRepository_Update_ShouldUpdateCategory(t *testing.T) {
 	sut.Category.Name = expected.Name
 	sut.Category.Status = expected.Status
 
-	require.NoError(t, sut.Repository.Update(sut.Category))
-	getCategory, err := sut.Repository.GetById(sut.Category.ID)
+	require.NoError(t, sut.Repository.Update(context.Background(), sut.Category))
+	getCategory, err := sut.Repository.GetById(context.Background(), sut.Category.ID)
 
 	require.NoError(t, err)
 	require.NotNil(t, getCategory)
@@ -96,10 +99,10 @@ func TestCategoryRepository_Update_ShouldUpdateCategory(t *testing.T) {
 func TestCategoryRepository_Update_ShouldReturnError_WhenCategoryNotFond(t *testing.T) {
 	sut := makeSut(t)
 
-	require.NoError(t, sut.Repository.Save(sut.Category))
+	require.NoError(t, sut.Repository.Save(context.Background(), sut.Category))
 
 	sut.Category.ID = "9999"
-	err := sut.Repository.Update(sut.Category)
+	err := sut.Repository.Update(context.Background(), sut.Category)
 
 	require.Error(t, err)
 	assert.ErrorIs(t, err, domain.ErrCategoryNotFound)
@@ -108,8 +111,8 @@ func TestCategoryRepository_Update_ShouldReturnError_WhenCategoryNotFond(t *test
 func TestCategoryRepository_Update_ShouldReturnError_WhenCategoryIsNil(t *testing.T) {
 	sut := makeSut(t)
 
-	require.NoError(t, sut.Repository.Save(sut.Category))
-	err := sut.Repository.Update(nil)
+	require.NoError(t, sut.Repository.Save(context.Background(), sut.Category))
+	err := sut.Repository.Update(context.Background(), nil)
 
 	require.Error(t, err)
 	assert.ErrorIs(t, err, ErrRepositoryCategoryNil)
@@ -118,9 +121,9 @@ func TestCategoryRepository_Update_ShouldReturnError_WhenCategoryIsNil(t *testin
 func TestCategoryRepository_GetById_ShouldReturnCategory(t *testing.T) {
 	sut := makeSut(t)
 
-	require.NoError(t, sut.Repository.Save(sut.Category))
+	require.NoError(t, sut.Repository.Save(context.Background(), sut.Category))
 
-	getCategory, err := sut.Repository.GetById(sut.Category.ID)
+	getCategory, err := sut.Repository.GetById(context.Background(), sut.Category.ID)
 
 	require.NoError(t, err)
 	require.NotNil(t, getCategory)
@@ -132,8 +135,8 @@ func TestCategoryRepository_GetById_ShouldReturnCategory(t *testing.T) {
 func TestCategoryRepository_GetById_ShouldReturnNil_WhenCategoryNotFound(t *testing.T) {
 	sut := makeSut(t)
 
-	require.NoError(t, sut.Repository.Save(sut.Category))
-	getCategory, err := sut.Repository.GetById("not-found")
+	require.NoError(t, sut.Repository.Save(context.Background(), sut.Category))
+	getCategory, err := sut.Repository.GetById(context.Background(), "not-found")
 
 	require.Error(t, err)
 	require.Nil(t, getCategory)
@@ -143,8 +146,8 @@ func TestCategoryRepository_GetById_ShouldReturnNil_WhenCategoryNotFound(t *test
 func TestCategoryRepository_GetByIdAndUserId_ShouldReturnCategory(t *testing.T) {
 	sut := makeSut(t)
 
-	require.NoError(t, sut.Repository.Save(sut.Category))
-	getCategory, err := sut.Repository.GetByIdAndUserId(sut.Category.ID, sut.Category.UserId)
+	require.NoError(t, sut.Repository.Save(context.Background(), sut.Category))
+	getCategory, err := sut.Repository.GetByIdAndUserId(context.Background(), sut.Category.ID, sut.Category.UserId)
 
 	require.NoError(t, err)
 	require.NotNil(t, getCategory)
@@ -157,8 +160,8 @@ func TestCategoryRepository_GetByIdAndUserId_ShouldReturnCategory(t *testing.T)
 func TestCategoryRepository_GetByIdAndUserId_ShouldReturnNil_WhenCategoryNotFound(t *testing.T) {
 	sut := makeSut(t)
 
-	require.NoError(t, sut.Repository.Save(sut.Category))
-	getCategory, err := sut.Repository.GetByIdAndUserId("id-not-found", "user-id-not-found")
+	require.NoError(t, sut.Repository.Save(context.Background(), sut.Category))
+	getCategory, err := sut.Repository.GetByIdAndUserId(context.Background(), "id-not-found", "user-id-not-found")
 
 	require.Error(t, err)
 	require.Nil(t, getCategory)
@@ -185,14 +188,15 @@ func TestCategoryRepository_ListByUserId_ShouldReturnCategories(t *testing.T) {
 	category4.UserId = "user-9999"
 	category4.Name = "cat 04"
 
-	require.NoError(t, sut.Repository.Save(&category1))
-	require.NoError(t, sut.Repository.Save(&category2))
-	require.NoError(t, sut.Repository.Save(&category3))
-	require.NoError(t, sut.Repository.Save(&category4))
+	require.NoError(t, sut.Repository.Save(context.Background(), &category1))
+	require.NoError(t, sut.Repository.Save(context.Background(), &category2))
+	require.NoError(t, sut.Repository.Save(context.Background(), &category3))
+	require.NoError(t, sut.Repository.Save(context.Background(), &category4))
 
-	categories, err := sut.Repository.ListByUserId(sut.Category.UserId)
+	page, err := sut.Repository.ListByUserId(context.Background(), sut.Category.UserId, firstPage)
 
 	require.NoError(t, err)
+	categories := page.Items
 	require.NotNil(t, categories)
 	assert.Len(t, categories, 3)
 	assert.ElementsMatch(
@@ -217,12 +221,173 @@ func TestCategoryRepository_Count_ShouldReturnCorrectValue(t *testing.T) {
 	category3.ID = "cat03"
 	category3.Name = "cat 03"
 
-	require.NoError(t, sut.Repository.Save(&category1))
-	require.NoError(t, sut.Repository.Save(&category2))
-	require.NoError(t, sut.Repository.Save(&category3))
+	require.NoError(t, sut.Repository.Save(context.Background(), &category1))
+	require.NoError(t, sut.Repository.Save(context.Background(), &category2))
+	require.NoError(t, sut.Repository.Save(context.Background(), &category3))
 
-	count, err := sut.Repository.Count()
+	count, err := sut.Repository.Count(context.Background())
 
 	require.NoError(t, err)
 	assert.Equal(t, 3, count)
 }
+
+func TestCategoryRepository_Delete_ShouldHideCategoryFromQueries(t *testing.T) {
+	sut := makeSut(t)
+
+	require.NoError(t, sut.Repository.Save(context.Background(), sut.Category))
+
+	sut.Category.Delete()
+	require.NoError(t, sut.Repository.Delete(context.Background(), sut.Category))
+
+	getCategory, err := sut.Repository.GetById(context.Background(), sut.Category.ID)
+	require.Error(t, err)
+	require.Nil(t, getCategory)
+	assert.ErrorIs(t, err, domain.ErrCategoryNotFound)
+
+	list, err := sut.Repository.ListByUserId(context.Background(), sut.Category.UserId, firstPage)
+	require.NoError(t, err)
+	assert.Empty(t, list.Items)
+
+	count, err := sut.Repository.Count(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+
+	deleted, err := sut.Repository.GetDeletedByIdAndUserId(context.Background(), sut.Category.ID, sut.Category.UserId)
+	require.NoError(t, err)
+	require.NotNil(t, deleted)
+	require.NotNil(t, deleted.DeletedAt)
+	assert.Equal(t, sut.Category.DeletedAt.Unix(), deleted.DeletedAt.Unix())
+}
+
+func TestCategoryRepository_Delete_ShouldReturnError_WhenCategoryNotFound(t *testing.T) {
+	sut := makeSut(t)
+
+	sut.Category.Delete()
+	err := sut.Repository.Delete(context.Background(), sut.Category)
+
+	require.Error(t, err)
+	assert.ErrorIs(t, err, domain.ErrCategoryNotFound)
+}
+
+func TestCategoryRepository_Restore_ShouldMakeCategoryVisibleAgain(t *testing.T) {
+	sut := makeSut(t)
+
+	require.NoError(t, sut.Repository.Save(context.Background(), sut.Category))
+	sut.Category.Delete()
+	require.NoError(t, sut.Repository.Delete(context.Background(), sut.Category))
+
+	sut.Category.Restore()
+	require.NoError(t, sut.Repository.Restore(context.Background(), sut.Category))
+
+	getCategory, err := sut.Repository.GetById(context.Background(), sut.Category.ID)
+	require.NoError(t, err)
+	require.NotNil(t, getCategory)
+	assert.Nil(t, getCategory.DeletedAt)
+}
+
+func TestCategoryRepository_Restore_ShouldReturnError_WhenCategoryNotDeleted(t *testing.T) {
+	sut := makeSut(t)
+
+	require.NoError(t, sut.Repository.Save(context.Background(), sut.Category))
+	err := sut.Repository.Restore(context.Background(), sut.Category)
+
+	require.Error(t, err)
+	assert.ErrorIs(t, err, domain.ErrCategoryNotFound)
+}
+
+func TestCategoryRepository_Purge_ShouldRemoveCategoryPermanently(t *testing.T) {
+	sut := makeSut(t)
+
+	require.NoError(t, sut.Repository.Save(context.Background(), sut.Category))
+	sut.Category.Delete()
+	require.NoError(t, sut.Repository.Delete(context.Background(), sut.Category))
+
+	require.NoError(t, sut.Repository.Purge(context.Background(), sut.Category.ID))
+
+	deleted, err := sut.Repository.GetDeletedByIdAndUserId(context.Background(), sut.Category.ID, sut.Category.UserId)
+	require.Error(t, err)
+	require.Nil(t, deleted)
+	assert.ErrorIs(t, err, domain.ErrCategoryNotFound)
+
+	var total int64
+	require.NoError(t, sut.DB.Unscoped().Model(&CategoryGorm{}).Count(&total).Error)
+	assert.Equal(t, int64(0), total)
+}
+
+func TestCategoryRepository_Purge_ShouldReturnError_WhenCategoryNotFound(t *testing.T) {
+	sut := makeSut(t)
+
+	err := sut.Repository.Purge(context.Background(), "not-found")
+
+	require.Error(t, err)
+	assert.ErrorIs(t, err, domain.ErrCategoryNotFound)
+}
+
+func TestCategoryRepository_GetById_ShouldReturnError_WhenContextCanceled(t *testing.T) {
+	sut := makeSut(t)
+
+	require.NoError(t, sut.Repository.Save(context.Background(), sut.Category))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	category, err := sut.Repository.GetById(ctx, sut.Category.ID)
+
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Nil(t, category)
+}
+
+func TestCategoryRepository_ListByUserId_ShouldPaginateByCreatedAtAndId(t *testing.T) {
+	sut := makeSut(t)
+
+	base := sut.Category.CreatedAt
+	for i, id := range []string{"cat01", "cat02", "cat03", "cat04", "cat05"} {
+		category := *sut.Category
+		category.ID = id
+		category.CreatedAt = base.Add(time.Duration(i/2) * time.Minute)
+		require.NoError(t, sut.Repository.Save(context.Background(), &category))
+	}
+
+	ids := func(page domain.Page[*domain.Category]) []string {
+		result := make([]string, 0, len(page.Items))
+		for _, c := range page.Items {
+			result = append(result, c.ID)
+		}
+		return result
+	}
+
+	first, err := sut.Repository.ListByUserId(context.Background(), sut.Category.UserId, domain.PageRequest{Limit: 2, Sort: domain.DefaultSort})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"cat01", "cat02"}, ids(first))
+	assert.Nil(t, first.Previous)
+	require.NotNil(t, first.Next)
+
+	second, err := sut.Repository.ListByUserId(context.Background(), sut.Category.UserId, domain.PageRequest{
+		Limit: 2,
+		Sort:  domain.DefaultSort,
+		After: first.Next,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"cat03", "cat04"}, ids(second))
+	require.NotNil(t, second.Previous)
+	require.NotNil(t, second.Next)
+
+	last, err := sut.Repository.ListByUserId(context.Background(), sut.Category.UserId, domain.PageRequest{
+		Limit: 2,
+		Sort:  domain.DefaultSort,
+		After: second.Next,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"cat05"}, ids(last))
+	assert.Nil(t, last.Next)
+
+	back, err := sut.Repository.ListByUserId(context.Background(), sut.Category.UserId, domain.PageRequest{
+		Limit:  2,
+		Sort:   domain.DefaultSort,
+		Before: second.Previous,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"cat01", "cat02"}, ids(back))
+	assert.Nil(t, back.Previous)
+	require.NotNil(t, back.Next)
+}
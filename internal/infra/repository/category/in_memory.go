@@ -1,9 +1,11 @@
 package category
 
 import (
+	"context"
 	"errors"
 
 	"github.com/areteacademy/internal/domain"
+	"github.com/areteacademy/internal/infra/repository/pagination"
 )
 
 var ErrSimulatedFailureRepoCategory = errors.New("database error")
@@ -13,8 +15,12 @@ type InMemoryCategoryRepository struct {
 	FailOnUpdate           bool
 	FailOnGetById          bool
 	FailOnGetByIdAndUserId bool
+	FailOnGetDeleted       bool
 	FailOnList             bool
 	FailOnCount            bool
+	FailOnDelete           bool
+	FailOnRestore          bool
+	FailOnPurge            bool
 	categories             map[string]*domain.Category
 }
 
@@ -24,7 +30,11 @@ func NewInMemoryCategoryRepository() *InMemoryCategoryRepository {
 	}
 }
 
-func (r *InMemoryCategoryRepository) Save(category *domain.Category) error {
+func (r *InMemoryCategoryRepository) Save(ctx context.Context, category *domain.Category) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
 	if r.FailOnSave {
 		return ErrSimulatedFailureRepoCategory
 	}
@@ -32,7 +42,11 @@ func (r *InMemoryCategoryRepository) Save(category *domain.Category) error {
 	return nil
 }
 
-func (r *InMemoryCategoryRepository) Update(category *domain.Category) error {
+func (r *InMemoryCategoryRepository) Update(ctx context.Context, category *domain.Category) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
 	if r.FailOnUpdate {
 		return ErrSimulatedFailureRepoCategory
 	}
@@ -40,24 +54,32 @@ func (r *InMemoryCategoryRepository) Update(category *domain.Category) error {
 	return nil
 }
 
-func (r *InMemoryCategoryRepository) GetById(id string) (*domain.Category, error) {
+func (r *InMemoryCategoryRepository) GetById(ctx context.Context, id string) (*domain.Category, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
 	if r.FailOnGetById {
 		return nil, ErrSimulatedFailureRepoCategory
 	}
 	category, exists := r.categories[id]
-	if !exists {
+	if !exists || category.IsDeleted() {
 		return nil, nil
 	}
 	return category, nil
 }
 
-func (r *InMemoryCategoryRepository) GetByIdAndUserId(id, userId string) (*domain.Category, error) {
+func (r *InMemoryCategoryRepository) GetByIdAndUserId(ctx context.Context, id, userId string) (*domain.Category, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
 	if r.FailOnGetByIdAndUserId {
 		return nil, ErrSimulatedFailureRepoCategory
 	}
 
 	for _, c := range r.categories {
-		if c.UserId == userId && c.ID == id {
+		if c.UserId == userId && c.ID == id && !c.IsDeleted() {
 			return c, nil
 		}
 	}
@@ -65,26 +87,118 @@ func (r *InMemoryCategoryRepository) GetByIdAndUserId(id, userId string) (*domai
 	return nil, nil
 }
 
-func (r *InMemoryCategoryRepository) ListByUserId(userId string) ([]*domain.Category, error) {
-	if r.FailOnList {
+func (r *InMemoryCategoryRepository) GetDeletedByIdAndUserId(ctx context.Context, id, userId string) (*domain.Category, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	if r.FailOnGetDeleted {
 		return nil, ErrSimulatedFailureRepoCategory
 	}
 
+	for _, c := range r.categories {
+		if c.UserId == userId && c.ID == id && c.IsDeleted() {
+			return c, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (r *InMemoryCategoryRepository) ListByUserId(
+	ctx context.Context,
+	userId string,
+	page domain.PageRequest,
+) (domain.Page[*domain.Category], error) {
+	if err := ctx.Err(); err != nil {
+		return domain.Page[*domain.Category]{}, err
+	}
+
+	if r.FailOnList {
+		return domain.Page[*domain.Category]{}, ErrSimulatedFailureRepoCategory
+	}
+
 	var categories []*domain.Category
 	for _, c := range r.categories {
-		if c.UserId == userId {
+		if c.UserId == userId && !c.IsDeleted() {
 			categories = append(categories, c)
 		}
 	}
 
-	return categories, nil
+	rows := pagination.Slice(categories, page, (*domain.Category).Cursor)
+
+	return domain.NewPage(rows, page, (*domain.Category).Cursor), nil
 }
 
-func (r *InMemoryCategoryRepository) Count() (int, error) {
+func (r *InMemoryCategoryRepository) Count(ctx context.Context) (int, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+
 	if r.FailOnCount {
 		return 0, ErrSimulatedFailureRepoCategory
 	}
-	return len(r.categories), nil
+
+	count := 0
+	for _, c := range r.categories {
+		if !c.IsDeleted() {
+			count++
+		}
+	}
+
+	return count, nil
+}
+
+func (r *InMemoryCategoryRepository) Delete(ctx context.Context, category *domain.Category) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if r.FailOnDelete {
+		return ErrSimulatedFailureRepoCategory
+	}
+	r.categories[category.ID] = category
+	return nil
+}
+
+func (r *InMemoryCategoryRepository) Restore(ctx context.Context, category *domain.Category) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if r.FailOnRestore {
+		return ErrSimulatedFailureRepoCategory
+	}
+	r.categories[category.ID] = category
+	return nil
+}
+
+func (r *InMemoryCategoryRepository) Purge(ctx context.Context, id string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if r.FailOnPurge {
+		return ErrSimulatedFailureRepoCategory
+	}
+	delete(r.categories, id)
+	return nil
+}
+
+// Snapshot copies the stored categories and returns a function that puts them
+// back, which is how the in-memory unit of work rolls back.
+func (r *InMemoryCategoryRepository) Snapshot() func() {
+	saved := make(map[string]domain.Category, len(r.categories))
+	for id, c := range r.categories {
+		saved[id] = *c
+	}
+
+	return func() {
+		r.categories = make(map[string]*domain.Category, len(saved))
+		for id, c := range saved {
+			r.categories[id] = &c
+		}
+	}
 }
 
 var _ domain.CategoryRepository = (*InMemoryCategoryRepository)(nil)
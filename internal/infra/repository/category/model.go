@@ -4,15 +4,18 @@ import (
 	"time"
 
 	"github.com/areteacademy/internal/domain"
+	"github.com/areteacademy/internal/infra/repository/pagination"
+	"gorm.io/gorm"
 )
 
 type CategoryGorm struct {
-	ID        string    `gorm:"primaryKey"`
-	UserId    string    `gorm:"index;not nul"`
-	Name      string    `gorm:"not nul"`
-	Status    string    `gorm:"not nul"`
-	CreatedAt time.Time `gorm:"autoCreateTime"`
-	UpdatedAt time.Time `gorm:"autoUpdateTime"`
+	ID        string         `gorm:"primaryKey"`
+	UserId    string         `gorm:"index;not nul"`
+	Name      string         `gorm:"not nul"`
+	Status    string         `gorm:"not nul"`
+	CreatedAt time.Time      `gorm:"autoCreateTime"`
+	UpdatedAt time.Time      `gorm:"autoUpdateTime"`
+	DeletedAt gorm.DeletedAt `gorm:"index"`
 }
 
 func (CategoryGorm) TableName() string {
@@ -27,6 +30,7 @@ func (c *CategoryGorm) ToDomain() *domain.Category {
 		Status:    c.Status,
 		CreatedAt: c.CreatedAt,
 		UpdatedAt: c.UpdatedAt,
+		DeletedAt: toDomainDeletedAt(c.DeletedAt),
 	}
 }
 
@@ -36,7 +40,25 @@ func ToRepository(category *domain.Category) *CategoryGorm {
 		UserId:    category.UserId,
 		Name:      category.Name,
 		Status:    category.Status,
-		CreatedAt: category.CreatedAt,
-		UpdatedAt: category.UpdatedAt,
+		CreatedAt: pagination.Timestamp(category.CreatedAt),
+		UpdatedAt: pagination.Timestamp(category.UpdatedAt),
+		DeletedAt: toRepositoryDeletedAt(category.DeletedAt),
 	}
 }
+
+func toDomainDeletedAt(deletedAt gorm.DeletedAt) *time.Time {
+	if !deletedAt.Valid {
+		return nil
+	}
+
+	t := deletedAt.Time
+	return &t
+}
+
+func toRepositoryDeletedAt(deletedAt *time.Time) gorm.DeletedAt {
+	if deletedAt == nil {
+		return gorm.DeletedAt{}
+	}
+
+	return gorm.DeletedAt{Time: pagination.Timestamp(*deletedAt), Valid: true}
+}
@@ -0,0 +1,71 @@
+package emailverification
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+	"gorm.io/gorm"
+)
+
+var ErrRepoEmailVerificationTokenIsNil = errors.New("email verification token is nil")
+
+type GormEmailVerificationTokenRepository struct {
+	db *gorm.DB
+}
+
+func NewGormEmailVerificationTokenRepository(db *gorm.DB) *GormEmailVerificationTokenRepository {
+	return &GormEmailVerificationTokenRepository{db: db}
+}
+
+func (r *GormEmailVerificationTokenRepository) Save(ctx context.Context, token *domain.EmailVerificationToken) error {
+	if token == nil {
+		return ErrRepoEmailVerificationTokenIsNil
+	}
+
+	return r.db.WithContext(ctx).Create(ToRepository(token)).Error
+}
+
+func (r *GormEmailVerificationTokenRepository) GetByTokenHash(ctx context.Context, tokenHash string) (*domain.EmailVerificationToken, error) {
+	var model EmailVerificationTokenGorm
+
+	err := r.db.WithContext(ctx).First(&model, "token_hash = ?", tokenHash).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrEmailVerificationTokenNotFound
+		}
+		return nil, err
+	}
+
+	return model.ToDomain(), nil
+}
+
+func (r *GormEmailVerificationTokenRepository) MarkUsed(ctx context.Context, id string, usedAt time.Time) error {
+	result := r.db.
+		WithContext(ctx).
+		Model(&EmailVerificationTokenGorm{}).
+		Where("id = ? AND used_at IS NULL", id).
+		Update("used_at", usedAt)
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrEmailVerificationTokenInvalid
+	}
+
+	return nil
+}
+
+func (r *GormEmailVerificationTokenRepository) InvalidateByUserId(ctx context.Context, userId string, usedAt time.Time) error {
+	return r.db.
+		WithContext(ctx).
+		Model(&EmailVerificationTokenGorm{}).
+		Where("user_id = ? AND used_at IS NULL", userId).
+		Update("used_at", usedAt).
+		Error
+}
+
+var _ domain.EmailVerificationTokenRepository = (*GormEmailVerificationTokenRepository)(nil)
@@ -0,0 +1,107 @@
+package emailverification
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+)
+
+var ErrSimulatedFailureRepoEmailVerificationToken = errors.New("database error")
+
+type InMemoryEmailVerificationTokenRepository struct {
+	FailOnSave       bool
+	FailOnGet        bool
+	FailOnMarkUsed   bool
+	FailOnInvalidate bool
+	tokens           map[string]*domain.EmailVerificationToken
+}
+
+func NewInMemoryEmailVerificationTokenRepository() *InMemoryEmailVerificationTokenRepository {
+	return &InMemoryEmailVerificationTokenRepository{
+		tokens: make(map[string]*domain.EmailVerificationToken),
+	}
+}
+
+func (r *InMemoryEmailVerificationTokenRepository) Save(ctx context.Context, token *domain.EmailVerificationToken) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if r.FailOnSave {
+		return ErrSimulatedFailureRepoEmailVerificationToken
+	}
+
+	r.tokens[token.ID] = token
+	return nil
+}
+
+func (r *InMemoryEmailVerificationTokenRepository) GetByTokenHash(ctx context.Context, tokenHash string) (*domain.EmailVerificationToken, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	if r.FailOnGet {
+		return nil, ErrSimulatedFailureRepoEmailVerificationToken
+	}
+
+	for _, t := range r.tokens {
+		if t.TokenHash == tokenHash {
+			return t, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (r *InMemoryEmailVerificationTokenRepository) MarkUsed(ctx context.Context, id string, usedAt time.Time) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if r.FailOnMarkUsed {
+		return ErrSimulatedFailureRepoEmailVerificationToken
+	}
+
+	token, exists := r.tokens[id]
+	if !exists || token.UsedAt != nil {
+		return domain.ErrEmailVerificationTokenInvalid
+	}
+
+	token.UsedAt = &usedAt
+	return nil
+}
+
+func (r *InMemoryEmailVerificationTokenRepository) InvalidateByUserId(ctx context.Context, userId string, usedAt time.Time) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if r.FailOnInvalidate {
+		return ErrSimulatedFailureRepoEmailVerificationToken
+	}
+
+	for _, t := range r.tokens {
+		if t.UserId == userId && t.UsedAt == nil {
+			t.UsedAt = &usedAt
+		}
+	}
+
+	return nil
+}
+
+// ByUserId returns the tokens issued for a user, so tests can inspect them
+// without knowing the secrets.
+func (r *InMemoryEmailVerificationTokenRepository) ByUserId(userId string) []*domain.EmailVerificationToken {
+	tokens := []*domain.EmailVerificationToken{}
+	for _, t := range r.tokens {
+		if t.UserId == userId {
+			tokens = append(tokens, t)
+		}
+	}
+
+	return tokens
+}
+
+var _ domain.EmailVerificationTokenRepository = (*InMemoryEmailVerificationTokenRepository)(nil)
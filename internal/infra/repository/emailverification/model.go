@@ -0,0 +1,45 @@
+package emailverification
+
+import (
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+)
+
+type EmailVerificationTokenGorm struct {
+	ID        string    `gorm:"primaryKey"`
+	UserId    string    `gorm:"index;not null"`
+	Email     string    `gorm:"not null"`
+	TokenHash string    `gorm:"uniqueIndex;not null"`
+	ExpiresAt time.Time `gorm:"not null"`
+	UsedAt    *time.Time
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+}
+
+func (EmailVerificationTokenGorm) TableName() string {
+	return "email_verification_tokens"
+}
+
+func (t *EmailVerificationTokenGorm) ToDomain() *domain.EmailVerificationToken {
+	return &domain.EmailVerificationToken{
+		ID:        t.ID,
+		UserId:    t.UserId,
+		Email:     t.Email,
+		TokenHash: t.TokenHash,
+		ExpiresAt: t.ExpiresAt,
+		UsedAt:    t.UsedAt,
+		CreatedAt: t.CreatedAt,
+	}
+}
+
+func ToRepository(t *domain.EmailVerificationToken) *EmailVerificationTokenGorm {
+	return &EmailVerificationTokenGorm{
+		ID:        t.ID,
+		UserId:    t.UserId,
+		Email:     t.Email,
+		TokenHash: t.TokenHash,
+		ExpiresAt: t.ExpiresAt,
+		UsedAt:    t.UsedAt,
+		CreatedAt: t.CreatedAt,
+	}
+}
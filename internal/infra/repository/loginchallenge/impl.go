@@ -0,0 +1,66 @@
+package loginchallenge
+
+import (
+	"context"
+	"errors"
+
+	"github.com/areteacademy/internal/domain"
+	"gorm.io/gorm"
+)
+
+var ErrRepoLoginChallengeIsNil = errors.New("login challenge is nil")
+
+type GormLoginChallengeRepository struct {
+	db *gorm.DB
+}
+
+func NewGormLoginChallengeRepository(db *gorm.DB) *GormLoginChallengeRepository {
+	return &GormLoginChallengeRepository{db: db}
+}
+
+func (r *GormLoginChallengeRepository) Save(ctx context.Context, challenge *domain.LoginChallenge) error {
+	if challenge == nil {
+		return ErrRepoLoginChallengeIsNil
+	}
+
+	return r.db.WithContext(ctx).Create(ToRepository(challenge)).Error
+}
+
+func (r *GormLoginChallengeRepository) GetByTokenHash(ctx context.Context, tokenHash string) (*domain.LoginChallenge, error) {
+	var model LoginChallengeGorm
+
+	err := r.db.WithContext(ctx).First(&model, "token_hash = ?", tokenHash).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrLoginChallengeNotFound
+		}
+		return nil, err
+	}
+
+	return model.ToDomain(), nil
+}
+
+func (r *GormLoginChallengeRepository) RecordFailure(ctx context.Context, id string) error {
+	return r.db.
+		WithContext(ctx).
+		Model(&LoginChallengeGorm{}).
+		Where("id = ?", id).
+		UpdateColumn("attempts", gorm.Expr("attempts + 1")).
+		Error
+}
+
+func (r *GormLoginChallengeRepository) Delete(ctx context.Context, id string) error {
+	result := r.db.WithContext(ctx).Where("id = ?", id).Delete(&LoginChallengeGorm{})
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrLoginChallengeInvalid
+	}
+
+	return nil
+}
+
+var _ domain.LoginChallengeRepository = (*GormLoginChallengeRepository)(nil)
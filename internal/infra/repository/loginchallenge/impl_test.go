@@ -0,0 +1,76 @@
+package loginchallenge
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+type SUT struct {
+	Repository *GormLoginChallengeRepository
+	Challenge  *domain.LoginChallenge
+	Secret     string
+}
+
+func makeSut(t *testing.T) SUT {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&LoginChallengeGorm{}))
+
+	challenge, secret, err := domain.NewLoginChallenge("user-123", time.Now())
+	require.NoError(t, err)
+
+	return SUT{
+		Repository: NewGormLoginChallengeRepository(db),
+		Challenge:  challenge,
+		Secret:     secret,
+	}
+}
+
+func TestLoginChallengeRepository_Save_ShouldPersistOnlyTheHash(t *testing.T) {
+	sut := makeSut(t)
+
+	require.NoError(t, sut.Repository.Save(context.Background(), sut.Challenge))
+
+	challenge, err := sut.Repository.GetByTokenHash(context.Background(), domain.HashSecretToken(sut.Secret))
+
+	require.NoError(t, err)
+	assert.Equal(t, sut.Challenge.ID, challenge.ID)
+	assert.NotEqual(t, sut.Secret, challenge.TokenHash)
+	assert.True(t, challenge.IsUsable(time.Now()))
+}
+
+func TestLoginChallengeRepository_RecordFailure_ShouldCountAttempts(t *testing.T) {
+	sut := makeSut(t)
+	require.NoError(t, sut.Repository.Save(context.Background(), sut.Challenge))
+
+	for range 5 {
+		require.NoError(t, sut.Repository.RecordFailure(context.Background(), sut.Challenge.ID))
+	}
+
+	challenge, err := sut.Repository.GetByTokenHash(context.Background(), sut.Challenge.TokenHash)
+
+	require.NoError(t, err)
+	assert.Equal(t, 5, challenge.Attempts)
+	assert.False(t, challenge.IsUsable(time.Now()))
+}
+
+func TestLoginChallengeRepository_Delete_ShouldOnlySucceedOnce(t *testing.T) {
+	sut := makeSut(t)
+	require.NoError(t, sut.Repository.Save(context.Background(), sut.Challenge))
+
+	firstErr := sut.Repository.Delete(context.Background(), sut.Challenge.ID)
+	secondErr := sut.Repository.Delete(context.Background(), sut.Challenge.ID)
+	_, getErr := sut.Repository.GetByTokenHash(context.Background(), sut.Challenge.TokenHash)
+
+	assert.NoError(t, firstErr)
+	assert.ErrorIs(t, secondErr, domain.ErrLoginChallengeInvalid)
+	assert.ErrorIs(t, getErr, domain.ErrLoginChallengeNotFound)
+}
@@ -0,0 +1,103 @@
+package loginchallenge
+
+import (
+	"context"
+	"errors"
+
+	"github.com/areteacademy/internal/domain"
+)
+
+var ErrSimulatedFailureRepoLoginChallenge = errors.New("database error")
+
+type InMemoryLoginChallengeRepository struct {
+	FailOnSave          bool
+	FailOnGet           bool
+	FailOnRecordFailure bool
+	FailOnDelete        bool
+	challenges          map[string]*domain.LoginChallenge
+}
+
+func NewInMemoryLoginChallengeRepository() *InMemoryLoginChallengeRepository {
+	return &InMemoryLoginChallengeRepository{
+		challenges: make(map[string]*domain.LoginChallenge),
+	}
+}
+
+func (r *InMemoryLoginChallengeRepository) Save(ctx context.Context, challenge *domain.LoginChallenge) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if r.FailOnSave {
+		return ErrSimulatedFailureRepoLoginChallenge
+	}
+
+	r.challenges[challenge.ID] = challenge
+	return nil
+}
+
+func (r *InMemoryLoginChallengeRepository) GetByTokenHash(ctx context.Context, tokenHash string) (*domain.LoginChallenge, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	if r.FailOnGet {
+		return nil, ErrSimulatedFailureRepoLoginChallenge
+	}
+
+	for _, c := range r.challenges {
+		if c.TokenHash == tokenHash {
+			return c, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (r *InMemoryLoginChallengeRepository) RecordFailure(ctx context.Context, id string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if r.FailOnRecordFailure {
+		return ErrSimulatedFailureRepoLoginChallenge
+	}
+
+	if challenge, exists := r.challenges[id]; exists {
+		challenge.Attempts++
+	}
+
+	return nil
+}
+
+func (r *InMemoryLoginChallengeRepository) Delete(ctx context.Context, id string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if r.FailOnDelete {
+		return ErrSimulatedFailureRepoLoginChallenge
+	}
+
+	if _, exists := r.challenges[id]; !exists {
+		return domain.ErrLoginChallengeInvalid
+	}
+
+	delete(r.challenges, id)
+	return nil
+}
+
+// ByUserId returns the challenges issued for a user, so tests can inspect
+// them without knowing the tokens.
+func (r *InMemoryLoginChallengeRepository) ByUserId(userId string) []*domain.LoginChallenge {
+	challenges := []*domain.LoginChallenge{}
+	for _, c := range r.challenges {
+		if c.UserId == userId {
+			challenges = append(challenges, c)
+		}
+	}
+
+	return challenges
+}
+
+var _ domain.LoginChallengeRepository = (*InMemoryLoginChallengeRepository)(nil)
@@ -0,0 +1,42 @@
+package loginchallenge
+
+import (
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+)
+
+type LoginChallengeGorm struct {
+	ID        string    `gorm:"primaryKey"`
+	UserId    string    `gorm:"index;not null"`
+	TokenHash string    `gorm:"uniqueIndex;not null"`
+	Attempts  int       `gorm:"not null;default:0"`
+	ExpiresAt time.Time `gorm:"not null"`
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+}
+
+func (LoginChallengeGorm) TableName() string {
+	return "login_challenges"
+}
+
+func (c *LoginChallengeGorm) ToDomain() *domain.LoginChallenge {
+	return &domain.LoginChallenge{
+		ID:        c.ID,
+		UserId:    c.UserId,
+		TokenHash: c.TokenHash,
+		Attempts:  c.Attempts,
+		ExpiresAt: c.ExpiresAt,
+		CreatedAt: c.CreatedAt,
+	}
+}
+
+func ToRepository(c *domain.LoginChallenge) *LoginChallengeGorm {
+	return &LoginChallengeGorm{
+		ID:        c.ID,
+		UserId:    c.UserId,
+		TokenHash: c.TokenHash,
+		Attempts:  c.Attempts,
+		ExpiresAt: c.ExpiresAt,
+		CreatedAt: c.CreatedAt,
+	}
+}
@@ -0,0 +1,147 @@
+package loginthrottle
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+	"github.com/areteacademy/internal/infra/repository/pagination"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrRepoLoginThrottleIsNil = errors.New("login throttle is nil")
+	ErrRepoLockoutEventIsNil  = errors.New("lockout event is nil")
+)
+
+type GormLoginThrottleRepository struct {
+	db *gorm.DB
+}
+
+func NewGormLoginThrottleRepository(db *gorm.DB) *GormLoginThrottleRepository {
+	return &GormLoginThrottleRepository{db: db}
+}
+
+func (r *GormLoginThrottleRepository) Get(ctx context.Context, scope domain.LoginThrottleScope, key string) (*domain.LoginThrottle, error) {
+	var model LoginThrottleGorm
+
+	err := r.db.WithContext(ctx).First(&model, "scope = ? AND key = ?", string(scope), key).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrLoginThrottleNotFound
+		}
+		return nil, err
+	}
+
+	return model.ToDomain(), nil
+}
+
+func (r *GormLoginThrottleRepository) Save(ctx context.Context, throttle *domain.LoginThrottle) error {
+	if throttle == nil {
+		return ErrRepoLoginThrottleIsNil
+	}
+
+	return r.db.
+		WithContext(ctx).
+		Clauses(clause.OnConflict{UpdateAll: true}).
+		Create(ToRepository(throttle)).
+		Error
+}
+
+// recordFailureSQL mirrors domain.LoginThrottle.RecordFailure in a single
+// upsert. Every expression in the update reads the row as it was before the
+// statement, so expired is evaluated against the old values throughout.
+const recordFailureSQL = `
+INSERT INTO login_throttles (scope, key, failures, last_failure_at, locked_until)
+VALUES (@scope, @key, 1, @now, CASE WHEN 1 >= @threshold THEN @locked_until END)
+ON CONFLICT (scope, key) DO UPDATE SET
+	failures = CASE WHEN ` + expiredSQL + ` THEN 1 ELSE failures + 1 END,
+	locked_until = CASE
+		WHEN ` + expiredSQL + ` THEN excluded.locked_until
+		WHEN locked_until IS NULL AND failures + 1 >= @threshold THEN @locked_until
+		ELSE locked_until
+	END,
+	last_failure_at = excluded.last_failure_at
+RETURNING scope, key, failures, last_failure_at, locked_until`
+
+const expiredSQL = `(locked_until <= @now OR (locked_until IS NULL AND last_failure_at <= @stale_before))`
+
+// RecordFailure counts the failure in sqlite rather than in Go, so attempts
+// made at the same time all add to the count. A lockout was started by this
+// call when the stored lockout is the one it proposed, which no other call
+// proposes since each attempt has its own now.
+func (r *GormLoginThrottleRepository) RecordFailure(
+	ctx context.Context,
+	scope domain.LoginThrottleScope,
+	key string,
+	now time.Time,
+	policy domain.LoginThrottlePolicy,
+) (*domain.LoginThrottle, bool, error) {
+	now = pagination.Timestamp(now)
+	lockedUntil := now.Add(policy.LockoutDuration)
+
+	var model LoginThrottleGorm
+
+	err := r.db.
+		WithContext(ctx).
+		Raw(recordFailureSQL, map[string]any{
+			"scope":        string(scope),
+			"key":          key,
+			"now":          now,
+			"stale_before": now.Add(-policy.FailureWindow),
+			"threshold":    policy.LockoutThreshold(scope),
+			"locked_until": lockedUntil,
+		}).
+		Scan(&model).
+		Error
+	if err != nil {
+		return nil, false, err
+	}
+
+	throttle := model.ToDomain()
+	locked := throttle.LockedUntil != nil && throttle.LockedUntil.Equal(lockedUntil)
+
+	return throttle, locked, nil
+}
+
+func (r *GormLoginThrottleRepository) Delete(ctx context.Context, scope domain.LoginThrottleScope, key string) error {
+	return r.db.
+		WithContext(ctx).
+		Where("scope = ? AND key = ?", string(scope), key).
+		Delete(&LoginThrottleGorm{}).
+		Error
+}
+
+func (r *GormLoginThrottleRepository) SaveEvent(ctx context.Context, event *domain.LockoutEvent) error {
+	if event == nil {
+		return ErrRepoLockoutEventIsNil
+	}
+
+	return r.db.WithContext(ctx).Create(ToRepositoryEvent(event)).Error
+}
+
+func (r *GormLoginThrottleRepository) ListEvents(ctx context.Context, limit int) ([]*domain.LockoutEvent, error) {
+	var models []LockoutEventGorm
+
+	err := r.db.
+		WithContext(ctx).
+		Order("created_at DESC").
+		Order("id DESC").
+		Limit(limit).
+		Find(&models).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]*domain.LockoutEvent, 0, len(models))
+	for i := range models {
+		events = append(events, models[i].ToDomain())
+	}
+
+	return events, nil
+}
+
+var _ domain.LoginThrottleRepository = (*GormLoginThrottleRepository)(nil)
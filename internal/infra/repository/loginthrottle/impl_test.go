@@ -0,0 +1,168 @@
+package loginthrottle
+
+import (
+	"context"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+type SUT struct {
+	Repository *GormLoginThrottleRepository
+	Throttle   *domain.LoginThrottle
+}
+
+func makeSut(t *testing.T) SUT {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&LoginThrottleGorm{}, &LockoutEventGorm{}))
+
+	throttle := domain.NewLoginThrottle(domain.LoginThrottleScopeAccount, "daniel@gmail.com")
+	throttle.RecordFailure(time.Now(), domain.DefaultLoginThrottlePolicy())
+
+	return SUT{
+		Repository: NewGormLoginThrottleRepository(db),
+		Throttle:   throttle,
+	}
+}
+
+func TestLoginThrottleRepository_Save_ShouldInsertThenReplace(t *testing.T) {
+	sut := makeSut(t)
+	require.NoError(t, sut.Repository.Save(context.Background(), sut.Throttle))
+
+	lockedUntil := time.Now().Add(time.Minute)
+	sut.Throttle.Failures = 10
+	sut.Throttle.LockedUntil = &lockedUntil
+
+	require.NoError(t, sut.Repository.Save(context.Background(), sut.Throttle))
+
+	throttle, err := sut.Repository.Get(context.Background(), domain.LoginThrottleScopeAccount, "daniel@gmail.com")
+
+	require.NoError(t, err)
+	assert.Equal(t, 10, throttle.Failures)
+	require.NotNil(t, throttle.LockedUntil)
+	assert.WithinDuration(t, lockedUntil, *throttle.LockedUntil, time.Second)
+}
+
+func TestLoginThrottleRepository_Get_ShouldKeepScopesApart(t *testing.T) {
+	sut := makeSut(t)
+	require.NoError(t, sut.Repository.Save(context.Background(), sut.Throttle))
+
+	_, err := sut.Repository.Get(context.Background(), domain.LoginThrottleScopeIP, "daniel@gmail.com")
+
+	assert.ErrorIs(t, err, domain.ErrLoginThrottleNotFound)
+}
+
+func TestLoginThrottleRepository_Delete_ShouldRemoveThrottle(t *testing.T) {
+	sut := makeSut(t)
+	require.NoError(t, sut.Repository.Save(context.Background(), sut.Throttle))
+
+	require.NoError(t, sut.Repository.Delete(context.Background(), sut.Throttle.Scope, sut.Throttle.Key))
+
+	_, err := sut.Repository.Get(context.Background(), sut.Throttle.Scope, sut.Throttle.Key)
+	assert.ErrorIs(t, err, domain.ErrLoginThrottleNotFound)
+}
+
+func TestLoginThrottleRepository_ListEvents_ShouldReturnNewestFirst(t *testing.T) {
+	sut := makeSut(t)
+	now := time.Now()
+
+	older := domain.NewLockoutEvent(domain.LockoutEventLocked, sut.Throttle, now.Add(-time.Hour))
+	newer := domain.NewLockoutEvent(domain.LockoutEventUnlocked, sut.Throttle, now)
+	require.NoError(t, sut.Repository.SaveEvent(context.Background(), older))
+	require.NoError(t, sut.Repository.SaveEvent(context.Background(), newer))
+
+	events, err := sut.Repository.ListEvents(context.Background(), 10)
+
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	assert.Equal(t, newer.ID, events[0].ID)
+	assert.Equal(t, domain.LockoutEventUnlocked, events[0].Kind)
+	assert.Equal(t, older.ID, events[1].ID)
+}
+
+func TestLoginThrottleRepository_ShouldReturnError_WhenNil(t *testing.T) {
+	sut := makeSut(t)
+
+	assert.ErrorIs(t, sut.Repository.Save(context.Background(), nil), ErrRepoLoginThrottleIsNil)
+	assert.ErrorIs(t, sut.Repository.SaveEvent(context.Background(), nil), ErrRepoLockoutEventIsNil)
+}
+
+func TestLoginThrottleRepository_RecordFailure_ShouldCountConcurrentFailures(t *testing.T) {
+	// A file lets every goroutine use its own connection, as the server does.
+	path := filepath.Join(t.TempDir(), "throttle.db")
+	db, err := gorm.Open(sqlite.Open(path+"?_busy_timeout=5000"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&LoginThrottleGorm{}))
+
+	repository := NewGormLoginThrottleRepository(db)
+	policy := domain.DefaultLoginThrottlePolicy()
+	now := time.Now()
+	attempts := 2 * policy.AccountLockoutThreshold
+
+	var wg sync.WaitGroup
+	locks := make(chan bool, attempts)
+	for i := range attempts {
+		at := now.Add(time.Duration(i) * time.Millisecond)
+		wg.Go(func() {
+			_, locked, err := repository.RecordFailure(context.Background(), domain.LoginThrottleScopeAccount, "daniel@gmail.com", at, policy)
+			assert.NoError(t, err)
+			locks <- locked
+		})
+	}
+	wg.Wait()
+	close(locks)
+
+	started := 0
+	for locked := range locks {
+		if locked {
+			started++
+		}
+	}
+
+	throttle, err := repository.Get(context.Background(), domain.LoginThrottleScopeAccount, "daniel@gmail.com")
+	require.NoError(t, err)
+	assert.Equal(t, attempts, throttle.Failures)
+	require.NotNil(t, throttle.LockedUntil)
+	assert.Equal(t, 1, started)
+}
+
+func TestLoginThrottleRepository_RecordFailure_ShouldMatchDomainThrottle(t *testing.T) {
+	sut := makeSut(t)
+	policy := domain.DefaultLoginThrottlePolicy()
+	now := time.Now()
+
+	// Each step is applied to a domain throttle too, which the stored one
+	// must keep matching as failures accumulate, lock and then expire.
+	expected := domain.NewLoginThrottle(domain.LoginThrottleScopeIP, "203.0.113.9")
+	steps := []time.Time{}
+	for i := range policy.IPLockoutThreshold {
+		steps = append(steps, now.Add(time.Duration(i)*time.Second))
+	}
+	lockedAt := steps[len(steps)-1]
+	steps = append(steps,
+		lockedAt.Add(time.Minute),
+		lockedAt.Add(policy.LockoutDuration),
+		lockedAt.Add(policy.LockoutDuration+policy.FailureWindow),
+	)
+
+	for _, at := range steps {
+		wantLocked := expected.RecordFailure(at, policy)
+
+		throttle, locked, err := sut.Repository.RecordFailure(context.Background(), expected.Scope, expected.Key, at, policy)
+
+		require.NoError(t, err)
+		assert.Equal(t, wantLocked, locked)
+		assert.Equal(t, expected.Failures, throttle.Failures)
+		assert.True(t, expected.LastFailureAt.Equal(throttle.LastFailureAt))
+		assert.Equal(t, expected.LockedUntil == nil, throttle.LockedUntil == nil)
+	}
+}
@@ -0,0 +1,144 @@
+package loginthrottle
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+)
+
+var ErrSimulatedFailureRepoLoginThrottle = errors.New("database error")
+
+type InMemoryLoginThrottleRepository struct {
+	FailOnGet           bool
+	FailOnSave          bool
+	FailOnRecordFailure bool
+	FailOnDelete        bool
+	FailOnSaveEvent     bool
+	FailOnListEvents    bool
+	throttles           map[string]*domain.LoginThrottle
+	events              []*domain.LockoutEvent
+}
+
+func NewInMemoryLoginThrottleRepository() *InMemoryLoginThrottleRepository {
+	return &InMemoryLoginThrottleRepository{
+		throttles: make(map[string]*domain.LoginThrottle),
+	}
+}
+
+func throttleKey(scope domain.LoginThrottleScope, key string) string {
+	return string(scope) + ":" + key
+}
+
+func (r *InMemoryLoginThrottleRepository) Get(ctx context.Context, scope domain.LoginThrottleScope, key string) (*domain.LoginThrottle, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	if r.FailOnGet {
+		return nil, ErrSimulatedFailureRepoLoginThrottle
+	}
+
+	throttle, exists := r.throttles[throttleKey(scope, key)]
+	if !exists {
+		return nil, nil
+	}
+
+	return throttle, nil
+}
+
+func (r *InMemoryLoginThrottleRepository) Save(ctx context.Context, throttle *domain.LoginThrottle) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if r.FailOnSave {
+		return ErrSimulatedFailureRepoLoginThrottle
+	}
+
+	r.throttles[throttleKey(throttle.Scope, throttle.Key)] = throttle
+	return nil
+}
+
+func (r *InMemoryLoginThrottleRepository) RecordFailure(
+	ctx context.Context,
+	scope domain.LoginThrottleScope,
+	key string,
+	now time.Time,
+	policy domain.LoginThrottlePolicy,
+) (*domain.LoginThrottle, bool, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, false, err
+	}
+
+	if r.FailOnRecordFailure {
+		return nil, false, ErrSimulatedFailureRepoLoginThrottle
+	}
+
+	throttle, exists := r.throttles[throttleKey(scope, key)]
+	if !exists {
+		throttle = domain.NewLoginThrottle(scope, key)
+		r.throttles[throttleKey(scope, key)] = throttle
+	}
+
+	locked := throttle.RecordFailure(now, policy)
+	return throttle, locked, nil
+}
+
+func (r *InMemoryLoginThrottleRepository) Delete(ctx context.Context, scope domain.LoginThrottleScope, key string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if r.FailOnDelete {
+		return ErrSimulatedFailureRepoLoginThrottle
+	}
+
+	delete(r.throttles, throttleKey(scope, key))
+	return nil
+}
+
+func (r *InMemoryLoginThrottleRepository) SaveEvent(ctx context.Context, event *domain.LockoutEvent) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if r.FailOnSaveEvent {
+		return ErrSimulatedFailureRepoLoginThrottle
+	}
+
+	r.events = append(r.events, event)
+	return nil
+}
+
+func (r *InMemoryLoginThrottleRepository) ListEvents(ctx context.Context, limit int) ([]*domain.LockoutEvent, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	if r.FailOnListEvents {
+		return nil, ErrSimulatedFailureRepoLoginThrottle
+	}
+
+	events := make([]*domain.LockoutEvent, len(r.events))
+	copy(events, r.events)
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].CreatedAt.After(events[j].CreatedAt)
+	})
+
+	if limit > 0 && len(events) > limit {
+		events = events[:limit]
+	}
+
+	return events, nil
+}
+
+// Throttle returns the stored throttle so tests can move its clock.
+func (r *InMemoryLoginThrottleRepository) Throttle(scope domain.LoginThrottleScope, key string) *domain.LoginThrottle {
+	return r.throttles[throttleKey(scope, key)]
+}
+
+var _ domain.LoginThrottleRepository = (*InMemoryLoginThrottleRepository)(nil)
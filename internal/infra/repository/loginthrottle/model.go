@@ -0,0 +1,86 @@
+package loginthrottle
+
+import (
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+	"github.com/areteacademy/internal/infra/repository/pagination"
+)
+
+type LoginThrottleGorm struct {
+	Scope         string    `gorm:"primaryKey"`
+	Key           string    `gorm:"primaryKey"`
+	Failures      int       `gorm:"not null"`
+	LastFailureAt time.Time `gorm:"not null"`
+	LockedUntil   *time.Time
+}
+
+func (LoginThrottleGorm) TableName() string {
+	return "login_throttles"
+}
+
+func (t *LoginThrottleGorm) ToDomain() *domain.LoginThrottle {
+	return &domain.LoginThrottle{
+		Scope:         domain.LoginThrottleScope(t.Scope),
+		Key:           t.Key,
+		Failures:      t.Failures,
+		LastFailureAt: t.LastFailureAt,
+		LockedUntil:   t.LockedUntil,
+	}
+}
+
+// ToRepository writes the times in UTC because RecordFailure compares them
+// as text.
+func ToRepository(t *domain.LoginThrottle) *LoginThrottleGorm {
+	var lockedUntil *time.Time
+	if t.LockedUntil != nil {
+		at := pagination.Timestamp(*t.LockedUntil)
+		lockedUntil = &at
+	}
+
+	return &LoginThrottleGorm{
+		Scope:         string(t.Scope),
+		Key:           t.Key,
+		Failures:      t.Failures,
+		LastFailureAt: pagination.Timestamp(t.LastFailureAt),
+		LockedUntil:   lockedUntil,
+	}
+}
+
+type LockoutEventGorm struct {
+	ID          string `gorm:"primaryKey"`
+	Kind        string `gorm:"not null"`
+	Scope       string `gorm:"not null;index:idx_lockout_events_target"`
+	Key         string `gorm:"not null;index:idx_lockout_events_target"`
+	Failures    int    `gorm:"not null"`
+	LockedUntil *time.Time
+	CreatedAt   time.Time `gorm:"index"`
+}
+
+func (LockoutEventGorm) TableName() string {
+	return "lockout_events"
+}
+
+func (e *LockoutEventGorm) ToDomain() *domain.LockoutEvent {
+	return &domain.LockoutEvent{
+		ID:          e.ID,
+		Kind:        domain.LockoutEventKind(e.Kind),
+		Scope:       domain.LoginThrottleScope(e.Scope),
+		Key:         e.Key,
+		Failures:    e.Failures,
+		LockedUntil: e.LockedUntil,
+		CreatedAt:   e.CreatedAt,
+	}
+}
+
+func ToRepositoryEvent(e *domain.LockoutEvent) *LockoutEventGorm {
+	return &LockoutEventGorm{
+		ID:          e.ID,
+		Kind:        string(e.Kind),
+		Scope:       string(e.Scope),
+		Key:         e.Key,
+		Failures:    e.Failures,
+		LockedUntil: e.LockedUntil,
+		CreatedAt:   e.CreatedAt,
+	}
+}
@@ -0,0 +1,115 @@
+package pagination
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+	"gorm.io/gorm"
+)
+
+// Scope restricts a gorm query to the rows of the requested page, ordered by
+// the sort field and id, fetching one extra row so domain.NewPage can tell
+// whether another page exists.
+func Scope(page domain.PageRequest) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		// The column comes from a validated domain.SortField, never from
+		// raw user input.
+		column := string(page.Sort.Field)
+
+		operator, direction := "<", "DESC"
+		if page.FetchAscending() {
+			operator, direction = ">", "ASC"
+		}
+
+		cursor := page.After
+		if page.IsBackward() {
+			cursor = page.Before
+		}
+
+		if cursor != nil {
+			value := cursor.Value()
+			if t, ok := value.(time.Time); ok {
+				value = Timestamp(t)
+			}
+
+			db = db.Where(
+				fmt.Sprintf("%[1]s %[2]s ? OR (%[1]s = ? AND id %[2]s ?)", column, operator),
+				value, value, cursor.ID,
+			)
+		}
+
+		return db.
+			Order(column + " " + direction).
+			Order("id " + direction).
+			Limit(page.Limit + 1)
+	}
+}
+
+// Slice is the in-memory counterpart of Scope.
+func Slice[T any](items []T, page domain.PageRequest, cursorOf func(T, domain.Sort) domain.Cursor) []T {
+	compare := func(a, b domain.Cursor) int {
+		if page.FetchAscending() {
+			return a.Compare(b)
+		}
+
+		return b.Compare(a)
+	}
+
+	cursor := page.After
+	if page.IsBackward() {
+		cursor = page.Before
+	}
+
+	rows := make([]T, 0, len(items))
+	for _, item := range items {
+		if cursor != nil && compare(cursorOf(item, page.Sort), *cursor) <= 0 {
+			continue
+		}
+
+		rows = append(rows, item)
+	}
+
+	slices.SortFunc(rows, func(a, b T) int {
+		return compare(cursorOf(a, page.Sort), cursorOf(b, page.Sort))
+	})
+
+	if len(rows) > page.Limit+1 {
+		rows = rows[:page.Limit+1]
+	}
+
+	return rows
+}
+
+// Timestamp is the form timestamps used in listings are written and queried
+// in. sqlite compares them as text, which only orders them correctly
+// while every value carries the same offset, so they are all kept in UTC.
+func Timestamp(t time.Time) time.Time {
+	return t.UTC()
+}
+
+// Timestamps returns db filling in the timestamps gorm sets itself, such as
+// updated_at on updates, as Timestamp values.
+func Timestamps(db *gorm.DB) *gorm.DB {
+	return db.Session(&gorm.Session{
+		NowFunc: func() time.Time { return Timestamp(time.Now()) },
+	})
+}
+
+// MigrateTimestamps rewrites the timestamps of table stored with another
+// offset in UTC, keeping millisecond precision.
+func MigrateTimestamps(db *gorm.DB, table string, columns ...string) error {
+	for _, column := range columns {
+		err := db.Exec(fmt.Sprintf(
+			`UPDATE %[1]s SET %[2]s = strftime('%%Y-%%m-%%d %%H:%%M:%%f', %[2]s) || '+00:00'
+			WHERE %[2]s IS NOT NULL AND %[2]s NOT LIKE '%%+00:00'`,
+			table, column,
+		)).Error
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,71 @@
+package passwordreset
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+	"gorm.io/gorm"
+)
+
+var ErrRepoPasswordResetTokenIsNil = errors.New("password reset token is nil")
+
+type GormPasswordResetTokenRepository struct {
+	db *gorm.DB
+}
+
+func NewGormPasswordResetTokenRepository(db *gorm.DB) *GormPasswordResetTokenRepository {
+	return &GormPasswordResetTokenRepository{db: db}
+}
+
+func (r *GormPasswordResetTokenRepository) Save(ctx context.Context, token *domain.PasswordResetToken) error {
+	if token == nil {
+		return ErrRepoPasswordResetTokenIsNil
+	}
+
+	return r.db.WithContext(ctx).Create(ToRepository(token)).Error
+}
+
+func (r *GormPasswordResetTokenRepository) GetByTokenHash(ctx context.Context, tokenHash string) (*domain.PasswordResetToken, error) {
+	var model PasswordResetTokenGorm
+
+	err := r.db.WithContext(ctx).First(&model, "token_hash = ?", tokenHash).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrPasswordResetTokenNotFound
+		}
+		return nil, err
+	}
+
+	return model.ToDomain(), nil
+}
+
+func (r *GormPasswordResetTokenRepository) MarkUsed(ctx context.Context, id string, usedAt time.Time) error {
+	result := r.db.
+		WithContext(ctx).
+		Model(&PasswordResetTokenGorm{}).
+		Where("id = ? AND used_at IS NULL", id).
+		Update("used_at", usedAt)
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrPasswordResetTokenInvalid
+	}
+
+	return nil
+}
+
+func (r *GormPasswordResetTokenRepository) InvalidateByUserId(ctx context.Context, userId string, usedAt time.Time) error {
+	return r.db.
+		WithContext(ctx).
+		Model(&PasswordResetTokenGorm{}).
+		Where("user_id = ? AND used_at IS NULL", userId).
+		Update("used_at", usedAt).
+		Error
+}
+
+var _ domain.PasswordResetTokenRepository = (*GormPasswordResetTokenRepository)(nil)
@@ -0,0 +1,101 @@
+package passwordreset
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+type SUT struct {
+	Repository *GormPasswordResetTokenRepository
+	Token      *domain.PasswordResetToken
+	Secret     string
+}
+
+func makeSut(t *testing.T) SUT {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&PasswordResetTokenGorm{}))
+
+	token, secret, err := domain.NewPasswordResetToken("user-123", time.Hour)
+	require.NoError(t, err)
+
+	return SUT{
+		Repository: NewGormPasswordResetTokenRepository(db),
+		Token:      token,
+		Secret:     secret,
+	}
+}
+
+func TestPasswordResetTokenRepository_Save_ShouldPersistOnlyTheHash(t *testing.T) {
+	sut := makeSut(t)
+
+	require.NoError(t, sut.Repository.Save(context.Background(), sut.Token))
+
+	token, err := sut.Repository.GetByTokenHash(context.Background(), domain.HashSecretToken(sut.Secret))
+
+	require.NoError(t, err)
+	assert.Equal(t, sut.Token.ID, token.ID)
+	assert.Equal(t, sut.Token.UserId, token.UserId)
+	assert.NotEqual(t, sut.Secret, token.TokenHash)
+	assert.Nil(t, token.UsedAt)
+	assert.True(t, token.IsUsable(time.Now()))
+}
+
+func TestPasswordResetTokenRepository_Save_ShouldReturnError_WhenTokenIsNil(t *testing.T) {
+	sut := makeSut(t)
+
+	err := sut.Repository.Save(context.Background(), nil)
+
+	assert.ErrorIs(t, err, ErrRepoPasswordResetTokenIsNil)
+}
+
+func TestPasswordResetTokenRepository_GetByTokenHash_ShouldReturnError_WhenNotFound(t *testing.T) {
+	sut := makeSut(t)
+
+	token, err := sut.Repository.GetByTokenHash(context.Background(), domain.HashSecretToken("unknown"))
+
+	require.Nil(t, token)
+	assert.ErrorIs(t, err, domain.ErrPasswordResetTokenNotFound)
+}
+
+func TestPasswordResetTokenRepository_MarkUsed_ShouldOnlySucceedOnce(t *testing.T) {
+	sut := makeSut(t)
+	require.NoError(t, sut.Repository.Save(context.Background(), sut.Token))
+
+	first := sut.Repository.MarkUsed(context.Background(), sut.Token.ID, time.Now())
+	second := sut.Repository.MarkUsed(context.Background(), sut.Token.ID, time.Now())
+
+	require.NoError(t, first)
+	assert.ErrorIs(t, second, domain.ErrPasswordResetTokenInvalid)
+
+	token, err := sut.Repository.GetByTokenHash(context.Background(), sut.Token.TokenHash)
+	require.NoError(t, err)
+	assert.False(t, token.IsUsable(time.Now()))
+}
+
+func TestPasswordResetTokenRepository_InvalidateByUserId_ShouldOnlyTouchThatUser(t *testing.T) {
+	sut := makeSut(t)
+	require.NoError(t, sut.Repository.Save(context.Background(), sut.Token))
+
+	other, _, err := domain.NewPasswordResetToken("user-456", time.Hour)
+	require.NoError(t, err)
+	require.NoError(t, sut.Repository.Save(context.Background(), other))
+
+	require.NoError(t, sut.Repository.InvalidateByUserId(context.Background(), sut.Token.UserId, time.Now()))
+
+	invalidated, err := sut.Repository.GetByTokenHash(context.Background(), sut.Token.TokenHash)
+	require.NoError(t, err)
+	assert.NotNil(t, invalidated.UsedAt)
+
+	untouched, err := sut.Repository.GetByTokenHash(context.Background(), other.TokenHash)
+	require.NoError(t, err)
+	assert.Nil(t, untouched.UsedAt)
+}
@@ -0,0 +1,107 @@
+package passwordreset
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+)
+
+var ErrSimulatedFailureRepoPasswordResetToken = errors.New("database error")
+
+type InMemoryPasswordResetTokenRepository struct {
+	FailOnSave       bool
+	FailOnGet        bool
+	FailOnMarkUsed   bool
+	FailOnInvalidate bool
+	tokens           map[string]*domain.PasswordResetToken
+}
+
+func NewInMemoryPasswordResetTokenRepository() *InMemoryPasswordResetTokenRepository {
+	return &InMemoryPasswordResetTokenRepository{
+		tokens: make(map[string]*domain.PasswordResetToken),
+	}
+}
+
+func (r *InMemoryPasswordResetTokenRepository) Save(ctx context.Context, token *domain.PasswordResetToken) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if r.FailOnSave {
+		return ErrSimulatedFailureRepoPasswordResetToken
+	}
+
+	r.tokens[token.ID] = token
+	return nil
+}
+
+func (r *InMemoryPasswordResetTokenRepository) GetByTokenHash(ctx context.Context, tokenHash string) (*domain.PasswordResetToken, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	if r.FailOnGet {
+		return nil, ErrSimulatedFailureRepoPasswordResetToken
+	}
+
+	for _, t := range r.tokens {
+		if t.TokenHash == tokenHash {
+			return t, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (r *InMemoryPasswordResetTokenRepository) MarkUsed(ctx context.Context, id string, usedAt time.Time) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if r.FailOnMarkUsed {
+		return ErrSimulatedFailureRepoPasswordResetToken
+	}
+
+	token, exists := r.tokens[id]
+	if !exists || token.UsedAt != nil {
+		return domain.ErrPasswordResetTokenInvalid
+	}
+
+	token.UsedAt = &usedAt
+	return nil
+}
+
+func (r *InMemoryPasswordResetTokenRepository) InvalidateByUserId(ctx context.Context, userId string, usedAt time.Time) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if r.FailOnInvalidate {
+		return ErrSimulatedFailureRepoPasswordResetToken
+	}
+
+	for _, t := range r.tokens {
+		if t.UserId == userId && t.UsedAt == nil {
+			t.UsedAt = &usedAt
+		}
+	}
+
+	return nil
+}
+
+// ByUserId returns the tokens issued for a user, so tests can inspect them
+// without knowing the secrets.
+func (r *InMemoryPasswordResetTokenRepository) ByUserId(userId string) []*domain.PasswordResetToken {
+	tokens := []*domain.PasswordResetToken{}
+	for _, t := range r.tokens {
+		if t.UserId == userId {
+			tokens = append(tokens, t)
+		}
+	}
+
+	return tokens
+}
+
+var _ domain.PasswordResetTokenRepository = (*InMemoryPasswordResetTokenRepository)(nil)
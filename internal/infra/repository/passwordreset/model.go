@@ -0,0 +1,42 @@
+package passwordreset
+
+import (
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+)
+
+type PasswordResetTokenGorm struct {
+	ID        string    `gorm:"primaryKey"`
+	UserId    string    `gorm:"index;not null"`
+	TokenHash string    `gorm:"uniqueIndex;not null"`
+	ExpiresAt time.Time `gorm:"not null"`
+	UsedAt    *time.Time
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+}
+
+func (PasswordResetTokenGorm) TableName() string {
+	return "password_reset_tokens"
+}
+
+func (t *PasswordResetTokenGorm) ToDomain() *domain.PasswordResetToken {
+	return &domain.PasswordResetToken{
+		ID:        t.ID,
+		UserId:    t.UserId,
+		TokenHash: t.TokenHash,
+		ExpiresAt: t.ExpiresAt,
+		UsedAt:    t.UsedAt,
+		CreatedAt: t.CreatedAt,
+	}
+}
+
+func ToRepository(t *domain.PasswordResetToken) *PasswordResetTokenGorm {
+	return &PasswordResetTokenGorm{
+		ID:        t.ID,
+		UserId:    t.UserId,
+		TokenHash: t.TokenHash,
+		ExpiresAt: t.ExpiresAt,
+		UsedAt:    t.UsedAt,
+		CreatedAt: t.CreatedAt,
+	}
+}
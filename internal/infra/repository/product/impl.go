@@ -1,9 +1,12 @@
 package product
 
 import (
+	"context"
 	"errors"
+	"time"
 
 	"github.com/areteacademy/internal/domain"
+	"github.com/areteacademy/internal/infra/repository/pagination"
 	"gorm.io/gorm"
 )
 
@@ -11,27 +14,47 @@ var ErrRepoProductIsNil = errors.New("product is nil")
 
 type GormProductRepository struct {
 	db *gorm.DB
+	// searchable is whether the search index existed when the repository
+	// was built. Without it Search reports domain.ErrProductSearchUnavailable.
+	searchable bool
 }
 
+// NewGormProductRepository returns a repository without search, as used
+// inside transactions, which never search.
 func NewGormProductRepository(db *gorm.DB) *GormProductRepository {
-	return &GormProductRepository{db: db}
+	return &GormProductRepository{db: pagination.Timestamps(db)}
 }
 
-func (r *GormProductRepository) Save(product *domain.Product) error {
+// NewGormSearchableProductRepository looks for the search index once, so it
+// must be called after MigrateSearch. A failed lookup is returned rather
+// than read as a missing index.
+func NewGormSearchableProductRepository(db *gorm.DB) (*GormProductRepository, error) {
+	searchable, err := hasSearchIndex(db)
+	if err != nil {
+		return nil, err
+	}
+
+	repository := NewGormProductRepository(db)
+	repository.searchable = searchable
+
+	return repository, nil
+}
+
+func (r *GormProductRepository) Save(ctx context.Context, product *domain.Product) error {
 	if product == nil {
 		return ErrRepoProductIsNil
 	}
 
 	model := ToRepository(product)
 
-	if err := r.db.Create(model).Error; err != nil {
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func (r *GormProductRepository) Update(product *domain.Product) error {
+func (r *GormProductRepository) Update(ctx context.Context, product *domain.Product) error {
 	if product == nil {
 		return ErrRepoProductIsNil
 	}
@@ -39,6 +62,7 @@ func (r *GormProductRepository) Update(product *domain.Product) error {
 	model := ToRepository(product)
 
 	result := r.db.
+		WithContext(ctx).
 		Model(&ProductGorm{}).
 		Where("id = ?", product.ID).
 		Updates(model)
@@ -54,13 +78,13 @@ func (r *GormProductRepository) Update(product *domain.Product) error {
 	return nil
 }
 
-func (r *GormProductRepository) GetById(id string) (*domain.Product, error) {
+func (r *GormProductRepository) GetById(ctx context.Context, id string) (*domain.Product, error) {
 	var models ProductGorm
 
-	err := r.db.First(&models, "id = ?", id).Error
+	err := r.db.WithContext(ctx).First(&models, "id = ?", id).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, err
+			return nil, domain.ErrProductNotFound
 		}
 		return nil, err
 	}
@@ -68,13 +92,13 @@ func (r *GormProductRepository) GetById(id string) (*domain.Product, error) {
 	return models.ToDomain(), nil
 }
 
-func (r *GormProductRepository) GetByIdAndUserId(id, userId string) (*domain.Product, error) {
+func (r *GormProductRepository) GetByIdAndUserId(ctx context.Context, id, userId string) (*domain.Product, error) {
 	var models ProductGorm
 
-	err := r.db.First(&models, "id = ? AND user_id = ?", id, userId).Error
+	err := r.db.WithContext(ctx).First(&models, "id = ? AND user_id = ?", id, userId).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, err
+			return nil, domain.ErrProductNotFound
 		}
 		return nil, err
 	}
@@ -82,33 +106,228 @@ func (r *GormProductRepository) GetByIdAndUserId(id, userId string) (*domain.Pro
 	return models.ToDomain(), nil
 }
 
-func (r *GormProductRepository) ListByUserId(userId string) ([]*domain.Product, error) {
-	var models []ProductGorm
+func (r *GormProductRepository) GetDeletedByIdAndUserId(ctx context.Context, id, userId string) (*domain.Product, error) {
+	var model ProductGorm
 
-	err := r.db.Find(&models, "user_id = ?", userId).Error
+	err := r.db.
+		WithContext(ctx).
+		Unscoped().
+		First(&model, "id = ? AND user_id = ? AND deleted_at IS NOT NULL", id, userId).
+		Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, err
+			return nil, domain.ErrProductNotFound
 		}
+
 		return nil, err
 	}
 
+	return model.ToDomain(), nil
+}
+
+func (r *GormProductRepository) ListByUserId(
+	ctx context.Context,
+	userId string,
+	criteria domain.ProductCriteria,
+) (domain.Page[*domain.Product], error) {
+	var models []ProductGorm
+
+	err := r.db.
+		WithContext(ctx).
+		Where("user_id = ?", userId).
+		Scopes(filterScope(criteria.Filter), pagination.Scope(criteria.Page)).
+		Find(&models).
+		Error
+	if err != nil {
+		return domain.Page[*domain.Product]{}, err
+	}
+
 	products := make([]*domain.Product, 0, len(models))
 	for _, model := range models {
 		products = append(products, model.ToDomain())
 	}
 
-	return products, nil
+	return domain.NewPage(products, criteria.Page, (*domain.Product).Cursor), nil
 }
 
-func (r *GormProductRepository) Count() (int, error) {
+func (r *GormProductRepository) Count(ctx context.Context) (int, error) {
 	var count int64
 
-	if err := r.db.Model(&ProductGorm{}).Count(&count).Error; err != nil {
+	if err := r.db.WithContext(ctx).Model(&ProductGorm{}).Count(&count).Error; err != nil {
 		return 0, err
 	}
 
 	return int(count), nil
 }
 
+func (r *GormProductRepository) CountByCategoryId(ctx context.Context, categoryId string) (int, error) {
+	var count int64
+
+	err := r.db.
+		WithContext(ctx).
+		Model(&ProductGorm{}).
+		Where("category_id = ?", categoryId).
+		Count(&count).
+		Error
+	if err != nil {
+		return 0, err
+	}
+
+	return int(count), nil
+}
+
+func (r *GormProductRepository) Delete(ctx context.Context, product *domain.Product) error {
+	if product == nil {
+		return ErrRepoProductIsNil
+	}
+
+	result := r.db.
+		WithContext(ctx).
+		Model(&ProductGorm{}).
+		Where("id = ?", product.ID).
+		Updates(map[string]any{
+			"deleted_at": toRepositoryDeletedAt(product.DeletedAt),
+			"updated_at": pagination.Timestamp(product.UpdatedAt),
+		})
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrProductNotFound
+	}
+
+	return nil
+}
+
+func (r *GormProductRepository) DeleteByCategoryId(ctx context.Context, categoryId string, deletedAt time.Time) (int, error) {
+	result := r.db.
+		WithContext(ctx).
+		Model(&ProductGorm{}).
+		Where("category_id = ?", categoryId).
+		Updates(map[string]any{
+			"deleted_at": pagination.Timestamp(deletedAt),
+			"updated_at": pagination.Timestamp(deletedAt),
+		})
+
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	return int(result.RowsAffected), nil
+}
+
+func (r *GormProductRepository) ReassignCategory(ctx context.Context, fromCategoryId, toCategoryId string, updatedAt time.Time) (int, error) {
+	result := r.db.
+		WithContext(ctx).
+		Model(&ProductGorm{}).
+		Where("category_id = ?", fromCategoryId).
+		Updates(map[string]any{
+			"category_id": toCategoryId,
+			"updated_at":  pagination.Timestamp(updatedAt),
+		})
+
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	return int(result.RowsAffected), nil
+}
+
+func (r *GormProductRepository) Restore(ctx context.Context, product *domain.Product) error {
+	if product == nil {
+		return ErrRepoProductIsNil
+	}
+
+	result := r.db.
+		WithContext(ctx).
+		Unscoped().
+		Model(&ProductGorm{}).
+		Where("id = ? AND deleted_at IS NOT NULL", product.ID).
+		Updates(map[string]any{
+			"deleted_at": nil,
+			"updated_at": pagination.Timestamp(product.UpdatedAt),
+		})
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrProductNotFound
+	}
+
+	return nil
+}
+
+func (r *GormProductRepository) Purge(ctx context.Context, id string) error {
+	result := r.db.
+		WithContext(ctx).
+		Unscoped().
+		Delete(&ProductGorm{}, "id = ?", id)
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrProductNotFound
+	}
+
+	return nil
+}
+
+func (r *GormProductRepository) PurgeByCategoryId(ctx context.Context, categoryId string) (int, error) {
+	result := r.db.
+		WithContext(ctx).
+		Unscoped().
+		Where("category_id = ? AND deleted_at IS NOT NULL", categoryId).
+		Delete(&ProductGorm{})
+
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	return int(result.RowsAffected), nil
+}
+
+// filterScope translates domain.ProductFilter.Matches into SQL.
+func filterScope(filter domain.ProductFilter) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if filter.Status != "" {
+			db = db.Where("status = ?", string(filter.Status))
+		}
+
+		if filter.CategoryId != "" {
+			db = db.Where("category_id = ?", filter.CategoryId)
+		}
+
+		if filter.MinPrice != nil {
+			db = db.Where("price >= ?", *filter.MinPrice)
+		}
+
+		if filter.MaxPrice != nil {
+			db = db.Where("price <= ?", *filter.MaxPrice)
+		}
+
+		db = timeRangeScope(db, "created_at", filter.Created)
+		db = timeRangeScope(db, "updated_at", filter.Updated)
+
+		return db
+	}
+}
+
+func timeRangeScope(db *gorm.DB, column string, r domain.TimeRange) *gorm.DB {
+	if r.From != nil {
+		db = db.Where(column+" >= ?", pagination.Timestamp(*r.From))
+	}
+
+	if r.To != nil {
+		db = db.Where(column+" <= ?", pagination.Timestamp(*r.To))
+	}
+
+	return db
+}
+
 var _ domain.ProductRepository = (*GormProductRepository)(nil)
@@ -1,6 +1,7 @@
 package product
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -17,6 +18,10 @@ type SUT struct {
 	Product    *domain.Product
 }
 
+var firstPage = domain.ProductCriteria{
+	Page: domain.PageRequest{Limit: domain.DefaultPageSize, Sort: domain.DefaultSort},
+}
+
 func makeSut(t *testing.T) SUT {
 	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
 
@@ -49,9 +54,9 @@ func makeSut(t *testing.T) SUT {
 func TestProductRepository_Save_ShouldPersistProduct(t *testing.T) {
 	sut := makeSut(t)
 
-	require.NoError(t, sut.Repository.Save(sut.Product))
+	require.NoError(t, sut.Repository.Save(context.Background(), sut.Product))
 
-	getProduct, err := sut.Repository.GetById(sut.Product.ID)
+	getProduct, err := sut.Repository.GetById(context.Background(), sut.Product.ID)
 
 	require.NoError(t, err)
 	require.NotNil(t, getProduct)
@@ -72,7 +77,7 @@ func TestProductRepository_Update_ShouldReturnError_WhenProductNotFound(t *testi
 
 	sut.Product.ID = "product-123456"
 
-	err := sut.Repository.Update(sut.Product)
+	err := sut.Repository.Update(context.Background(), sut.Product)
 
 	require.Error(t, err)
 	assert.ErrorIs(t, err, domain.ErrProductNotFound)
@@ -81,15 +86,15 @@ func TestProductRepository_Update_ShouldReturnError_WhenProductNotFound(t *testi
 func TestProductRepository_Update_ShouldUpdateProduct(t *testing.T) {
 	sut := makeSut(t)
 
-	require.NoError(t, sut.Repository.Save(sut.Product))
+	require.NoError(t, sut.Repository.Save(context.Background(), sut.Product))
 
 	sut.Product.CategoryId = "category-updated"
 	sut.Product.Name = "Notebook updated"
 	sut.Product.Price = 1200
 
-	require.NoError(t, sut.Repository.Update(sut.Product))
+	require.NoError(t, sut.Repository.Update(context.Background(), sut.Product))
 
-	getProduct, err := sut.Repository.GetById(sut.Product.ID)
+	getProduct, err := sut.Repository.GetById(context.Background(), sut.Product.ID)
 
 	require.NoError(t, err)
 	require.NotNil(t, getProduct)
@@ -102,9 +107,9 @@ func TestProductRepository_Update_ShouldUpdateProduct(t *testing.T) {
 func TestProductRepository_GetById_ShouldReturnProduct(t *testing.T) {
 	sut := makeSut(t)
 
-	require.NoError(t, sut.Repository.Save(sut.Product))
+	require.NoError(t, sut.Repository.Save(context.Background(), sut.Product))
 
-	getProduct, err := sut.Repository.GetById(sut.Product.ID)
+	getProduct, err := sut.Repository.GetById(context.Background(), sut.Product.ID)
 
 	require.NoError(t, err)
 	require.NotNil(t, getProduct)
@@ -117,9 +122,9 @@ func TestProductRepository_GetById_ShouldReturnProduct(t *testing.T) {
 func TestProductRepository_GetByIdAndUserId_ShouldReturnProduct(t *testing.T) {
 	sut := makeSut(t)
 
-	require.NoError(t, sut.Repository.Save(sut.Product))
+	require.NoError(t, sut.Repository.Save(context.Background(), sut.Product))
 
-	getProduct, err := sut.Repository.GetByIdAndUserId(sut.Product.ID, sut.Product.UserId)
+	getProduct, err := sut.Repository.GetByIdAndUserId(context.Background(), sut.Product.ID, sut.Product.UserId)
 
 	require.NoError(t, err)
 	require.NotNil(t, getProduct)
@@ -144,13 +149,14 @@ func TestProductRepository_ListByIdAndUserId_ShouldReturnProduct(t *testing.T) {
 	product3.ID = "product-03"
 	product3.Name = "product-name-03"
 
-	require.NoError(t, sut.Repository.Save(&product1))
-	require.NoError(t, sut.Repository.Save(&product2))
-	require.NoError(t, sut.Repository.Save(&product3))
+	require.NoError(t, sut.Repository.Save(context.Background(), &product1))
+	require.NoError(t, sut.Repository.Save(context.Background(), &product2))
+	require.NoError(t, sut.Repository.Save(context.Background(), &product3))
 
-	products, err := sut.Repository.ListByUserId(sut.Product.UserId)
+	page, err := sut.Repository.ListByUserId(context.Background(), sut.Product.UserId, firstPage)
 
 	require.NoError(t, err)
+	products := page.Items
 	require.NotNil(t, products)
 
 	ids := []string{products[0].ID, products[1].ID, products[2].ID}
@@ -172,12 +178,372 @@ func TestProductRepository_Count_ShouldReturnCorrectValue(t *testing.T) {
 	product3.ID = "product-03"
 	product3.Name = "product-name-03"
 
-	require.NoError(t, sut.Repository.Save(&product1))
-	require.NoError(t, sut.Repository.Save(&product2))
-	require.NoError(t, sut.Repository.Save(&product3))
+	require.NoError(t, sut.Repository.Save(context.Background(), &product1))
+	require.NoError(t, sut.Repository.Save(context.Background(), &product2))
+	require.NoError(t, sut.Repository.Save(context.Background(), &product3))
 
-	count, err := sut.Repository.Count()
+	count, err := sut.Repository.Count(context.Background())
 
 	require.NoError(t, err)
 	assert.Equal(t, 3, count)
 }
+
+func TestProductRepository_Delete_ShouldHideProductFromQueries(t *testing.T) {
+	sut := makeSut(t)
+
+	require.NoError(t, sut.Repository.Save(context.Background(), sut.Product))
+
+	sut.Product.Delete()
+	require.NoError(t, sut.Repository.Delete(context.Background(), sut.Product))
+
+	getProduct, err := sut.Repository.GetById(context.Background(), sut.Product.ID)
+	require.Error(t, err)
+	require.Nil(t, getProduct)
+	assert.ErrorIs(t, err, domain.ErrProductNotFound)
+
+	list, err := sut.Repository.ListByUserId(context.Background(), sut.Product.UserId, firstPage)
+	require.NoError(t, err)
+	assert.Empty(t, list.Items)
+
+	count, err := sut.Repository.Count(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+
+	deleted, err := sut.Repository.GetDeletedByIdAndUserId(context.Background(), sut.Product.ID, sut.Product.UserId)
+	require.NoError(t, err)
+	require.NotNil(t, deleted)
+	require.NotNil(t, deleted.DeletedAt)
+	assert.Equal(t, sut.Product.DeletedAt.Unix(), deleted.DeletedAt.Unix())
+}
+
+func TestProductRepository_Delete_ShouldReturnError_WhenProductNotFound(t *testing.T) {
+	sut := makeSut(t)
+
+	sut.Product.Delete()
+	err := sut.Repository.Delete(context.Background(), sut.Product)
+
+	require.Error(t, err)
+	assert.ErrorIs(t, err, domain.ErrProductNotFound)
+}
+
+func TestProductRepository_Restore_ShouldMakeProductVisibleAgain(t *testing.T) {
+	sut := makeSut(t)
+
+	require.NoError(t, sut.Repository.Save(context.Background(), sut.Product))
+	sut.Product.Delete()
+	require.NoError(t, sut.Repository.Delete(context.Background(), sut.Product))
+
+	sut.Product.Restore()
+	require.NoError(t, sut.Repository.Restore(context.Background(), sut.Product))
+
+	getProduct, err := sut.Repository.GetById(context.Background(), sut.Product.ID)
+	require.NoError(t, err)
+	require.NotNil(t, getProduct)
+	assert.Nil(t, getProduct.DeletedAt)
+}
+
+func TestProductRepository_Restore_ShouldReturnError_WhenProductNotDeleted(t *testing.T) {
+	sut := makeSut(t)
+
+	require.NoError(t, sut.Repository.Save(context.Background(), sut.Product))
+	err := sut.Repository.Restore(context.Background(), sut.Product)
+
+	require.Error(t, err)
+	assert.ErrorIs(t, err, domain.ErrProductNotFound)
+}
+
+func TestProductRepository_Purge_ShouldRemoveProductPermanently(t *testing.T) {
+	sut := makeSut(t)
+
+	require.NoError(t, sut.Repository.Save(context.Background(), sut.Product))
+	sut.Product.Delete()
+	require.NoError(t, sut.Repository.Delete(context.Background(), sut.Product))
+
+	require.NoError(t, sut.Repository.Purge(context.Background(), sut.Product.ID))
+
+	deleted, err := sut.Repository.GetDeletedByIdAndUserId(context.Background(), sut.Product.ID, sut.Product.UserId)
+	require.Error(t, err)
+	require.Nil(t, deleted)
+	assert.ErrorIs(t, err, domain.ErrProductNotFound)
+
+	var total int64
+	require.NoError(t, sut.DB.Unscoped().Model(&ProductGorm{}).Count(&total).Error)
+	assert.Equal(t, int64(0), total)
+}
+
+func TestProductRepository_Purge_ShouldReturnError_WhenProductNotFound(t *testing.T) {
+	sut := makeSut(t)
+
+	err := sut.Repository.Purge(context.Background(), "not-found")
+
+	require.Error(t, err)
+	assert.ErrorIs(t, err, domain.ErrProductNotFound)
+}
+
+func TestProductRepository_PurgeByCategoryId_ShouldOnlyRemoveDeletedProducts(t *testing.T) {
+	sut := makeSut(t)
+
+	active := *sut.Product
+	active.ID = "product-01"
+
+	deleted := *sut.Product
+	deleted.ID = "product-02"
+
+	require.NoError(t, sut.Repository.Save(context.Background(), &active))
+	require.NoError(t, sut.Repository.Save(context.Background(), &deleted))
+	deleted.Delete()
+	require.NoError(t, sut.Repository.Delete(context.Background(), &deleted))
+
+	affected, err := sut.Repository.PurgeByCategoryId(context.Background(), sut.Product.CategoryId)
+	require.NoError(t, err)
+	assert.Equal(t, 1, affected)
+
+	var total int64
+	require.NoError(t, sut.DB.Unscoped().Model(&ProductGorm{}).Count(&total).Error)
+	assert.Equal(t, int64(1), total)
+
+	getProduct, err := sut.Repository.GetById(context.Background(), active.ID)
+	require.NoError(t, err)
+	require.NotNil(t, getProduct)
+}
+
+func TestProductRepository_CountByCategoryId_ShouldIgnoreDeletedProducts(t *testing.T) {
+	sut := makeSut(t)
+
+	product1 := *sut.Product
+	product1.ID = "product-01"
+
+	product2 := *sut.Product
+	product2.ID = "product-02"
+
+	product3 := *sut.Product
+	product3.ID = "product-03"
+	product3.CategoryId = "category-other"
+
+	require.NoError(t, sut.Repository.Save(context.Background(), &product1))
+	require.NoError(t, sut.Repository.Save(context.Background(), &product2))
+	require.NoError(t, sut.Repository.Save(context.Background(), &product3))
+
+	product2.Delete()
+	require.NoError(t, sut.Repository.Delete(context.Background(), &product2))
+
+	count, err := sut.Repository.CountByCategoryId(context.Background(), sut.Product.CategoryId)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+}
+
+func TestProductRepository_DeleteByCategoryId_ShouldSoftDeleteCategoryProducts(t *testing.T) {
+	sut := makeSut(t)
+
+	product1 := *sut.Product
+	product1.ID = "product-01"
+
+	product2 := *sut.Product
+	product2.ID = "product-02"
+	product2.CategoryId = "category-other"
+
+	require.NoError(t, sut.Repository.Save(context.Background(), &product1))
+	require.NoError(t, sut.Repository.Save(context.Background(), &product2))
+
+	affected, err := sut.Repository.DeleteByCategoryId(context.Background(), sut.Product.CategoryId, time.Now())
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, affected)
+
+	_, err = sut.Repository.GetById(context.Background(), product1.ID)
+	assert.ErrorIs(t, err, domain.ErrProductNotFound)
+
+	other, err := sut.Repository.GetById(context.Background(), product2.ID)
+	require.NoError(t, err)
+	assert.Nil(t, other.DeletedAt)
+}
+
+func TestProductRepository_ReassignCategory_ShouldMoveProducts(t *testing.T) {
+	sut := makeSut(t)
+
+	product1 := *sut.Product
+	product1.ID = "product-01"
+
+	product2 := *sut.Product
+	product2.ID = "product-02"
+
+	require.NoError(t, sut.Repository.Save(context.Background(), &product1))
+	require.NoError(t, sut.Repository.Save(context.Background(), &product2))
+
+	affected, err := sut.Repository.ReassignCategory(context.Background(), sut.Product.CategoryId, "category-new", time.Now())
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, affected)
+
+	count, err := sut.Repository.CountByCategoryId(context.Background(), "category-new")
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+}
+
+func TestProductRepository_ListByUserId_ShouldMatchInMemoryForEveryCriteria(t *testing.T) {
+	sut := makeSut(t)
+	inMemory := NewInMemoryProductRepository()
+
+	base := sut.Product.CreatedAt
+	seed := []struct {
+		id         string
+		name       string
+		categoryId string
+		status     domain.ProductStatus
+		price      int
+		offset     time.Duration
+	}{
+		{"p01", "Mouse", "cat-a", domain.ProductStatusActive, 100, 0},
+		{"p02", "Teclado", "cat-a", domain.ProductStatusInactive, 300, time.Hour},
+		{"p03", "Monitor", "cat-b", domain.ProductStatusActive, 1500, 2 * time.Hour},
+		{"p04", "Cadeira", "cat-b", domain.ProductStatusActive, 300, 3 * time.Hour},
+		{"p05", "Mesa", "cat-a", domain.ProductStatusActive, 800, 3 * time.Hour},
+	}
+
+	for _, s := range seed {
+		product := *sut.Product
+		product.ID = s.id
+		product.Name = s.name
+		product.CategoryId = s.categoryId
+		product.Status = string(s.status)
+		product.Price = s.price
+		product.CreatedAt = base.Add(s.offset)
+		product.UpdatedAt = base.Add(4*time.Hour - s.offset)
+
+		require.NoError(t, sut.Repository.Save(context.Background(), &product))
+		stored := product
+		require.NoError(t, inMemory.Save(context.Background(), &stored))
+	}
+
+	minPrice, maxPrice := 300, 1000
+	from, to := base.Add(time.Hour), base.Add(3*time.Hour)
+
+	testCases := []struct {
+		name     string
+		filter   domain.ProductFilter
+		sort     domain.Sort
+		expected []string
+	}{
+		{
+			name:     "Default order",
+			sort:     domain.DefaultSort,
+			expected: []string{"p01", "p02", "p03", "p04", "p05"},
+		},
+		{
+			name:     "Status and category",
+			filter:   domain.ProductFilter{Status: domain.ProductStatusActive, CategoryId: "cat-a"},
+			sort:     domain.DefaultSort,
+			expected: []string{"p01", "p05"},
+		},
+		{
+			name:     "Price range sorted by price descending",
+			filter:   domain.ProductFilter{MinPrice: &minPrice, MaxPrice: &maxPrice},
+			sort:     domain.Sort{Field: domain.SortByPrice, Direction: domain.SortDescending},
+			expected: []string{"p05", "p04", "p02"},
+		},
+		{
+			name:     "Created window sorted by name",
+			filter:   domain.ProductFilter{Created: domain.TimeRange{From: &from, To: &to}},
+			sort:     domain.Sort{Field: domain.SortByName, Direction: domain.SortAscending},
+			expected: []string{"p04", "p05", "p03", "p02"},
+		},
+		{
+			name:     "Updated window sorted by updated descending",
+			filter:   domain.ProductFilter{Updated: domain.TimeRange{From: &from}},
+			sort:     domain.Sort{Field: domain.SortByUpdatedAt, Direction: domain.SortDescending},
+			expected: []string{"p01", "p02", "p03", "p05", "p04"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			for _, limit := range []int{domain.DefaultPageSize, 2} {
+				gormIds := collectIds(t, sut.Repository, tc.filter, tc.sort, limit)
+				inMemoryIds := collectIds(t, inMemory, tc.filter, tc.sort, limit)
+
+				assert.Equal(t, tc.expected, gormIds)
+				assert.Equal(t, tc.expected, inMemoryIds)
+			}
+		})
+	}
+}
+
+func TestProductRepository_ListByUserId_ShouldCompareTimesAcrossOffsets(t *testing.T) {
+	sut := makeSut(t)
+
+	saoPaulo := time.FixedZone("UTC-3", -3*60*60)
+	tokyo := time.FixedZone("UTC+9", 9*60*60)
+	base := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+
+	// Each product is written in another zone, as happens when the server's
+	// zone changes between writes.
+	seed := []struct {
+		id   string
+		zone *time.Location
+	}{
+		{"p01", saoPaulo},
+		{"p02", tokyo},
+		{"p03", saoPaulo},
+		{"p04", tokyo},
+	}
+
+	for i, s := range seed {
+		product := *sut.Product
+		product.ID = s.id
+		product.CreatedAt = base.Add(time.Duration(i) * time.Hour).In(s.zone)
+		product.UpdatedAt = product.CreatedAt
+
+		require.NoError(t, sut.Repository.Save(context.Background(), &product))
+	}
+
+	from := base.Add(time.Hour).In(time.FixedZone("UTC+5:30", 5*60*60+30*60))
+	to := base.Add(2 * time.Hour).In(time.FixedZone("UTC-8", -8*60*60))
+	filter := domain.ProductFilter{Created: domain.TimeRange{From: &from, To: &to}}
+	sort := domain.Sort{Field: domain.SortByCreatedAt, Direction: domain.SortAscending}
+
+	assert.Equal(t, []string{"p02", "p03"}, collectIds(t, sut.Repository, filter, sort, 1))
+	assert.Equal(t, []string{"p01", "p02", "p03", "p04"}, collectIds(t, sut.Repository, domain.ProductFilter{}, sort, 1))
+}
+
+// collectIds walks every page forward and returns the product ids in order.
+func collectIds(
+	t *testing.T,
+	repository domain.ProductRepository,
+	filter domain.ProductFilter,
+	sort domain.Sort,
+	limit int,
+) []string {
+	ids := []string{}
+	criteria := domain.ProductCriteria{
+		Filter: filter,
+		Page:   domain.PageRequest{Limit: limit, Sort: sort},
+	}
+
+	for {
+		page, err := repository.ListByUserId(context.Background(), "user-123", criteria)
+		require.NoError(t, err)
+
+		for _, p := range page.Items {
+			ids = append(ids, p.ID)
+		}
+
+		if page.Next == nil {
+			return ids
+		}
+
+		criteria.Page.After = page.Next
+	}
+}
+
+func TestNewGormSearchableProductRepository_ShouldReturnError_WhenIndexLookupFails(t *testing.T) {
+	sut := makeSut(t)
+	sqlDB, err := sut.DB.DB()
+	require.NoError(t, err)
+	require.NoError(t, sqlDB.Close())
+
+	repository, err := NewGormSearchableProductRepository(sut.DB)
+
+	assert.Nil(t, repository)
+	assert.Error(t, err)
+}
@@ -1,9 +1,16 @@
 package product
 
 import (
+	"cmp"
+	"context"
 	"errors"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
 
 	"github.com/areteacademy/internal/domain"
+	"github.com/areteacademy/internal/infra/repository/pagination"
 )
 
 var ErrSimulatedFailureRepoProduct = errors.New("database error")
@@ -13,8 +20,17 @@ type InMemoryProductRepository struct {
 	FailOnUpdate           bool
 	FailOnGetById          bool
 	FailOnGetByIdAndUserId bool
+	FailOnGetDeleted       bool
 	FailOnList             bool
+	FailOnSearch           bool
 	FailOnCount            bool
+	FailOnCountByCategory  bool
+	FailOnDelete           bool
+	FailOnDeleteByCategory bool
+	FailOnReassign         bool
+	FailOnRestore          bool
+	FailOnPurge            bool
+	FailOnPurgeByCategory  bool
 	producties             map[string]*domain.Product
 }
 
@@ -24,7 +40,11 @@ func NewInMemoryProductRepository() *InMemoryProductRepository {
 	}
 }
 
-func (r *InMemoryProductRepository) Save(product *domain.Product) error {
+func (r *InMemoryProductRepository) Save(ctx context.Context, product *domain.Product) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
 	if r.FailOnSave {
 		return ErrSimulatedFailureRepoProduct
 	}
@@ -32,7 +52,11 @@ func (r *InMemoryProductRepository) Save(product *domain.Product) error {
 	return nil
 }
 
-func (r *InMemoryProductRepository) Update(product *domain.Product) error {
+func (r *InMemoryProductRepository) Update(ctx context.Context, product *domain.Product) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
 	if r.FailOnUpdate {
 		return ErrSimulatedFailureRepoProduct
 	}
@@ -40,24 +64,32 @@ func (r *InMemoryProductRepository) Update(product *domain.Product) error {
 	return nil
 }
 
-func (r *InMemoryProductRepository) GetById(id string) (*domain.Product, error) {
+func (r *InMemoryProductRepository) GetById(ctx context.Context, id string) (*domain.Product, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
 	if r.FailOnGetById {
 		return nil, ErrSimulatedFailureRepoProduct
 	}
 	product, exists := r.producties[id]
-	if !exists {
+	if !exists || product.IsDeleted() {
 		return nil, nil
 	}
 	return product, nil
 }
 
-func (r *InMemoryProductRepository) GetByIdAndUserId(id, userId string) (*domain.Product, error) {
+func (r *InMemoryProductRepository) GetByIdAndUserId(ctx context.Context, id, userId string) (*domain.Product, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
 	if r.FailOnGetByIdAndUserId {
 		return nil, ErrSimulatedFailureRepoProduct
 	}
 
 	for _, c := range r.producties {
-		if c.UserId == userId && c.ID == id {
+		if c.UserId == userId && c.ID == id && !c.IsDeleted() {
 			return c, nil
 		}
 	}
@@ -65,26 +97,313 @@ func (r *InMemoryProductRepository) GetByIdAndUserId(id, userId string) (*domain
 	return nil, nil
 }
 
-func (r *InMemoryProductRepository) ListByUserId(userId string) ([]*domain.Product, error) {
-	if r.FailOnList {
+func (r *InMemoryProductRepository) GetDeletedByIdAndUserId(ctx context.Context, id, userId string) (*domain.Product, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	if r.FailOnGetDeleted {
 		return nil, ErrSimulatedFailureRepoProduct
 	}
 
+	for _, c := range r.producties {
+		if c.UserId == userId && c.ID == id && c.IsDeleted() {
+			return c, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (r *InMemoryProductRepository) ListByUserId(
+	ctx context.Context,
+	userId string,
+	criteria domain.ProductCriteria,
+) (domain.Page[*domain.Product], error) {
+	if err := ctx.Err(); err != nil {
+		return domain.Page[*domain.Product]{}, err
+	}
+
+	if r.FailOnList {
+		return domain.Page[*domain.Product]{}, ErrSimulatedFailureRepoProduct
+	}
+
 	var producties []*domain.Product
 	for _, c := range r.producties {
-		if c.UserId == userId {
+		if c.UserId == userId && !c.IsDeleted() && criteria.Filter.Matches(c) {
 			producties = append(producties, c)
 		}
 	}
 
-	return producties, nil
+	rows := pagination.Slice(producties, criteria.Page, (*domain.Product).Cursor)
+
+	return domain.NewPage(rows, criteria.Page, (*domain.Product).Cursor), nil
 }
 
-func (r *InMemoryProductRepository) Count() (int, error) {
+func (r *InMemoryProductRepository) Count(ctx context.Context) (int, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+
 	if r.FailOnCount {
 		return 0, ErrSimulatedFailureRepoProduct
 	}
-	return len(r.producties), nil
+
+	count := 0
+	for _, c := range r.producties {
+		if !c.IsDeleted() {
+			count++
+		}
+	}
+
+	return count, nil
+}
+
+func (r *InMemoryProductRepository) CountByCategoryId(ctx context.Context, categoryId string) (int, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	if r.FailOnCountByCategory {
+		return 0, ErrSimulatedFailureRepoProduct
+	}
+
+	count := 0
+	for _, c := range r.producties {
+		if c.CategoryId == categoryId && !c.IsDeleted() {
+			count++
+		}
+	}
+
+	return count, nil
+}
+
+func (r *InMemoryProductRepository) Delete(ctx context.Context, product *domain.Product) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if r.FailOnDelete {
+		return ErrSimulatedFailureRepoProduct
+	}
+	r.producties[product.ID] = product
+	return nil
+}
+
+func (r *InMemoryProductRepository) DeleteByCategoryId(ctx context.Context, categoryId string, deletedAt time.Time) (int, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	if r.FailOnDeleteByCategory {
+		return 0, ErrSimulatedFailureRepoProduct
+	}
+
+	affected := 0
+	for _, c := range r.producties {
+		if c.CategoryId == categoryId && !c.IsDeleted() {
+			at := deletedAt
+			c.DeletedAt = &at
+			c.UpdatedAt = deletedAt
+			affected++
+		}
+	}
+
+	return affected, nil
+}
+
+func (r *InMemoryProductRepository) ReassignCategory(ctx context.Context, fromCategoryId, toCategoryId string, updatedAt time.Time) (int, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	if r.FailOnReassign {
+		return 0, ErrSimulatedFailureRepoProduct
+	}
+
+	affected := 0
+	for _, c := range r.producties {
+		if c.CategoryId == fromCategoryId && !c.IsDeleted() {
+			c.CategoryId = toCategoryId
+			c.UpdatedAt = updatedAt
+			affected++
+		}
+	}
+
+	return affected, nil
+}
+
+func (r *InMemoryProductRepository) Restore(ctx context.Context, product *domain.Product) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if r.FailOnRestore {
+		return ErrSimulatedFailureRepoProduct
+	}
+	r.producties[product.ID] = product
+	return nil
+}
+
+func (r *InMemoryProductRepository) Purge(ctx context.Context, id string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if r.FailOnPurge {
+		return ErrSimulatedFailureRepoProduct
+	}
+	delete(r.producties, id)
+	return nil
+}
+
+func (r *InMemoryProductRepository) PurgeByCategoryId(ctx context.Context, categoryId string) (int, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	if r.FailOnPurgeByCategory {
+		return 0, ErrSimulatedFailureRepoProduct
+	}
+
+	affected := 0
+	for id, p := range r.producties {
+		if p.CategoryId == categoryId && p.IsDeleted() {
+			delete(r.producties, id)
+			affected++
+		}
+	}
+
+	return affected, nil
+}
+
+// Search is a naive stand-in for the FTS5 index: every term must prefix a
+// word of the name or description, and name matches weigh ten times more.
+func (r *InMemoryProductRepository) Search(
+	ctx context.Context,
+	userId string,
+	query domain.ProductSearchQuery,
+) ([]domain.ProductSearchResult, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	if r.FailOnSearch {
+		return nil, ErrSimulatedFailureRepoProduct
+	}
+
+	results := []domain.ProductSearchResult{}
+	for _, p := range r.producties {
+		if p.UserId != userId || p.IsDeleted() {
+			continue
+		}
+
+		name, nameHits := highlight(p.Name, query.Terms)
+		description, descriptionHits := highlight(p.Description, query.Terms)
+
+		if !matchesAll(p.Name+" "+p.Description, query.Terms) {
+			continue
+		}
+
+		snippet := name
+		if descriptionHits > nameHits {
+			snippet = description
+		}
+
+		results = append(results, domain.ProductSearchResult{
+			Product: p,
+			Rank:    float64(10*nameHits + descriptionHits),
+			Snippet: domain.HighlightSnippet(snippet),
+		})
+	}
+
+	slices.SortFunc(results, func(a, b domain.ProductSearchResult) int {
+		if a.Rank != b.Rank {
+			return cmp.Compare(b.Rank, a.Rank)
+		}
+
+		return strings.Compare(a.Product.ID, b.Product.ID)
+	})
+
+	if len(results) > query.Limit {
+		results = results[:query.Limit]
+	}
+
+	return results, nil
+}
+
+// Snapshot copies the stored products and returns a function that puts them
+// back, which is how the in-memory unit of work rolls back.
+func (r *InMemoryProductRepository) Snapshot() func() {
+	saved := make(map[string]domain.Product, len(r.producties))
+	for id, p := range r.producties {
+		saved[id] = *p
+	}
+
+	return func() {
+		r.producties = make(map[string]*domain.Product, len(saved))
+		for id, p := range saved {
+			r.producties[id] = &p
+		}
+	}
 }
 
 var _ domain.ProductRepository = (*InMemoryProductRepository)(nil)
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+func matchesTerm(word string, terms []string) bool {
+	word = strings.ToLower(word)
+	for _, term := range terms {
+		if strings.HasPrefix(word, term) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func matchesAll(text string, terms []string) bool {
+	for _, term := range terms {
+		if _, hits := highlight(text, []string{term}); hits == 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// highlight wraps every word of text starting with one of terms in the
+// domain match delimiters and reports how many words it wrapped.
+func highlight(text string, terms []string) (string, int) {
+	var builder strings.Builder
+	hits := 0
+	runes := []rune(text)
+
+	for i := 0; i < len(runes); {
+		if !isWordRune(runes[i]) {
+			builder.WriteRune(runes[i])
+			i++
+			continue
+		}
+
+		end := i
+		for end < len(runes) && isWordRune(runes[end]) {
+			end++
+		}
+
+		word := string(runes[i:end])
+		if matchesTerm(word, terms) {
+			hits++
+			builder.WriteString(domain.SearchMatchStart + word + domain.SearchMatchEnd)
+		} else {
+			builder.WriteString(word)
+		}
+
+		i = end
+	}
+
+	return builder.String(), hits
+}
@@ -4,18 +4,21 @@ import (
 	"time"
 
 	"github.com/areteacademy/internal/domain"
+	"github.com/areteacademy/internal/infra/repository/pagination"
+	"gorm.io/gorm"
 )
 
 type ProductGorm struct {
-	ID          string    `gorm:"primaryKey"`
-	UserId      string    `gorm:"index;not nul"`
-	CategoryId  string    `gorm:"index;not nul"`
-	Name        string    `gorm:"not nul"`
-	Description string    `gorm:"not nul"`
-	Status      string    `gorm:"index;not nul"`
-	Price       int       `gorm:"not nul"`
-	CreatedAt   time.Time `gorm:"autoCreateTime"`
-	UpdatedAt   time.Time `gorm:"autoUpdateTime"`
+	ID          string         `gorm:"primaryKey"`
+	UserId      string         `gorm:"index;not nul"`
+	CategoryId  string         `gorm:"index;not nul"`
+	Name        string         `gorm:"not nul"`
+	Description string         `gorm:"not nul"`
+	Status      string         `gorm:"index;not nul"`
+	Price       int            `gorm:"not nul"`
+	CreatedAt   time.Time      `gorm:"autoCreateTime"`
+	UpdatedAt   time.Time      `gorm:"autoUpdateTime"`
+	DeletedAt   gorm.DeletedAt `gorm:"index"`
 }
 
 func (ProductGorm) TableName() string {
@@ -33,6 +36,7 @@ func (u *ProductGorm) ToDomain() *domain.Product {
 		Price:       u.Price,
 		CreatedAt:   u.CreatedAt,
 		UpdatedAt:   u.UpdatedAt,
+		DeletedAt:   toDomainDeletedAt(u.DeletedAt),
 	}
 }
 
@@ -45,7 +49,25 @@ func ToRepository(u *domain.Product) *ProductGorm {
 		Description: u.Description,
 		Status:      u.Status,
 		Price:       u.Price,
-		CreatedAt:   u.CreatedAt,
-		UpdatedAt:   u.UpdatedAt,
+		CreatedAt:   pagination.Timestamp(u.CreatedAt),
+		UpdatedAt:   pagination.Timestamp(u.UpdatedAt),
+		DeletedAt:   toRepositoryDeletedAt(u.DeletedAt),
+	}
+}
+
+func toDomainDeletedAt(deletedAt gorm.DeletedAt) *time.Time {
+	if !deletedAt.Valid {
+		return nil
 	}
+
+	t := deletedAt.Time
+	return &t
+}
+
+func toRepositoryDeletedAt(deletedAt *time.Time) gorm.DeletedAt {
+	if deletedAt == nil {
+		return gorm.DeletedAt{}
+	}
+
+	return gorm.DeletedAt{Time: pagination.Timestamp(*deletedAt), Valid: true}
 }
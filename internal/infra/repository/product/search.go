@@ -0,0 +1,173 @@
+package product
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/areteacademy/internal/domain"
+	"gorm.io/gorm"
+)
+
+// The FTS5 module is only compiled into go-sqlite3 with the sqlite_fts5
+// build tag. Without it the index is not created and Search reports
+// domain.ErrProductSearchUnavailable.
+const searchTable = "products_fts"
+
+var searchTriggerNames = []string{"products_fts_insert", "products_fts_delete", "products_fts_update"}
+
+// The index keeps its own copy of the text, keyed by product id. It cannot
+// point at products by rowid: products has a text primary key, so its rowid
+// is not stable and VACUUM may renumber it.
+var searchTriggers = []string{
+	`CREATE TRIGGER IF NOT EXISTS products_fts_insert AFTER INSERT ON products BEGIN
+		INSERT INTO products_fts(id, name, description) VALUES (new.id, new.name, new.description);
+	END`,
+	`CREATE TRIGGER IF NOT EXISTS products_fts_delete AFTER DELETE ON products BEGIN
+		DELETE FROM products_fts WHERE id = old.id;
+	END`,
+	`CREATE TRIGGER IF NOT EXISTS products_fts_update AFTER UPDATE OF name, description ON products BEGIN
+		UPDATE products_fts SET name = new.name, description = new.description WHERE id = old.id;
+	END`,
+}
+
+// SearchAvailable reports whether the sqlite library was built with FTS5.
+func SearchAvailable(db *gorm.DB) bool {
+	var enabled bool
+	if err := db.Raw("SELECT sqlite_compileoption_used('ENABLE_FTS5')").Scan(&enabled).Error; err != nil {
+		return false
+	}
+
+	return enabled
+}
+
+// MigrateSearch creates the FTS5 index over the products table and the
+// triggers keeping it in sync. Rows that existed before the index are
+// indexed once, when it is created. An index keyed by rowid, as created
+// before, is replaced.
+func MigrateSearch(db *gorm.DB) error {
+	if !SearchAvailable(db) {
+		return nil
+	}
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		exists := tx.Migrator().HasTable(searchTable)
+
+		if exists && !tx.Migrator().HasColumn(searchTable, "id") {
+			if err := dropSearch(tx); err != nil {
+				return err
+			}
+
+			exists = false
+		}
+
+		err := tx.Exec(`CREATE VIRTUAL TABLE IF NOT EXISTS products_fts USING fts5(
+			id UNINDEXED,
+			name,
+			description,
+			tokenize='unicode61 remove_diacritics 2'
+		)`).Error
+		if err != nil {
+			return err
+		}
+
+		for _, trigger := range searchTriggers {
+			if err := tx.Exec(trigger).Error; err != nil {
+				return err
+			}
+		}
+
+		if exists {
+			return nil
+		}
+
+		return tx.Exec("INSERT INTO products_fts(id, name, description) SELECT id, name, description FROM products").Error
+	})
+}
+
+func hasSearchIndex(db *gorm.DB) (bool, error) {
+	var count int64
+
+	err := db.
+		Raw("SELECT count(*) FROM sqlite_master WHERE type = 'table' AND name = ?", searchTable).
+		Scan(&count).
+		Error
+
+	return count > 0, err
+}
+
+func dropSearch(tx *gorm.DB) error {
+	for _, name := range searchTriggerNames {
+		if err := tx.Exec("DROP TRIGGER IF EXISTS " + name).Error; err != nil {
+			return err
+		}
+	}
+
+	return tx.Exec("DROP TABLE " + searchTable).Error
+}
+
+type searchRow struct {
+	ProductGorm
+	Rank    float64
+	Snippet string
+}
+
+func (r *GormProductRepository) Search(
+	ctx context.Context,
+	userId string,
+	query domain.ProductSearchQuery,
+) ([]domain.ProductSearchResult, error) {
+	if !r.searchable {
+		return nil, domain.ErrProductSearchUnavailable
+	}
+
+	db := r.db.WithContext(ctx)
+
+	var rows []searchRow
+
+	// bm25 weighs a match in the name ten times one in the description, the
+	// id column is never matched, and returns lower scores for better
+	// matches, hence the negation.
+	err := db.Raw(`
+		SELECT products.*,
+			-bm25(products_fts, 0.0, 10.0, 1.0) AS rank,
+			snippet(products_fts, -1, ?, ?, '…', 12) AS snippet
+		FROM products_fts
+		JOIN products ON products.id = products_fts.id
+		WHERE products_fts MATCH ?
+			AND products.user_id = ?
+			AND products.deleted_at IS NULL
+		ORDER BY rank DESC, products.id ASC
+		LIMIT ?`,
+		domain.SearchMatchStart,
+		domain.SearchMatchEnd,
+		matchExpression(query.Terms),
+		userId,
+		query.Limit,
+	).Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]domain.ProductSearchResult, 0, len(rows))
+	for i := range rows {
+		results = append(results, domain.ProductSearchResult{
+			Product: rows[i].ToDomain(),
+			Rank:    rows[i].Rank,
+			Snippet: domain.HighlightSnippet(rows[i].Snippet),
+		})
+	}
+
+	return results, nil
+}
+
+// matchExpression quotes every term, so it is never parsed as FTS5 syntax,
+// and turns it into a prefix query.
+func matchExpression(terms []string) string {
+	quoted := make([]string, 0, len(terms))
+	for _, term := range terms {
+		quoted = append(quoted, fmt.Sprintf(`"%s"*`, strings.ReplaceAll(term, `"`, `""`)))
+	}
+
+	return strings.Join(quoted, " ")
+}
@@ -0,0 +1,201 @@
+//go:build sqlite_fts5
+
+package product
+
+import (
+	"context"
+	"testing"
+
+	"github.com/areteacademy/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func makeSearchSut(t *testing.T) SUT {
+	sut := makeSut(t)
+
+	require.True(t, SearchAvailable(sut.DB))
+	require.NoError(t, MigrateSearch(sut.DB))
+
+	return sut
+}
+
+func searchFor(t *testing.T, sut SUT, text string) []domain.ProductSearchResult {
+	query, err := domain.NewProductSearchQuery(text, 0)
+	require.NoError(t, err)
+
+	// Built here, since some tests only create the index after makeSut.
+	repository, err := NewGormSearchableProductRepository(sut.DB)
+	require.NoError(t, err)
+
+	results, err := repository.Search(context.Background(), sut.Product.UserId, query)
+	require.NoError(t, err)
+
+	return results
+}
+
+func searchIds(results []domain.ProductSearchResult) []string {
+	ids := make([]string, 0, len(results))
+	for _, r := range results {
+		ids = append(ids, r.Product.ID)
+	}
+
+	return ids
+}
+
+func TestProductRepository_Search_ShouldRankNameMatchesFirst(t *testing.T) {
+	sut := makeSearchSut(t)
+
+	inDescription := *sut.Product
+	inDescription.ID = "product-01"
+	inDescription.Name = "Mouse sem fio"
+	inDescription.Description = "Acompanha notebook"
+	require.NoError(t, sut.Repository.Save(context.Background(), &inDescription))
+
+	inName := *sut.Product
+	inName.ID = "product-02"
+	inName.Name = "Notebook Gamer"
+	inName.Description = "Placa de vídeo dedicada"
+	require.NoError(t, sut.Repository.Save(context.Background(), &inName))
+
+	unrelated := *sut.Product
+	unrelated.ID = "product-03"
+	unrelated.Name = "Cadeira"
+	unrelated.Description = "Ergonômica"
+	require.NoError(t, sut.Repository.Save(context.Background(), &unrelated))
+
+	results := searchFor(t, sut, "notebook")
+
+	assert.Equal(t, []string{"product-02", "product-01"}, searchIds(results))
+	assert.Greater(t, results[0].Rank, results[1].Rank)
+}
+
+func TestProductRepository_Search_ShouldMatchPrefixesAndHighlight(t *testing.T) {
+	sut := makeSearchSut(t)
+	require.NoError(t, sut.Repository.Save(context.Background(), sut.Product))
+
+	results := searchFor(t, sut, "note DEV")
+
+	require.Len(t, results, 1)
+	assert.Equal(t, sut.Product.ID, results[0].Product.ID)
+	assert.Contains(t, results[0].Snippet, "<mark>Notebook</mark>")
+}
+
+func TestProductRepository_Search_ShouldEscapeMarkupInSnippet(t *testing.T) {
+	sut := makeSearchSut(t)
+	sut.Product.Name = `<img src=x onerror="alert(1)"> Notebook`
+	require.NoError(t, sut.Repository.Save(context.Background(), sut.Product))
+
+	results := searchFor(t, sut, "notebook")
+
+	require.Len(t, results, 1)
+	assert.Equal(t, `&lt;img src=x onerror=&#34;alert(1)&#34;&gt; <mark>Notebook</mark>`, results[0].Snippet)
+}
+
+func TestProductRepository_Search_ShouldIgnoreDiacritics(t *testing.T) {
+	sut := makeSearchSut(t)
+	sut.Product.Description = "Cadeira ergonômica"
+	require.NoError(t, sut.Repository.Save(context.Background(), sut.Product))
+
+	assert.Equal(t, []string{sut.Product.ID}, searchIds(searchFor(t, sut, "ergonomica")))
+}
+
+func TestProductRepository_Search_ShouldFollowUpdates(t *testing.T) {
+	sut := makeSearchSut(t)
+	require.NoError(t, sut.Repository.Save(context.Background(), sut.Product))
+
+	sut.Product.Name = "Monitor"
+	sut.Product.Description = "Monitor ultrawide"
+	require.NoError(t, sut.Repository.Update(context.Background(), sut.Product))
+
+	assert.Empty(t, searchFor(t, sut, "notebook"))
+	assert.Equal(t, []string{sut.Product.ID}, searchIds(searchFor(t, sut, "ultrawide")))
+}
+
+func TestProductRepository_Search_ShouldExcludeDeletedAndPurgedProducts(t *testing.T) {
+	sut := makeSearchSut(t)
+	require.NoError(t, sut.Repository.Save(context.Background(), sut.Product))
+
+	purged := *sut.Product
+	purged.ID = "product-purged"
+	require.NoError(t, sut.Repository.Save(context.Background(), &purged))
+	require.NoError(t, sut.Repository.Purge(context.Background(), purged.ID))
+
+	sut.Product.Delete()
+	require.NoError(t, sut.Repository.Delete(context.Background(), sut.Product))
+
+	assert.Empty(t, searchFor(t, sut, "notebook"))
+}
+
+func TestProductRepository_Search_ShouldOnlyReturnUserProducts(t *testing.T) {
+	sut := makeSearchSut(t)
+	require.NoError(t, sut.Repository.Save(context.Background(), sut.Product))
+
+	other := *sut.Product
+	other.ID = "product-other"
+	other.UserId = "user-other"
+	require.NoError(t, sut.Repository.Save(context.Background(), &other))
+
+	assert.Equal(t, []string{sut.Product.ID}, searchIds(searchFor(t, sut, "notebook")))
+}
+
+func TestProductRepository_Search_ShouldTreatQuerySyntaxAsText(t *testing.T) {
+	sut := makeSearchSut(t)
+	require.NoError(t, sut.Repository.Save(context.Background(), sut.Product))
+
+	assert.Equal(t, []string{sut.Product.ID}, searchIds(searchFor(t, sut, `notebook* -"dev:(`)))
+}
+
+func TestProductRepository_MigrateSearch_ShouldIndexExistingProducts(t *testing.T) {
+	sut := makeSut(t)
+	require.NoError(t, sut.Repository.Save(context.Background(), sut.Product))
+
+	require.NoError(t, MigrateSearch(sut.DB))
+	require.NoError(t, MigrateSearch(sut.DB))
+
+	assert.Equal(t, []string{sut.Product.ID}, searchIds(searchFor(t, sut, "notebook")))
+}
+
+func TestProductRepository_Search_ShouldSurviveRowidRenumbering(t *testing.T) {
+	sut := makeSearchSut(t)
+
+	mouse := *sut.Product
+	mouse.ID = "product-01"
+	mouse.Name = "Mouse"
+	mouse.Description = "Mouse sem fio"
+	require.NoError(t, sut.Repository.Save(context.Background(), &mouse))
+	require.NoError(t, sut.Repository.Save(context.Background(), sut.Product))
+
+	// VACUUM may do the same to a table without an integer primary key.
+	require.NoError(t, sut.DB.Exec("UPDATE products SET rowid = rowid + 10").Error)
+	require.NoError(t, sut.DB.Exec("UPDATE products SET rowid = 13 - rowid").Error)
+
+	assert.Equal(t, []string{sut.Product.ID}, searchIds(searchFor(t, sut, "notebook")))
+	assert.Equal(t, []string{mouse.ID}, searchIds(searchFor(t, sut, "mouse")))
+}
+
+func TestProductRepository_MigrateSearch_ShouldReplaceRowidIndex(t *testing.T) {
+	sut := makeSut(t)
+	require.NoError(t, sut.Repository.Save(context.Background(), sut.Product))
+
+	require.NoError(t, sut.DB.Exec(`CREATE VIRTUAL TABLE products_fts USING fts5(
+		name,
+		description,
+		content='products',
+		content_rowid='rowid'
+	)`).Error)
+	require.NoError(t, sut.DB.Exec(`CREATE TRIGGER products_fts_insert AFTER INSERT ON products BEGIN
+		INSERT INTO products_fts(rowid, name, description) VALUES (new.rowid, new.name, new.description);
+	END`).Error)
+
+	require.NoError(t, MigrateSearch(sut.DB))
+
+	added := *sut.Product
+	added.ID = "product-added"
+	added.Name = "Monitor"
+	added.Description = "Monitor ultrawide"
+	require.NoError(t, sut.Repository.Save(context.Background(), &added))
+
+	assert.Equal(t, []string{sut.Product.ID}, searchIds(searchFor(t, sut, "notebook")))
+	assert.Equal(t, []string{added.ID}, searchIds(searchFor(t, sut, "ultrawide")))
+}
@@ -0,0 +1,29 @@
+//go:build !sqlite_fts5
+
+package product
+
+import (
+	"context"
+	"testing"
+
+	"github.com/areteacademy/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProductRepository_Search_ShouldReturnUnavailable_WithoutFts5(t *testing.T) {
+	sut := makeSut(t)
+	require.NoError(t, MigrateSearch(sut.DB))
+	require.NoError(t, sut.Repository.Save(context.Background(), sut.Product))
+
+	repository, err := NewGormSearchableProductRepository(sut.DB)
+	require.NoError(t, err)
+
+	query, err := domain.NewProductSearchQuery("notebook", 0)
+	require.NoError(t, err)
+
+	results, err := repository.Search(context.Background(), sut.Product.UserId, query)
+
+	assert.Nil(t, results)
+	assert.ErrorIs(t, err, domain.ErrProductSearchUnavailable)
+}
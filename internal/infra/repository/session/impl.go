@@ -0,0 +1,171 @@
+package session
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+	"github.com/areteacademy/internal/infra/repository/pagination"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrRepoSessionIsNil      = errors.New("session is nil")
+	ErrRepoRefreshTokenIsNil = errors.New("refresh token is nil")
+)
+
+type GormSessionRepository struct {
+	db *gorm.DB
+}
+
+func NewGormSessionRepository(db *gorm.DB) *GormSessionRepository {
+	return &GormSessionRepository{db: pagination.Timestamps(db)}
+}
+
+func (r *GormSessionRepository) Create(ctx context.Context, session *domain.Session, token *domain.RefreshToken) error {
+	if session == nil {
+		return ErrRepoSessionIsNil
+	}
+
+	if token == nil {
+		return ErrRepoRefreshTokenIsNil
+	}
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(ToRepository(session)).Error; err != nil {
+			return err
+		}
+
+		return tx.Create(ToRepositoryRefreshToken(token)).Error
+	})
+}
+
+func (r *GormSessionRepository) GetById(ctx context.Context, id string) (*domain.Session, error) {
+	var model SessionGorm
+
+	err := r.db.WithContext(ctx).First(&model, "id = ?", id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrSessionNotFound
+		}
+		return nil, err
+	}
+
+	return model.ToDomain(), nil
+}
+
+func (r *GormSessionRepository) GetByIdAndUserId(ctx context.Context, id, userId string) (*domain.Session, error) {
+	var model SessionGorm
+
+	err := r.db.WithContext(ctx).First(&model, "id = ? AND user_id = ?", id, userId).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrSessionNotFound
+		}
+		return nil, err
+	}
+
+	return model.ToDomain(), nil
+}
+
+func (r *GormSessionRepository) GetRefreshToken(ctx context.Context, tokenHash string) (*domain.RefreshToken, error) {
+	var model RefreshTokenGorm
+
+	err := r.db.WithContext(ctx).First(&model, "token_hash = ?", tokenHash).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrRefreshTokenNotFound
+		}
+		return nil, err
+	}
+
+	return model.ToDomain(), nil
+}
+
+// Rotate marks current as rotated at the time next was created.
+func (r *GormSessionRepository) Rotate(ctx context.Context, session *domain.Session, current, next *domain.RefreshToken) error {
+	if session == nil {
+		return ErrRepoSessionIsNil
+	}
+
+	if current == nil || next == nil {
+		return ErrRepoRefreshTokenIsNil
+	}
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		rotated := tx.
+			Model(&RefreshTokenGorm{}).
+			Where("id = ? AND rotated_at IS NULL", current.ID).
+			Update("rotated_at", pagination.Timestamp(next.CreatedAt))
+
+		if rotated.Error != nil {
+			return rotated.Error
+		}
+
+		if rotated.RowsAffected == 0 {
+			return domain.ErrRefreshTokenReused
+		}
+
+		refreshed := tx.
+			Model(&SessionGorm{}).
+			Where("id = ? AND revoked_at IS NULL", session.ID).
+			Updates(map[string]any{
+				"ip":           session.IP,
+				"last_used_at": pagination.Timestamp(session.LastUsedAt),
+				"expires_at":   pagination.Timestamp(session.ExpiresAt),
+			})
+
+		if refreshed.Error != nil {
+			return refreshed.Error
+		}
+
+		if refreshed.RowsAffected == 0 {
+			return domain.ErrRefreshTokenInvalid
+		}
+
+		return tx.Create(ToRepositoryRefreshToken(next)).Error
+	})
+}
+
+func (r *GormSessionRepository) ListActiveByUserId(ctx context.Context, userId string, now time.Time) ([]*domain.Session, error) {
+	var models []SessionGorm
+
+	err := r.db.
+		WithContext(ctx).
+		Where("user_id = ? AND revoked_at IS NULL AND expires_at > ?", userId, pagination.Timestamp(now)).
+		Order("last_used_at DESC").
+		Order("id DESC").
+		Find(&models).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	sessions := make([]*domain.Session, 0, len(models))
+	for i := range models {
+		sessions = append(sessions, models[i].ToDomain())
+	}
+
+	return sessions, nil
+}
+
+func (r *GormSessionRepository) Revoke(ctx context.Context, id string, revokedAt time.Time) error {
+	return r.db.
+		WithContext(ctx).
+		Model(&SessionGorm{}).
+		Where("id = ? AND revoked_at IS NULL", id).
+		Update("revoked_at", pagination.Timestamp(revokedAt)).
+		Error
+}
+
+func (r *GormSessionRepository) RevokeAllByUserId(ctx context.Context, userId string, revokedAt time.Time) error {
+	return r.db.
+		WithContext(ctx).
+		Model(&SessionGorm{}).
+		Where("user_id = ? AND revoked_at IS NULL", userId).
+		Update("revoked_at", pagination.Timestamp(revokedAt)).
+		Error
+}
+
+var _ domain.SessionRepository = (*GormSessionRepository)(nil)
@@ -0,0 +1,196 @@
+package session
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+type SUT struct {
+	Repository *GormSessionRepository
+	Session    *domain.Session
+	Token      *domain.RefreshToken
+	Secret     string
+}
+
+func makeSut(t *testing.T) SUT {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&SessionGorm{}, &RefreshTokenGorm{}))
+
+	user := &domain.User{ID: "user-123", TokenVersion: 2}
+	session, token, secret, err := domain.NewSession(user, "Firefox", "203.0.113.7", time.Hour, time.Now())
+	require.NoError(t, err)
+
+	return SUT{
+		Repository: NewGormSessionRepository(db),
+		Session:    session,
+		Token:      token,
+		Secret:     secret,
+	}
+}
+
+func TestSessionRepository_Create_ShouldPersistSessionAndTokenHash(t *testing.T) {
+	sut := makeSut(t)
+
+	require.NoError(t, sut.Repository.Create(context.Background(), sut.Session, sut.Token))
+
+	session, err := sut.Repository.GetById(context.Background(), sut.Session.ID)
+	require.NoError(t, err)
+	token, err := sut.Repository.GetRefreshToken(context.Background(), domain.HashSecretToken(sut.Secret))
+	require.NoError(t, err)
+
+	assert.Equal(t, "Firefox", session.Device)
+	assert.Equal(t, 2, session.TokenVersion)
+	assert.True(t, session.IsActive(time.Now()))
+	assert.Equal(t, sut.Session.ID, token.SessionId)
+	assert.NotEqual(t, sut.Secret, token.TokenHash)
+	assert.False(t, token.IsRotated())
+}
+
+func TestSessionRepository_GetByIdAndUserId_ShouldNotFindSessionOfAnotherUser(t *testing.T) {
+	sut := makeSut(t)
+	require.NoError(t, sut.Repository.Create(context.Background(), sut.Session, sut.Token))
+
+	_, err := sut.Repository.GetByIdAndUserId(context.Background(), sut.Session.ID, "user-456")
+
+	assert.ErrorIs(t, err, domain.ErrSessionNotFound)
+}
+
+func TestSessionRepository_Rotate_ShouldOnlySucceedOncePerToken(t *testing.T) {
+	sut := makeSut(t)
+	require.NoError(t, sut.Repository.Create(context.Background(), sut.Session, sut.Token))
+
+	now := time.Now().Add(time.Minute)
+	sut.Session.Refresh("198.51.100.1", time.Hour, now)
+	first, _, err := domain.NewRefreshToken(sut.Session.ID, now)
+	require.NoError(t, err)
+	second, _, err := domain.NewRefreshToken(sut.Session.ID, now)
+	require.NoError(t, err)
+
+	firstErr := sut.Repository.Rotate(context.Background(), sut.Session, sut.Token, first)
+	secondErr := sut.Repository.Rotate(context.Background(), sut.Session, sut.Token, second)
+
+	assert.NoError(t, firstErr)
+	assert.ErrorIs(t, secondErr, domain.ErrRefreshTokenReused)
+
+	rotated, err := sut.Repository.GetRefreshToken(context.Background(), sut.Token.TokenHash)
+	require.NoError(t, err)
+	assert.True(t, rotated.IsRotated())
+
+	_, err = sut.Repository.GetRefreshToken(context.Background(), second.TokenHash)
+	assert.ErrorIs(t, err, domain.ErrRefreshTokenNotFound)
+
+	session, err := sut.Repository.GetById(context.Background(), sut.Session.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "198.51.100.1", session.IP)
+	assert.WithinDuration(t, now.Add(time.Hour), session.ExpiresAt, time.Second)
+}
+
+func TestSessionRepository_Rotate_ShouldFailWhenSessionIsRevoked(t *testing.T) {
+	sut := makeSut(t)
+	require.NoError(t, sut.Repository.Create(context.Background(), sut.Session, sut.Token))
+	require.NoError(t, sut.Repository.Revoke(context.Background(), sut.Session.ID, time.Now()))
+
+	next, _, err := domain.NewRefreshToken(sut.Session.ID, time.Now())
+	require.NoError(t, err)
+
+	err = sut.Repository.Rotate(context.Background(), sut.Session, sut.Token, next)
+
+	assert.ErrorIs(t, err, domain.ErrRefreshTokenInvalid)
+
+	token, err := sut.Repository.GetRefreshToken(context.Background(), sut.Token.TokenHash)
+	require.NoError(t, err)
+	assert.False(t, token.IsRotated())
+}
+
+func TestSessionRepository_ListActiveByUserId_ShouldSkipRevokedAndExpiredSessions(t *testing.T) {
+	sut := makeSut(t)
+	user := &domain.User{ID: sut.Session.UserId}
+	now := time.Now()
+
+	revoked, revokedToken, _, err := domain.NewSession(user, "Chrome", "", time.Hour, now)
+	require.NoError(t, err)
+	expired, expiredToken, _, err := domain.NewSession(user, "Safari", "", time.Minute, now.Add(-time.Hour))
+	require.NoError(t, err)
+	other, otherToken, _, err := domain.NewSession(&domain.User{ID: "user-456"}, "Edge", "", time.Hour, now)
+	require.NoError(t, err)
+
+	require.NoError(t, sut.Repository.Create(context.Background(), sut.Session, sut.Token))
+	require.NoError(t, sut.Repository.Create(context.Background(), revoked, revokedToken))
+	require.NoError(t, sut.Repository.Create(context.Background(), expired, expiredToken))
+	require.NoError(t, sut.Repository.Create(context.Background(), other, otherToken))
+	require.NoError(t, sut.Repository.Revoke(context.Background(), revoked.ID, now))
+
+	sessions, err := sut.Repository.ListActiveByUserId(context.Background(), user.ID, now)
+
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	assert.Equal(t, sut.Session.ID, sessions[0].ID)
+}
+
+func TestSessionRepository_ListActiveByUserId_ShouldCompareExpiryAcrossOffsets(t *testing.T) {
+	sut := makeSut(t)
+	user := &domain.User{ID: sut.Session.UserId}
+
+	// The clocks fell back from UTC-2 to UTC-3 after the expired session was
+	// written, which makes it look an hour later as text.
+	summer := time.FixedZone("UTC-2", -2*60*60)
+	winter := time.FixedZone("UTC-3", -3*60*60)
+	now := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC).In(winter)
+
+	live, liveToken, _, err := domain.NewSession(user, "Chrome", "", 30*time.Minute, now.Add(-time.Minute))
+	require.NoError(t, err)
+	gone, goneToken, _, err := domain.NewSession(user, "Safari", "", 30*time.Minute, now.Add(-40*time.Minute).In(summer))
+	require.NoError(t, err)
+
+	require.NoError(t, sut.Repository.Create(context.Background(), live, liveToken))
+	require.NoError(t, sut.Repository.Create(context.Background(), gone, goneToken))
+
+	sessions, err := sut.Repository.ListActiveByUserId(context.Background(), user.ID, now)
+
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	assert.Equal(t, live.ID, sessions[0].ID)
+}
+
+func TestSessionRepository_Revoke_ShouldKeepFirstRevocationTime(t *testing.T) {
+	sut := makeSut(t)
+	require.NoError(t, sut.Repository.Create(context.Background(), sut.Session, sut.Token))
+
+	first := time.Now().Add(-time.Minute)
+	require.NoError(t, sut.Repository.Revoke(context.Background(), sut.Session.ID, first))
+	require.NoError(t, sut.Repository.Revoke(context.Background(), sut.Session.ID, time.Now()))
+
+	session, err := sut.Repository.GetById(context.Background(), sut.Session.ID)
+
+	require.NoError(t, err)
+	require.NotNil(t, session.RevokedAt)
+	assert.WithinDuration(t, first, *session.RevokedAt, time.Millisecond)
+}
+
+func TestSessionRepository_RevokeAllByUserId_ShouldOnlyRevokeThatUser(t *testing.T) {
+	sut := makeSut(t)
+	other, otherToken, _, err := domain.NewSession(&domain.User{ID: "user-456"}, "Edge", "", time.Hour, time.Now())
+	require.NoError(t, err)
+
+	require.NoError(t, sut.Repository.Create(context.Background(), sut.Session, sut.Token))
+	require.NoError(t, sut.Repository.Create(context.Background(), other, otherToken))
+
+	require.NoError(t, sut.Repository.RevokeAllByUserId(context.Background(), sut.Session.UserId, time.Now()))
+
+	mine, err := sut.Repository.GetById(context.Background(), sut.Session.ID)
+	require.NoError(t, err)
+	theirs, err := sut.Repository.GetById(context.Background(), other.ID)
+	require.NoError(t, err)
+
+	assert.True(t, mine.IsRevoked())
+	assert.False(t, theirs.IsRevoked())
+}
@@ -0,0 +1,192 @@
+package session
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+)
+
+var ErrSimulatedFailureRepoSession = errors.New("database error")
+
+type InMemorySessionRepository struct {
+	FailOnCreate  bool
+	FailOnGet     bool
+	FailOnRotate  bool
+	FailOnList    bool
+	FailOnRevoke  bool
+	sessions      map[string]*domain.Session
+	refreshTokens map[string]*domain.RefreshToken
+}
+
+func NewInMemorySessionRepository() *InMemorySessionRepository {
+	return &InMemorySessionRepository{
+		sessions:      make(map[string]*domain.Session),
+		refreshTokens: make(map[string]*domain.RefreshToken),
+	}
+}
+
+func (r *InMemorySessionRepository) Create(ctx context.Context, session *domain.Session, token *domain.RefreshToken) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if r.FailOnCreate {
+		return ErrSimulatedFailureRepoSession
+	}
+
+	r.sessions[session.ID] = session
+	r.refreshTokens[token.ID] = token
+	return nil
+}
+
+func (r *InMemorySessionRepository) GetById(ctx context.Context, id string) (*domain.Session, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	if r.FailOnGet {
+		return nil, ErrSimulatedFailureRepoSession
+	}
+
+	return r.sessions[id], nil
+}
+
+func (r *InMemorySessionRepository) GetByIdAndUserId(ctx context.Context, id, userId string) (*domain.Session, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	if r.FailOnGet {
+		return nil, ErrSimulatedFailureRepoSession
+	}
+
+	session, exists := r.sessions[id]
+	if !exists || session.UserId != userId {
+		return nil, nil
+	}
+
+	return session, nil
+}
+
+func (r *InMemorySessionRepository) GetRefreshToken(ctx context.Context, tokenHash string) (*domain.RefreshToken, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	if r.FailOnGet {
+		return nil, ErrSimulatedFailureRepoSession
+	}
+
+	for _, t := range r.refreshTokens {
+		if t.TokenHash == tokenHash {
+			return t, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (r *InMemorySessionRepository) Rotate(ctx context.Context, session *domain.Session, current, next *domain.RefreshToken) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if r.FailOnRotate {
+		return ErrSimulatedFailureRepoSession
+	}
+
+	stored, exists := r.refreshTokens[current.ID]
+	if !exists || stored.IsRotated() {
+		return domain.ErrRefreshTokenReused
+	}
+
+	if s, exists := r.sessions[session.ID]; !exists || s.IsRevoked() {
+		return domain.ErrRefreshTokenInvalid
+	}
+
+	rotatedAt := next.CreatedAt
+	stored.RotatedAt = &rotatedAt
+	r.sessions[session.ID] = session
+	r.refreshTokens[next.ID] = next
+	return nil
+}
+
+func (r *InMemorySessionRepository) ListActiveByUserId(ctx context.Context, userId string, now time.Time) ([]*domain.Session, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	if r.FailOnList {
+		return nil, ErrSimulatedFailureRepoSession
+	}
+
+	sessions := []*domain.Session{}
+	for _, s := range r.sessions {
+		if s.UserId == userId && s.IsActive(now) {
+			sessions = append(sessions, s)
+		}
+	}
+
+	slices.SortFunc(sessions, func(a, b *domain.Session) int {
+		if c := b.LastUsedAt.Compare(a.LastUsedAt); c != 0 {
+			return c
+		}
+
+		return cmp.Compare(b.ID, a.ID)
+	})
+
+	return sessions, nil
+}
+
+func (r *InMemorySessionRepository) Revoke(ctx context.Context, id string, revokedAt time.Time) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if r.FailOnRevoke {
+		return ErrSimulatedFailureRepoSession
+	}
+
+	if session, exists := r.sessions[id]; exists && !session.IsRevoked() {
+		session.RevokedAt = &revokedAt
+	}
+
+	return nil
+}
+
+func (r *InMemorySessionRepository) RevokeAllByUserId(ctx context.Context, userId string, revokedAt time.Time) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if r.FailOnRevoke {
+		return ErrSimulatedFailureRepoSession
+	}
+
+	for _, session := range r.sessions {
+		if session.UserId == userId && !session.IsRevoked() {
+			session.RevokedAt = &revokedAt
+		}
+	}
+
+	return nil
+}
+
+// ByUserId returns every session of a user, revoked ones included, so
+// tests can inspect them without knowing the tokens.
+func (r *InMemorySessionRepository) ByUserId(userId string) []*domain.Session {
+	sessions := []*domain.Session{}
+	for _, s := range r.sessions {
+		if s.UserId == userId {
+			sessions = append(sessions, s)
+		}
+	}
+
+	return sessions
+}
+
+var _ domain.SessionRepository = (*InMemorySessionRepository)(nil)
@@ -0,0 +1,93 @@
+package session
+
+import (
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+	"github.com/areteacademy/internal/infra/repository/pagination"
+)
+
+type SessionGorm struct {
+	ID           string `gorm:"primaryKey"`
+	UserId       string `gorm:"index;not null"`
+	TokenVersion int    `gorm:"not null;default:0"`
+	Device       string
+	IP           string
+	LastUsedAt   time.Time `gorm:"not null"`
+	ExpiresAt    time.Time `gorm:"not null"`
+	RevokedAt    *time.Time
+	CreatedAt    time.Time `gorm:"autoCreateTime"`
+}
+
+func (SessionGorm) TableName() string {
+	return "sessions"
+}
+
+func (s *SessionGorm) ToDomain() *domain.Session {
+	return &domain.Session{
+		ID:           s.ID,
+		UserId:       s.UserId,
+		TokenVersion: s.TokenVersion,
+		Device:       s.Device,
+		IP:           s.IP,
+		LastUsedAt:   s.LastUsedAt,
+		ExpiresAt:    s.ExpiresAt,
+		RevokedAt:    s.RevokedAt,
+		CreatedAt:    s.CreatedAt,
+	}
+}
+
+func ToRepository(s *domain.Session) *SessionGorm {
+	return &SessionGorm{
+		ID:           s.ID,
+		UserId:       s.UserId,
+		TokenVersion: s.TokenVersion,
+		Device:       s.Device,
+		IP:           s.IP,
+		LastUsedAt:   pagination.Timestamp(s.LastUsedAt),
+		ExpiresAt:    pagination.Timestamp(s.ExpiresAt),
+		RevokedAt:    toRepositoryTime(s.RevokedAt),
+		CreatedAt:    pagination.Timestamp(s.CreatedAt),
+	}
+}
+
+type RefreshTokenGorm struct {
+	ID        string `gorm:"primaryKey"`
+	SessionId string `gorm:"index;not null"`
+	TokenHash string `gorm:"uniqueIndex;not null"`
+	RotatedAt *time.Time
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+}
+
+func (RefreshTokenGorm) TableName() string {
+	return "refresh_tokens"
+}
+
+func (t *RefreshTokenGorm) ToDomain() *domain.RefreshToken {
+	return &domain.RefreshToken{
+		ID:        t.ID,
+		SessionId: t.SessionId,
+		TokenHash: t.TokenHash,
+		RotatedAt: t.RotatedAt,
+		CreatedAt: t.CreatedAt,
+	}
+}
+
+func ToRepositoryRefreshToken(t *domain.RefreshToken) *RefreshTokenGorm {
+	return &RefreshTokenGorm{
+		ID:        t.ID,
+		SessionId: t.SessionId,
+		TokenHash: t.TokenHash,
+		RotatedAt: toRepositoryTime(t.RotatedAt),
+		CreatedAt: pagination.Timestamp(t.CreatedAt),
+	}
+}
+
+func toRepositoryTime(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+
+	at := pagination.Timestamp(*t)
+	return &at
+}
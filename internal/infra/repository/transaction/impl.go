@@ -0,0 +1,43 @@
+package transaction
+
+import (
+	"context"
+
+	"github.com/areteacademy/internal/domain"
+	"github.com/areteacademy/internal/infra/repository/category"
+	"github.com/areteacademy/internal/infra/repository/product"
+	"github.com/areteacademy/internal/infra/repository/user"
+	"gorm.io/gorm"
+)
+
+type GormUnitOfWork struct {
+	db *gorm.DB
+}
+
+func NewGormUnitOfWork(db *gorm.DB) *GormUnitOfWork {
+	return &GormUnitOfWork{db: db}
+}
+
+func (u *GormUnitOfWork) Do(ctx context.Context, fn func(repos domain.Repositories) error) error {
+	return u.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(&gormRepositories{tx: tx})
+	})
+}
+
+type gormRepositories struct {
+	tx *gorm.DB
+}
+
+func (r *gormRepositories) Users() domain.UserRepository {
+	return user.NewGoUserRepository(r.tx)
+}
+
+func (r *gormRepositories) Categories() domain.CategoryRepository {
+	return category.NewGormCategoryRepository(r.tx)
+}
+
+func (r *gormRepositories) Products() domain.ProductRepository {
+	return product.NewGormProductRepository(r.tx)
+}
+
+var _ domain.UnitOfWork = (*GormUnitOfWork)(nil)
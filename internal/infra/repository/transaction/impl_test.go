@@ -0,0 +1,131 @@
+package transaction
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+	"github.com/areteacademy/internal/infra/repository/category"
+	"github.com/areteacademy/internal/infra/repository/product"
+	"github.com/areteacademy/internal/infra/repository/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+type SUT struct {
+	UnitOfWork *GormUnitOfWork
+	DB         *gorm.DB
+	Category   *domain.Category
+	Product    *domain.Product
+}
+
+func makeSut(t *testing.T) SUT {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	sqlDB.SetMaxOpenConns(1)
+
+	require.NoError(t, db.AutoMigrate(&user.UserGorm{}, &category.CategoryGorm{}, &product.ProductGorm{}))
+
+	now := time.Now()
+
+	return SUT{
+		UnitOfWork: NewGormUnitOfWork(db),
+		DB:         db,
+		Category: &domain.Category{
+			ID:        "cat-01",
+			UserId:    "user-01",
+			Name:      "Categoria",
+			Status:    string(domain.CategoryStatusActive),
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+		Product: &domain.Product{
+			ID:          "product-01",
+			UserId:      "user-01",
+			CategoryId:  "cat-01",
+			Name:        "Notebook",
+			Description: "Notebook para dev",
+			Status:      string(domain.ProductStatusActive),
+			Price:       5000,
+			CreatedAt:   now,
+			UpdatedAt:   now,
+		},
+	}
+}
+
+func TestGormUnitOfWork_Do_ShouldCommit_WhenFnSucceeds(t *testing.T) {
+	sut := makeSut(t)
+
+	err := sut.UnitOfWork.Do(context.Background(), func(repos domain.Repositories) error {
+		if err := repos.Categories().Save(context.Background(), sut.Category); err != nil {
+			return err
+		}
+
+		return repos.Products().Save(context.Background(), sut.Product)
+	})
+	require.NoError(t, err)
+
+	categories, err := category.NewGormCategoryRepository(sut.DB).Count(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, categories)
+
+	products, err := product.NewGormProductRepository(sut.DB).Count(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, products)
+}
+
+func TestGormUnitOfWork_Do_ShouldRollback_WhenFnFails(t *testing.T) {
+	sut := makeSut(t)
+	expectedErr := errors.New("boom")
+
+	err := sut.UnitOfWork.Do(context.Background(), func(repos domain.Repositories) error {
+		if err := repos.Categories().Save(context.Background(), sut.Category); err != nil {
+			return err
+		}
+
+		if err := repos.Products().Save(context.Background(), sut.Product); err != nil {
+			return err
+		}
+
+		return expectedErr
+	})
+	require.ErrorIs(t, err, expectedErr)
+
+	categories, err := category.NewGormCategoryRepository(sut.DB).Count(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 0, categories)
+
+	products, err := product.NewGormProductRepository(sut.DB).Count(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 0, products)
+}
+
+func TestGormUnitOfWork_Do_ShouldRollbackUsers_WhenFnFails(t *testing.T) {
+	sut := makeSut(t)
+	expectedErr := errors.New("boom")
+
+	err := sut.UnitOfWork.Do(context.Background(), func(repos domain.Repositories) error {
+		if err := repos.Users().Save(context.Background(), &domain.User{
+			ID:       "user-01",
+			Name:     "Daniel",
+			Email:    "daniel@gmail.com",
+			Password: "hash",
+		}); err != nil {
+			return err
+		}
+
+		return expectedErr
+	})
+	require.ErrorIs(t, err, expectedErr)
+
+	count, err := user.NewGoUserRepository(sut.DB).Count(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+}
@@ -0,0 +1,80 @@
+package transaction
+
+import (
+	"context"
+	"errors"
+
+	"github.com/areteacademy/internal/domain"
+	"github.com/areteacademy/internal/infra/repository/category"
+	"github.com/areteacademy/internal/infra/repository/product"
+	"github.com/areteacademy/internal/infra/repository/user"
+)
+
+var ErrSimulatedFailureUnitOfWork = errors.New("transaction error")
+
+// InMemoryUnitOfWork snapshots the wrapped repositories before running fn and
+// restores them when fn fails, mimicking a rollback.
+type InMemoryUnitOfWork struct {
+	FailOnDo     bool
+	FailOnCommit bool
+	users        *user.InMemoryUserRepository
+	categories   *category.InMemoryCategoryRepository
+	products     *product.InMemoryProductRepository
+}
+
+func NewInMemoryUnitOfWork(
+	users *user.InMemoryUserRepository,
+	categories *category.InMemoryCategoryRepository,
+	products *product.InMemoryProductRepository,
+) *InMemoryUnitOfWork {
+	return &InMemoryUnitOfWork{
+		users:      users,
+		categories: categories,
+		products:   products,
+	}
+}
+
+func (u *InMemoryUnitOfWork) Do(ctx context.Context, fn func(repos domain.Repositories) error) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if u.FailOnDo {
+		return ErrSimulatedFailureUnitOfWork
+	}
+
+	rollbacks := []func(){
+		u.users.Snapshot(),
+		u.categories.Snapshot(),
+		u.products.Snapshot(),
+	}
+
+	err := fn(u)
+	if err == nil && u.FailOnCommit {
+		err = ErrSimulatedFailureUnitOfWork
+	}
+
+	if err != nil {
+		for _, rollback := range rollbacks {
+			rollback()
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (u *InMemoryUnitOfWork) Users() domain.UserRepository {
+	return u.users
+}
+
+func (u *InMemoryUnitOfWork) Categories() domain.CategoryRepository {
+	return u.categories
+}
+
+func (u *InMemoryUnitOfWork) Products() domain.ProductRepository {
+	return u.products
+}
+
+var _ domain.UnitOfWork = (*InMemoryUnitOfWork)(nil)
@@ -0,0 +1,83 @@
+package transaction
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+	"github.com/areteacademy/internal/infra/repository/category"
+	"github.com/areteacademy/internal/infra/repository/product"
+	"github.com/areteacademy/internal/infra/repository/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInMemoryUnitOfWork_Do_ShouldRollback_WhenFnFails(t *testing.T) {
+	users := user.NewInMemoryUserRepository()
+	categories := category.NewInMemoryCategoryRepository()
+	products := product.NewInMemoryProductRepository()
+	sut := NewInMemoryUnitOfWork(users, categories, products)
+
+	now := time.Now()
+	existing := &domain.Category{
+		ID:        "cat-01",
+		UserId:    "user-01",
+		Name:      "Categoria",
+		Status:    string(domain.CategoryStatusActive),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	require.NoError(t, categories.Save(context.Background(), existing))
+
+	expectedErr := errors.New("boom")
+
+	err := sut.Do(context.Background(), func(repos domain.Repositories) error {
+		category, err := repos.Categories().GetById(context.Background(), existing.ID)
+		if err != nil {
+			return err
+		}
+
+		category.Name = "Categoria editada"
+		if err := repos.Categories().Update(context.Background(), category); err != nil {
+			return err
+		}
+
+		if err := repos.Users().Save(context.Background(), &domain.User{ID: "user-02"}); err != nil {
+			return err
+		}
+
+		return expectedErr
+	})
+	require.ErrorIs(t, err, expectedErr)
+
+	category, err := categories.GetById(context.Background(), existing.ID)
+	require.NoError(t, err)
+	require.NotNil(t, category)
+	assert.Equal(t, "Categoria", category.Name)
+
+	count, err := users.Count(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+}
+
+func TestInMemoryUnitOfWork_Do_ShouldReturnError_WhenContextCanceled(t *testing.T) {
+	sut := NewInMemoryUnitOfWork(
+		user.NewInMemoryUserRepository(),
+		category.NewInMemoryCategoryRepository(),
+		product.NewInMemoryProductRepository(),
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	called := false
+	err := sut.Do(ctx, func(repos domain.Repositories) error {
+		called = true
+		return nil
+	})
+
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.False(t, called)
+}
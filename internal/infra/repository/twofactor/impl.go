@@ -0,0 +1,120 @@
+package twofactor
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var ErrRepoTwoFactorIsNil = errors.New("two factor is nil")
+
+type GormTwoFactorRepository struct {
+	db *gorm.DB
+}
+
+func NewGormTwoFactorRepository(db *gorm.DB) *GormTwoFactorRepository {
+	return &GormTwoFactorRepository{db: db}
+}
+
+func (r *GormTwoFactorRepository) Save(ctx context.Context, twoFactor *domain.TwoFactor) error {
+	if twoFactor == nil {
+		return ErrRepoTwoFactorIsNil
+	}
+
+	return r.db.
+		WithContext(ctx).
+		Clauses(clause.OnConflict{UpdateAll: true}).
+		Create(ToRepository(twoFactor)).
+		Error
+}
+
+func (r *GormTwoFactorRepository) GetByUserId(ctx context.Context, userId string) (*domain.TwoFactor, error) {
+	var model TwoFactorGorm
+
+	err := r.db.WithContext(ctx).First(&model, "user_id = ?", userId).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrTwoFactorNotFound
+		}
+		return nil, err
+	}
+
+	return model.ToDomain(), nil
+}
+
+func (r *GormTwoFactorRepository) Enable(ctx context.Context, twoFactor *domain.TwoFactor, codes []*domain.RecoveryCode) error {
+	if twoFactor == nil {
+		return ErrRepoTwoFactorIsNil
+	}
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("user_id = ?", twoFactor.UserId).Delete(&RecoveryCodeGorm{}).Error; err != nil {
+			return err
+		}
+
+		models := make([]*RecoveryCodeGorm, len(codes))
+		for i, code := range codes {
+			models[i] = ToRepositoryRecoveryCode(code)
+		}
+
+		if len(models) > 0 {
+			if err := tx.Create(&models).Error; err != nil {
+				return err
+			}
+		}
+
+		return tx.Save(ToRepository(twoFactor)).Error
+	})
+}
+
+func (r *GormTwoFactorRepository) Delete(ctx context.Context, userId string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("user_id = ?", userId).Delete(&RecoveryCodeGorm{}).Error; err != nil {
+			return err
+		}
+
+		return tx.Where("user_id = ?", userId).Delete(&TwoFactorGorm{}).Error
+	})
+}
+
+func (r *GormTwoFactorRepository) ClaimStep(ctx context.Context, userId string, step int64) error {
+	result := r.db.
+		WithContext(ctx).
+		Model(&TwoFactorGorm{}).
+		Where("user_id = ? AND last_used_step < ?", userId, step).
+		Update("last_used_step", step)
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrTwoFactorCodeInvalid
+	}
+
+	return nil
+}
+
+func (r *GormTwoFactorRepository) UseRecoveryCode(ctx context.Context, userId, codeHash string, usedAt time.Time) error {
+	result := r.db.
+		WithContext(ctx).
+		Model(&RecoveryCodeGorm{}).
+		Where("user_id = ? AND code_hash = ? AND used_at IS NULL", userId, codeHash).
+		Update("used_at", usedAt)
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrTwoFactorCodeInvalid
+	}
+
+	return nil
+}
+
+var _ domain.TwoFactorRepository = (*GormTwoFactorRepository)(nil)
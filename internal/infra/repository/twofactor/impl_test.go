@@ -0,0 +1,113 @@
+package twofactor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+type SUT struct {
+	Repository *GormTwoFactorRepository
+	TwoFactor  *domain.TwoFactor
+}
+
+func makeSut(t *testing.T) SUT {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&TwoFactorGorm{}, &RecoveryCodeGorm{}))
+
+	twoFactor, err := domain.NewTwoFactor("user-123", time.Now())
+	require.NoError(t, err)
+
+	return SUT{
+		Repository: NewGormTwoFactorRepository(db),
+		TwoFactor:  twoFactor,
+	}
+}
+
+func TestTwoFactorRepository_Save_ShouldReplacePendingEnrolment(t *testing.T) {
+	sut := makeSut(t)
+	require.NoError(t, sut.Repository.Save(context.Background(), sut.TwoFactor))
+
+	replacement, err := domain.NewTwoFactor(sut.TwoFactor.UserId, time.Now())
+	require.NoError(t, err)
+	require.NoError(t, sut.Repository.Save(context.Background(), replacement))
+
+	stored, err := sut.Repository.GetByUserId(context.Background(), sut.TwoFactor.UserId)
+
+	require.NoError(t, err)
+	assert.Equal(t, replacement.Secret, stored.Secret)
+	assert.False(t, stored.IsEnabled())
+}
+
+func TestTwoFactorRepository_GetByUserId_ShouldReturnNotFound(t *testing.T) {
+	sut := makeSut(t)
+
+	_, err := sut.Repository.GetByUserId(context.Background(), "unknown")
+
+	assert.ErrorIs(t, err, domain.ErrTwoFactorNotFound)
+}
+
+func TestTwoFactorRepository_Enable_ShouldStoreRecoveryCodesForSingleUse(t *testing.T) {
+	sut := makeSut(t)
+	require.NoError(t, sut.Repository.Save(context.Background(), sut.TwoFactor))
+
+	now := time.Now()
+	sut.TwoFactor.Enable(100, now)
+	codes, plain := domain.NewRecoveryCodes(sut.TwoFactor.UserId, now)
+
+	require.NoError(t, sut.Repository.Enable(context.Background(), sut.TwoFactor, codes))
+
+	stored, err := sut.Repository.GetByUserId(context.Background(), sut.TwoFactor.UserId)
+	require.NoError(t, err)
+	assert.True(t, stored.IsEnabled())
+	assert.Equal(t, int64(100), stored.LastUsedStep)
+
+	hash := domain.HashRecoveryCode(plain[0])
+	firstErr := sut.Repository.UseRecoveryCode(context.Background(), sut.TwoFactor.UserId, hash, now)
+	secondErr := sut.Repository.UseRecoveryCode(context.Background(), sut.TwoFactor.UserId, hash, now)
+	otherUserErr := sut.Repository.UseRecoveryCode(context.Background(), "user-456", domain.HashRecoveryCode(plain[1]), now)
+
+	assert.NoError(t, firstErr)
+	assert.ErrorIs(t, secondErr, domain.ErrTwoFactorCodeInvalid)
+	assert.ErrorIs(t, otherUserErr, domain.ErrTwoFactorCodeInvalid)
+}
+
+func TestTwoFactorRepository_ClaimStep_ShouldRejectStepsAlreadyUsed(t *testing.T) {
+	sut := makeSut(t)
+	require.NoError(t, sut.Repository.Save(context.Background(), sut.TwoFactor))
+
+	firstErr := sut.Repository.ClaimStep(context.Background(), sut.TwoFactor.UserId, 10)
+	sameErr := sut.Repository.ClaimStep(context.Background(), sut.TwoFactor.UserId, 10)
+	olderErr := sut.Repository.ClaimStep(context.Background(), sut.TwoFactor.UserId, 9)
+	newerErr := sut.Repository.ClaimStep(context.Background(), sut.TwoFactor.UserId, 11)
+
+	assert.NoError(t, firstErr)
+	assert.ErrorIs(t, sameErr, domain.ErrTwoFactorCodeInvalid)
+	assert.ErrorIs(t, olderErr, domain.ErrTwoFactorCodeInvalid)
+	assert.NoError(t, newerErr)
+}
+
+func TestTwoFactorRepository_Delete_ShouldRemoveRecoveryCodes(t *testing.T) {
+	sut := makeSut(t)
+
+	now := time.Now()
+	sut.TwoFactor.Enable(1, now)
+	codes, plain := domain.NewRecoveryCodes(sut.TwoFactor.UserId, now)
+	require.NoError(t, sut.Repository.Enable(context.Background(), sut.TwoFactor, codes))
+
+	require.NoError(t, sut.Repository.Delete(context.Background(), sut.TwoFactor.UserId))
+
+	_, getErr := sut.Repository.GetByUserId(context.Background(), sut.TwoFactor.UserId)
+	useErr := sut.Repository.UseRecoveryCode(context.Background(), sut.TwoFactor.UserId, domain.HashRecoveryCode(plain[0]), now)
+
+	assert.ErrorIs(t, getErr, domain.ErrTwoFactorNotFound)
+	assert.ErrorIs(t, useErr, domain.ErrTwoFactorCodeInvalid)
+}
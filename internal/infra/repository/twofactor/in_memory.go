@@ -0,0 +1,126 @@
+package twofactor
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+)
+
+var ErrSimulatedFailureRepoTwoFactor = errors.New("database error")
+
+type InMemoryTwoFactorRepository struct {
+	FailOnSave    bool
+	FailOnGet     bool
+	FailOnEnable  bool
+	FailOnDelete  bool
+	FailOnClaim   bool
+	twoFactors    map[string]*domain.TwoFactor
+	recoveryCodes map[string][]*domain.RecoveryCode
+}
+
+func NewInMemoryTwoFactorRepository() *InMemoryTwoFactorRepository {
+	return &InMemoryTwoFactorRepository{
+		twoFactors:    make(map[string]*domain.TwoFactor),
+		recoveryCodes: make(map[string][]*domain.RecoveryCode),
+	}
+}
+
+func (r *InMemoryTwoFactorRepository) Save(ctx context.Context, twoFactor *domain.TwoFactor) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if r.FailOnSave {
+		return ErrSimulatedFailureRepoTwoFactor
+	}
+
+	r.twoFactors[twoFactor.UserId] = twoFactor
+	return nil
+}
+
+func (r *InMemoryTwoFactorRepository) GetByUserId(ctx context.Context, userId string) (*domain.TwoFactor, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	if r.FailOnGet {
+		return nil, ErrSimulatedFailureRepoTwoFactor
+	}
+
+	return r.twoFactors[userId], nil
+}
+
+func (r *InMemoryTwoFactorRepository) Enable(ctx context.Context, twoFactor *domain.TwoFactor, codes []*domain.RecoveryCode) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if r.FailOnEnable {
+		return ErrSimulatedFailureRepoTwoFactor
+	}
+
+	r.twoFactors[twoFactor.UserId] = twoFactor
+	r.recoveryCodes[twoFactor.UserId] = codes
+	return nil
+}
+
+func (r *InMemoryTwoFactorRepository) Delete(ctx context.Context, userId string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if r.FailOnDelete {
+		return ErrSimulatedFailureRepoTwoFactor
+	}
+
+	delete(r.twoFactors, userId)
+	delete(r.recoveryCodes, userId)
+	return nil
+}
+
+func (r *InMemoryTwoFactorRepository) ClaimStep(ctx context.Context, userId string, step int64) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if r.FailOnClaim {
+		return ErrSimulatedFailureRepoTwoFactor
+	}
+
+	twoFactor, exists := r.twoFactors[userId]
+	if !exists || twoFactor.LastUsedStep >= step {
+		return domain.ErrTwoFactorCodeInvalid
+	}
+
+	twoFactor.LastUsedStep = step
+	return nil
+}
+
+func (r *InMemoryTwoFactorRepository) UseRecoveryCode(ctx context.Context, userId, codeHash string, usedAt time.Time) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if r.FailOnClaim {
+		return ErrSimulatedFailureRepoTwoFactor
+	}
+
+	for _, code := range r.recoveryCodes[userId] {
+		if code.CodeHash == codeHash && code.UsedAt == nil {
+			code.UsedAt = &usedAt
+			return nil
+		}
+	}
+
+	return domain.ErrTwoFactorCodeInvalid
+}
+
+// RecoveryCodes returns the recovery codes stored for a user, so tests can
+// inspect them.
+func (r *InMemoryTwoFactorRepository) RecoveryCodes(userId string) []*domain.RecoveryCode {
+	return r.recoveryCodes[userId]
+}
+
+var _ domain.TwoFactorRepository = (*InMemoryTwoFactorRepository)(nil)
@@ -0,0 +1,64 @@
+package twofactor
+
+import (
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+)
+
+type TwoFactorGorm struct {
+	UserId       string `gorm:"primaryKey"`
+	Secret       string `gorm:"not null"`
+	LastUsedStep int64  `gorm:"not null;default:0"`
+	EnabledAt    *time.Time
+	CreatedAt    time.Time `gorm:"autoCreateTime"`
+	UpdatedAt    time.Time `gorm:"autoUpdateTime"`
+}
+
+func (TwoFactorGorm) TableName() string {
+	return "two_factors"
+}
+
+func (t *TwoFactorGorm) ToDomain() *domain.TwoFactor {
+	return &domain.TwoFactor{
+		UserId:       t.UserId,
+		Secret:       t.Secret,
+		LastUsedStep: t.LastUsedStep,
+		EnabledAt:    t.EnabledAt,
+		CreatedAt:    t.CreatedAt,
+		UpdatedAt:    t.UpdatedAt,
+	}
+}
+
+func ToRepository(t *domain.TwoFactor) *TwoFactorGorm {
+	return &TwoFactorGorm{
+		UserId:       t.UserId,
+		Secret:       t.Secret,
+		LastUsedStep: t.LastUsedStep,
+		EnabledAt:    t.EnabledAt,
+		CreatedAt:    t.CreatedAt,
+		UpdatedAt:    t.UpdatedAt,
+	}
+}
+
+type RecoveryCodeGorm struct {
+	ID        string `gorm:"primaryKey"`
+	UserId    string `gorm:"index;not null"`
+	CodeHash  string `gorm:"not null"`
+	UsedAt    *time.Time
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+}
+
+func (RecoveryCodeGorm) TableName() string {
+	return "two_factor_recovery_codes"
+}
+
+func ToRepositoryRecoveryCode(c *domain.RecoveryCode) *RecoveryCodeGorm {
+	return &RecoveryCodeGorm{
+		ID:        c.ID,
+		UserId:    c.UserId,
+		CodeHash:  c.CodeHash,
+		UsedAt:    c.UsedAt,
+		CreatedAt: c.CreatedAt,
+	}
+}
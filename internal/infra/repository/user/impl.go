@@ -1,9 +1,12 @@
 package user
 
 import (
+	"context"
 	"errors"
+	"strings"
 
 	"github.com/areteacademy/internal/domain"
+	"github.com/areteacademy/internal/infra/repository/pagination"
 	"gorm.io/gorm"
 )
 
@@ -14,37 +17,42 @@ type GormUserRepository struct {
 }
 
 func NewGoUserRepository(db *gorm.DB) *GormUserRepository {
-	return &GormUserRepository{db: db}
+	return &GormUserRepository{db: pagination.Timestamps(db)}
 }
 
-func (r *GormUserRepository) Save(user *domain.User) error {
+func (r *GormUserRepository) Save(ctx context.Context, user *domain.User) error {
 	if user == nil {
 		return ErrRepoUserIsNil
 	}
 
 	model := ToRepository(user)
 
-	if err := r.db.Create(model).Error; err != nil {
-		return err
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		return r.translateError(err)
 	}
 
 	return nil
 }
 
-func (r *GormUserRepository) Update(user *domain.User) error {
+func (r *GormUserRepository) Update(ctx context.Context, user *domain.User) error {
 	if user == nil {
 		return ErrRepoUserIsNil
 	}
 
 	model := ToRepository(user)
 
+	// Every column is written, so clearing a field such as the pending
+	// email is persisted too.
 	result := r.db.
+		WithContext(ctx).
 		Model(&UserGorm{}).
 		Where("id = ?", user.ID).
+		Select("*").
+		Omit("id", "created_at").
 		Updates(model)
 
 	if result.Error != nil {
-		return result.Error
+		return r.translateError(result.Error)
 	}
 
 	if result.RowsAffected == 0 {
@@ -54,10 +62,10 @@ func (r *GormUserRepository) Update(user *domain.User) error {
 	return nil
 }
 
-func (r *GormUserRepository) GetById(id string) (*domain.User, error) {
+func (r *GormUserRepository) GetById(ctx context.Context, id string) (*domain.User, error) {
 	var model UserGorm
 
-	err := r.db.First(&model, "id = ?", id).Error
+	err := r.db.WithContext(ctx).First(&model, "id = ?", id).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, domain.ErrUserNotFound
@@ -68,14 +76,85 @@ func (r *GormUserRepository) GetById(id string) (*domain.User, error) {
 	return model.ToDomain(), nil
 }
 
-func (r *GormUserRepository) Count() (int, error) {
+func (r *GormUserRepository) GetByEmail(ctx context.Context, email string) (*domain.User, error) {
+	var model UserGorm
+
+	err := r.db.WithContext(ctx).First(&model, "email = ?", domain.NormalizeEmail(email)).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrUserNotFound
+		}
+		return nil, err
+	}
+
+	return model.ToDomain(), nil
+}
+
+func (r *GormUserRepository) List(
+	ctx context.Context,
+	filter domain.UserFilter,
+	page domain.PageRequest,
+) (domain.Page[*domain.User], error) {
+	query := r.db.WithContext(ctx).Model(&UserGorm{})
+
+	if filter.Text != "" {
+		pattern := "%" + likeEscaper.Replace(strings.ToLower(filter.Text)) + "%"
+		query = query.Where(`LOWER(name) LIKE ? ESCAPE '\' OR email LIKE ? ESCAPE '\'`, pattern, pattern)
+	}
+
+	if filter.Role != "" {
+		query = query.Where("role = ?", filter.Role)
+	}
+
+	var models []UserGorm
+
+	if err := query.Scopes(pagination.Scope(page)).Find(&models).Error; err != nil {
+		return domain.Page[*domain.User]{}, err
+	}
+
+	users := make([]*domain.User, 0, len(models))
+	for i := range models {
+		users = append(users, models[i].ToDomain())
+	}
+
+	return domain.NewPage(users, page, (*domain.User).Cursor), nil
+}
+
+// likeEscaper keeps wildcards typed in a search from matching everything.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+func (r *GormUserRepository) Count(ctx context.Context) (int, error) {
 	var count int64
 
-	if err := r.db.Model(&UserGorm{}).Count(&count).Error; err != nil {
+	if err := r.db.WithContext(ctx).Model(&UserGorm{}).Count(&count).Error; err != nil {
 		return 0, err
 	}
 
 	return int(count), nil
 }
 
+// translateError reports a unique index violation as the email being in
+// use, since ids are generated and email is the only other unique column.
+func (r *GormUserRepository) translateError(err error) error {
+	translator, ok := r.db.Dialector.(gorm.ErrorTranslator)
+	if ok && errors.Is(translator.Translate(err), gorm.ErrDuplicatedKey) {
+		return domain.ErrUserEmailAlreadyInUse
+	}
+
+	return err
+}
+
+// MigrateEmails normalises the emails stored before they were normalised on
+// write. An email is left untouched when normalising it would collide with
+// another user's, since only its owner can tell which account to keep.
+func MigrateEmails(db *gorm.DB) error {
+	return db.Exec(`UPDATE users SET email = LOWER(TRIM(email))
+		WHERE email <> LOWER(TRIM(email))
+			AND NOT EXISTS (
+				SELECT 1 FROM users AS other
+				WHERE other.id <> users.id
+					AND LOWER(TRIM(other.email)) = LOWER(TRIM(users.email))
+			)`).Error
+}
+
 var _ domain.UserRepository = (*GormUserRepository)(nil)
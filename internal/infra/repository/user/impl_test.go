@@ -1,6 +1,8 @@
 package user
 
 import (
+	"context"
+	"fmt"
 	"testing"
 	"time"
 
@@ -48,8 +50,8 @@ func TestUserRepository_Save_ShouldPersistUser(t *testing.T) {
 	sut := makeSut(t)
 
 	// Act
-	require.NoError(t, sut.Repository.Save(sut.User))
-	getUser, err := sut.Repository.GetById(sut.User.ID)
+	require.NoError(t, sut.Repository.Save(context.Background(), sut.User))
+	getUser, err := sut.Repository.GetById(context.Background(), sut.User.ID)
 
 	require.NoError(t, err)
 	require.NotNil(t, getUser)
@@ -67,23 +69,26 @@ func TestUserRepository_Update_ShouldUpdateUser(t *testing.T) {
 	// Arrange
 	sut := makeSut(t)
 
-	require.NoError(t, sut.Repository.Save(sut.User))
+	require.NoError(t, sut.Repository.Save(context.Background(), sut.User))
 
 	expected := struct {
 		Name     string
 		Email    string
 		Password string
+		Locale   domain.Locale
 	}{
 		Name:     "Daniel Editado",
 		Email:    "daniel.editado@gmail.com",
 		Password: "@@Daniel1234",
+		Locale:   domain.LocalePortugueseBrazil,
 	}
 	sut.User.Name = expected.Name
 	sut.User.Email = expected.Email
 	sut.User.Password = expected.Password
+	sut.User.Locale = expected.Locale
 
-	require.NoError(t, sut.Repository.Update(sut.User))
-	getUser, err := sut.Repository.GetById(sut.User.ID)
+	require.NoError(t, sut.Repository.Update(context.Background(), sut.User))
+	getUser, err := sut.Repository.GetById(context.Background(), sut.User.ID)
 
 	require.NoError(t, err)
 	require.NotNil(t, getUser)
@@ -91,16 +96,58 @@ func TestUserRepository_Update_ShouldUpdateUser(t *testing.T) {
 	assert.Equal(t, expected.Name, getUser.Name)
 	assert.Equal(t, expected.Email, getUser.Email)
 	assert.Equal(t, expected.Password, getUser.Password)
+	assert.Equal(t, expected.Locale, getUser.Locale)
+}
+
+func TestUserRepository_Update_ShouldPersistPasswordHistoryAndTokenVersion(t *testing.T) {
+	// Arrange
+	sut := makeSut(t)
+
+	require.NoError(t, sut.Repository.Save(context.Background(), sut.User))
+
+	sut.User.ChangePassword("new-hash", domain.DefaultPasswordHistorySize)
+
+	// Act
+	require.NoError(t, sut.Repository.Update(context.Background(), sut.User))
+	getUser, err := sut.Repository.GetById(context.Background(), sut.User.ID)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "new-hash", getUser.Password)
+	assert.Equal(t, []string{"@Daniel123"}, getUser.PasswordHistory)
+	assert.Equal(t, 1, getUser.TokenVersion)
+}
+
+func TestUserRepository_Update_ShouldPersistConfirmedEmailAndClearPending(t *testing.T) {
+	// Arrange
+	sut := makeSut(t)
+	sut.User.PendingEmail = "daniel@gmail.com"
+
+	require.NoError(t, sut.Repository.Save(context.Background(), sut.User))
+
+	verifiedAt := time.Now()
+	sut.User.ConfirmEmail("daniel@gmail.com", verifiedAt)
+
+	// Act
+	require.NoError(t, sut.Repository.Update(context.Background(), sut.User))
+	getUser, err := sut.Repository.GetById(context.Background(), sut.User.ID)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "daniel@gmail.com", getUser.Email)
+	assert.Empty(t, getUser.PendingEmail)
+	require.NotNil(t, getUser.EmailVerifiedAt)
+	assert.WithinDuration(t, verifiedAt, *getUser.EmailVerifiedAt, time.Second)
 }
 
 func TestUserRepository_Update_ShouldReturnError_WhenUserNotFound(t *testing.T) {
 	// Arrange
 	sut := makeSut(t)
 
-	require.NoError(t, sut.Repository.Save(sut.User))
+	require.NoError(t, sut.Repository.Save(context.Background(), sut.User))
 
 	sut.User.ID = "9999"
-	err := sut.Repository.Update(sut.User)
+	err := sut.Repository.Update(context.Background(), sut.User)
 
 	require.Error(t, err)
 	assert.ErrorIs(t, err, domain.ErrUserNotFound)
@@ -110,8 +157,8 @@ func TestUserRepository_Update_ShouldReturnError_WhenUserIsNil(t *testing.T) {
 	// Arrange
 	sut := makeSut(t)
 
-	require.NoError(t, sut.Repository.Save(sut.User))
-	err := sut.Repository.Update(nil)
+	require.NoError(t, sut.Repository.Save(context.Background(), sut.User))
+	err := sut.Repository.Update(context.Background(), nil)
 
 	require.Error(t, err)
 	assert.ErrorIs(t, err, ErrRepoUserIsNil)
@@ -121,9 +168,9 @@ func TestUserRepository_GetById_ShouldReturnUser(t *testing.T) {
 	// Arrange
 	sut := makeSut(t)
 
-	require.NoError(t, sut.Repository.Save((sut.User)))
+	require.NoError(t, sut.Repository.Save(context.Background(), (sut.User)))
 
-	getUser, err := sut.Repository.GetById(sut.User.ID)
+	getUser, err := sut.Repository.GetById(context.Background(), sut.User.ID)
 
 	require.NoError(t, err)
 	require.NotNil(t, getUser)
@@ -137,14 +184,120 @@ func TestUserRepository_GetById_ShouldReturnNil_WhenUserNotFound(t *testing.T) {
 	// Arrange
 	sut := makeSut(t)
 
-	require.NoError(t, sut.Repository.Save((sut.User)))
-	getUser, err := sut.Repository.GetById("not-found")
+	require.NoError(t, sut.Repository.Save(context.Background(), (sut.User)))
+	getUser, err := sut.Repository.GetById(context.Background(), "not-found")
+
+	require.Error(t, err)
+	require.Nil(t, getUser)
+	assert.ErrorIs(t, err, domain.ErrUserNotFound)
+}
+
+func TestUserRepository_GetByEmail_ShouldReturnUser(t *testing.T) {
+	// Arrange
+	sut := makeSut(t)
+
+	require.NoError(t, sut.Repository.Save(context.Background(), sut.User))
+
+	getUser, err := sut.Repository.GetByEmail(context.Background(), sut.User.Email)
+
+	require.NoError(t, err)
+	require.NotNil(t, getUser)
+	assert.Equal(t, sut.User.ID, getUser.ID)
+	assert.Equal(t, sut.User.Email, getUser.Email)
+	assert.Equal(t, sut.User.Password, getUser.Password)
+}
+
+func TestUserRepository_GetByEmail_ShouldReturnNil_WhenUserNotFound(t *testing.T) {
+	// Arrange
+	sut := makeSut(t)
+
+	require.NoError(t, sut.Repository.Save(context.Background(), sut.User))
+	getUser, err := sut.Repository.GetByEmail(context.Background(), "not-found@gmail.com")
 
 	require.Error(t, err)
 	require.Nil(t, getUser)
 	assert.ErrorIs(t, err, domain.ErrUserNotFound)
 }
 
+func TestUserRepository_GetByEmail_ShouldIgnoreCaseAndSpaces(t *testing.T) {
+	// Arrange
+	sut := makeSut(t)
+
+	require.NoError(t, sut.Repository.Save(context.Background(), sut.User))
+
+	getUser, err := sut.Repository.GetByEmail(context.Background(), " Daniel@COM.br ")
+
+	require.NoError(t, err)
+	require.NotNil(t, getUser)
+	assert.Equal(t, sut.User.ID, getUser.ID)
+}
+
+func TestUserRepository_Save_ShouldReturnError_WhenEmailAlreadyInUse(t *testing.T) {
+	// Arrange
+	sut := makeSut(t)
+
+	require.NoError(t, sut.Repository.Save(context.Background(), sut.User))
+
+	other := *sut.User
+	other.ID = "654321"
+
+	// Act
+	err := sut.Repository.Save(context.Background(), &other)
+
+	// Assert
+	assert.ErrorIs(t, err, domain.ErrUserEmailAlreadyInUse)
+}
+
+func TestUserRepository_Update_ShouldReturnError_WhenEmailAlreadyInUse(t *testing.T) {
+	// Arrange
+	sut := makeSut(t)
+
+	require.NoError(t, sut.Repository.Save(context.Background(), sut.User))
+
+	other := *sut.User
+	other.ID = "654321"
+	other.Email = "other@com.br"
+	require.NoError(t, sut.Repository.Save(context.Background(), &other))
+
+	other.Email = sut.User.Email
+
+	// Act
+	err := sut.Repository.Update(context.Background(), &other)
+
+	// Assert
+	assert.ErrorIs(t, err, domain.ErrUserEmailAlreadyInUse)
+}
+
+func TestMigrateEmails_ShouldNormalizeEmailsUnlessTheyCollide(t *testing.T) {
+	// Arrange
+	sut := makeSut(t)
+
+	for id, email := range map[string]string{
+		"u1": " Ana@Gmail.com",
+		"u2": "BIA@gmail.com",
+		"u3": "bia@GMAIL.com",
+	} {
+		require.NoError(t, sut.DB.Create(&UserGorm{ID: id, Name: id, Email: email, PasswordHash: "hash"}).Error)
+	}
+
+	// Act
+	require.NoError(t, MigrateEmails(sut.DB))
+
+	// Assert
+	emails := map[string]string{}
+	var models []UserGorm
+	require.NoError(t, sut.DB.Find(&models).Error)
+	for _, m := range models {
+		emails[m.ID] = m.Email
+	}
+
+	assert.Equal(t, map[string]string{
+		"u1": "ana@gmail.com",
+		"u2": "BIA@gmail.com",
+		"u3": "bia@GMAIL.com",
+	}, emails)
+}
+
 func TestUserRepository_Count_ShouldReturnCorrectValue(t *testing.T) {
 	// Arrange
 	sut := makeSut(t)
@@ -164,12 +317,144 @@ func TestUserRepository_Count_ShouldReturnCorrectValue(t *testing.T) {
 	user3.Name = "User 03"
 	user3.Email = "user03@gmail.com"
 
-	require.NoError(t, sut.Repository.Save(&user1))
-	require.NoError(t, sut.Repository.Save(&user2))
-	require.NoError(t, sut.Repository.Save(&user3))
+	require.NoError(t, sut.Repository.Save(context.Background(), &user1))
+	require.NoError(t, sut.Repository.Save(context.Background(), &user2))
+	require.NoError(t, sut.Repository.Save(context.Background(), &user3))
 
-	count, err := sut.Repository.Count()
+	count, err := sut.Repository.Count(context.Background())
 
 	require.NoError(t, err)
 	assert.Equal(t, 3, count)
 }
+
+func TestUserRepository_Update_ShouldPersistRoleAndDeactivation(t *testing.T) {
+	// Arrange
+	sut := makeSut(t)
+	require.NoError(t, sut.Repository.Save(context.Background(), sut.User))
+
+	stored, err := sut.Repository.GetById(context.Background(), sut.User.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.RoleUser, stored.Role)
+
+	require.NoError(t, stored.ChangeRole(domain.RoleAdmin))
+	require.NoError(t, stored.Deactivate(time.Now()))
+
+	// Act
+	require.NoError(t, sut.Repository.Update(context.Background(), stored))
+
+	// Assert
+	updated, err := sut.Repository.GetById(context.Background(), sut.User.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.RoleAdmin, updated.Role)
+	assert.False(t, updated.IsActive())
+	assert.Equal(t, stored.TokenVersion, updated.TokenVersion)
+}
+
+func TestUserRepository_List_ShouldFilterAndPage(t *testing.T) {
+	// Arrange
+	sut := makeSut(t)
+	now := time.Now()
+
+	users := []*domain.User{
+		{ID: "1", Name: "Daniel", Email: "daniel@com.br", Role: domain.RoleAdmin},
+		{ID: "2", Name: "Danielle", Email: "dani@com.br", Role: domain.RoleUser},
+		{ID: "3", Name: "Maria", Email: "maria_daniel@com.br", Role: domain.RoleUser},
+		{ID: "4", Name: "Joana 100%", Email: "joana@com.br", Role: domain.RoleUser},
+	}
+	for i, u := range users {
+		u.Password = "hash"
+		u.CreatedAt = now.Add(time.Duration(i) * time.Minute)
+		u.UpdatedAt = u.CreatedAt
+		require.NoError(t, sut.Repository.Save(context.Background(), u))
+	}
+
+	ids := func(page domain.Page[*domain.User]) []string {
+		result := []string{}
+		for _, u := range page.Items {
+			result = append(result, u.ID)
+		}
+		return result
+	}
+
+	firstPage, err := domain.NewPageRequest(2, "", "", domain.DefaultSort)
+	require.NoError(t, err)
+
+	everyone, err := domain.NewPageRequest(0, "", "", domain.DefaultSort)
+	require.NoError(t, err)
+
+	// Act
+	byText, err := sut.Repository.List(context.Background(), domain.UserFilter{Text: "DANIEL"}, everyone)
+	require.NoError(t, err)
+
+	byRole, err := sut.Repository.List(context.Background(), domain.UserFilter{Text: "daniel", Role: domain.RoleUser}, everyone)
+	require.NoError(t, err)
+
+	wildcard, err := sut.Repository.List(context.Background(), domain.UserFilter{Text: "%"}, everyone)
+	require.NoError(t, err)
+
+	page, err := sut.Repository.List(context.Background(), domain.UserFilter{}, firstPage)
+	require.NoError(t, err)
+
+	next, err := domain.NewPageRequest(2, page.NextCursor(), "", domain.DefaultSort)
+	require.NoError(t, err)
+
+	secondPage, err := sut.Repository.List(context.Background(), domain.UserFilter{}, next)
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, []string{"1", "2", "3"}, ids(byText))
+	assert.Equal(t, []string{"2", "3"}, ids(byRole))
+	assert.Equal(t, []string{"4"}, ids(wildcard))
+	assert.Equal(t, []string{"1", "2"}, ids(page))
+	assert.Equal(t, []string{"3", "4"}, ids(secondPage))
+}
+
+func TestUserRepository_List_ShouldPageByCreatedAt_WhenLocalZoneIsNotUtc(t *testing.T) {
+	// Arrange
+	local := time.Local
+	time.Local = time.FixedZone("UTC-3", -3*60*60)
+	t.Cleanup(func() { time.Local = local })
+
+	sut := makeSut(t)
+	base := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+
+	want := []string{}
+	for i := range 6 {
+		u := &domain.User{
+			ID:       fmt.Sprintf("u%d", i),
+			Name:     fmt.Sprintf("User %d", i),
+			Email:    fmt.Sprintf("u%d@com.br", i),
+			Password: "hash",
+		}
+		u.CreatedAt = base.Add(time.Duration(i) * time.Hour).Local()
+		u.UpdatedAt = u.CreatedAt
+		require.NoError(t, sut.Repository.Save(context.Background(), u))
+
+		want = append(want, u.ID)
+	}
+
+	sort := domain.Sort{Field: domain.SortByCreatedAt, Direction: domain.SortAscending}
+
+	// Act
+	got := []string{}
+	cursor := ""
+	for {
+		page, err := domain.NewPageRequest(2, cursor, "", sort)
+		require.NoError(t, err)
+
+		users, err := sut.Repository.List(context.Background(), domain.UserFilter{}, page)
+		require.NoError(t, err)
+
+		for _, u := range users.Items {
+			got = append(got, u.ID)
+		}
+
+		cursor = users.NextCursor()
+		if cursor == "" {
+			break
+		}
+	}
+
+	// Assert
+	assert.Equal(t, want, got)
+}
@@ -1,19 +1,24 @@
 package user
 
 import (
+	"context"
 	"errors"
+	"strings"
 
 	"github.com/areteacademy/internal/domain"
+	"github.com/areteacademy/internal/infra/repository/pagination"
 )
 
 var ErrSimulatedFailureRepoUser = errors.New("database error")
 
 type InMemoryUserRepository struct {
-	FailOnSave   bool
-	FailOnUpdate bool
-	FailOnGet    bool
-	FailOnCount  bool
-	users        map[string]*domain.User
+	FailOnSave       bool
+	FailOnUpdate     bool
+	FailOnGet        bool
+	FailOnGetByEmail bool
+	FailOnList       bool
+	FailOnCount      bool
+	users            map[string]*domain.User
 }
 
 func NewInMemoryUserRepository() *InMemoryUserRepository {
@@ -22,23 +27,45 @@ func NewInMemoryUserRepository() *InMemoryUserRepository {
 	}
 }
 
-func (r *InMemoryUserRepository) Save(user *domain.User) error {
+func (r *InMemoryUserRepository) Save(ctx context.Context, user *domain.User) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
 	if r.FailOnSave {
 		return ErrSimulatedFailureRepoUser
 	}
+
+	if r.emailTaken(user) {
+		return domain.ErrUserEmailAlreadyInUse
+	}
+
 	r.users[user.ID] = user
 	return nil
 }
 
-func (r *InMemoryUserRepository) Update(user *domain.User) error {
+func (r *InMemoryUserRepository) Update(ctx context.Context, user *domain.User) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
 	if r.FailOnUpdate {
 		return ErrSimulatedFailureRepoUser
 	}
+
+	if r.emailTaken(user) {
+		return domain.ErrUserEmailAlreadyInUse
+	}
+
 	r.users[user.ID] = user
 	return nil
 }
 
-func (r *InMemoryUserRepository) GetById(id string) (*domain.User, error) {
+func (r *InMemoryUserRepository) GetById(ctx context.Context, id string) (*domain.User, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
 	if r.FailOnGet {
 		return nil, ErrSimulatedFailureRepoUser
 	}
@@ -49,11 +76,90 @@ func (r *InMemoryUserRepository) GetById(id string) (*domain.User, error) {
 	return user, nil
 }
 
-func (r *InMemoryUserRepository) Count() (int, error) {
+func (r *InMemoryUserRepository) GetByEmail(ctx context.Context, email string) (*domain.User, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	if r.FailOnGetByEmail {
+		return nil, ErrSimulatedFailureRepoUser
+	}
+
+	email = domain.NormalizeEmail(email)
+	for _, u := range r.users {
+		if domain.NormalizeEmail(u.Email) == email {
+			return u, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (r *InMemoryUserRepository) List(
+	ctx context.Context,
+	filter domain.UserFilter,
+	page domain.PageRequest,
+) (domain.Page[*domain.User], error) {
+	if err := ctx.Err(); err != nil {
+		return domain.Page[*domain.User]{}, err
+	}
+
+	if r.FailOnList {
+		return domain.Page[*domain.User]{}, ErrSimulatedFailureRepoUser
+	}
+
+	text := strings.ToLower(filter.Text)
+
+	var users []*domain.User
+	for _, u := range r.users {
+		matchesText := strings.Contains(strings.ToLower(u.Name), text) || strings.Contains(u.Email, text)
+		if matchesText && (filter.Role == "" || u.Role == filter.Role) {
+			users = append(users, u)
+		}
+	}
+
+	rows := pagination.Slice(users, page, (*domain.User).Cursor)
+
+	return domain.NewPage(rows, page, (*domain.User).Cursor), nil
+}
+
+func (r *InMemoryUserRepository) Count(ctx context.Context) (int, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+
 	if r.FailOnCount {
 		return 0, ErrSimulatedFailureRepoUser
 	}
 	return len(r.users), nil
 }
 
+// emailTaken mirrors the unique index on users.email of the Gorm repository.
+func (r *InMemoryUserRepository) emailTaken(user *domain.User) bool {
+	email := domain.NormalizeEmail(user.Email)
+	for _, u := range r.users {
+		if u.ID != user.ID && domain.NormalizeEmail(u.Email) == email {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Snapshot copies the stored users and returns a function that puts them
+// back, which is how the in-memory unit of work rolls back.
+func (r *InMemoryUserRepository) Snapshot() func() {
+	saved := make(map[string]domain.User, len(r.users))
+	for id, u := range r.users {
+		saved[id] = *u
+	}
+
+	return func() {
+		r.users = make(map[string]*domain.User, len(saved))
+		for id, u := range saved {
+			r.users[id] = &u
+		}
+	}
+}
+
 var _ domain.UserRepository = (*InMemoryUserRepository)(nil)
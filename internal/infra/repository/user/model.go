@@ -4,15 +4,23 @@ import (
 	"time"
 
 	"github.com/areteacademy/internal/domain"
+	"github.com/areteacademy/internal/infra/repository/pagination"
 )
 
 type UserGorm struct {
-	ID           string    `gorm:"primaryKey"`
-	Name         string    `gorm:"not null"`
-	Email        string    `gorm:"uniqueIndex;not nul"`
-	PasswordHash string    `gorm:"not null"`
-	CreatedAt    time.Time `gorm:"autoCreateTime"`
-	UpdatedAt    time.Time `gorm:"autoUpdateTime"`
+	ID              string     `gorm:"primaryKey"`
+	Name            string     `gorm:"not null"`
+	Email           string     `gorm:"uniqueIndex;not nul"`
+	PendingEmail    string     `gorm:"not null;default:''"`
+	EmailVerifiedAt *time.Time `gorm:"default:null"`
+	PasswordHash    string     `gorm:"not null"`
+	PasswordHistory []string   `gorm:"serializer:json"`
+	TokenVersion    int        `gorm:"not null;default:0"`
+	Role            string     `gorm:"index;not null;default:'USER'"`
+	Locale          string     `gorm:"not null;default:''"`
+	DeactivatedAt   *time.Time `gorm:"default:null"`
+	CreatedAt       time.Time  `gorm:"autoCreateTime"`
+	UpdatedAt       time.Time  `gorm:"autoUpdateTime"`
 }
 
 func (UserGorm) TableName() string {
@@ -21,22 +29,42 @@ func (UserGorm) TableName() string {
 
 func (u *UserGorm) ToDomain() *domain.User {
 	return &domain.User{
-		ID:        u.ID,
-		Name:      u.Name,
-		Email:     u.Email,
-		Password:  u.PasswordHash,
-		CreatedAt: u.CreatedAt,
-		UpdatedAt: u.UpdatedAt,
+		ID:              u.ID,
+		Name:            u.Name,
+		Email:           u.Email,
+		PendingEmail:    u.PendingEmail,
+		EmailVerifiedAt: u.EmailVerifiedAt,
+		Password:        u.PasswordHash,
+		PasswordHistory: u.PasswordHistory,
+		TokenVersion:    u.TokenVersion,
+		Role:            domain.Role(u.Role),
+		Locale:          domain.Locale(u.Locale),
+		DeactivatedAt:   u.DeactivatedAt,
+		CreatedAt:       u.CreatedAt,
+		UpdatedAt:       u.UpdatedAt,
 	}
 }
 
+// ToRepository stores users built without a role as plain users.
 func ToRepository(u *domain.User) *UserGorm {
+	role := u.Role
+	if role == "" {
+		role = domain.RoleUser
+	}
+
 	return &UserGorm{
-		ID:           u.ID,
-		Name:         u.Name,
-		Email:        u.Email,
-		PasswordHash: u.Password,
-		CreatedAt:    u.CreatedAt,
-		UpdatedAt:    u.UpdatedAt,
+		ID:              u.ID,
+		Name:            u.Name,
+		Email:           u.Email,
+		PendingEmail:    u.PendingEmail,
+		EmailVerifiedAt: u.EmailVerifiedAt,
+		PasswordHash:    u.Password,
+		PasswordHistory: u.PasswordHistory,
+		TokenVersion:    u.TokenVersion,
+		Role:            string(role),
+		Locale:          string(u.Locale),
+		DeactivatedAt:   u.DeactivatedAt,
+		CreatedAt:       pagination.Timestamp(u.CreatedAt),
+		UpdatedAt:       pagination.Timestamp(u.UpdatedAt),
 	}
 }
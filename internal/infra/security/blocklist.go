@@ -0,0 +1,37 @@
+package user
+
+import (
+	"bufio"
+	"os"
+	"strings"
+
+	"github.com/areteacademy/internal/domain"
+)
+
+// LoadPasswordBlocklist reads one password per line. Blank lines and lines
+// starting with # are skipped.
+func LoadPasswordBlocklist(path string) (domain.PasswordBlocklist, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var passwords []string
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		passwords = append(passwords, line)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return domain.NewPasswordBlocklist(passwords), nil
+}
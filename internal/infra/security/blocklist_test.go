@@ -0,0 +1,29 @@
+package user
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadPasswordBlocklist_ShouldSkipCommentsAndBlankLines(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "blocklist.txt")
+	require.NoError(t, os.WriteFile(path, []byte("# common passwords\nP@ssw0rd\n\n  Qwerty!123  \n"), 0o600))
+
+	blocklist, err := LoadPasswordBlocklist(path)
+
+	require.NoError(t, err)
+	assert.Len(t, blocklist, 2)
+	assert.True(t, blocklist.Contains("p@SSW0RD"))
+	assert.True(t, blocklist.Contains("Qwerty!123"))
+	assert.False(t, blocklist.Contains("# common passwords"))
+}
+
+func TestLoadPasswordBlocklist_ShouldReturnError_WhenFileIsMissing(t *testing.T) {
+	_, err := LoadPasswordBlocklist(filepath.Join(t.TempDir(), "missing.txt"))
+
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
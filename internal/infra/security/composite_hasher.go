@@ -0,0 +1,38 @@
+package user
+
+import "github.com/areteacademy/internal/domain"
+
+// CompositePasswordHasher hashes new passwords with its primary algorithm
+// and still verifies hashes of the others, so stored hashes can move from
+// one algorithm to another as users log in.
+type CompositePasswordHasher struct {
+	primary AlgorithmPasswordHasher
+	all     []AlgorithmPasswordHasher
+}
+
+func NewCompositePasswordHasher(primary AlgorithmPasswordHasher, others ...AlgorithmPasswordHasher) *CompositePasswordHasher {
+	return &CompositePasswordHasher{
+		primary: primary,
+		all:     append([]AlgorithmPasswordHasher{primary}, others...),
+	}
+}
+
+func (h *CompositePasswordHasher) Hash(password string) (string, error) {
+	return h.primary.Hash(password)
+}
+
+func (h *CompositePasswordHasher) Verify(hashedPassword, password string) (bool, error) {
+	for _, hasher := range h.all {
+		if hasher.Identifies(hashedPassword) {
+			return hasher.Verify(hashedPassword, password)
+		}
+	}
+
+	return false, ErrPasswordHashUnsupported
+}
+
+func (h *CompositePasswordHasher) NeedsRehash(hashedPassword string) bool {
+	return !h.primary.Identifies(hashedPassword) || h.primary.NeedsRehash(hashedPassword)
+}
+
+var _ domain.UserPasswordHasher = (*CompositePasswordHasher)(nil)
@@ -1,18 +1,45 @@
 package user
 
 import (
+	"errors"
+	"fmt"
+	"strings"
+
 	"github.com/areteacademy/internal/domain"
 	"golang.org/x/crypto/bcrypt"
 )
 
-type BcryptPasswordhasher struct{}
+var (
+	ErrPasswordHashUnsupported = errors.New("password hash algorithm not supported")
+	ErrPasswordHashMalformed   = errors.New("password hash malformed")
+	ErrBcryptCostInvalid       = errors.New("bcrypt cost invalid")
+)
+
+// AlgorithmPasswordHasher is a hasher for a single algorithm that can tell
+// its own encoded hashes apart from those of other algorithms.
+type AlgorithmPasswordHasher interface {
+	domain.UserPasswordHasher
+	Identifies(hashedPassword string) bool
+}
+
+type BcryptPasswordhasher struct {
+	cost int
+}
 
 func NewBcryptPasswordHasher() *BcryptPasswordhasher {
-	return &BcryptPasswordhasher{}
+	return &BcryptPasswordhasher{cost: bcrypt.DefaultCost}
+}
+
+func NewBcryptPasswordHasherWithCost(cost int) (*BcryptPasswordhasher, error) {
+	if cost < bcrypt.MinCost || cost > bcrypt.MaxCost {
+		return nil, ErrBcryptCostInvalid
+	}
+
+	return &BcryptPasswordhasher{cost: cost}, nil
 }
 
 func (h *BcryptPasswordhasher) Hash(password string) (string, error) {
-	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), h.cost)
 	if err != nil {
 		return "", err
 	}
@@ -20,4 +47,38 @@ func (h *BcryptPasswordhasher) Hash(password string) (string, error) {
 	return string(hash), nil
 }
 
-var _ domain.UserPasswordHasher = (*BcryptPasswordhasher)(nil)
+func (h *BcryptPasswordhasher) Verify(hashedPassword, password string) (bool, error) {
+	if !h.Identifies(hashedPassword) {
+		return false, ErrPasswordHashUnsupported
+	}
+
+	err := bcrypt.CompareHashAndPassword([]byte(hashedPassword), []byte(password))
+	if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, fmt.Errorf("%w: %v", ErrPasswordHashMalformed, err)
+	}
+
+	return true, nil
+}
+
+func (h *BcryptPasswordhasher) NeedsRehash(hashedPassword string) bool {
+	cost, err := bcrypt.Cost([]byte(hashedPassword))
+
+	return err != nil || cost != h.cost
+}
+
+// Identifies recognises the modular crypt prefixes bcrypt hashes carry.
+func (h *BcryptPasswordhasher) Identifies(hashedPassword string) bool {
+	for _, prefix := range []string{"$2a$", "$2b$", "$2y$"} {
+		if strings.HasPrefix(hashedPassword, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+var _ AlgorithmPasswordHasher = (*BcryptPasswordhasher)(nil)
@@ -0,0 +1,172 @@
+package user
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// lightArgon2idParams keeps tests fast; they are far below what production
+// should use.
+func lightArgon2idParams() Argon2idParams {
+	return Argon2idParams{
+		Memory:      64,
+		Iterations:  1,
+		Parallelism: 1,
+		SaltLength:  16,
+		KeyLength:   32,
+	}
+}
+
+func makeArgon2idSut(t *testing.T, params Argon2idParams) *Argon2idPasswordHasher {
+	hasher, err := NewArgon2idPasswordHasher(params)
+	require.NoError(t, err)
+
+	return hasher
+}
+
+func TestPasswordHashers_ShouldVerifyOwnHashes(t *testing.T) {
+	testCases := []struct {
+		name   string
+		hasher func(t *testing.T) AlgorithmPasswordHasher
+		prefix string
+	}{
+		{
+			name: "Bcrypt",
+			hasher: func(t *testing.T) AlgorithmPasswordHasher {
+				hasher, err := NewBcryptPasswordHasherWithCost(bcrypt.MinCost)
+				require.NoError(t, err)
+				return hasher
+			},
+			prefix: "$2a$04$",
+		},
+		{
+			name: "Argon2id",
+			hasher: func(t *testing.T) AlgorithmPasswordHasher {
+				return makeArgon2idSut(t, lightArgon2idParams())
+			},
+			prefix: "$argon2id$v=19$m=64,t=1,p=1$",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sut := tc.hasher(t)
+
+			hash, err := sut.Hash("@Daniel123")
+			require.NoError(t, err)
+
+			matches, err := sut.Verify(hash, "@Daniel123")
+			require.NoError(t, err)
+
+			mismatches, err := sut.Verify(hash, "@Daniel124")
+			require.NoError(t, err)
+
+			assert.True(t, strings.HasPrefix(hash, tc.prefix), hash)
+			assert.True(t, sut.Identifies(hash))
+			assert.True(t, matches)
+			assert.False(t, mismatches)
+			assert.False(t, sut.NeedsRehash(hash))
+		})
+	}
+}
+
+func TestArgon2idPasswordHasher_ShouldSaltEachHash(t *testing.T) {
+	sut := makeArgon2idSut(t, lightArgon2idParams())
+
+	first, err := sut.Hash("@Daniel123")
+	require.NoError(t, err)
+	second, err := sut.Hash("@Daniel123")
+	require.NoError(t, err)
+
+	assert.NotEqual(t, first, second)
+}
+
+func TestArgon2idPasswordHasher_ShouldNeedRehash_WhenParamsChange(t *testing.T) {
+	old := makeArgon2idSut(t, lightArgon2idParams())
+	hash, err := old.Hash("@Daniel123")
+	require.NoError(t, err)
+
+	params := lightArgon2idParams()
+	params.Iterations = 2
+	sut := makeArgon2idSut(t, params)
+
+	matches, err := sut.Verify(hash, "@Daniel123")
+
+	require.NoError(t, err)
+	assert.True(t, matches)
+	assert.True(t, sut.NeedsRehash(hash))
+}
+
+func TestArgon2idPasswordHasher_ShouldRejectMalformedHashes(t *testing.T) {
+	sut := makeArgon2idSut(t, lightArgon2idParams())
+
+	testCases := []string{
+		"$argon2id$v=19$m=64,t=1,p=1$c2FsdA",
+		"$argon2id$v=18$m=64,t=1,p=1$c2FsdHNhbHQ$a2V5",
+		"$argon2id$v=19$m=64,t=0,p=1$c2FsdHNhbHQ$a2V5",
+		"$argon2id$v=19$m=64,t=1,p=1$!!!$a2V5",
+	}
+
+	for _, hash := range testCases {
+		t.Run(hash, func(t *testing.T) {
+			matches, err := sut.Verify(hash, "@Daniel123")
+
+			assert.ErrorIs(t, err, ErrPasswordHashMalformed)
+			assert.False(t, matches)
+			assert.True(t, sut.NeedsRehash(hash))
+		})
+	}
+}
+
+func TestNewPasswordHashers_ShouldRejectInvalidParameters(t *testing.T) {
+	_, err := NewBcryptPasswordHasherWithCost(bcrypt.MaxCost + 1)
+	assert.ErrorIs(t, err, ErrBcryptCostInvalid)
+
+	params := lightArgon2idParams()
+	params.Iterations = 0
+	_, err = NewArgon2idPasswordHasher(params)
+	assert.ErrorIs(t, err, ErrArgon2idParamsInvalid)
+}
+
+func TestBcryptPasswordHasher_ShouldNeedRehash_WhenCostChanges(t *testing.T) {
+	old, err := NewBcryptPasswordHasherWithCost(bcrypt.MinCost)
+	require.NoError(t, err)
+	hash, err := old.Hash("@Daniel123")
+	require.NoError(t, err)
+
+	sut, err := NewBcryptPasswordHasherWithCost(bcrypt.MinCost + 1)
+	require.NoError(t, err)
+
+	assert.True(t, sut.NeedsRehash(hash))
+}
+
+func TestCompositePasswordHasher_ShouldVerifyEveryAlgorithmAndPreferPrimary(t *testing.T) {
+	bcryptHasher, err := NewBcryptPasswordHasherWithCost(bcrypt.MinCost)
+	require.NoError(t, err)
+	argon2idHasher := makeArgon2idSut(t, lightArgon2idParams())
+
+	legacyHash, err := bcryptHasher.Hash("@Daniel123")
+	require.NoError(t, err)
+
+	sut := NewCompositePasswordHasher(argon2idHasher, bcryptHasher)
+
+	newHash, err := sut.Hash("@Daniel123")
+	require.NoError(t, err)
+
+	legacyMatches, err := sut.Verify(legacyHash, "@Daniel123")
+	require.NoError(t, err)
+	newMatches, err := sut.Verify(newHash, "@Daniel123")
+	require.NoError(t, err)
+	_, unsupportedErr := sut.Verify("plain-text", "@Daniel123")
+
+	assert.True(t, argon2idHasher.Identifies(newHash))
+	assert.True(t, legacyMatches)
+	assert.True(t, newMatches)
+	assert.ErrorIs(t, unsupportedErr, ErrPasswordHashUnsupported)
+	assert.True(t, sut.NeedsRehash(legacyHash))
+	assert.False(t, sut.NeedsRehash(newHash))
+}
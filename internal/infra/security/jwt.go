@@ -0,0 +1,124 @@
+package user
+
+import (
+	"errors"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+var ErrJwtUnknownKey = errors.New("jwt signed with an unknown or retired key")
+
+type JwtConfig struct {
+	Keys       *JwtKeySet
+	Issuer     string
+	Expiration time.Duration
+}
+
+type jwtClaims struct {
+	jwt.RegisteredClaims
+	TokenVersion int    `json:"ver"`
+	Role         string `json:"role"`
+	SessionId    string `json:"sid"`
+}
+
+type JwtTokenService struct {
+	keys       *JwtKeySet
+	issuer     string
+	expiration time.Duration
+	now        func() time.Time
+}
+
+func NewJwtTokenService(config JwtConfig) (*JwtTokenService, error) {
+	if config.Keys == nil {
+		return nil, ErrJwtKeysAreRequired
+	}
+
+	return &JwtTokenService{
+		keys:       config.Keys,
+		issuer:     config.Issuer,
+		expiration: config.Expiration,
+		now:        time.Now,
+	}, nil
+}
+
+// Generate signs with the key currently active and names it in the kid
+// header, so the token can still be verified after the next rotation.
+func (s *JwtTokenService) Generate(user *domain.User, sessionId string) (string, *domain.TokenClaims, error) {
+	issuedAt := s.now()
+	expiresAt := issuedAt.Add(s.expiration)
+
+	key, err := s.keys.signingKey(issuedAt)
+	if err != nil {
+		return "", nil, err
+	}
+
+	token := jwt.NewWithClaims(key.method, jwtClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   user.ID,
+			Issuer:    s.issuer,
+			IssuedAt:  jwt.NewNumericDate(issuedAt),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		},
+		TokenVersion: user.TokenVersion,
+		Role:         string(user.Role),
+		SessionId:    sessionId,
+	})
+	token.Header["kid"] = key.Kid
+
+	signed, err := token.SignedString(key.private)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return signed, &domain.TokenClaims{
+		UserId:       user.ID,
+		SessionId:    sessionId,
+		TokenVersion: user.TokenVersion,
+		Role:         user.Role,
+		IssuedAt:     issuedAt,
+		ExpiresAt:    expiresAt,
+	}, nil
+}
+
+func (s *JwtTokenService) Validate(token string) (*domain.TokenClaims, error) {
+	var claims jwtClaims
+
+	_, err := jwt.ParseWithClaims(
+		token,
+		&claims,
+		s.verificationKey,
+		jwt.WithValidMethods([]string{jwt.SigningMethodRS256.Alg(), jwt.SigningMethodEdDSA.Alg()}),
+		jwt.WithIssuer(s.issuer),
+		jwt.WithExpirationRequired(),
+		jwt.WithTimeFunc(s.now),
+	)
+	if err != nil || claims.Subject == "" {
+		return nil, domain.ErrAuthTokenInvalid
+	}
+
+	return &domain.TokenClaims{
+		UserId:       claims.Subject,
+		SessionId:    claims.SessionId,
+		TokenVersion: claims.TokenVersion,
+		Role:         domain.Role(claims.Role),
+		IssuedAt:     claims.IssuedAt.Time,
+		ExpiresAt:    claims.ExpiresAt.Time,
+	}, nil
+}
+
+// verificationKey also checks the algorithm against the key, so a token
+// cannot pick how its signature is checked.
+func (s *JwtTokenService) verificationKey(token *jwt.Token) (any, error) {
+	kid, _ := token.Header["kid"].(string)
+
+	key := s.keys.verificationKey(kid, s.now())
+	if key == nil || key.Algorithm() != token.Method.Alg() {
+		return nil, ErrJwtUnknownKey
+	}
+
+	return key.public(), nil
+}
+
+var _ domain.TokenService = (*JwtTokenService)(nil)
@@ -0,0 +1,358 @@
+package user
+
+import (
+	"context"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const jwtRsaMinBits = 2048
+
+var (
+	ErrJwtKeysAreRequired   = errors.New("jwt keys are required")
+	ErrJwtKeyIdIsRequired   = errors.New("jwt key id is required")
+	ErrJwtKeyIdRepeated     = errors.New("jwt key ids must not repeat")
+	ErrJwtKeyTypeInvalid    = errors.New("jwt key must be an RSA or Ed25519 private key")
+	ErrJwtKeyTooWeak        = errors.New("jwt RSA key must have at least 2048 bits")
+	ErrJwtNoSigningKey      = errors.New("no jwt key is active yet")
+	ErrJwtKeySetNotReloaded = errors.New("jwt key set was not loaded from a file")
+)
+
+// JwtKey is a private key tokens are signed with, known to verifiers by
+// its kid. It starts signing at ActiveFrom.
+type JwtKey struct {
+	Kid        string
+	ActiveFrom time.Time
+	method     jwt.SigningMethod
+	private    crypto.Signer
+}
+
+// NewJwtKey signs with RS256 for RSA keys and EdDSA for Ed25519 keys.
+func NewJwtKey(kid string, private crypto.PrivateKey, activeFrom time.Time) (*JwtKey, error) {
+	if kid == "" {
+		return nil, ErrJwtKeyIdIsRequired
+	}
+
+	switch key := private.(type) {
+	case *rsa.PrivateKey:
+		if key.N.BitLen() < jwtRsaMinBits {
+			return nil, ErrJwtKeyTooWeak
+		}
+
+		return &JwtKey{Kid: kid, ActiveFrom: activeFrom, method: jwt.SigningMethodRS256, private: key}, nil
+	case ed25519.PrivateKey:
+		return &JwtKey{Kid: kid, ActiveFrom: activeFrom, method: jwt.SigningMethodEdDSA, private: key}, nil
+	default:
+		return nil, ErrJwtKeyTypeInvalid
+	}
+}
+
+// GenerateJwtKey returns a new Ed25519 key that lives only in memory.
+func GenerateJwtKey(kid string, activeFrom time.Time) (*JwtKey, error) {
+	_, private, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewJwtKey(kid, private, activeFrom)
+}
+
+func (k *JwtKey) Algorithm() string {
+	return k.method.Alg()
+}
+
+func (k *JwtKey) public() crypto.PublicKey {
+	return k.private.Public()
+}
+
+// JwtKeySet holds every key tokens may be signed with. The key that most
+// recently became active signs new tokens. A key it replaced keeps being
+// accepted for overlap after the replacement, which should be at least the
+// token lifetime, so tokens it signed are not cut short, and then retires.
+type JwtKeySet struct {
+	mu      sync.RWMutex
+	keys    []*JwtKey
+	overlap time.Duration
+	path    string
+}
+
+func NewJwtKeySet(overlap time.Duration, keys ...*JwtKey) (*JwtKeySet, error) {
+	set := &JwtKeySet{overlap: overlap}
+	if err := set.replace(keys); err != nil {
+		return nil, err
+	}
+
+	return set, nil
+}
+
+// NewEphemeralJwtKeySet returns a set with a single key generated in
+// memory, for tests. Tokens it signs are not accepted after a restart.
+func NewEphemeralJwtKeySet() (*JwtKeySet, error) {
+	key, err := GenerateJwtKey("ephemeral", time.Time{})
+	if err != nil {
+		return nil, err
+	}
+
+	return NewJwtKeySet(0, key)
+}
+
+type jwtKeyManifest struct {
+	Keys []struct {
+		Kid            string    `json:"kid"`
+		PrivateKeyFile string    `json:"private_key_file"`
+		ActiveFrom     time.Time `json:"active_from"`
+	} `json:"keys"`
+}
+
+// LoadJwtKeySet reads a JSON manifest listing each key's kid, PEM private
+// key file, relative to the manifest, and the time it starts signing:
+//
+//	{"keys": [{"kid": "2026-10", "private_key_file": "2026-10.pem", "active_from": "2026-10-01T00:00:00Z"}]}
+//
+// Keys are rotated by adding the next one ahead of its active_from, so
+// verifiers fetch it before it signs anything, and removing keys once they
+// retired. Removing a key that has not retired rejects its tokens at once.
+func LoadJwtKeySet(path string, overlap time.Duration) (*JwtKeySet, error) {
+	set := &JwtKeySet{overlap: overlap, path: path}
+	if err := set.Reload(); err != nil {
+		return nil, err
+	}
+
+	return set, nil
+}
+
+// Reload reads the manifest again. The keys in use are kept when it fails.
+func (s *JwtKeySet) Reload() error {
+	if s.path == "" {
+		return ErrJwtKeySetNotReloaded
+	}
+
+	keys, err := readJwtKeyManifest(s.path)
+	if err != nil {
+		return fmt.Errorf("load jwt keys %s: %w", s.path, err)
+	}
+
+	return s.replace(keys)
+}
+
+// Watch reloads the manifest every interval until ctx is done, so keys can
+// be rotated without a restart.
+func (s *JwtKeySet) Watch(ctx context.Context, interval time.Duration, onError func(error)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.Reload(); err != nil {
+				onError(err)
+			}
+		}
+	}
+}
+
+func readJwtKeyManifest(path string) ([]*JwtKey, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var manifest jwtKeyManifest
+	if err := json.Unmarshal(raw, &manifest); err != nil {
+		return nil, err
+	}
+
+	keys := make([]*JwtKey, 0, len(manifest.Keys))
+	for _, entry := range manifest.Keys {
+		file := entry.PrivateKeyFile
+		if !filepath.IsAbs(file) {
+			file = filepath.Join(filepath.Dir(path), file)
+		}
+
+		private, err := readPrivateKey(file)
+		if err != nil {
+			return nil, fmt.Errorf("key %q: %w", entry.Kid, err)
+		}
+
+		key, err := NewJwtKey(entry.Kid, private, entry.ActiveFrom)
+		if err != nil {
+			return nil, fmt.Errorf("key %q: %w", entry.Kid, err)
+		}
+
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
+
+// readPrivateKey accepts PKCS #8 keys, as written by openssl genpkey, and
+// PKCS #1 RSA keys.
+func readPrivateKey(path string) (crypto.PrivateKey, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	block, _ := pem.Decode(raw)
+	if block == nil {
+		return nil, ErrJwtKeyTypeInvalid
+	}
+
+	switch block.Type {
+	case "PRIVATE KEY":
+		return x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		return x509.ParsePKCS1PrivateKey(block.Bytes)
+	default:
+		return nil, ErrJwtKeyTypeInvalid
+	}
+}
+
+func (s *JwtKeySet) replace(keys []*JwtKey) error {
+	if len(keys) == 0 {
+		return ErrJwtKeysAreRequired
+	}
+
+	sorted := slices.Clone(keys)
+	slices.SortStableFunc(sorted, func(a, b *JwtKey) int {
+		return a.ActiveFrom.Compare(b.ActiveFrom)
+	})
+
+	// A set that cannot sign yet would fail every login.
+	if sorted[0].ActiveFrom.After(time.Now()) {
+		return ErrJwtNoSigningKey
+	}
+
+	for i, key := range sorted {
+		if slices.ContainsFunc(sorted[:i], func(other *JwtKey) bool { return other.Kid == key.Kid }) {
+			return ErrJwtKeyIdRepeated
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.keys = sorted
+	return nil
+}
+
+// signingKey returns the key that most recently became active.
+func (s *JwtKeySet) signingKey(now time.Time) (*JwtKey, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var signing *JwtKey
+	for _, key := range s.keys {
+		if key.ActiveFrom.After(now) {
+			break
+		}
+
+		signing = key
+	}
+
+	if signing == nil {
+		return nil, ErrJwtNoSigningKey
+	}
+
+	return signing, nil
+}
+
+// verificationKey returns the key named kid unless it retired.
+func (s *JwtKeySet) verificationKey(kid string, now time.Time) *JwtKey {
+	for _, key := range s.published(now) {
+		if key.Kid == kid {
+			return key
+		}
+	}
+
+	return nil
+}
+
+// published returns the keys that have not retired, including the ones
+// that are not active yet.
+func (s *JwtKeySet) published(now time.Time) []*JwtKey {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	published := make([]*JwtKey, 0, len(s.keys))
+	for i, key := range s.keys {
+		if s.retired(i, now) {
+			continue
+		}
+
+		published = append(published, key)
+	}
+
+	return published
+}
+
+// retired reports whether a later key has been signing for longer than the
+// overlap. Keys activating at the same time as key i do not retire it.
+func (s *JwtKeySet) retired(i int, now time.Time) bool {
+	for _, next := range s.keys[i+1:] {
+		if !next.ActiveFrom.After(s.keys[i].ActiveFrom) {
+			continue
+		}
+
+		return !now.Before(next.ActiveFrom.Add(s.overlap))
+	}
+
+	return false
+}
+
+// JsonWebKey is the public half of a key, as RFC 7517 describes it.
+type JsonWebKey struct {
+	Kty string
+	Kid string
+	Use string
+	Alg string
+	// N and E are the RSA modulus and exponent.
+	N string
+	E string
+	// Crv and X are the curve and public key of Ed25519 keys.
+	Crv string
+	X   string
+}
+
+// PublicKeys returns the keys verifiers should accept tokens from at now.
+func (s *JwtKeySet) PublicKeys(now time.Time) []JsonWebKey {
+	keys := s.published(now)
+
+	jwks := make([]JsonWebKey, 0, len(keys))
+	for _, key := range keys {
+		jwk := JsonWebKey{Kid: key.Kid, Use: "sig", Alg: key.Algorithm()}
+
+		switch public := key.public().(type) {
+		case *rsa.PublicKey:
+			jwk.Kty = "RSA"
+			jwk.N = base64.RawURLEncoding.EncodeToString(public.N.Bytes())
+			jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(public.E)).Bytes())
+		case ed25519.PublicKey:
+			jwk.Kty = "OKP"
+			jwk.Crv = "Ed25519"
+			jwk.X = base64.RawURLEncoding.EncodeToString(public)
+		}
+
+		jwks = append(jwks, jwk)
+	}
+
+	return jwks
+}
@@ -0,0 +1,224 @@
+package user
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type manifestKey struct {
+	Kid            string    `json:"kid"`
+	PrivateKeyFile string    `json:"private_key_file"`
+	ActiveFrom     time.Time `json:"active_from"`
+}
+
+func generateRsaKey(t *testing.T, bits int) *rsa.PrivateKey {
+	key, err := rsa.GenerateKey(rand.Reader, bits)
+	require.NoError(t, err)
+
+	return key
+}
+
+func writePemKey(t *testing.T, dir, name, blockType string, der []byte) {
+	raw := pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der})
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name), raw, 0o600))
+}
+
+func writeEd25519Key(t *testing.T, dir, name string) {
+	_, private, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	der, err := x509.MarshalPKCS8PrivateKey(private)
+	require.NoError(t, err)
+
+	writePemKey(t, dir, name, "PRIVATE KEY", der)
+}
+
+func writeManifest(t *testing.T, dir string, keys ...manifestKey) string {
+	raw, err := json.Marshal(map[string][]manifestKey{"keys": keys})
+	require.NoError(t, err)
+
+	path := filepath.Join(dir, "jwt-keys.json")
+	require.NoError(t, os.WriteFile(path, raw, 0o600))
+
+	return path
+}
+
+func TestLoadJwtKeySet_ShouldLoadRsaAndEd25519Keys(t *testing.T) {
+	dir := t.TempDir()
+	now := time.Now()
+
+	writePemKey(t, dir, "rsa.pem", "RSA PRIVATE KEY", x509.MarshalPKCS1PrivateKey(generateRsaKey(t, 2048)))
+	writeEd25519Key(t, dir, "ed25519.pem")
+	path := writeManifest(t, dir,
+		manifestKey{Kid: "rsa", PrivateKeyFile: "rsa.pem", ActiveFrom: now.Add(-time.Hour)},
+		manifestKey{Kid: "ed25519", PrivateKeyFile: filepath.Join(dir, "ed25519.pem"), ActiveFrom: now.Add(time.Hour)},
+	)
+
+	keys, err := LoadJwtKeySet(path, time.Hour)
+	require.NoError(t, err)
+
+	signing, err := keys.signingKey(now)
+	require.NoError(t, err)
+	assert.Equal(t, "rsa", signing.Kid)
+
+	published := keys.PublicKeys(now)
+	require.Len(t, published, 2)
+	assert.Equal(t, "rsa", published[0].Kid)
+	assert.Equal(t, "RSA", published[0].Kty)
+	assert.Equal(t, "RS256", published[0].Alg)
+	assert.Equal(t, "AQAB", published[0].E)
+	assert.NotEmpty(t, published[0].N)
+	assert.Equal(t, "ed25519", published[1].Kid)
+	assert.Equal(t, "OKP", published[1].Kty)
+	assert.Equal(t, "Ed25519", published[1].Crv)
+	assert.Equal(t, "EdDSA", published[1].Alg)
+	assert.NotEmpty(t, published[1].X)
+}
+
+func TestJwtKeySet_ShouldStopPublishingRetiredKeys(t *testing.T) {
+	rotatesAt := time.Now()
+
+	previous, err := GenerateJwtKey("previous", rotatesAt.Add(-24*time.Hour))
+	require.NoError(t, err)
+	next, err := GenerateJwtKey("next", rotatesAt)
+	require.NoError(t, err)
+	keys, err := NewJwtKeySet(time.Hour, previous, next)
+	require.NoError(t, err)
+
+	during := keys.PublicKeys(rotatesAt.Add(59 * time.Minute))
+	after := keys.PublicKeys(rotatesAt.Add(time.Hour))
+
+	require.Len(t, during, 2)
+	require.Len(t, after, 1)
+	assert.Equal(t, "next", after[0].Kid)
+}
+
+func TestJwtKeySet_Reload_ShouldPickUpNewKeys(t *testing.T) {
+	dir := t.TempDir()
+	now := time.Now()
+
+	writeEd25519Key(t, dir, "first.pem")
+	writeEd25519Key(t, dir, "second.pem")
+	first := manifestKey{Kid: "first", PrivateKeyFile: "first.pem", ActiveFrom: now.Add(-time.Hour)}
+	path := writeManifest(t, dir, first)
+
+	keys, err := LoadJwtKeySet(path, time.Hour)
+	require.NoError(t, err)
+
+	writeManifest(t, dir, first, manifestKey{Kid: "second", PrivateKeyFile: "second.pem", ActiveFrom: now.Add(time.Minute)})
+	require.NoError(t, keys.Reload())
+
+	current, err := keys.signingKey(now)
+	require.NoError(t, err)
+	upcoming, err := keys.signingKey(now.Add(2 * time.Minute))
+	require.NoError(t, err)
+
+	assert.Equal(t, "first", current.Kid)
+	assert.Equal(t, "second", upcoming.Kid)
+}
+
+func TestJwtKeySet_Reload_ShouldKeepKeys_WhenManifestIsInvalid(t *testing.T) {
+	dir := t.TempDir()
+
+	writeEd25519Key(t, dir, "first.pem")
+	path := writeManifest(t, dir, manifestKey{Kid: "first", PrivateKeyFile: "first.pem"})
+
+	keys, err := LoadJwtKeySet(path, time.Hour)
+	require.NoError(t, err)
+
+	writeManifest(t, dir, manifestKey{Kid: "second", PrivateKeyFile: "missing.pem"})
+
+	assert.ErrorIs(t, keys.Reload(), os.ErrNotExist)
+	signing, err := keys.signingKey(time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, "first", signing.Kid)
+}
+
+func TestLoadJwtKeySet_GivenInvalidManifest_ShouldReturnError(t *testing.T) {
+	testCases := []struct {
+		name        string
+		setup       func(t *testing.T, dir string) string
+		expectedErr error
+	}{
+		{
+			name: "Missing Manifest",
+			setup: func(t *testing.T, dir string) string {
+				return filepath.Join(dir, "missing.json")
+			},
+			expectedErr: os.ErrNotExist,
+		},
+		{
+			name: "No Keys",
+			setup: func(t *testing.T, dir string) string {
+				return writeManifest(t, dir)
+			},
+			expectedErr: ErrJwtKeysAreRequired,
+		},
+		{
+			name: "Missing Kid",
+			setup: func(t *testing.T, dir string) string {
+				writeEd25519Key(t, dir, "key.pem")
+				return writeManifest(t, dir, manifestKey{PrivateKeyFile: "key.pem"})
+			},
+			expectedErr: ErrJwtKeyIdIsRequired,
+		},
+		{
+			name: "Repeated Kid",
+			setup: func(t *testing.T, dir string) string {
+				writeEd25519Key(t, dir, "first.pem")
+				writeEd25519Key(t, dir, "second.pem")
+				return writeManifest(t, dir,
+					manifestKey{Kid: "key", PrivateKeyFile: "first.pem"},
+					manifestKey{Kid: "key", PrivateKeyFile: "second.pem"},
+				)
+			},
+			expectedErr: ErrJwtKeyIdRepeated,
+		},
+		{
+			name: "Weak RSA Key",
+			setup: func(t *testing.T, dir string) string {
+				writePemKey(t, dir, "rsa.pem", "RSA PRIVATE KEY", x509.MarshalPKCS1PrivateKey(generateRsaKey(t, 1024)))
+				return writeManifest(t, dir, manifestKey{Kid: "rsa", PrivateKeyFile: "rsa.pem"})
+			},
+			expectedErr: ErrJwtKeyTooWeak,
+		},
+		{
+			name: "Not A Private Key",
+			setup: func(t *testing.T, dir string) string {
+				require.NoError(t, os.WriteFile(filepath.Join(dir, "key.pem"), []byte("not a key"), 0o600))
+				return writeManifest(t, dir, manifestKey{Kid: "key", PrivateKeyFile: "key.pem"})
+			},
+			expectedErr: ErrJwtKeyTypeInvalid,
+		},
+		{
+			name: "No Active Key",
+			setup: func(t *testing.T, dir string) string {
+				writeEd25519Key(t, dir, "key.pem")
+				return writeManifest(t, dir, manifestKey{Kid: "key", PrivateKeyFile: "key.pem", ActiveFrom: time.Now().Add(time.Hour)})
+			},
+			expectedErr: ErrJwtNoSigningKey,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			path := tc.setup(t, t.TempDir())
+
+			keys, err := LoadJwtKeySet(path, time.Hour)
+
+			require.Nil(t, keys)
+			assert.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+}
@@ -0,0 +1,189 @@
+package user
+
+import (
+	"testing"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func makeJwtSut(t *testing.T) *JwtTokenService {
+	keys, err := NewEphemeralJwtKeySet()
+	require.NoError(t, err)
+
+	service, err := NewJwtTokenService(JwtConfig{
+		Keys:       keys,
+		Issuer:     "areteacademy",
+		Expiration: time.Hour,
+	})
+	require.NoError(t, err)
+
+	return service
+}
+
+func TestJwtTokenService_ShouldValidateGeneratedToken(t *testing.T) {
+	sut := makeJwtSut(t)
+
+	token, claims, err := sut.Generate(&domain.User{ID: "user-01", Role: domain.RoleAdmin}, "session-01")
+	require.NoError(t, err)
+
+	validated, err := sut.Validate(token)
+
+	require.NoError(t, err)
+	assert.Equal(t, "user-01", validated.UserId)
+	assert.Equal(t, "session-01", validated.SessionId)
+	assert.Equal(t, domain.RoleAdmin, validated.Role)
+	assert.Equal(t, claims.ExpiresAt.Unix(), validated.ExpiresAt.Unix())
+}
+
+func TestJwtTokenService_ShouldSignWithRsaKeys(t *testing.T) {
+	key, err := NewJwtKey("rsa-01", generateRsaKey(t, 2048), time.Time{})
+	require.NoError(t, err)
+	keys, err := NewJwtKeySet(time.Hour, key)
+	require.NoError(t, err)
+	sut, err := NewJwtTokenService(JwtConfig{Keys: keys, Issuer: "areteacademy", Expiration: time.Hour})
+	require.NoError(t, err)
+
+	token, _, err := sut.Generate(&domain.User{ID: "user-01"}, "session-01")
+	require.NoError(t, err)
+
+	parsed, _, err := jwt.NewParser().ParseUnverified(token, &jwtClaims{})
+	require.NoError(t, err)
+	assert.Equal(t, "RS256", parsed.Method.Alg())
+	assert.Equal(t, "rsa-01", parsed.Header["kid"])
+
+	_, err = sut.Validate(token)
+	assert.NoError(t, err)
+}
+
+func TestJwtTokenService_ShouldRejectInvalidTokens(t *testing.T) {
+	testCases := []struct {
+		name  string
+		token func(t *testing.T, sut *JwtTokenService) string
+	}{
+		{
+			name: "Expired",
+			token: func(t *testing.T, sut *JwtTokenService) string {
+				sut.now = func() time.Time { return time.Now().Add(-2 * time.Hour) }
+				token, _, err := sut.Generate(&domain.User{ID: "user-01"}, "session-01")
+				require.NoError(t, err)
+				sut.now = time.Now
+				return token
+			},
+		},
+		{
+			name: "Other Key With The Same Kid",
+			token: func(t *testing.T, sut *JwtTokenService) string {
+				other := makeJwtSut(t)
+				token, _, err := other.Generate(&domain.User{ID: "user-01"}, "session-01")
+				require.NoError(t, err)
+				return token
+			},
+		},
+		{
+			name: "Unknown Kid",
+			token: func(t *testing.T, sut *JwtTokenService) string {
+				key, err := GenerateJwtKey("unknown", time.Time{})
+				require.NoError(t, err)
+				keys, err := NewJwtKeySet(time.Hour, key)
+				require.NoError(t, err)
+				other, err := NewJwtTokenService(JwtConfig{Keys: keys, Issuer: "areteacademy", Expiration: time.Hour})
+				require.NoError(t, err)
+				token, _, err := other.Generate(&domain.User{ID: "user-01"}, "session-01")
+				require.NoError(t, err)
+				return token
+			},
+		},
+		{
+			name: "Symmetric Algorithm",
+			token: func(t *testing.T, sut *JwtTokenService) string {
+				token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwtClaims{
+					RegisteredClaims: jwt.RegisteredClaims{
+						Subject:   "user-01",
+						Issuer:    "areteacademy",
+						ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+					},
+				})
+				token.Header["kid"] = "ephemeral"
+				signed, err := token.SignedString([]byte("secret"))
+				require.NoError(t, err)
+				return signed
+			},
+		},
+		{
+			name: "Other Issuer",
+			token: func(t *testing.T, sut *JwtTokenService) string {
+				other, err := NewJwtTokenService(JwtConfig{Keys: sut.keys, Issuer: "other", Expiration: time.Hour})
+				require.NoError(t, err)
+				token, _, err := other.Generate(&domain.User{ID: "user-01"}, "session-01")
+				require.NoError(t, err)
+				return token
+			},
+		},
+		{
+			name: "Malformed",
+			token: func(t *testing.T, sut *JwtTokenService) string {
+				return "not-a-token"
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sut := makeJwtSut(t)
+
+			claims, err := sut.Validate(tc.token(t, sut))
+
+			require.Nil(t, claims)
+			assert.ErrorIs(t, err, domain.ErrAuthTokenInvalid)
+		})
+	}
+}
+
+func TestJwtTokenService_ShouldKeepAcceptingReplacedKeyDuringOverlap(t *testing.T) {
+	rotatesAt := time.Now()
+
+	previous, err := GenerateJwtKey("previous", rotatesAt.Add(-24*time.Hour))
+	require.NoError(t, err)
+	next, err := GenerateJwtKey("next", rotatesAt)
+	require.NoError(t, err)
+	keys, err := NewJwtKeySet(time.Hour, next, previous)
+	require.NoError(t, err)
+
+	sut, err := NewJwtTokenService(JwtConfig{Keys: keys, Issuer: "areteacademy", Expiration: 2 * time.Hour})
+	require.NoError(t, err)
+
+	at := func(offset time.Duration) { sut.now = func() time.Time { return rotatesAt.Add(offset) } }
+
+	at(-time.Minute)
+	before, _, err := sut.Generate(&domain.User{ID: "user-01"}, "session-01")
+	require.NoError(t, err)
+
+	at(time.Minute)
+	after, _, err := sut.Generate(&domain.User{ID: "user-01"}, "session-01")
+	require.NoError(t, err)
+
+	at(30 * time.Minute)
+	_, overlapErr := sut.Validate(before)
+
+	at(61 * time.Minute)
+	_, retiredErr := sut.Validate(before)
+	_, currentErr := sut.Validate(after)
+
+	assert.Equal(t, "previous", kidOf(t, before))
+	assert.Equal(t, "next", kidOf(t, after))
+	assert.NoError(t, overlapErr)
+	assert.ErrorIs(t, retiredErr, domain.ErrAuthTokenInvalid)
+	assert.NoError(t, currentErr)
+}
+
+func kidOf(t *testing.T, token string) string {
+	parsed, _, err := jwt.NewParser().ParseUnverified(token, &jwtClaims{})
+	require.NoError(t, err)
+
+	kid, _ := parsed.Header["kid"].(string)
+	return kid
+}
@@ -0,0 +1,50 @@
+package admin
+
+import (
+	"context"
+	"errors"
+
+	"github.com/areteacademy/internal/domain"
+)
+
+type bootstrapAdminUseCase struct {
+	userRepo domain.UserRepository
+}
+
+type BootstrapAdminUseCase interface {
+	Perform(ctx context.Context, input BootstrapAdminInput) error
+}
+
+func NewBootstrapAdminUseCase(userRepo domain.UserRepository) BootstrapAdminUseCase {
+	return &bootstrapAdminUseCase{
+		userRepo: userRepo,
+	}
+}
+
+// Perform makes the user registered with Email an administrator. It runs on
+// behalf of whoever deploys the application, so it checks no permission;
+// it is how the first administrator comes to exist.
+func (uc *bootstrapAdminUseCase) Perform(ctx context.Context, input BootstrapAdminInput) error {
+	if input.Email == "" {
+		return domain.ErrUserEmailIsRequired
+	}
+
+	user, err := uc.userRepo.GetByEmail(ctx, input.Email)
+	if err != nil && !errors.Is(err, domain.ErrUserNotFound) {
+		return err
+	}
+
+	if user == nil {
+		return domain.ErrUserNotFound
+	}
+
+	if user.Role == domain.RoleAdmin {
+		return nil
+	}
+
+	if err := user.ChangeRole(domain.RoleAdmin); err != nil {
+		return err
+	}
+
+	return uc.userRepo.Update(ctx, user)
+}
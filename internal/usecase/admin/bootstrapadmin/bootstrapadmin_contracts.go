@@ -0,0 +1,5 @@
+package admin
+
+type BootstrapAdminInput struct {
+	Email string
+}
@@ -0,0 +1,110 @@
+package admin
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+	repo "github.com/areteacademy/internal/infra/repository/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type SUT struct {
+	UseCase BootstrapAdminUseCase
+	Repo    *repo.InMemoryUserRepository
+	User    *domain.User
+}
+
+func makeSut() SUT {
+	repo := repo.NewInMemoryUserRepository()
+	usecase := NewBootstrapAdminUseCase(repo)
+
+	now := time.Now()
+
+	user := &domain.User{
+		ID:        "123456",
+		Name:      "Daniel",
+		Email:     "daniel@gmail.com",
+		Role:      domain.RoleUser,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	return SUT{
+		UseCase: usecase,
+		Repo:    repo,
+		User:    user,
+	}
+}
+
+func TestBootstrapAdmin_GivenInvalidInput_ShouldReturnError(t *testing.T) {
+	testCases := []struct {
+		name        string
+		setup       func(sut SUT)
+		input       BootstrapAdminInput
+		expectedErr error
+	}{
+		{
+			name:        "Empty Email",
+			input:       BootstrapAdminInput{},
+			expectedErr: domain.ErrUserEmailIsRequired,
+		},
+		{
+			name:        "User Not Found",
+			input:       BootstrapAdminInput{Email: "maria@gmail.com"},
+			expectedErr: domain.ErrUserNotFound,
+		},
+		{
+			name: "Repo User Fail On Get By Email",
+			setup: func(sut SUT) {
+				sut.Repo.FailOnGetByEmail = true
+			},
+			input:       BootstrapAdminInput{Email: "daniel@gmail.com"},
+			expectedErr: repo.ErrSimulatedFailureRepoUser,
+		},
+		{
+			name: "Repo User Fail On Update",
+			setup: func(sut SUT) {
+				sut.Repo.FailOnUpdate = true
+			},
+			input:       BootstrapAdminInput{Email: "daniel@gmail.com"},
+			expectedErr: repo.ErrSimulatedFailureRepoUser,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			sut := makeSut()
+			require.NoError(t, sut.Repo.Save(context.Background(), sut.User))
+
+			if tc.setup != nil {
+				tc.setup(sut)
+			}
+
+			// Act
+			err := sut.UseCase.Perform(context.Background(), tc.input)
+
+			// Assert
+			assert.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestBootstrapAdmin_ShouldPromoteOnlyOnce(t *testing.T) {
+	// Arrange
+	sut := makeSut()
+	require.NoError(t, sut.Repo.Save(context.Background(), sut.User))
+
+	// Act
+	first := sut.UseCase.Perform(context.Background(), BootstrapAdminInput{Email: " Daniel@Gmail.com"})
+	second := sut.UseCase.Perform(context.Background(), BootstrapAdminInput{Email: "daniel@gmail.com"})
+
+	// Assert
+	require.NoError(t, first)
+	require.NoError(t, second)
+	assert.Equal(t, domain.RoleAdmin, sut.User.Role)
+	assert.Equal(t, 1, sut.User.TokenVersion)
+}
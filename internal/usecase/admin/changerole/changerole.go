@@ -0,0 +1,62 @@
+package admin
+
+import (
+	"context"
+	"strings"
+
+	"github.com/areteacademy/internal/domain"
+)
+
+type changeRoleUseCase struct {
+	userRepo domain.UserRepository
+}
+
+type ChangeRoleUseCase interface {
+	Perform(ctx context.Context, input ChangeRoleInput) error
+}
+
+func NewChangeRoleUseCase(userRepo domain.UserRepository) ChangeRoleUseCase {
+	return &changeRoleUseCase{
+		userRepo: userRepo,
+	}
+}
+
+// Perform grants the user a new role. The user has to log in again, since
+// their tokens carry the old one.
+func (uc *changeRoleUseCase) Perform(ctx context.Context, input ChangeRoleInput) error {
+	if err := input.Actor.Authorize(domain.PermissionRolesManage); err != nil {
+		return err
+	}
+
+	if input.UserId == "" {
+		return domain.ErrUserIdIsRequired
+	}
+
+	role, err := domain.NewRole(strings.ToUpper(input.Role))
+	if err != nil {
+		return err
+	}
+
+	if input.UserId == input.Actor.UserId {
+		return domain.ErrActorIsSubject
+	}
+
+	user, err := uc.userRepo.GetById(ctx, input.UserId)
+	if err != nil {
+		return err
+	}
+
+	if user == nil {
+		return domain.ErrUserNotFound
+	}
+
+	if user.Role == role {
+		return nil
+	}
+
+	if err := user.ChangeRole(role); err != nil {
+		return err
+	}
+
+	return uc.userRepo.Update(ctx, user)
+}
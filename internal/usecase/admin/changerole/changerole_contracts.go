@@ -0,0 +1,9 @@
+package admin
+
+import "github.com/areteacademy/internal/domain"
+
+type ChangeRoleInput struct {
+	Actor  domain.Actor
+	UserId string
+	Role   string
+}
@@ -0,0 +1,146 @@
+package admin
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+	repo "github.com/areteacademy/internal/infra/repository/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type SUT struct {
+	UseCase ChangeRoleUseCase
+	Repo    *repo.InMemoryUserRepository
+	Admin   domain.Actor
+	User    *domain.User
+}
+
+func makeSut() SUT {
+	repo := repo.NewInMemoryUserRepository()
+	usecase := NewChangeRoleUseCase(repo)
+
+	now := time.Now()
+
+	user := &domain.User{
+		ID:        "123456",
+		Name:      "Daniel",
+		Email:     "daniel@gmail.com",
+		Role:      domain.RoleUser,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	return SUT{
+		UseCase: usecase,
+		Repo:    repo,
+		Admin:   domain.Actor{UserId: "654321", Role: domain.RoleAdmin},
+		User:    user,
+	}
+}
+
+func TestChangeRole_GivenInvalidInput_ShouldReturnError(t *testing.T) {
+	testCases := []struct {
+		name        string
+		setup       func(sut SUT)
+		input       func(sut SUT) ChangeRoleInput
+		expectedErr error
+	}{
+		{
+			name: "Not An Admin",
+			input: func(sut SUT) ChangeRoleInput {
+				return ChangeRoleInput{Actor: domain.Actor{UserId: sut.User.ID, Role: domain.RoleUser}, UserId: sut.User.ID, Role: "ADMIN"}
+			},
+			expectedErr: domain.ErrPermissionDenied,
+		},
+		{
+			name: "Empty User Id",
+			input: func(sut SUT) ChangeRoleInput {
+				return ChangeRoleInput{Actor: sut.Admin, Role: "ADMIN"}
+			},
+			expectedErr: domain.ErrUserIdIsRequired,
+		},
+		{
+			name: "Invalid Role",
+			input: func(sut SUT) ChangeRoleInput {
+				return ChangeRoleInput{Actor: sut.Admin, UserId: sut.User.ID, Role: "ROOT"}
+			},
+			expectedErr: domain.ErrRoleInvalid,
+		},
+		{
+			name: "Own Account",
+			input: func(sut SUT) ChangeRoleInput {
+				return ChangeRoleInput{Actor: sut.Admin, UserId: sut.Admin.UserId, Role: "USER"}
+			},
+			expectedErr: domain.ErrActorIsSubject,
+		},
+		{
+			name: "User Not Found",
+			input: func(sut SUT) ChangeRoleInput {
+				return ChangeRoleInput{Actor: sut.Admin, UserId: "777777", Role: "ADMIN"}
+			},
+			expectedErr: domain.ErrUserNotFound,
+		},
+		{
+			name: "Repo User Fail On Update",
+			setup: func(sut SUT) {
+				sut.Repo.FailOnUpdate = true
+			},
+			input: func(sut SUT) ChangeRoleInput {
+				return ChangeRoleInput{Actor: sut.Admin, UserId: sut.User.ID, Role: "ADMIN"}
+			},
+			expectedErr: repo.ErrSimulatedFailureRepoUser,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			sut := makeSut()
+			require.NoError(t, sut.Repo.Save(context.Background(), sut.User))
+
+			if tc.setup != nil {
+				tc.setup(sut)
+			}
+
+			// Act
+			err := sut.UseCase.Perform(context.Background(), tc.input(sut))
+
+			// Assert
+			assert.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestChangeRole_ShouldPromoteAndRevokeTokens(t *testing.T) {
+	// Arrange
+	sut := makeSut()
+	require.NoError(t, sut.Repo.Save(context.Background(), sut.User))
+
+	// Act
+	err := sut.UseCase.Perform(context.Background(), ChangeRoleInput{Actor: sut.Admin, UserId: sut.User.ID, Role: "admin"})
+
+	// Assert
+	require.NoError(t, err)
+
+	stored, err := sut.Repo.GetById(context.Background(), sut.User.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.RoleAdmin, stored.Role)
+	assert.Equal(t, 1, stored.TokenVersion)
+}
+
+func TestChangeRole_ShouldKeepTokens_WhenRoleIsUnchanged(t *testing.T) {
+	// Arrange
+	sut := makeSut()
+	require.NoError(t, sut.Repo.Save(context.Background(), sut.User))
+	sut.Repo.FailOnUpdate = true
+
+	// Act
+	err := sut.UseCase.Perform(context.Background(), ChangeRoleInput{Actor: sut.Admin, UserId: sut.User.ID, Role: "USER"})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 0, sut.User.TokenVersion)
+}
@@ -0,0 +1,53 @@
+package admin
+
+import (
+	"context"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+)
+
+type deactivateUserUseCase struct {
+	userRepo domain.UserRepository
+}
+
+type DeactivateUserUseCase interface {
+	Perform(ctx context.Context, input DeactivateUserInput) error
+}
+
+func NewDeactivateUserUseCase(userRepo domain.UserRepository) DeactivateUserUseCase {
+	return &deactivateUserUseCase{
+		userRepo: userRepo,
+	}
+}
+
+// Perform keeps the account and its catalogue but stops the user from
+// logging in, and ends the sessions already open.
+func (uc *deactivateUserUseCase) Perform(ctx context.Context, input DeactivateUserInput) error {
+	if err := input.Actor.Authorize(domain.PermissionUsersDeactivate); err != nil {
+		return err
+	}
+
+	if input.UserId == "" {
+		return domain.ErrUserIdIsRequired
+	}
+
+	if input.UserId == input.Actor.UserId {
+		return domain.ErrActorIsSubject
+	}
+
+	user, err := uc.userRepo.GetById(ctx, input.UserId)
+	if err != nil {
+		return err
+	}
+
+	if user == nil {
+		return domain.ErrUserNotFound
+	}
+
+	if err := user.Deactivate(time.Now()); err != nil {
+		return err
+	}
+
+	return uc.userRepo.Update(ctx, user)
+}
@@ -0,0 +1,8 @@
+package admin
+
+import "github.com/areteacademy/internal/domain"
+
+type DeactivateUserInput struct {
+	Actor  domain.Actor
+	UserId string
+}
@@ -0,0 +1,146 @@
+package admin
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+	repo "github.com/areteacademy/internal/infra/repository/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type SUT struct {
+	UseCase DeactivateUserUseCase
+	Repo    *repo.InMemoryUserRepository
+	Admin   domain.Actor
+	User    *domain.User
+}
+
+func makeSut() SUT {
+	repo := repo.NewInMemoryUserRepository()
+	usecase := NewDeactivateUserUseCase(repo)
+
+	now := time.Now()
+
+	user := &domain.User{
+		ID:        "123456",
+		Name:      "Daniel",
+		Email:     "daniel@gmail.com",
+		Role:      domain.RoleUser,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	return SUT{
+		UseCase: usecase,
+		Repo:    repo,
+		Admin:   domain.Actor{UserId: "654321", Role: domain.RoleAdmin},
+		User:    user,
+	}
+}
+
+func TestDeactivateUser_GivenInvalidInput_ShouldReturnError(t *testing.T) {
+	testCases := []struct {
+		name        string
+		setup       func(sut SUT)
+		input       func(sut SUT) DeactivateUserInput
+		expectedErr error
+	}{
+		{
+			name: "Not An Admin",
+			input: func(sut SUT) DeactivateUserInput {
+				return DeactivateUserInput{Actor: domain.Actor{UserId: "777777", Role: domain.RoleUser}, UserId: sut.User.ID}
+			},
+			expectedErr: domain.ErrPermissionDenied,
+		},
+		{
+			name: "Empty User Id",
+			input: func(sut SUT) DeactivateUserInput {
+				return DeactivateUserInput{Actor: sut.Admin}
+			},
+			expectedErr: domain.ErrUserIdIsRequired,
+		},
+		{
+			name: "Own Account",
+			input: func(sut SUT) DeactivateUserInput {
+				return DeactivateUserInput{Actor: sut.Admin, UserId: sut.Admin.UserId}
+			},
+			expectedErr: domain.ErrActorIsSubject,
+		},
+		{
+			name: "User Not Found",
+			input: func(sut SUT) DeactivateUserInput {
+				return DeactivateUserInput{Actor: sut.Admin, UserId: "777777"}
+			},
+			expectedErr: domain.ErrUserNotFound,
+		},
+		{
+			name: "Already Deactivated",
+			setup: func(sut SUT) {
+				deactivatedAt := time.Now()
+				sut.User.DeactivatedAt = &deactivatedAt
+			},
+			input: func(sut SUT) DeactivateUserInput {
+				return DeactivateUserInput{Actor: sut.Admin, UserId: sut.User.ID}
+			},
+			expectedErr: domain.ErrUserAlreadyDeactivated,
+		},
+		{
+			name: "Repo User Fail On Get",
+			setup: func(sut SUT) {
+				sut.Repo.FailOnGet = true
+			},
+			input: func(sut SUT) DeactivateUserInput {
+				return DeactivateUserInput{Actor: sut.Admin, UserId: sut.User.ID}
+			},
+			expectedErr: repo.ErrSimulatedFailureRepoUser,
+		},
+		{
+			name: "Repo User Fail On Update",
+			setup: func(sut SUT) {
+				sut.Repo.FailOnUpdate = true
+			},
+			input: func(sut SUT) DeactivateUserInput {
+				return DeactivateUserInput{Actor: sut.Admin, UserId: sut.User.ID}
+			},
+			expectedErr: repo.ErrSimulatedFailureRepoUser,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			sut := makeSut()
+			require.NoError(t, sut.Repo.Save(context.Background(), sut.User))
+
+			if tc.setup != nil {
+				tc.setup(sut)
+			}
+
+			// Act
+			err := sut.UseCase.Perform(context.Background(), tc.input(sut))
+
+			// Assert
+			assert.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestDeactivateUser_ShouldDeactivateAndRevokeTokens(t *testing.T) {
+	// Arrange
+	sut := makeSut()
+	require.NoError(t, sut.Repo.Save(context.Background(), sut.User))
+
+	// Act
+	err := sut.UseCase.Perform(context.Background(), DeactivateUserInput{Actor: sut.Admin, UserId: sut.User.ID})
+
+	// Assert
+	require.NoError(t, err)
+
+	stored, err := sut.Repo.GetById(context.Background(), sut.User.ID)
+	require.NoError(t, err)
+	assert.False(t, stored.IsActive())
+	assert.Equal(t, 1, stored.TokenVersion)
+}
@@ -0,0 +1,39 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/areteacademy/internal/domain"
+	listCategory "github.com/areteacademy/internal/usecase/category/listbyuserid"
+)
+
+type listUserCategoriesUseCase struct {
+	listCategory listCategory.ListByUserIdCategoryUseCase
+}
+
+type ListUserCategoriesUseCase interface {
+	Perform(ctx context.Context, input ListUserCategoriesInput) (*listCategory.ListUserByIdCategoryOutput, error)
+}
+
+func NewListUserCategoriesUseCase(listCategory listCategory.ListByUserIdCategoryUseCase) ListUserCategoriesUseCase {
+	return &listUserCategoriesUseCase{
+		listCategory: listCategory,
+	}
+}
+
+// Perform lists the categories of any user, the way that user sees them.
+func (uc *listUserCategoriesUseCase) Perform(
+	ctx context.Context,
+	input ListUserCategoriesInput,
+) (*listCategory.ListUserByIdCategoryOutput, error) {
+	if err := input.Actor.Authorize(domain.PermissionCatalogReadAny); err != nil {
+		return nil, err
+	}
+
+	return uc.listCategory.Perform(ctx, listCategory.ListByUserIdCategoryInput{
+		UserId: input.UserId,
+		Limit:  input.Limit,
+		After:  input.After,
+		Before: input.Before,
+	})
+}
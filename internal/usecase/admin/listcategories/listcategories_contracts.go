@@ -0,0 +1,11 @@
+package admin
+
+import "github.com/areteacademy/internal/domain"
+
+type ListUserCategoriesInput struct {
+	Actor  domain.Actor
+	UserId string
+	Limit  int
+	After  string
+	Before string
+}
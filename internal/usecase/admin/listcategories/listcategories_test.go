@@ -0,0 +1,134 @@
+package admin
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+	categoryRepo "github.com/areteacademy/internal/infra/repository/category"
+	userRepo "github.com/areteacademy/internal/infra/repository/user"
+	listCategory "github.com/areteacademy/internal/usecase/category/listbyuserid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type SUT struct {
+	UseCase      ListUserCategoriesUseCase
+	CategoryRepo *categoryRepo.InMemoryCategoryRepository
+	Admin        domain.Actor
+	Owner        *domain.User
+}
+
+func makeSut(t *testing.T) SUT {
+	categoryRepo := categoryRepo.NewInMemoryCategoryRepository()
+	userRepo := userRepo.NewInMemoryUserRepository()
+	usecase := NewListUserCategoriesUseCase(listCategory.NewListByUserIdCategoryUseCase(categoryRepo, userRepo))
+
+	now := time.Now()
+
+	owner := &domain.User{
+		ID:        "123456",
+		Name:      "Daniel",
+		Email:     "daniel@gmail.com",
+		Role:      domain.RoleUser,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	require.NoError(t, userRepo.Save(context.Background(), owner))
+
+	require.NoError(t, categoryRepo.Save(context.Background(), &domain.Category{
+		ID:        "category-01",
+		UserId:    owner.ID,
+		Name:      "Categoria Daniel",
+		Status:    "ACTIVE",
+		CreatedAt: now,
+		UpdatedAt: now,
+	}))
+
+	return SUT{
+		UseCase:      usecase,
+		CategoryRepo: categoryRepo,
+		Admin:        domain.Actor{UserId: "654321", Role: domain.RoleAdmin},
+		Owner:        owner,
+	}
+}
+
+func TestListUserCategories_GivenInvalidInput_ShouldReturnError(t *testing.T) {
+	testCases := []struct {
+		name        string
+		setup       func(sut SUT)
+		input       func(sut SUT) ListUserCategoriesInput
+		expectedErr error
+	}{
+		{
+			name: "Not An Admin",
+			input: func(sut SUT) ListUserCategoriesInput {
+				return ListUserCategoriesInput{
+					Actor:  domain.Actor{UserId: "777777", Role: domain.RoleUser},
+					UserId: sut.Owner.ID,
+				}
+			},
+			expectedErr: domain.ErrPermissionDenied,
+		},
+		{
+			name: "Empty User Id",
+			input: func(sut SUT) ListUserCategoriesInput {
+				return ListUserCategoriesInput{Actor: sut.Admin}
+			},
+			expectedErr: domain.ErrCategoryUserIdIsRequired,
+		},
+		{
+			name: "User Not Found",
+			input: func(sut SUT) ListUserCategoriesInput {
+				return ListUserCategoriesInput{Actor: sut.Admin, UserId: "777777"}
+			},
+			expectedErr: domain.ErrCategoryUserNotFound,
+		},
+		{
+			name: "Repo Category Fail On List",
+			setup: func(sut SUT) {
+				sut.CategoryRepo.FailOnList = true
+			},
+			input: func(sut SUT) ListUserCategoriesInput {
+				return ListUserCategoriesInput{Actor: sut.Admin, UserId: sut.Owner.ID}
+			},
+			expectedErr: categoryRepo.ErrSimulatedFailureRepoCategory,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			sut := makeSut(t)
+
+			if tc.setup != nil {
+				tc.setup(sut)
+			}
+
+			// Act
+			output, err := sut.UseCase.Perform(context.Background(), tc.input(sut))
+
+			// Assert
+			require.Nil(t, output)
+			assert.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestListUserCategories_ShouldListAnotherUsersCategories(t *testing.T) {
+	// Arrange
+	sut := makeSut(t)
+
+	// Act
+	output, err := sut.UseCase.Perform(context.Background(), ListUserCategoriesInput{
+		Actor:  sut.Admin,
+		UserId: sut.Owner.ID,
+	})
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, output.Items, 1)
+	assert.Equal(t, "category-01", output.Items[0].ID)
+	assert.Equal(t, sut.Owner.ID, output.Items[0].UserId)
+}
@@ -0,0 +1,60 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/areteacademy/internal/domain"
+)
+
+type listLockoutEventsUseCase struct {
+	throttleRepo domain.LoginThrottleRepository
+}
+
+type ListLockoutEventsUseCase interface {
+	Perform(ctx context.Context, input ListLockoutEventsInput) (*ListLockoutEventsOutput, error)
+}
+
+func NewListLockoutEventsUseCase(throttleRepo domain.LoginThrottleRepository) ListLockoutEventsUseCase {
+	return &listLockoutEventsUseCase{
+		throttleRepo: throttleRepo,
+	}
+}
+
+// Perform returns the most recent lockouts and unlocks, newest first.
+func (uc *listLockoutEventsUseCase) Perform(ctx context.Context, input ListLockoutEventsInput) (*ListLockoutEventsOutput, error) {
+	if err := input.Actor.Authorize(domain.PermissionLockoutsRead); err != nil {
+		return nil, err
+	}
+
+	limit := input.Limit
+	if limit == 0 {
+		limit = domain.DefaultPageSize
+	}
+
+	if limit < 1 || limit > domain.MaxPageSize {
+		return nil, domain.ErrPageSizeInvalid
+	}
+
+	events, err := uc.throttleRepo.ListEvents(ctx, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &ListLockoutEventsOutput{
+		Items: make([]LockoutEventItem, 0, len(events)),
+	}
+
+	for _, e := range events {
+		output.Items = append(output.Items, LockoutEventItem{
+			ID:          e.ID,
+			Kind:        string(e.Kind),
+			Scope:       string(e.Scope),
+			Key:         e.Key,
+			Failures:    e.Failures,
+			LockedUntil: e.LockedUntil,
+			CreatedAt:   e.CreatedAt,
+		})
+	}
+
+	return output, nil
+}
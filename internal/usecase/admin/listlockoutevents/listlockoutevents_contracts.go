@@ -0,0 +1,26 @@
+package admin
+
+import (
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+)
+
+type LockoutEventItem struct {
+	ID          string
+	Kind        string
+	Scope       string
+	Key         string
+	Failures    int
+	LockedUntil *time.Time
+	CreatedAt   time.Time
+}
+
+type ListLockoutEventsInput struct {
+	Actor domain.Actor
+	Limit int
+}
+
+type ListLockoutEventsOutput struct {
+	Items []LockoutEventItem
+}
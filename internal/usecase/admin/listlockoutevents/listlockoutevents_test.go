@@ -0,0 +1,106 @@
+package admin
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+	throttleRepo "github.com/areteacademy/internal/infra/repository/loginthrottle"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type SUT struct {
+	UseCase      ListLockoutEventsUseCase
+	ThrottleRepo *throttleRepo.InMemoryLoginThrottleRepository
+	Admin        domain.Actor
+}
+
+func makeSut(t *testing.T) SUT {
+	throttleRepo := throttleRepo.NewInMemoryLoginThrottleRepository()
+	usecase := NewListLockoutEventsUseCase(throttleRepo)
+
+	now := time.Now()
+	policy := domain.DefaultLoginThrottlePolicy()
+
+	throttle := domain.NewLoginThrottle(domain.LoginThrottleScopeAccount, "daniel@gmail.com")
+	for i := 0; i < policy.AccountLockoutThreshold; i++ {
+		throttle.RecordFailure(now, policy)
+	}
+
+	require.NoError(t, throttleRepo.SaveEvent(context.Background(), domain.NewLockoutEvent(domain.LockoutEventLocked, throttle, now)))
+	require.NoError(t, throttleRepo.SaveEvent(context.Background(), domain.NewLockoutEvent(domain.LockoutEventUnlocked, throttle, now.Add(time.Minute))))
+
+	return SUT{
+		UseCase:      usecase,
+		ThrottleRepo: throttleRepo,
+		Admin:        domain.Actor{UserId: "654321", Role: domain.RoleAdmin},
+	}
+}
+
+func TestListLockoutEvents_GivenInvalidInput_ShouldReturnError(t *testing.T) {
+	testCases := []struct {
+		name        string
+		setup       func(sut SUT)
+		input       func(sut SUT) ListLockoutEventsInput
+		expectedErr error
+	}{
+		{
+			name: "Not An Admin",
+			input: func(sut SUT) ListLockoutEventsInput {
+				return ListLockoutEventsInput{Actor: domain.Actor{UserId: "123456", Role: domain.RoleUser}}
+			},
+			expectedErr: domain.ErrPermissionDenied,
+		},
+		{
+			name: "Invalid Limit",
+			input: func(sut SUT) ListLockoutEventsInput {
+				return ListLockoutEventsInput{Actor: sut.Admin, Limit: -1}
+			},
+			expectedErr: domain.ErrPageSizeInvalid,
+		},
+		{
+			name: "Repo Throttle Fail On List Events",
+			setup: func(sut SUT) {
+				sut.ThrottleRepo.FailOnListEvents = true
+			},
+			input: func(sut SUT) ListLockoutEventsInput {
+				return ListLockoutEventsInput{Actor: sut.Admin}
+			},
+			expectedErr: throttleRepo.ErrSimulatedFailureRepoLoginThrottle,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			sut := makeSut(t)
+
+			if tc.setup != nil {
+				tc.setup(sut)
+			}
+
+			// Act
+			output, err := sut.UseCase.Perform(context.Background(), tc.input(sut))
+
+			// Assert
+			require.Nil(t, output)
+			assert.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestListLockoutEvents_ShouldReturnNewestFirst(t *testing.T) {
+	// Arrange
+	sut := makeSut(t)
+
+	// Act
+	output, err := sut.UseCase.Perform(context.Background(), ListLockoutEventsInput{Actor: sut.Admin, Limit: 1})
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, output.Items, 1)
+	assert.Equal(t, "unlocked", output.Items[0].Kind)
+	assert.Equal(t, "daniel@gmail.com", output.Items[0].Key)
+}
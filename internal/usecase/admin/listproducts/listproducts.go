@@ -0,0 +1,40 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/areteacademy/internal/domain"
+	listProduct "github.com/areteacademy/internal/usecase/product/listbyuserid"
+)
+
+type listUserProductsUseCase struct {
+	listProduct listProduct.ListByUserIdProductUseCase
+}
+
+type ListUserProductsUseCase interface {
+	Perform(ctx context.Context, input ListUserProductsInput) (*listProduct.ListByUserIdProductOutput, error)
+}
+
+func NewListUserProductsUseCase(listProduct listProduct.ListByUserIdProductUseCase) ListUserProductsUseCase {
+	return &listUserProductsUseCase{
+		listProduct: listProduct,
+	}
+}
+
+// Perform lists the products of any user, the way that user sees them.
+func (uc *listUserProductsUseCase) Perform(
+	ctx context.Context,
+	input ListUserProductsInput,
+) (*listProduct.ListByUserIdProductOutput, error) {
+	if err := input.Actor.Authorize(domain.PermissionCatalogReadAny); err != nil {
+		return nil, err
+	}
+
+	return uc.listProduct.Perform(ctx, listProduct.ListByUserIdProductInput{
+		UserId: input.UserId,
+		Status: input.Status,
+		Limit:  input.Limit,
+		After:  input.After,
+		Before: input.Before,
+	})
+}
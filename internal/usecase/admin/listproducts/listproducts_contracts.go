@@ -0,0 +1,12 @@
+package admin
+
+import "github.com/areteacademy/internal/domain"
+
+type ListUserProductsInput struct {
+	Actor  domain.Actor
+	UserId string
+	Status string
+	Limit  int
+	After  string
+	Before string
+}
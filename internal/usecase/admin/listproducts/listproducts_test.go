@@ -0,0 +1,150 @@
+package admin
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+	productRepo "github.com/areteacademy/internal/infra/repository/product"
+	userRepo "github.com/areteacademy/internal/infra/repository/user"
+	listProduct "github.com/areteacademy/internal/usecase/product/listbyuserid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type SUT struct {
+	UseCase     ListUserProductsUseCase
+	ProductRepo *productRepo.InMemoryProductRepository
+	Admin       domain.Actor
+	Owner       *domain.User
+}
+
+func makeSut(t *testing.T) SUT {
+	productRepo := productRepo.NewInMemoryProductRepository()
+	userRepo := userRepo.NewInMemoryUserRepository()
+	usecase := NewListUserProductsUseCase(listProduct.NewListByUserIdProductUseCase(productRepo, userRepo))
+
+	now := time.Now()
+
+	owner := &domain.User{
+		ID:        "123456",
+		Name:      "Daniel",
+		Email:     "daniel@gmail.com",
+		Role:      domain.RoleUser,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	require.NoError(t, userRepo.Save(context.Background(), owner))
+
+	for _, p := range []*domain.Product{
+		{ID: "product-01", Status: "ACTIVE"},
+		{ID: "product-02", Status: "INACTIVE"},
+	} {
+		p.UserId = owner.ID
+		p.CategoryId = "category-01"
+		p.Name = "Produto " + p.ID
+		p.Description = "Descricao"
+		p.Price = 100
+		p.CreatedAt = now
+		p.UpdatedAt = now
+		require.NoError(t, productRepo.Save(context.Background(), p))
+	}
+
+	return SUT{
+		UseCase:     usecase,
+		ProductRepo: productRepo,
+		Admin:       domain.Actor{UserId: "654321", Role: domain.RoleAdmin},
+		Owner:       owner,
+	}
+}
+
+func TestListUserProducts_GivenInvalidInput_ShouldReturnError(t *testing.T) {
+	testCases := []struct {
+		name        string
+		setup       func(sut SUT)
+		input       func(sut SUT) ListUserProductsInput
+		expectedErr error
+	}{
+		{
+			name: "Not An Admin",
+			input: func(sut SUT) ListUserProductsInput {
+				return ListUserProductsInput{Actor: domain.Actor{UserId: sut.Owner.ID, Role: domain.RoleUser}, UserId: sut.Owner.ID}
+			},
+			expectedErr: domain.ErrPermissionDenied,
+		},
+		{
+			name: "Empty User Id",
+			input: func(sut SUT) ListUserProductsInput {
+				return ListUserProductsInput{Actor: sut.Admin}
+			},
+			expectedErr: domain.ErrProductUserIdIsRequired,
+		},
+		{
+			name: "User Not Found",
+			input: func(sut SUT) ListUserProductsInput {
+				return ListUserProductsInput{Actor: sut.Admin, UserId: "777777"}
+			},
+			expectedErr: domain.ErrProductUserNotFound,
+		},
+		{
+			name: "Invalid Status",
+			input: func(sut SUT) ListUserProductsInput {
+				return ListUserProductsInput{Actor: sut.Admin, UserId: sut.Owner.ID, Status: "SOLD"}
+			},
+			expectedErr: domain.ErrProductStatusInvalid,
+		},
+		{
+			name: "Repo Product Fail On List",
+			setup: func(sut SUT) {
+				sut.ProductRepo.FailOnList = true
+			},
+			input: func(sut SUT) ListUserProductsInput {
+				return ListUserProductsInput{Actor: sut.Admin, UserId: sut.Owner.ID}
+			},
+			expectedErr: productRepo.ErrSimulatedFailureRepoProduct,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			sut := makeSut(t)
+
+			if tc.setup != nil {
+				tc.setup(sut)
+			}
+
+			// Act
+			output, err := sut.UseCase.Perform(context.Background(), tc.input(sut))
+
+			// Assert
+			require.Nil(t, output)
+			assert.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestListUserProducts_ShouldListAnotherUsersProducts(t *testing.T) {
+	// Arrange
+	sut := makeSut(t)
+
+	// Act
+	all, allErr := sut.UseCase.Perform(context.Background(), ListUserProductsInput{
+		Actor:  sut.Admin,
+		UserId: sut.Owner.ID,
+	})
+	inactive, inactiveErr := sut.UseCase.Perform(context.Background(), ListUserProductsInput{
+		Actor:  sut.Admin,
+		UserId: sut.Owner.ID,
+		Status: "INACTIVE",
+	})
+
+	// Assert
+	require.NoError(t, allErr)
+	assert.Len(t, all.Items, 2)
+
+	require.NoError(t, inactiveErr)
+	require.Len(t, inactive.Items, 1)
+	assert.Equal(t, "product-02", inactive.Items[0].ID)
+}
@@ -0,0 +1,79 @@
+package admin
+
+import (
+	"context"
+	"strings"
+
+	"github.com/areteacademy/internal/domain"
+)
+
+type listUsersUseCase struct {
+	userRepo domain.UserRepository
+}
+
+type ListUsersUseCase interface {
+	Perform(ctx context.Context, input ListUsersInput) (*ListUsersOutput, error)
+}
+
+func NewListUsersUseCase(userRepo domain.UserRepository) ListUsersUseCase {
+	return &listUsersUseCase{
+		userRepo: userRepo,
+	}
+}
+
+// Perform lists every user, deactivated ones included, optionally narrowed
+// to a role and to a name or email containing Search.
+func (uc *listUsersUseCase) Perform(ctx context.Context, input ListUsersInput) (*ListUsersOutput, error) {
+	if err := input.Actor.Authorize(domain.PermissionUsersRead); err != nil {
+		return nil, err
+	}
+
+	filter := domain.UserFilter{Text: strings.TrimSpace(input.Search)}
+
+	if input.Role != "" {
+		role, err := domain.NewRole(strings.ToUpper(input.Role))
+		if err != nil {
+			return nil, err
+		}
+
+		filter.Role = role
+	}
+
+	sort, err := domain.NewSort(input.SortBy, input.SortOrder, domain.UserSortFields...)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := domain.NewPageRequest(input.Limit, input.After, input.Before, sort)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := uc.userRepo.List(ctx, filter, request)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &ListUsersOutput{
+		Items:          make([]UserItem, 0, len(page.Items)),
+		NextCursor:     page.NextCursor(),
+		PreviousCursor: page.PreviousCursor(),
+	}
+
+	for _, u := range page.Items {
+		output.Items = append(output.Items, UserItem{
+			ID:            u.ID,
+			Name:          u.Name,
+			Email:         u.Email,
+			PendingEmail:  u.PendingEmail,
+			EmailVerified: u.IsEmailVerified(),
+			Role:          string(u.Role),
+			Active:        u.IsActive(),
+			DeactivatedAt: u.DeactivatedAt,
+			CreatedAt:     u.CreatedAt,
+			UpdatedAt:     u.UpdatedAt,
+		})
+	}
+
+	return output, nil
+}
@@ -0,0 +1,37 @@
+package admin
+
+import (
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+)
+
+type UserItem struct {
+	ID            string
+	Name          string
+	Email         string
+	PendingEmail  string
+	EmailVerified bool
+	Role          string
+	Active        bool
+	DeactivatedAt *time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+type ListUsersInput struct {
+	Actor     domain.Actor
+	Search    string
+	Role      string
+	SortBy    string
+	SortOrder string
+	Limit     int
+	After     string
+	Before    string
+}
+
+type ListUsersOutput struct {
+	Items          []UserItem
+	NextCursor     string
+	PreviousCursor string
+}
@@ -0,0 +1,175 @@
+package admin
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+	repo "github.com/areteacademy/internal/infra/repository/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type SUT struct {
+	UseCase ListUsersUseCase
+	Repo    *repo.InMemoryUserRepository
+	Admin   domain.Actor
+}
+
+func makeSut(t *testing.T) SUT {
+	repo := repo.NewInMemoryUserRepository()
+	usecase := NewListUsersUseCase(repo)
+
+	now := time.Now()
+	deactivatedAt := now
+
+	users := []*domain.User{
+		{ID: "1", Name: "Admin", Email: "admin@gmail.com", Role: domain.RoleAdmin},
+		{ID: "2", Name: "Daniel", Email: "daniel@gmail.com", Role: domain.RoleUser},
+		{ID: "3", Name: "Maria", Email: "maria@gmail.com", Role: domain.RoleUser, DeactivatedAt: &deactivatedAt},
+	}
+	for i, u := range users {
+		u.CreatedAt = now.Add(time.Duration(i) * time.Minute)
+		u.UpdatedAt = u.CreatedAt
+		require.NoError(t, repo.Save(context.Background(), u))
+	}
+
+	return SUT{
+		UseCase: usecase,
+		Repo:    repo,
+		Admin:   domain.Actor{UserId: "1", Role: domain.RoleAdmin},
+	}
+}
+
+func ids(output *ListUsersOutput) []string {
+	result := []string{}
+	for _, item := range output.Items {
+		result = append(result, item.ID)
+	}
+
+	return result
+}
+
+func TestListUsers_GivenInvalidInput_ShouldReturnError(t *testing.T) {
+	testCases := []struct {
+		name        string
+		setup       func(sut SUT)
+		input       func(sut SUT) ListUsersInput
+		expectedErr error
+	}{
+		{
+			name: "Not An Admin",
+			input: func(sut SUT) ListUsersInput {
+				return ListUsersInput{Actor: domain.Actor{UserId: "2", Role: domain.RoleUser}}
+			},
+			expectedErr: domain.ErrPermissionDenied,
+		},
+		{
+			name: "No Actor",
+			input: func(sut SUT) ListUsersInput {
+				return ListUsersInput{}
+			},
+			expectedErr: domain.ErrPermissionDenied,
+		},
+		{
+			name: "Invalid Role",
+			input: func(sut SUT) ListUsersInput {
+				return ListUsersInput{Actor: sut.Admin, Role: "ROOT"}
+			},
+			expectedErr: domain.ErrRoleInvalid,
+		},
+		{
+			name: "Invalid Sort Field",
+			input: func(sut SUT) ListUsersInput {
+				return ListUsersInput{Actor: sut.Admin, SortBy: "price"}
+			},
+			expectedErr: domain.ErrSortFieldInvalid,
+		},
+		{
+			name: "Invalid Page Size",
+			input: func(sut SUT) ListUsersInput {
+				return ListUsersInput{Actor: sut.Admin, Limit: domain.MaxPageSize + 1}
+			},
+			expectedErr: domain.ErrPageSizeInvalid,
+		},
+		{
+			name: "Repo User Fail On List",
+			setup: func(sut SUT) {
+				sut.Repo.FailOnList = true
+			},
+			input: func(sut SUT) ListUsersInput {
+				return ListUsersInput{Actor: sut.Admin}
+			},
+			expectedErr: repo.ErrSimulatedFailureRepoUser,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			sut := makeSut(t)
+
+			if tc.setup != nil {
+				tc.setup(sut)
+			}
+
+			// Act
+			output, err := sut.UseCase.Perform(context.Background(), tc.input(sut))
+
+			// Assert
+			require.Nil(t, output)
+			assert.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestListUsers_ShouldListEveryUser(t *testing.T) {
+	// Arrange
+	sut := makeSut(t)
+
+	// Act
+	output, err := sut.UseCase.Perform(context.Background(), ListUsersInput{Actor: sut.Admin})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1", "2", "3"}, ids(output))
+	assert.Equal(t, "ADMIN", output.Items[0].Role)
+	assert.True(t, output.Items[1].Active)
+	assert.False(t, output.Items[2].Active)
+	assert.Empty(t, output.NextCursor)
+}
+
+func TestListUsers_ShouldFilterBySearchAndRole(t *testing.T) {
+	// Arrange
+	sut := makeSut(t)
+
+	// Act
+	bySearch, searchErr := sut.UseCase.Perform(context.Background(), ListUsersInput{Actor: sut.Admin, Search: " MARIA@ "})
+	byRole, roleErr := sut.UseCase.Perform(context.Background(), ListUsersInput{Actor: sut.Admin, Role: "user"})
+
+	// Assert
+	require.NoError(t, searchErr)
+	assert.Equal(t, []string{"3"}, ids(bySearch))
+
+	require.NoError(t, roleErr)
+	assert.Equal(t, []string{"2", "3"}, ids(byRole))
+}
+
+func TestListUsers_ShouldPageByName(t *testing.T) {
+	// Arrange
+	sut := makeSut(t)
+	input := ListUsersInput{Actor: sut.Admin, SortBy: "name", SortOrder: "DESC", Limit: 2}
+
+	// Act
+	first, err := sut.UseCase.Perform(context.Background(), input)
+	require.NoError(t, err)
+
+	input.After = first.NextCursor
+	second, err := sut.UseCase.Perform(context.Background(), input)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []string{"3", "2"}, ids(first))
+	assert.Equal(t, []string{"1"}, ids(second))
+}
@@ -0,0 +1,59 @@
+package apikey
+
+import (
+	"context"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+)
+
+type createApiKeyUseCase struct {
+	apiKeyRepo domain.ApiKeyRepository
+	userRepo   domain.UserRepository
+}
+
+type CreateApiKeyUseCase interface {
+	Perform(ctx context.Context, input CreateApiKeyInput) (*CreateApiKeyOutput, error)
+}
+
+func NewCreateApiKeyUseCase(apiKeyRepo domain.ApiKeyRepository, userRepo domain.UserRepository) CreateApiKeyUseCase {
+	return &createApiKeyUseCase{
+		apiKeyRepo: apiKeyRepo,
+		userRepo:   userRepo,
+	}
+}
+
+func (uc *createApiKeyUseCase) Perform(ctx context.Context, input CreateApiKeyInput) (*CreateApiKeyOutput, error) {
+	key, secret, err := domain.NewApiKey(input.UserId, input.Name, input.Scopes, input.ExpiresAt, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := uc.userRepo.GetById(ctx, input.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if user == nil {
+		return nil, domain.ErrUserNotFound
+	}
+
+	if err := uc.apiKeyRepo.Save(ctx, key); err != nil {
+		return nil, err
+	}
+
+	scopes := make([]string, len(key.Scopes))
+	for i, scope := range key.Scopes {
+		scopes[i] = string(scope)
+	}
+
+	return &CreateApiKeyOutput{
+		ID:        key.ID,
+		Name:      key.Name,
+		Hint:      key.Hint,
+		Scopes:    scopes,
+		Key:       secret,
+		ExpiresAt: key.ExpiresAt,
+		CreatedAt: key.CreatedAt,
+	}, nil
+}
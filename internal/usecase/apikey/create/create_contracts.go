@@ -0,0 +1,22 @@
+package apikey
+
+import "time"
+
+type CreateApiKeyInput struct {
+	UserId    string
+	Name      string
+	Scopes    []string
+	ExpiresAt *time.Time
+}
+
+type CreateApiKeyOutput struct {
+	ID     string
+	Name   string
+	Hint   string
+	Scopes []string
+	// Key is the secret the client authenticates with. It cannot be
+	// retrieved again.
+	Key       string
+	ExpiresAt *time.Time
+	CreatedAt time.Time
+}
@@ -0,0 +1,178 @@
+package apikey
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+	apiKeyRepo "github.com/areteacademy/internal/infra/repository/apikey"
+	userRepo "github.com/areteacademy/internal/infra/repository/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type SUT struct {
+	UseCase    CreateApiKeyUseCase
+	ApiKeyRepo *apiKeyRepo.InMemoryApiKeyRepository
+	UserRepo   *userRepo.InMemoryUserRepository
+	User       *domain.User
+}
+
+func makeSut() SUT {
+	apiKeys := apiKeyRepo.NewInMemoryApiKeyRepository()
+	users := userRepo.NewInMemoryUserRepository()
+	usecase := NewCreateApiKeyUseCase(apiKeys, users)
+
+	now := time.Now()
+
+	user := &domain.User{
+		ID:        "123456",
+		Name:      "Daniel",
+		Email:     "daniel@gmail.com",
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	return SUT{
+		UseCase:    usecase,
+		ApiKeyRepo: apiKeys,
+		UserRepo:   users,
+		User:       user,
+	}
+}
+
+func TestCreateApiKey_GivenInvalidInput_ShouldReturnError(t *testing.T) {
+	past := time.Now().Add(-time.Hour)
+
+	testCases := []struct {
+		name        string
+		setup       func(sut SUT)
+		input       CreateApiKeyInput
+		expectedErr error
+	}{
+		{
+			name:        "Empty User Id",
+			input:       CreateApiKeyInput{Name: "CI", Scopes: []string{"products:read"}},
+			expectedErr: domain.ErrApiKeyUserIdIsRequired,
+		},
+		{
+			name:        "Empty Name",
+			input:       CreateApiKeyInput{UserId: "123456", Name: "  ", Scopes: []string{"products:read"}},
+			expectedErr: domain.ErrApiKeyNameIsRequired,
+		},
+		{
+			name:        "Empty Scopes",
+			input:       CreateApiKeyInput{UserId: "123456", Name: "CI"},
+			expectedErr: domain.ErrApiKeyScopesAreRequired,
+		},
+		{
+			name:        "Invalid Scope",
+			input:       CreateApiKeyInput{UserId: "123456", Name: "CI", Scopes: []string{"users:read"}},
+			expectedErr: domain.ErrApiKeyScopeInvalid,
+		},
+		{
+			name:        "Repeated Scope",
+			input:       CreateApiKeyInput{UserId: "123456", Name: "CI", Scopes: []string{"products:read", "PRODUCTS:READ"}},
+			expectedErr: domain.ErrApiKeyScopeRepeated,
+		},
+		{
+			name:        "Expiry In The Past",
+			input:       CreateApiKeyInput{UserId: "123456", Name: "CI", Scopes: []string{"products:read"}, ExpiresAt: &past},
+			expectedErr: domain.ErrApiKeyExpiryInvalid,
+		},
+		{
+			name:        "User Not Found",
+			input:       CreateApiKeyInput{UserId: "654321", Name: "CI", Scopes: []string{"products:read"}},
+			expectedErr: domain.ErrUserNotFound,
+		},
+		{
+			name: "Repo User Fail On Get",
+			setup: func(sut SUT) {
+				sut.UserRepo.FailOnGet = true
+			},
+			input:       CreateApiKeyInput{UserId: "123456", Name: "CI", Scopes: []string{"products:read"}},
+			expectedErr: userRepo.ErrSimulatedFailureRepoUser,
+		},
+		{
+			name: "Repo Api Key Fail On Save",
+			setup: func(sut SUT) {
+				sut.ApiKeyRepo.FailOnSave = true
+			},
+			input:       CreateApiKeyInput{UserId: "123456", Name: "CI", Scopes: []string{"products:read"}},
+			expectedErr: apiKeyRepo.ErrSimulatedFailureRepoApiKey,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			sut := makeSut()
+			require.NoError(t, sut.UserRepo.Save(context.Background(), sut.User))
+
+			if tc.setup != nil {
+				tc.setup(sut)
+			}
+
+			// Act
+			output, err := sut.UseCase.Perform(context.Background(), tc.input)
+
+			// Assert
+			require.Nil(t, output)
+			assert.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestCreateApiKey_ShouldReportEveryInvalidField(t *testing.T) {
+	// Arrange
+	sut := makeSut()
+	require.NoError(t, sut.UserRepo.Save(context.Background(), sut.User))
+	past := time.Now().Add(-time.Hour)
+
+	// Act
+	output, err := sut.UseCase.Perform(context.Background(), CreateApiKeyInput{
+		UserId:    "123456",
+		Name:      "  ",
+		Scopes:    []string{"users:read"},
+		ExpiresAt: &past,
+	})
+
+	// Assert
+	require.Nil(t, output)
+	assert.ErrorIs(t, err, domain.ErrValidationFailed)
+	assert.ErrorIs(t, err, domain.ErrApiKeyNameIsRequired)
+	assert.ErrorIs(t, err, domain.ErrApiKeyScopeInvalid)
+	assert.ErrorIs(t, err, domain.ErrApiKeyExpiryInvalid)
+	assert.Len(t, domain.FieldErrors(err), 3)
+}
+
+func TestCreateApiKey_ShouldStoreHashAndReturnKeyOnce(t *testing.T) {
+	// Arrange
+	sut := makeSut()
+	require.NoError(t, sut.UserRepo.Save(context.Background(), sut.User))
+
+	expiresAt := time.Now().Add(24 * time.Hour)
+
+	// Act
+	output, err := sut.UseCase.Perform(context.Background(), CreateApiKeyInput{
+		UserId:    sut.User.ID,
+		Name:      " CI ",
+		Scopes:    []string{"products:read", "categories:read"},
+		ExpiresAt: &expiresAt,
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "CI", output.Name)
+	assert.Equal(t, []string{"products:read", "categories:read"}, output.Scopes)
+	assert.True(t, domain.IsApiKey(output.Key))
+	assert.Equal(t, output.Key[:len(output.Hint)], output.Hint)
+
+	stored, err := sut.ApiKeyRepo.GetByKeyHash(context.Background(), domain.HashSecretToken(output.Key))
+	require.NoError(t, err)
+	require.NotNil(t, stored)
+	assert.Equal(t, output.ID, stored.ID)
+	assert.Equal(t, sut.User.ID, stored.UserId)
+	assert.NotContains(t, stored.KeyHash, output.Key)
+}
@@ -0,0 +1,57 @@
+package apikey
+
+import (
+	"context"
+
+	"github.com/areteacademy/internal/domain"
+)
+
+type listApiKeysUseCase struct {
+	apiKeyRepo domain.ApiKeyRepository
+}
+
+type ListApiKeysUseCase interface {
+	Perform(ctx context.Context, input ListApiKeysInput) (*ListApiKeysOutput, error)
+}
+
+func NewListApiKeysUseCase(apiKeyRepo domain.ApiKeyRepository) ListApiKeysUseCase {
+	return &listApiKeysUseCase{
+		apiKeyRepo: apiKeyRepo,
+	}
+}
+
+// Perform lists revoked keys too, so the user can see when a key stopped
+// working.
+func (uc *listApiKeysUseCase) Perform(ctx context.Context, input ListApiKeysInput) (*ListApiKeysOutput, error) {
+	if input.UserId == "" {
+		return nil, domain.ErrApiKeyUserIdIsRequired
+	}
+
+	keys, err := uc.apiKeyRepo.ListByUserId(ctx, input.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]ApiKeyItem, len(keys))
+	for i, key := range keys {
+		scopes := make([]string, len(key.Scopes))
+		for j, scope := range key.Scopes {
+			scopes[j] = string(scope)
+		}
+
+		items[i] = ApiKeyItem{
+			ID:         key.ID,
+			Name:       key.Name,
+			Hint:       key.Hint,
+			Scopes:     scopes,
+			Revoked:    key.IsRevoked(),
+			ExpiresAt:  key.ExpiresAt,
+			LastUsedAt: key.LastUsedAt,
+			RevokedAt:  key.RevokedAt,
+			CreatedAt:  key.CreatedAt,
+			UpdatedAt:  key.UpdatedAt,
+		}
+	}
+
+	return &ListApiKeysOutput{Items: items}, nil
+}
@@ -0,0 +1,24 @@
+package apikey
+
+import "time"
+
+type ApiKeyItem struct {
+	ID         string
+	Name       string
+	Hint       string
+	Scopes     []string
+	Revoked    bool
+	ExpiresAt  *time.Time
+	LastUsedAt *time.Time
+	RevokedAt  *time.Time
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+type ListApiKeysInput struct {
+	UserId string
+}
+
+type ListApiKeysOutput struct {
+	Items []ApiKeyItem
+}
@@ -0,0 +1,99 @@
+package apikey
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+	repo "github.com/areteacademy/internal/infra/repository/apikey"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type SUT struct {
+	UseCase ListApiKeysUseCase
+	Repo    *repo.InMemoryApiKeyRepository
+}
+
+func makeSut() SUT {
+	repo := repo.NewInMemoryApiKeyRepository()
+
+	return SUT{
+		UseCase: NewListApiKeysUseCase(repo),
+		Repo:    repo,
+	}
+}
+
+func TestListApiKeys_GivenInvalidInput_ShouldReturnError(t *testing.T) {
+	testCases := []struct {
+		name        string
+		setup       func(sut SUT)
+		input       ListApiKeysInput
+		expectedErr error
+	}{
+		{
+			name:        "Empty User Id",
+			input:       ListApiKeysInput{},
+			expectedErr: domain.ErrApiKeyUserIdIsRequired,
+		},
+		{
+			name: "Repo Api Key Fail On List",
+			setup: func(sut SUT) {
+				sut.Repo.FailOnList = true
+			},
+			input:       ListApiKeysInput{UserId: "123456"},
+			expectedErr: repo.ErrSimulatedFailureRepoApiKey,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			sut := makeSut()
+
+			if tc.setup != nil {
+				tc.setup(sut)
+			}
+
+			// Act
+			output, err := sut.UseCase.Perform(context.Background(), tc.input)
+
+			// Assert
+			require.Nil(t, output)
+			assert.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestListApiKeys_ShouldReturnOwnKeysNewestFirst(t *testing.T) {
+	// Arrange
+	sut := makeSut()
+	now := time.Now()
+
+	older, _, err := domain.NewApiKey("123456", "CI", []string{"products:read"}, nil, now.Add(-time.Hour))
+	require.NoError(t, err)
+	older.Revoke(now)
+
+	newer, _, err := domain.NewApiKey("123456", "Deploy", []string{"products:write"}, nil, now)
+	require.NoError(t, err)
+
+	other, _, err := domain.NewApiKey("654321", "Other", []string{"products:read"}, nil, now)
+	require.NoError(t, err)
+
+	for _, key := range []*domain.ApiKey{older, newer, other} {
+		require.NoError(t, sut.Repo.Save(context.Background(), key))
+	}
+
+	// Act
+	output, err := sut.UseCase.Perform(context.Background(), ListApiKeysInput{UserId: "123456"})
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, output.Items, 2)
+	assert.Equal(t, newer.ID, output.Items[0].ID)
+	assert.False(t, output.Items[0].Revoked)
+	assert.Equal(t, older.ID, output.Items[1].ID)
+	assert.True(t, output.Items[1].Revoked)
+	assert.Equal(t, []string{"products:read"}, output.Items[1].Scopes)
+}
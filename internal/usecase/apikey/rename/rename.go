@@ -0,0 +1,57 @@
+package apikey
+
+import (
+	"context"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+)
+
+type renameApiKeyUseCase struct {
+	apiKeyRepo domain.ApiKeyRepository
+}
+
+type RenameApiKeyUseCase interface {
+	Perform(ctx context.Context, input RenameApiKeyInput) (*RenameApiKeyOutput, error)
+}
+
+func NewRenameApiKeyUseCase(apiKeyRepo domain.ApiKeyRepository) RenameApiKeyUseCase {
+	return &renameApiKeyUseCase{
+		apiKeyRepo: apiKeyRepo,
+	}
+}
+
+// Perform only finds keys of the given user, so a key of someone else is
+// reported as not found.
+func (uc *renameApiKeyUseCase) Perform(ctx context.Context, input RenameApiKeyInput) (*RenameApiKeyOutput, error) {
+	if input.ID == "" {
+		return nil, domain.ErrApiKeyIdIsRequired
+	}
+
+	if input.UserId == "" {
+		return nil, domain.ErrApiKeyUserIdIsRequired
+	}
+
+	key, err := uc.apiKeyRepo.GetByIdAndUserId(ctx, input.ID, input.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if key == nil {
+		return nil, domain.ErrApiKeyNotFound
+	}
+
+	if err := key.Rename(input.Name, time.Now()); err != nil {
+		return nil, err
+	}
+
+	if err := uc.apiKeyRepo.Update(ctx, key); err != nil {
+		return nil, err
+	}
+
+	return &RenameApiKeyOutput{
+		ID:        key.ID,
+		Name:      key.Name,
+		UpdatedAt: key.UpdatedAt,
+	}, nil
+}
@@ -0,0 +1,15 @@
+package apikey
+
+import "time"
+
+type RenameApiKeyInput struct {
+	ID     string
+	UserId string
+	Name   string
+}
+
+type RenameApiKeyOutput struct {
+	ID        string
+	Name      string
+	UpdatedAt time.Time
+}
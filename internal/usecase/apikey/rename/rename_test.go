@@ -0,0 +1,139 @@
+package apikey
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+	repo "github.com/areteacademy/internal/infra/repository/apikey"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type SUT struct {
+	UseCase RenameApiKeyUseCase
+	Repo    *repo.InMemoryApiKeyRepository
+	Key     *domain.ApiKey
+}
+
+func makeSut(t *testing.T) SUT {
+	repo := repo.NewInMemoryApiKeyRepository()
+
+	key, _, err := domain.NewApiKey("123456", "CI", []string{"products:read"}, nil, time.Now())
+	require.NoError(t, err)
+
+	return SUT{
+		UseCase: NewRenameApiKeyUseCase(repo),
+		Repo:    repo,
+		Key:     key,
+	}
+}
+
+func TestRenameApiKey_GivenInvalidInput_ShouldReturnError(t *testing.T) {
+	testCases := []struct {
+		name        string
+		setup       func(sut SUT)
+		input       func(sut SUT) RenameApiKeyInput
+		expectedErr error
+	}{
+		{
+			name: "Empty Id",
+			input: func(sut SUT) RenameApiKeyInput {
+				return RenameApiKeyInput{UserId: sut.Key.UserId, Name: "Deploy"}
+			},
+			expectedErr: domain.ErrApiKeyIdIsRequired,
+		},
+		{
+			name: "Empty User Id",
+			input: func(sut SUT) RenameApiKeyInput {
+				return RenameApiKeyInput{ID: sut.Key.ID, Name: "Deploy"}
+			},
+			expectedErr: domain.ErrApiKeyUserIdIsRequired,
+		},
+		{
+			name: "Empty Name",
+			input: func(sut SUT) RenameApiKeyInput {
+				return RenameApiKeyInput{ID: sut.Key.ID, UserId: sut.Key.UserId}
+			},
+			expectedErr: domain.ErrApiKeyNameIsRequired,
+		},
+		{
+			name: "Key Of Another User",
+			input: func(sut SUT) RenameApiKeyInput {
+				return RenameApiKeyInput{ID: sut.Key.ID, UserId: "654321", Name: "Deploy"}
+			},
+			expectedErr: domain.ErrApiKeyNotFound,
+		},
+		{
+			name: "Key Revoked",
+			setup: func(sut SUT) {
+				sut.Key.Revoke(time.Now())
+			},
+			input: func(sut SUT) RenameApiKeyInput {
+				return RenameApiKeyInput{ID: sut.Key.ID, UserId: sut.Key.UserId, Name: "Deploy"}
+			},
+			expectedErr: domain.ErrApiKeyRevoked,
+		},
+		{
+			name: "Repo Api Key Fail On Get",
+			setup: func(sut SUT) {
+				sut.Repo.FailOnGet = true
+			},
+			input: func(sut SUT) RenameApiKeyInput {
+				return RenameApiKeyInput{ID: sut.Key.ID, UserId: sut.Key.UserId, Name: "Deploy"}
+			},
+			expectedErr: repo.ErrSimulatedFailureRepoApiKey,
+		},
+		{
+			name: "Repo Api Key Fail On Update",
+			setup: func(sut SUT) {
+				sut.Repo.FailOnUpdate = true
+			},
+			input: func(sut SUT) RenameApiKeyInput {
+				return RenameApiKeyInput{ID: sut.Key.ID, UserId: sut.Key.UserId, Name: "Deploy"}
+			},
+			expectedErr: repo.ErrSimulatedFailureRepoApiKey,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			sut := makeSut(t)
+			require.NoError(t, sut.Repo.Save(context.Background(), sut.Key))
+
+			if tc.setup != nil {
+				tc.setup(sut)
+			}
+
+			// Act
+			output, err := sut.UseCase.Perform(context.Background(), tc.input(sut))
+
+			// Assert
+			require.Nil(t, output)
+			assert.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestRenameApiKey_ShouldRename(t *testing.T) {
+	// Arrange
+	sut := makeSut(t)
+	require.NoError(t, sut.Repo.Save(context.Background(), sut.Key))
+
+	// Act
+	output, err := sut.UseCase.Perform(context.Background(), RenameApiKeyInput{
+		ID:     sut.Key.ID,
+		UserId: sut.Key.UserId,
+		Name:   " Deploy ",
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "Deploy", output.Name)
+
+	stored, err := sut.Repo.GetByIdAndUserId(context.Background(), sut.Key.ID, sut.Key.UserId)
+	require.NoError(t, err)
+	assert.Equal(t, "Deploy", stored.Name)
+}
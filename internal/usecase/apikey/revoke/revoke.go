@@ -0,0 +1,51 @@
+package apikey
+
+import (
+	"context"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+)
+
+type revokeApiKeyUseCase struct {
+	apiKeyRepo domain.ApiKeyRepository
+}
+
+type RevokeApiKeyUseCase interface {
+	Perform(ctx context.Context, input RevokeApiKeyInput) error
+}
+
+func NewRevokeApiKeyUseCase(apiKeyRepo domain.ApiKeyRepository) RevokeApiKeyUseCase {
+	return &revokeApiKeyUseCase{
+		apiKeyRepo: apiKeyRepo,
+	}
+}
+
+// Perform succeeds without writing when the key is already revoked, so a
+// client retrying the request gets the same answer.
+func (uc *revokeApiKeyUseCase) Perform(ctx context.Context, input RevokeApiKeyInput) error {
+	if input.ID == "" {
+		return domain.ErrApiKeyIdIsRequired
+	}
+
+	if input.UserId == "" {
+		return domain.ErrApiKeyUserIdIsRequired
+	}
+
+	key, err := uc.apiKeyRepo.GetByIdAndUserId(ctx, input.ID, input.UserId)
+	if err != nil {
+		return err
+	}
+
+	if key == nil {
+		return domain.ErrApiKeyNotFound
+	}
+
+	if key.IsRevoked() {
+		return nil
+	}
+
+	key.Revoke(time.Now())
+
+	return uc.apiKeyRepo.Update(ctx, key)
+}
@@ -0,0 +1,6 @@
+package apikey
+
+type RevokeApiKeyInput struct {
+	ID     string
+	UserId string
+}
@@ -0,0 +1,123 @@
+package apikey
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+	repo "github.com/areteacademy/internal/infra/repository/apikey"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type SUT struct {
+	UseCase RevokeApiKeyUseCase
+	Repo    *repo.InMemoryApiKeyRepository
+	Key     *domain.ApiKey
+}
+
+func makeSut(t *testing.T) SUT {
+	repo := repo.NewInMemoryApiKeyRepository()
+
+	key, _, err := domain.NewApiKey("123456", "CI", []string{"products:read"}, nil, time.Now())
+	require.NoError(t, err)
+
+	return SUT{
+		UseCase: NewRevokeApiKeyUseCase(repo),
+		Repo:    repo,
+		Key:     key,
+	}
+}
+
+func TestRevokeApiKey_GivenInvalidInput_ShouldReturnError(t *testing.T) {
+	testCases := []struct {
+		name        string
+		setup       func(sut SUT)
+		input       func(sut SUT) RevokeApiKeyInput
+		expectedErr error
+	}{
+		{
+			name: "Empty Id",
+			input: func(sut SUT) RevokeApiKeyInput {
+				return RevokeApiKeyInput{UserId: sut.Key.UserId}
+			},
+			expectedErr: domain.ErrApiKeyIdIsRequired,
+		},
+		{
+			name: "Empty User Id",
+			input: func(sut SUT) RevokeApiKeyInput {
+				return RevokeApiKeyInput{ID: sut.Key.ID}
+			},
+			expectedErr: domain.ErrApiKeyUserIdIsRequired,
+		},
+		{
+			name: "Key Of Another User",
+			input: func(sut SUT) RevokeApiKeyInput {
+				return RevokeApiKeyInput{ID: sut.Key.ID, UserId: "654321"}
+			},
+			expectedErr: domain.ErrApiKeyNotFound,
+		},
+		{
+			name: "Repo Api Key Fail On Get",
+			setup: func(sut SUT) {
+				sut.Repo.FailOnGet = true
+			},
+			input: func(sut SUT) RevokeApiKeyInput {
+				return RevokeApiKeyInput{ID: sut.Key.ID, UserId: sut.Key.UserId}
+			},
+			expectedErr: repo.ErrSimulatedFailureRepoApiKey,
+		},
+		{
+			name: "Repo Api Key Fail On Update",
+			setup: func(sut SUT) {
+				sut.Repo.FailOnUpdate = true
+			},
+			input: func(sut SUT) RevokeApiKeyInput {
+				return RevokeApiKeyInput{ID: sut.Key.ID, UserId: sut.Key.UserId}
+			},
+			expectedErr: repo.ErrSimulatedFailureRepoApiKey,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			sut := makeSut(t)
+			require.NoError(t, sut.Repo.Save(context.Background(), sut.Key))
+
+			if tc.setup != nil {
+				tc.setup(sut)
+			}
+
+			// Act
+			err := sut.UseCase.Perform(context.Background(), tc.input(sut))
+
+			// Assert
+			assert.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestRevokeApiKey_ShouldRevokeAndKeepFirstRevocation(t *testing.T) {
+	// Arrange
+	sut := makeSut(t)
+	require.NoError(t, sut.Repo.Save(context.Background(), sut.Key))
+	input := RevokeApiKeyInput{ID: sut.Key.ID, UserId: sut.Key.UserId}
+
+	// Act
+	firstErr := sut.UseCase.Perform(context.Background(), input)
+	revokedAt := *sut.Key.RevokedAt
+
+	sut.Repo.FailOnUpdate = true
+	secondErr := sut.UseCase.Perform(context.Background(), input)
+
+	// Assert
+	require.NoError(t, firstErr)
+	require.NoError(t, secondErr)
+
+	stored, err := sut.Repo.GetByIdAndUserId(context.Background(), sut.Key.ID, sut.Key.UserId)
+	require.NoError(t, err)
+	assert.False(t, stored.IsUsable(time.Now()))
+	assert.Equal(t, revokedAt, *stored.RevokedAt)
+}
@@ -0,0 +1,135 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+)
+
+type authenticateUseCase struct {
+	userRepo    domain.UserRepository
+	tokens      domain.TokenService
+	apiKeyRepo  domain.ApiKeyRepository
+	sessionRepo domain.SessionRepository
+}
+
+type AuthenticateUseCase interface {
+	Perform(ctx context.Context, input AuthenticateInput) (*AuthenticateOutput, error)
+}
+
+func NewAuthenticateUseCase(
+	userRepo domain.UserRepository,
+	tokens domain.TokenService,
+	apiKeyRepo domain.ApiKeyRepository,
+	sessionRepo domain.SessionRepository,
+) AuthenticateUseCase {
+	return &authenticateUseCase{
+		userRepo:    userRepo,
+		tokens:      tokens,
+		apiKeyRepo:  apiKeyRepo,
+		sessionRepo: sessionRepo,
+	}
+}
+
+// Perform accepts a token only while it carries the user's current token
+// version and role, so revoking a user's tokens takes effect on the next
+// request and the role in the token can be trusted. The session the token
+// was issued for must not have been logged out. API keys are accepted in
+// place of a token when they grant the scope asked for.
+func (uc *authenticateUseCase) Perform(ctx context.Context, input AuthenticateInput) (*AuthenticateOutput, error) {
+	if domain.IsApiKey(input.Token) {
+		return uc.authenticateApiKey(ctx, input)
+	}
+
+	claims, err := uc.tokens.Validate(input.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := uc.getUser(ctx, claims.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if user == nil || user.TokenVersion != claims.TokenVersion || user.Role != claims.Role {
+		return nil, domain.ErrAuthTokenInvalid
+	}
+
+	session, err := uc.sessionRepo.GetById(ctx, claims.SessionId)
+	if err != nil && !errors.Is(err, domain.ErrSessionNotFound) {
+		return nil, err
+	}
+
+	if session == nil || session.UserId != user.ID || !session.IsActive(time.Now()) {
+		return nil, domain.ErrAuthTokenInvalid
+	}
+
+	if err := checkUser(user, input); err != nil {
+		return nil, err
+	}
+
+	return &AuthenticateOutput{UserId: user.ID, Role: user.Role, SessionId: session.ID, Locale: user.Locale}, nil
+}
+
+// authenticateApiKey leaves the role out of the output, so a key never
+// carries its owner's administrative permissions.
+func (uc *authenticateUseCase) authenticateApiKey(ctx context.Context, input AuthenticateInput) (*AuthenticateOutput, error) {
+	key, err := uc.apiKeyRepo.GetByKeyHash(ctx, domain.HashSecretToken(input.Token))
+	if err != nil && !errors.Is(err, domain.ErrApiKeyNotFound) {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	if key == nil || !key.IsUsable(now) {
+		return nil, domain.ErrAuthTokenInvalid
+	}
+
+	if input.Scope == "" || !key.Allows(input.Scope) {
+		return nil, domain.ErrApiKeyScopeDenied
+	}
+
+	user, err := uc.getUser(ctx, key.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if user == nil {
+		return nil, domain.ErrAuthTokenInvalid
+	}
+
+	if err := checkUser(user, input); err != nil {
+		return nil, err
+	}
+
+	if key.NeedsTouch(now) {
+		if err := uc.apiKeyRepo.TouchLastUsed(ctx, key.ID, now); err != nil {
+			return nil, err
+		}
+	}
+
+	return &AuthenticateOutput{UserId: user.ID, Locale: user.Locale}, nil
+}
+
+func (uc *authenticateUseCase) getUser(ctx context.Context, id string) (*domain.User, error) {
+	user, err := uc.userRepo.GetById(ctx, id)
+	if err != nil && !errors.Is(err, domain.ErrUserNotFound) {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+func checkUser(user *domain.User, input AuthenticateInput) error {
+	if !user.IsActive() {
+		return domain.ErrUserDeactivated
+	}
+
+	if input.RequireVerifiedEmail && !user.IsEmailVerified() {
+		return domain.ErrUserEmailNotVerified
+	}
+
+	return nil
+}
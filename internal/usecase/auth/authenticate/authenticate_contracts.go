@@ -0,0 +1,22 @@
+package auth
+
+import "github.com/areteacademy/internal/domain"
+
+type AuthenticateInput struct {
+	Token string
+	// RequireVerifiedEmail rejects users who have not verified their email
+	// with domain.ErrUserEmailNotVerified.
+	RequireVerifiedEmail bool
+	// Scope is what an API key must grant to be accepted. API keys are
+	// rejected with domain.ErrApiKeyScopeDenied when it is empty.
+	Scope domain.ApiKeyScope
+}
+
+// AuthenticateOutput has no role or session for API keys. Locale is the
+// user's preferred one, empty when they have none.
+type AuthenticateOutput struct {
+	UserId    string
+	Role      domain.Role
+	SessionId string
+	Locale    domain.Locale
+}
@@ -0,0 +1,397 @@
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+	apiKeyRepo "github.com/areteacademy/internal/infra/repository/apikey"
+	sessionRepo "github.com/areteacademy/internal/infra/repository/session"
+	repo "github.com/areteacademy/internal/infra/repository/user"
+	security "github.com/areteacademy/internal/infra/security"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type SUT struct {
+	UseCase     AuthenticateUseCase
+	Repo        *repo.InMemoryUserRepository
+	ApiKeyRepo  *apiKeyRepo.InMemoryApiKeyRepository
+	SessionRepo *sessionRepo.InMemorySessionRepository
+	Tokens      *security.JwtTokenService
+	User        *domain.User
+}
+
+func makeSut(t *testing.T) SUT {
+	repo := repo.NewInMemoryUserRepository()
+	keys, err := security.NewEphemeralJwtKeySet()
+	require.NoError(t, err)
+
+	tokens, err := security.NewJwtTokenService(security.JwtConfig{
+		Keys:       keys,
+		Issuer:     "areteacademy",
+		Expiration: time.Hour,
+	})
+	require.NoError(t, err)
+
+	apiKeys := apiKeyRepo.NewInMemoryApiKeyRepository()
+	sessions := sessionRepo.NewInMemorySessionRepository()
+	usecase := NewAuthenticateUseCase(repo, tokens, apiKeys, sessions)
+
+	now := time.Now()
+
+	user := &domain.User{
+		ID:        "123456",
+		Name:      "Daniel",
+		Email:     "daniel@gmail.com",
+		Role:      domain.RoleUser,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	return SUT{
+		UseCase:     usecase,
+		Repo:        repo,
+		ApiKeyRepo:  apiKeys,
+		SessionRepo: sessions,
+		Tokens:      tokens,
+		User:        user,
+	}
+}
+
+// issueToken logs user in the way the login use case does, returning the
+// access token and the session it was issued for.
+func issueToken(t *testing.T, sut SUT, user *domain.User) (string, *domain.Session) {
+	session, refreshToken, _, err := domain.NewSession(user, "", "", time.Hour, time.Now())
+	require.NoError(t, err)
+	require.NoError(t, sut.SessionRepo.Create(context.Background(), session, refreshToken))
+
+	token, _, err := sut.Tokens.Generate(user, session.ID)
+	require.NoError(t, err)
+
+	return token, session
+}
+
+func TestAuthenticate_ShouldReturnUserId_WhenTokenIsCurrent(t *testing.T) {
+	// Arrange
+	sut := makeSut(t)
+	require.NoError(t, sut.Repo.Save(context.Background(), sut.User))
+
+	token, session := issueToken(t, sut, sut.User)
+
+	// Act
+	output, err := sut.UseCase.Perform(context.Background(), AuthenticateInput{Token: token})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, sut.User.ID, output.UserId)
+	assert.Equal(t, domain.RoleUser, output.Role)
+	assert.Equal(t, session.ID, output.SessionId)
+}
+
+func TestAuthenticate_ShouldReturnError_WhenTokenIsRejected(t *testing.T) {
+	testCases := []struct {
+		name  string
+		token func(t *testing.T, sut SUT) string
+	}{
+		{
+			name: "Malformed Token",
+			token: func(t *testing.T, sut SUT) string {
+				return "not-a-token"
+			},
+		},
+		{
+			name: "User Not Found",
+			token: func(t *testing.T, sut SUT) string {
+				token, _ := issueToken(t, sut, &domain.User{ID: "654321"})
+				return token
+			},
+		},
+		{
+			name: "Tokens Revoked",
+			token: func(t *testing.T, sut SUT) string {
+				token, _ := issueToken(t, sut, sut.User)
+				sut.User.RevokeTokens()
+				return token
+			},
+		},
+		{
+			name: "Role Changed",
+			token: func(t *testing.T, sut SUT) string {
+				token, _ := issueToken(t, sut, sut.User)
+				sut.User.Role = domain.RoleAdmin
+				return token
+			},
+		},
+		{
+			name: "Session Not Found",
+			token: func(t *testing.T, sut SUT) string {
+				token, _, err := sut.Tokens.Generate(sut.User, "unknown")
+				require.NoError(t, err)
+				return token
+			},
+		},
+		{
+			name: "Session Logged Out",
+			token: func(t *testing.T, sut SUT) string {
+				token, session := issueToken(t, sut, sut.User)
+				require.NoError(t, sut.SessionRepo.Revoke(context.Background(), session.ID, time.Now()))
+				return token
+			},
+		},
+		{
+			name: "Session Expired",
+			token: func(t *testing.T, sut SUT) string {
+				token, session := issueToken(t, sut, sut.User)
+				session.ExpiresAt = time.Now().Add(-time.Second)
+				return token
+			},
+		},
+		{
+			name: "Session Of Another User",
+			token: func(t *testing.T, sut SUT) string {
+				_, session := issueToken(t, sut, &domain.User{ID: "654321"})
+				token, _, err := sut.Tokens.Generate(sut.User, session.ID)
+				require.NoError(t, err)
+				return token
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			sut := makeSut(t)
+			require.NoError(t, sut.Repo.Save(context.Background(), sut.User))
+			token := tc.token(t, sut)
+
+			// Act
+			output, err := sut.UseCase.Perform(context.Background(), AuthenticateInput{Token: token})
+
+			// Assert
+			require.Nil(t, output)
+			assert.ErrorIs(t, err, domain.ErrAuthTokenInvalid)
+		})
+	}
+}
+
+func TestAuthenticate_ShouldRequireVerifiedEmail_WhenAsked(t *testing.T) {
+	// Arrange
+	sut := makeSut(t)
+	require.NoError(t, sut.Repo.Save(context.Background(), sut.User))
+
+	token, _ := issueToken(t, sut, sut.User)
+
+	input := AuthenticateInput{Token: token, RequireVerifiedEmail: true}
+
+	// Act
+	unverifiedOutput, unverifiedErr := sut.UseCase.Perform(context.Background(), input)
+
+	verifiedAt := time.Now()
+	sut.User.EmailVerifiedAt = &verifiedAt
+	verifiedOutput, verifiedErr := sut.UseCase.Perform(context.Background(), input)
+
+	// Assert
+	require.Nil(t, unverifiedOutput)
+	assert.ErrorIs(t, unverifiedErr, domain.ErrUserEmailNotVerified)
+
+	require.NoError(t, verifiedErr)
+	assert.Equal(t, sut.User.ID, verifiedOutput.UserId)
+}
+
+func TestAuthenticate_ShouldReturnError_WhenUserIsDeactivated(t *testing.T) {
+	// Arrange
+	sut := makeSut(t)
+	require.NoError(t, sut.Repo.Save(context.Background(), sut.User))
+
+	token, _ := issueToken(t, sut, sut.User)
+
+	deactivatedAt := time.Now()
+	sut.User.DeactivatedAt = &deactivatedAt
+
+	// Act
+	output, err := sut.UseCase.Perform(context.Background(), AuthenticateInput{Token: token})
+
+	// Assert
+	require.Nil(t, output)
+	assert.ErrorIs(t, err, domain.ErrUserDeactivated)
+}
+
+func TestAuthenticate_ShouldReturnError_WhenRepositoryFailOnGet(t *testing.T) {
+	// Arrange
+	sut := makeSut(t)
+	require.NoError(t, sut.Repo.Save(context.Background(), sut.User))
+	sut.Repo.FailOnGet = true
+
+	token, _ := issueToken(t, sut, sut.User)
+
+	// Act
+	output, err := sut.UseCase.Perform(context.Background(), AuthenticateInput{Token: token})
+
+	// Assert
+	require.Nil(t, output)
+	assert.ErrorIs(t, err, repo.ErrSimulatedFailureRepoUser)
+}
+
+func TestAuthenticate_ShouldReturnError_WhenSessionRepositoryFailOnGet(t *testing.T) {
+	// Arrange
+	sut := makeSut(t)
+	require.NoError(t, sut.Repo.Save(context.Background(), sut.User))
+
+	token, _ := issueToken(t, sut, sut.User)
+	sut.SessionRepo.FailOnGet = true
+
+	// Act
+	output, err := sut.UseCase.Perform(context.Background(), AuthenticateInput{Token: token})
+
+	// Assert
+	require.Nil(t, output)
+	assert.ErrorIs(t, err, sessionRepo.ErrSimulatedFailureRepoSession)
+}
+
+func saveApiKey(t *testing.T, sut SUT, scopes []string, expiresAt *time.Time) (*domain.ApiKey, string) {
+	key, secret, err := domain.NewApiKey(sut.User.ID, "CI", scopes, expiresAt, time.Now())
+	require.NoError(t, err)
+	require.NoError(t, sut.ApiKeyRepo.Save(context.Background(), key))
+
+	return key, secret
+}
+
+func TestAuthenticate_ShouldAcceptApiKey_WhenScopeIsGranted(t *testing.T) {
+	// Arrange
+	sut := makeSut(t)
+	sut.User.Role = domain.RoleAdmin
+	require.NoError(t, sut.Repo.Save(context.Background(), sut.User))
+
+	key, secret := saveApiKey(t, sut, []string{"products:read"}, nil)
+
+	// Act
+	output, err := sut.UseCase.Perform(context.Background(), AuthenticateInput{
+		Token: secret,
+		Scope: domain.ApiKeyScopeProductsRead,
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, sut.User.ID, output.UserId)
+	assert.Empty(t, output.Role)
+
+	stored, err := sut.ApiKeyRepo.GetByIdAndUserId(context.Background(), key.ID, sut.User.ID)
+	require.NoError(t, err)
+	assert.NotNil(t, stored.LastUsedAt)
+}
+
+func TestAuthenticate_ShouldReturnError_WhenApiKeyIsRejected(t *testing.T) {
+	testCases := []struct {
+		name        string
+		scope       domain.ApiKeyScope
+		secret      func(t *testing.T, sut SUT) string
+		expectedErr error
+	}{
+		{
+			name:  "Unknown Key",
+			scope: domain.ApiKeyScopeProductsRead,
+			secret: func(t *testing.T, sut SUT) string {
+				return domain.ApiKeyPrefix + "unknown"
+			},
+			expectedErr: domain.ErrAuthTokenInvalid,
+		},
+		{
+			name:  "Revoked Key",
+			scope: domain.ApiKeyScopeProductsRead,
+			secret: func(t *testing.T, sut SUT) string {
+				key, secret := saveApiKey(t, sut, []string{"products:read"}, nil)
+				key.Revoke(time.Now())
+				return secret
+			},
+			expectedErr: domain.ErrAuthTokenInvalid,
+		},
+		{
+			name:  "Expired Key",
+			scope: domain.ApiKeyScopeProductsRead,
+			secret: func(t *testing.T, sut SUT) string {
+				key, secret := saveApiKey(t, sut, []string{"products:read"}, nil)
+				expiredAt := time.Now().Add(-time.Minute)
+				key.ExpiresAt = &expiredAt
+				return secret
+			},
+			expectedErr: domain.ErrAuthTokenInvalid,
+		},
+		{
+			name:  "Scope Not Granted",
+			scope: domain.ApiKeyScopeProductsWrite,
+			secret: func(t *testing.T, sut SUT) string {
+				_, secret := saveApiKey(t, sut, []string{"products:read"}, nil)
+				return secret
+			},
+			expectedErr: domain.ErrApiKeyScopeDenied,
+		},
+		{
+			name:  "Route Without Scope",
+			scope: "",
+			secret: func(t *testing.T, sut SUT) string {
+				_, secret := saveApiKey(t, sut, []string{"products:read"}, nil)
+				return secret
+			},
+			expectedErr: domain.ErrApiKeyScopeDenied,
+		},
+		{
+			name:  "User Deactivated",
+			scope: domain.ApiKeyScopeProductsRead,
+			secret: func(t *testing.T, sut SUT) string {
+				_, secret := saveApiKey(t, sut, []string{"products:read"}, nil)
+				deactivatedAt := time.Now()
+				sut.User.DeactivatedAt = &deactivatedAt
+				return secret
+			},
+			expectedErr: domain.ErrUserDeactivated,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			sut := makeSut(t)
+			require.NoError(t, sut.Repo.Save(context.Background(), sut.User))
+			secret := tc.secret(t, sut)
+
+			// Act
+			output, err := sut.UseCase.Perform(context.Background(), AuthenticateInput{Token: secret, Scope: tc.scope})
+
+			// Assert
+			require.Nil(t, output)
+			assert.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestAuthenticate_ShouldReturnError_WhenApiKeyRepositoryFails(t *testing.T) {
+	testCases := []struct {
+		name  string
+		setup func(sut SUT)
+	}{
+		{name: "Fail On Get", setup: func(sut SUT) { sut.ApiKeyRepo.FailOnGet = true }},
+		{name: "Fail On Touch", setup: func(sut SUT) { sut.ApiKeyRepo.FailOnTouch = true }},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			sut := makeSut(t)
+			require.NoError(t, sut.Repo.Save(context.Background(), sut.User))
+			_, secret := saveApiKey(t, sut, []string{"products:read"}, nil)
+			tc.setup(sut)
+
+			// Act
+			output, err := sut.UseCase.Perform(context.Background(), AuthenticateInput{
+				Token: secret,
+				Scope: domain.ApiKeyScopeProductsRead,
+			})
+
+			// Assert
+			require.Nil(t, output)
+			assert.ErrorIs(t, err, apiKeyRepo.ErrSimulatedFailureRepoApiKey)
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+)
+
+type confirmPasswordResetUseCase struct {
+	userRepo    domain.UserRepository
+	tokenRepo   domain.PasswordResetTokenRepository
+	hasher      domain.UserPasswordHasher
+	historySize int
+	policy      domain.PasswordPolicy
+}
+
+type ConfirmPasswordResetUseCase interface {
+	Perform(ctx context.Context, input ConfirmPasswordResetInput) error
+}
+
+// NewConfirmPasswordResetUseCase applies the same history rule as changing
+// the password. A historySize below 1 falls back to
+// domain.DefaultPasswordHistorySize.
+func NewConfirmPasswordResetUseCase(
+	userRepo domain.UserRepository,
+	tokenRepo domain.PasswordResetTokenRepository,
+	hasher domain.UserPasswordHasher,
+	historySize int,
+	policy domain.PasswordPolicy,
+) ConfirmPasswordResetUseCase {
+	if historySize < 1 {
+		historySize = domain.DefaultPasswordHistorySize
+	}
+
+	return &confirmPasswordResetUseCase{
+		userRepo:    userRepo,
+		tokenRepo:   tokenRepo,
+		hasher:      hasher,
+		historySize: historySize,
+		policy:      policy,
+	}
+}
+
+func (uc *confirmPasswordResetUseCase) Perform(ctx context.Context, input ConfirmPasswordResetInput) error {
+	if input.Token == "" {
+		return domain.ErrPasswordResetTokenIsRequired
+	}
+
+	if input.NewPassword == "" {
+		return domain.ErrUserPasswordIsRequired
+	}
+
+	now := time.Now()
+
+	token, err := uc.tokenRepo.GetByTokenHash(ctx, domain.HashSecretToken(input.Token))
+	if err != nil && !errors.Is(err, domain.ErrPasswordResetTokenNotFound) {
+		return err
+	}
+
+	if token == nil || !token.IsUsable(now) {
+		return domain.ErrPasswordResetTokenInvalid
+	}
+
+	user, err := uc.userRepo.GetById(ctx, token.UserId)
+	if err != nil && !errors.Is(err, domain.ErrUserNotFound) {
+		return err
+	}
+
+	if user == nil {
+		return domain.ErrPasswordResetTokenInvalid
+	}
+
+	if err := uc.policy.Validate(input.NewPassword, user.Name, user.Email); err != nil {
+		return err
+	}
+
+	for _, recent := range user.RecentPasswords(uc.historySize) {
+		reused, err := uc.hasher.Verify(recent, input.NewPassword)
+		if err != nil {
+			return err
+		}
+
+		if reused {
+			return domain.ErrUserPasswordReused
+		}
+	}
+
+	hashedPassword, err := uc.hasher.Hash(input.NewPassword)
+	if err != nil {
+		return err
+	}
+
+	// The token is spent before the password changes: if the update fails
+	// the user asks for another token, but a token can never be used twice.
+	if err := uc.tokenRepo.MarkUsed(ctx, token.ID, now); err != nil {
+		return err
+	}
+
+	user.ChangePassword(hashedPassword, uc.historySize)
+
+	return uc.userRepo.Update(ctx, user)
+}
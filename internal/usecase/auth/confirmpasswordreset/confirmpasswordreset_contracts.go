@@ -0,0 +1,6 @@
+package auth
+
+type ConfirmPasswordResetInput struct {
+	Token       string
+	NewPassword string
+}
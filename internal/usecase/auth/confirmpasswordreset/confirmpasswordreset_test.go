@@ -0,0 +1,218 @@
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+	tokenRepo "github.com/areteacademy/internal/infra/repository/passwordreset"
+	userRepo "github.com/areteacademy/internal/infra/repository/user"
+	security "github.com/areteacademy/internal/infra/security"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	currentPassword = "@Secret123"
+	newPassword     = "@Secret456"
+)
+
+type SUT struct {
+	UseCase   ConfirmPasswordResetUseCase
+	UserRepo  *userRepo.InMemoryUserRepository
+	TokenRepo *tokenRepo.InMemoryPasswordResetTokenRepository
+	Hasher    *security.BcryptPasswordhasher
+	User      *domain.User
+	Token     *domain.PasswordResetToken
+	Secret    string
+}
+
+func makeSut(t *testing.T) SUT {
+	userRepo := userRepo.NewInMemoryUserRepository()
+	tokenRepo := tokenRepo.NewInMemoryPasswordResetTokenRepository()
+	hasher := security.NewBcryptPasswordHasher()
+	usecase := NewConfirmPasswordResetUseCase(userRepo, tokenRepo, hasher, domain.DefaultPasswordHistorySize, domain.DefaultPasswordPolicy())
+
+	hash, err := hasher.Hash(currentPassword)
+	require.NoError(t, err)
+
+	now := time.Now()
+
+	user := &domain.User{
+		ID:        "123456",
+		Name:      "Daniel",
+		Email:     "daniel@gmail.com",
+		Password:  hash,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	token, secret, err := domain.NewPasswordResetToken(user.ID, time.Hour)
+	require.NoError(t, err)
+
+	return SUT{
+		UseCase:   usecase,
+		UserRepo:  userRepo,
+		TokenRepo: tokenRepo,
+		Hasher:    hasher,
+		User:      user,
+		Token:     token,
+		Secret:    secret,
+	}
+}
+
+func validInput(sut SUT) ConfirmPasswordResetInput {
+	return ConfirmPasswordResetInput{
+		Token:       sut.Secret,
+		NewPassword: newPassword,
+	}
+}
+
+func TestConfirmPasswordReset_GivenInvalidInput_ShouldReturnError(t *testing.T) {
+	testCases := []struct {
+		name        string
+		setup       func(sut SUT)
+		input       func(sut SUT) ConfirmPasswordResetInput
+		expectedErr error
+	}{
+		{
+			name: "Empty Token",
+			input: func(sut SUT) ConfirmPasswordResetInput {
+				in := validInput(sut)
+				in.Token = ""
+				return in
+			},
+			expectedErr: domain.ErrPasswordResetTokenIsRequired,
+		},
+		{
+			name: "Weak Password",
+			input: func(sut SUT) ConfirmPasswordResetInput {
+				in := validInput(sut)
+				in.NewPassword = "daniel"
+				return in
+			},
+			expectedErr: domain.ErrUserPasswordInvalid,
+		},
+		{
+			name: "Unknown Token",
+			input: func(sut SUT) ConfirmPasswordResetInput {
+				in := validInput(sut)
+				in.Token = "unknown"
+				return in
+			},
+			expectedErr: domain.ErrPasswordResetTokenInvalid,
+		},
+		{
+			name: "Expired Token",
+			setup: func(sut SUT) {
+				sut.Token.ExpiresAt = time.Now().Add(-time.Minute)
+			},
+			input:       validInput,
+			expectedErr: domain.ErrPasswordResetTokenInvalid,
+		},
+		{
+			name: "Used Token",
+			setup: func(sut SUT) {
+				usedAt := time.Now()
+				sut.Token.UsedAt = &usedAt
+			},
+			input:       validInput,
+			expectedErr: domain.ErrPasswordResetTokenInvalid,
+		},
+		{
+			name: "User Not Found",
+			setup: func(sut SUT) {
+				sut.Token.UserId = "654321"
+			},
+			input:       validInput,
+			expectedErr: domain.ErrPasswordResetTokenInvalid,
+		},
+		{
+			name: "Password Reused",
+			input: func(sut SUT) ConfirmPasswordResetInput {
+				in := validInput(sut)
+				in.NewPassword = currentPassword
+				return in
+			},
+			expectedErr: domain.ErrUserPasswordReused,
+		},
+		{
+			name: "Repo Token Fail On Get",
+			setup: func(sut SUT) {
+				sut.TokenRepo.FailOnGet = true
+			},
+			input:       validInput,
+			expectedErr: tokenRepo.ErrSimulatedFailureRepoPasswordResetToken,
+		},
+		{
+			name: "Repo Token Fail On Mark Used",
+			setup: func(sut SUT) {
+				sut.TokenRepo.FailOnMarkUsed = true
+			},
+			input:       validInput,
+			expectedErr: tokenRepo.ErrSimulatedFailureRepoPasswordResetToken,
+		},
+		{
+			name: "Repo User Fail On Get",
+			setup: func(sut SUT) {
+				sut.UserRepo.FailOnGet = true
+			},
+			input:       validInput,
+			expectedErr: userRepo.ErrSimulatedFailureRepoUser,
+		},
+		{
+			name: "Repo User Fail On Update",
+			setup: func(sut SUT) {
+				sut.UserRepo.FailOnUpdate = true
+			},
+			input:       validInput,
+			expectedErr: userRepo.ErrSimulatedFailureRepoUser,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			sut := makeSut(t)
+			require.NoError(t, sut.UserRepo.Save(context.Background(), sut.User))
+			require.NoError(t, sut.TokenRepo.Save(context.Background(), sut.Token))
+
+			if tc.setup != nil {
+				tc.setup(sut)
+			}
+
+			// Act
+			err := sut.UseCase.Perform(context.Background(), tc.input(sut))
+
+			// Assert
+			assert.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestConfirmPasswordReset_ShouldChangePasswordOnce(t *testing.T) {
+	// Arrange
+	sut := makeSut(t)
+	require.NoError(t, sut.UserRepo.Save(context.Background(), sut.User))
+	require.NoError(t, sut.TokenRepo.Save(context.Background(), sut.Token))
+
+	// Act
+	first := sut.UseCase.Perform(context.Background(), validInput(sut))
+	second := sut.UseCase.Perform(context.Background(), ConfirmPasswordResetInput{
+		Token:       sut.Secret,
+		NewPassword: "@Secret789",
+	})
+
+	// Assert
+	require.NoError(t, first)
+	assert.ErrorIs(t, second, domain.ErrPasswordResetTokenInvalid)
+
+	user, err := sut.UserRepo.GetById(context.Background(), sut.User.ID)
+	require.NoError(t, err)
+	matches, err := sut.Hasher.Verify(user.Password, newPassword)
+	require.NoError(t, err)
+	assert.True(t, matches)
+	assert.Equal(t, 1, user.TokenVersion)
+	assert.NotNil(t, sut.Token.UsedAt)
+}
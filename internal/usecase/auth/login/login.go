@@ -0,0 +1,236 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+)
+
+type loginUseCase struct {
+	userRepo      domain.UserRepository
+	hasher        domain.UserPasswordHasher
+	tokens        domain.TokenService
+	throttleRepo  domain.LoginThrottleRepository
+	twoFactorRepo domain.TwoFactorRepository
+	challengeRepo domain.LoginChallengeRepository
+	sessionRepo   domain.SessionRepository
+	policy        domain.LoginThrottlePolicy
+	refreshTTL    time.Duration
+	// dummyHash is what the password is checked against when no user has
+	// the email, so unknown emails take as long to answer as wrong
+	// passwords and cannot be told apart by timing.
+	dummyHash func() (string, error)
+}
+
+type LoginUseCase interface {
+	Perform(ctx context.Context, input LoginInput) (*LoginOutput, error)
+}
+
+func NewLoginUseCase(
+	userRepo domain.UserRepository,
+	hasher domain.UserPasswordHasher,
+	tokens domain.TokenService,
+	throttleRepo domain.LoginThrottleRepository,
+	twoFactorRepo domain.TwoFactorRepository,
+	challengeRepo domain.LoginChallengeRepository,
+	sessionRepo domain.SessionRepository,
+	policy domain.LoginThrottlePolicy,
+	refreshTTL time.Duration,
+) LoginUseCase {
+	return &loginUseCase{
+		userRepo:      userRepo,
+		hasher:        hasher,
+		tokens:        tokens,
+		throttleRepo:  throttleRepo,
+		twoFactorRepo: twoFactorRepo,
+		challengeRepo: challengeRepo,
+		sessionRepo:   sessionRepo,
+		policy:        policy,
+		refreshTTL:    refreshTTL,
+		dummyHash: sync.OnceValues(func() (string, error) {
+			return hasher.Hash(rand.Text())
+		}),
+	}
+}
+
+// Perform refuses attempts while the account or the client IP is backing
+// off or locked, before looking at the password, so a correct guess made
+// during a lockout is not confirmed. Accounts with two-factor
+// authentication get a challenge to complete instead of a token. Every
+// other login starts a session, whose refresh token outlives the access
+// token.
+func (uc *loginUseCase) Perform(ctx context.Context, input LoginInput) (*LoginOutput, error) {
+	if input.Email == "" {
+		return nil, domain.ErrAuthEmailIsRequired
+	}
+
+	if input.Password == "" {
+		return nil, domain.ErrAuthPasswordIsRequired
+	}
+
+	now := time.Now()
+
+	throttles, err := uc.loadThrottles(ctx, domain.NormalizeEmail(input.Email), input.IP)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, throttle := range throttles {
+		if !throttle.Allows(now, uc.policy) {
+			return nil, domain.ErrLoginThrottled
+		}
+	}
+
+	user, err := uc.userRepo.GetByEmail(ctx, input.Email)
+	if err != nil && !errors.Is(err, domain.ErrUserNotFound) {
+		return nil, err
+	}
+
+	hash := ""
+	if user != nil {
+		hash = user.Password
+	} else if hash, err = uc.dummyHash(); err != nil {
+		return nil, err
+	}
+
+	matches, err := uc.hasher.Verify(hash, input.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	matches = matches && user != nil
+
+	if !matches {
+		if err := uc.recordFailure(ctx, throttles, now); err != nil {
+			return nil, err
+		}
+
+		return nil, domain.ErrAuthInvalidCredentials
+	}
+
+	// Only someone who knows the password learns the account is
+	// deactivated.
+	if !user.IsActive() {
+		return nil, domain.ErrUserDeactivated
+	}
+
+	// The plain password is only known here, so this is where hashes made
+	// with an older algorithm or weaker parameters get replaced.
+	if uc.hasher.NeedsRehash(user.Password) {
+		hashedPassword, err := uc.hasher.Hash(input.Password)
+		if err != nil {
+			return nil, err
+		}
+
+		user.Password = hashedPassword
+		if err := uc.userRepo.Update(ctx, user); err != nil {
+			return nil, err
+		}
+	}
+
+	twoFactor, err := uc.twoFactorRepo.GetByUserId(ctx, user.ID)
+	if err != nil && !errors.Is(err, domain.ErrTwoFactorNotFound) {
+		return nil, err
+	}
+
+	if twoFactor != nil && twoFactor.IsEnabled() {
+		challenge, secret, err := domain.NewLoginChallenge(user.ID, now)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := uc.challengeRepo.Save(ctx, challenge); err != nil {
+			return nil, err
+		}
+
+		return &LoginOutput{
+			ChallengeToken: secret,
+			ExpiresAt:      challenge.ExpiresAt,
+		}, nil
+	}
+
+	// The failures are only forgotten once the login is complete, so the
+	// password alone does not reset the count the second factor adds to.
+	account := throttles[0]
+	if !account.LastFailureAt.IsZero() {
+		if err := uc.throttleRepo.Delete(ctx, account.Scope, account.Key); err != nil {
+			return nil, err
+		}
+	}
+
+	session, refreshToken, secret, err := domain.NewSession(user, input.Device, input.IP, uc.refreshTTL, now)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.sessionRepo.Create(ctx, session, refreshToken); err != nil {
+		return nil, err
+	}
+
+	token, claims, err := uc.tokens.Generate(user, session.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LoginOutput{
+		Token:        token,
+		RefreshToken: secret,
+		ExpiresAt:    claims.ExpiresAt,
+	}, nil
+}
+
+// loadThrottles returns the account throttle first, followed by the IP
+// throttle when there is an IP.
+func (uc *loginUseCase) loadThrottles(ctx context.Context, email, ip string) ([]*domain.LoginThrottle, error) {
+	account, err := uc.loadThrottle(ctx, domain.LoginThrottleScopeAccount, email)
+	if err != nil {
+		return nil, err
+	}
+
+	if ip == "" {
+		return []*domain.LoginThrottle{account}, nil
+	}
+
+	client, err := uc.loadThrottle(ctx, domain.LoginThrottleScopeIP, ip)
+	if err != nil {
+		return nil, err
+	}
+
+	return []*domain.LoginThrottle{account, client}, nil
+}
+
+func (uc *loginUseCase) loadThrottle(ctx context.Context, scope domain.LoginThrottleScope, key string) (*domain.LoginThrottle, error) {
+	throttle, err := uc.throttleRepo.Get(ctx, scope, key)
+	if err != nil && !errors.Is(err, domain.ErrLoginThrottleNotFound) {
+		return nil, err
+	}
+
+	if throttle == nil {
+		throttle = domain.NewLoginThrottle(scope, key)
+	}
+
+	return throttle, nil
+}
+
+func (uc *loginUseCase) recordFailure(ctx context.Context, throttles []*domain.LoginThrottle, now time.Time) error {
+	for _, throttle := range throttles {
+		stored, locked, err := uc.throttleRepo.RecordFailure(ctx, throttle.Scope, throttle.Key, now, uc.policy)
+		if err != nil {
+			return err
+		}
+
+		if !locked {
+			continue
+		}
+
+		if err := uc.throttleRepo.SaveEvent(ctx, domain.NewLockoutEvent(domain.LockoutEventLocked, stored, now)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,24 @@
+package auth
+
+import "time"
+
+type LoginInput struct {
+	Email    string
+	Password string
+	// IP is the client address; failures are also counted against it when
+	// it is set.
+	IP string
+	// Device describes the client, such as its user agent, so the user can
+	// tell their sessions apart.
+	Device string
+}
+
+// LoginOutput carries either the access and refresh tokens or, when the
+// account has two-factor authentication, the challenge token to complete
+// with a code. ExpiresAt is when the access or challenge token expires.
+type LoginOutput struct {
+	Token          string
+	RefreshToken   string
+	ChallengeToken string
+	ExpiresAt      time.Time
+}
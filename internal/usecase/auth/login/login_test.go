@@ -0,0 +1,578 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+	challengeRepo "github.com/areteacademy/internal/infra/repository/loginchallenge"
+	throttleRepo "github.com/areteacademy/internal/infra/repository/loginthrottle"
+	sessionRepo "github.com/areteacademy/internal/infra/repository/session"
+	twoFactorRepo "github.com/areteacademy/internal/infra/repository/twofactor"
+	repo "github.com/areteacademy/internal/infra/repository/user"
+	security "github.com/areteacademy/internal/infra/security"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	password = "@Daniel123"
+	clientIP = "192.0.2.1"
+)
+
+type SUT struct {
+	UseCase       LoginUseCase
+	Repo          *repo.InMemoryUserRepository
+	ThrottleRepo  *throttleRepo.InMemoryLoginThrottleRepository
+	TwoFactorRepo *twoFactorRepo.InMemoryTwoFactorRepository
+	ChallengeRepo *challengeRepo.InMemoryLoginChallengeRepository
+	SessionRepo   *sessionRepo.InMemorySessionRepository
+	Policy        domain.LoginThrottlePolicy
+	Tokens        *security.JwtTokenService
+	User          *domain.User
+}
+
+func makeSut(t *testing.T) SUT {
+	repo := repo.NewInMemoryUserRepository()
+	hasher := security.NewBcryptPasswordHasher()
+	keys, err := security.NewEphemeralJwtKeySet()
+	require.NoError(t, err)
+
+	tokens, err := security.NewJwtTokenService(security.JwtConfig{
+		Keys:       keys,
+		Issuer:     "areteacademy",
+		Expiration: time.Hour,
+	})
+	require.NoError(t, err)
+
+	throttleRepo := throttleRepo.NewInMemoryLoginThrottleRepository()
+	policy := domain.LoginThrottlePolicy{
+		FreeFailures:            2,
+		BaseDelay:               time.Minute,
+		MaxDelay:                4 * time.Minute,
+		AccountLockoutThreshold: 5,
+		IPLockoutThreshold:      8,
+		LockoutDuration:         time.Hour,
+		FailureWindow:           time.Hour,
+	}
+
+	twoFactorRepo := twoFactorRepo.NewInMemoryTwoFactorRepository()
+	challengeRepo := challengeRepo.NewInMemoryLoginChallengeRepository()
+	sessionRepo := sessionRepo.NewInMemorySessionRepository()
+
+	usecase := NewLoginUseCase(repo, hasher, tokens, throttleRepo, twoFactorRepo, challengeRepo, sessionRepo, policy, domain.DefaultRefreshTokenTTL)
+
+	hash, err := hasher.Hash(password)
+	require.NoError(t, err)
+
+	now := time.Now()
+
+	user := &domain.User{
+		ID:        "123456",
+		Name:      "Daniel",
+		Email:     "daniel@gmail.com",
+		Password:  hash,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	return SUT{
+		UseCase:       usecase,
+		Repo:          repo,
+		ThrottleRepo:  throttleRepo,
+		TwoFactorRepo: twoFactorRepo,
+		ChallengeRepo: challengeRepo,
+		SessionRepo:   sessionRepo,
+		Policy:        policy,
+		Tokens:        tokens,
+		User:          user,
+	}
+}
+
+func TestLogin_GivenInvalidInput_ShouldReturnError(t *testing.T) {
+	testCases := []struct {
+		name        string
+		setup       func(sut SUT)
+		input       LoginInput
+		expectedErr error
+	}{
+		{
+			name:        "Empty Email",
+			input:       LoginInput{Email: "", Password: password},
+			expectedErr: domain.ErrAuthEmailIsRequired,
+		},
+		{
+			name:        "Empty Password",
+			input:       LoginInput{Email: "daniel@gmail.com", Password: ""},
+			expectedErr: domain.ErrAuthPasswordIsRequired,
+		},
+		{
+			name:        "Unknown Email",
+			input:       LoginInput{Email: "unknown@gmail.com", Password: password},
+			expectedErr: domain.ErrAuthInvalidCredentials,
+		},
+		{
+			name:        "Wrong Password",
+			input:       LoginInput{Email: "daniel@gmail.com", Password: "@Wrong1234"},
+			expectedErr: domain.ErrAuthInvalidCredentials,
+		},
+		{
+			name: "Deactivated Account",
+			setup: func(sut SUT) {
+				deactivatedAt := time.Now()
+				sut.User.DeactivatedAt = &deactivatedAt
+			},
+			input:       LoginInput{Email: "daniel@gmail.com", Password: password},
+			expectedErr: domain.ErrUserDeactivated,
+		},
+		{
+			name: "Repo User Fail On GetByEmail",
+			setup: func(sut SUT) {
+				sut.Repo.FailOnGetByEmail = true
+			},
+			input:       LoginInput{Email: "daniel@gmail.com", Password: password},
+			expectedErr: repo.ErrSimulatedFailureRepoUser,
+		},
+		{
+			name: "Repo Throttle Fail On Get",
+			setup: func(sut SUT) {
+				sut.ThrottleRepo.FailOnGet = true
+			},
+			input:       LoginInput{Email: "daniel@gmail.com", Password: password},
+			expectedErr: throttleRepo.ErrSimulatedFailureRepoLoginThrottle,
+		},
+		{
+			name: "Repo Throttle Fail On Record Failure",
+			setup: func(sut SUT) {
+				sut.ThrottleRepo.FailOnRecordFailure = true
+			},
+			input:       LoginInput{Email: "daniel@gmail.com", Password: "@Wrong1234"},
+			expectedErr: throttleRepo.ErrSimulatedFailureRepoLoginThrottle,
+		},
+		{
+			name: "Repo Two Factor Fail On Get",
+			setup: func(sut SUT) {
+				sut.TwoFactorRepo.FailOnGet = true
+			},
+			input:       LoginInput{Email: "daniel@gmail.com", Password: password},
+			expectedErr: twoFactorRepo.ErrSimulatedFailureRepoTwoFactor,
+		},
+		{
+			name: "Repo Challenge Fail On Save",
+			setup: func(sut SUT) {
+				enableTwoFactor(sut)
+				sut.ChallengeRepo.FailOnSave = true
+			},
+			input:       LoginInput{Email: "daniel@gmail.com", Password: password},
+			expectedErr: challengeRepo.ErrSimulatedFailureRepoLoginChallenge,
+		},
+		{
+			name: "Repo Session Fail On Create",
+			setup: func(sut SUT) {
+				sut.SessionRepo.FailOnCreate = true
+			},
+			input:       LoginInput{Email: "daniel@gmail.com", Password: password},
+			expectedErr: sessionRepo.ErrSimulatedFailureRepoSession,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			sut := makeSut(t)
+			require.NoError(t, sut.Repo.Save(context.Background(), sut.User))
+
+			if tc.setup != nil {
+				tc.setup(sut)
+			}
+
+			// Act
+			output, err := sut.UseCase.Perform(context.Background(), tc.input)
+
+			// Assert
+			require.Error(t, err)
+			require.Nil(t, output)
+			assert.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestLogin_ShouldReturnValidToken(t *testing.T) {
+	// Arrange
+	sut := makeSut(t)
+	require.NoError(t, sut.Repo.Save(context.Background(), sut.User))
+
+	// Act
+	output, err := sut.UseCase.Perform(context.Background(), LoginInput{
+		Email:    sut.User.Email,
+		Password: password,
+		IP:       clientIP,
+		Device:   "Firefox",
+	})
+
+	// Assert
+	require.NoError(t, err)
+	require.NotNil(t, output)
+	assert.NotEmpty(t, output.Token)
+	assert.True(t, output.ExpiresAt.After(time.Now()))
+
+	claims, err := sut.Tokens.Validate(output.Token)
+	require.NoError(t, err)
+	assert.Equal(t, sut.User.ID, claims.UserId)
+
+	sessions := sut.SessionRepo.ByUserId(sut.User.ID)
+	require.Len(t, sessions, 1)
+	assert.Equal(t, sessions[0].ID, claims.SessionId)
+	assert.Equal(t, clientIP, sessions[0].IP)
+	assert.Equal(t, "Firefox", sessions[0].Device)
+
+	refreshToken, err := sut.SessionRepo.GetRefreshToken(context.Background(), domain.HashSecretToken(output.RefreshToken))
+	require.NoError(t, err)
+	require.NotNil(t, refreshToken)
+	assert.Equal(t, sessions[0].ID, refreshToken.SessionId)
+}
+
+func enableTwoFactor(sut SUT) {
+	now := time.Now()
+	sut.TwoFactorRepo.Save(context.Background(), &domain.TwoFactor{UserId: sut.User.ID, EnabledAt: &now})
+}
+
+func TestLogin_ShouldReturnChallenge_WhenTwoFactorIsEnabled(t *testing.T) {
+	// Arrange
+	sut := makeSut(t)
+	require.NoError(t, sut.Repo.Save(context.Background(), sut.User))
+	enableTwoFactor(sut)
+
+	// Act
+	output, err := sut.UseCase.Perform(context.Background(), LoginInput{
+		Email:    sut.User.Email,
+		Password: password,
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, output.Token)
+	assert.Empty(t, output.RefreshToken)
+	assert.NotEmpty(t, output.ChallengeToken)
+	assert.Empty(t, sut.SessionRepo.ByUserId(sut.User.ID))
+
+	challenges := sut.ChallengeRepo.ByUserId(sut.User.ID)
+	require.Len(t, challenges, 1)
+	assert.Equal(t, domain.HashSecretToken(output.ChallengeToken), challenges[0].TokenHash)
+	assert.Equal(t, challenges[0].ExpiresAt, output.ExpiresAt)
+}
+
+func TestLogin_ShouldKeepAccountFailures_UntilTwoFactorIsCompleted(t *testing.T) {
+	// Arrange
+	sut := makeSut(t)
+	require.NoError(t, sut.Repo.Save(context.Background(), sut.User))
+	enableTwoFactor(sut)
+
+	input := LoginInput{Email: sut.User.Email, Password: password}
+	fail(t, sut, input, sut.Policy.FreeFailures)
+
+	// Act
+	output, err := sut.UseCase.Perform(context.Background(), input)
+
+	// Assert
+	require.NoError(t, err)
+	assert.NotEmpty(t, output.ChallengeToken)
+
+	throttle := sut.ThrottleRepo.Throttle(domain.LoginThrottleScopeAccount, sut.User.Email)
+	require.NotNil(t, throttle)
+	assert.Equal(t, sut.Policy.FreeFailures, throttle.Failures)
+}
+
+func TestLogin_ShouldReturnToken_WhenTwoFactorIsOnlyPending(t *testing.T) {
+	// Arrange
+	sut := makeSut(t)
+	require.NoError(t, sut.Repo.Save(context.Background(), sut.User))
+	require.NoError(t, sut.TwoFactorRepo.Save(context.Background(), &domain.TwoFactor{UserId: sut.User.ID}))
+
+	// Act
+	output, err := sut.UseCase.Perform(context.Background(), LoginInput{
+		Email:    sut.User.Email,
+		Password: password,
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.NotEmpty(t, output.Token)
+	assert.Empty(t, output.ChallengeToken)
+}
+
+// fail makes n failed attempts and moves the clock of the stored throttles
+// back by the back-off, so the next attempt is judged on the count alone.
+func fail(t *testing.T, sut SUT, input LoginInput, n int) {
+	t.Helper()
+
+	input.Password = "@Wrong1234"
+	for i := 0; i < n; i++ {
+		_, err := sut.UseCase.Perform(context.Background(), input)
+		require.ErrorIs(t, err, domain.ErrAuthInvalidCredentials)
+
+		rewind(sut, domain.LoginThrottleScopeAccount, domain.NormalizeEmail(input.Email), sut.Policy.MaxDelay)
+		rewind(sut, domain.LoginThrottleScopeIP, input.IP, sut.Policy.MaxDelay)
+	}
+}
+
+func rewind(sut SUT, scope domain.LoginThrottleScope, key string, by time.Duration) {
+	throttle := sut.ThrottleRepo.Throttle(scope, key)
+	if throttle == nil {
+		return
+	}
+
+	throttle.LastFailureAt = throttle.LastFailureAt.Add(-by)
+	if throttle.LockedUntil != nil {
+		lockedUntil := throttle.LockedUntil.Add(-by)
+		throttle.LockedUntil = &lockedUntil
+	}
+}
+
+func TestLogin_ShouldBackOffAfterFreeFailures(t *testing.T) {
+	// Arrange
+	sut := makeSut(t)
+	require.NoError(t, sut.Repo.Save(context.Background(), sut.User))
+
+	input := LoginInput{Email: sut.User.Email, Password: "@Wrong1234", IP: clientIP}
+
+	// Act
+	var errs []error
+	for i := 0; i < sut.Policy.FreeFailures+2; i++ {
+		_, err := sut.UseCase.Perform(context.Background(), input)
+		errs = append(errs, err)
+	}
+
+	// Assert
+	for i := 0; i <= sut.Policy.FreeFailures; i++ {
+		assert.ErrorIs(t, errs[i], domain.ErrAuthInvalidCredentials)
+	}
+	assert.ErrorIs(t, errs[sut.Policy.FreeFailures+1], domain.ErrLoginThrottled)
+
+	throttle := sut.ThrottleRepo.Throttle(domain.LoginThrottleScopeAccount, sut.User.Email)
+	require.NotNil(t, throttle)
+	assert.Equal(t, sut.Policy.FreeFailures+1, throttle.Failures)
+	assert.Equal(t, throttle.LastFailureAt.Add(sut.Policy.BaseDelay), throttle.RetryAt(sut.Policy))
+}
+
+func TestLogin_ShouldLockAccountAndRejectCorrectPassword(t *testing.T) {
+	// Arrange
+	sut := makeSut(t)
+	require.NoError(t, sut.Repo.Save(context.Background(), sut.User))
+
+	input := LoginInput{Email: sut.User.Email, Password: password}
+	fail(t, sut, input, sut.Policy.AccountLockoutThreshold)
+
+	// Act
+	output, err := sut.UseCase.Perform(context.Background(), input)
+
+	// Assert
+	require.Nil(t, output)
+	assert.ErrorIs(t, err, domain.ErrLoginThrottled)
+
+	events, err := sut.ThrottleRepo.ListEvents(context.Background(), 10)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, domain.LockoutEventLocked, events[0].Kind)
+	assert.Equal(t, domain.LoginThrottleScopeAccount, events[0].Scope)
+	assert.Equal(t, sut.User.Email, events[0].Key)
+}
+
+func TestLogin_ShouldAcceptLoginAfterLockoutExpires(t *testing.T) {
+	// Arrange
+	sut := makeSut(t)
+	require.NoError(t, sut.Repo.Save(context.Background(), sut.User))
+
+	input := LoginInput{Email: sut.User.Email, Password: password}
+	fail(t, sut, input, sut.Policy.AccountLockoutThreshold)
+	rewind(sut, domain.LoginThrottleScopeAccount, sut.User.Email, sut.Policy.LockoutDuration)
+
+	// Act
+	output, err := sut.UseCase.Perform(context.Background(), input)
+
+	// Assert
+	require.NoError(t, err)
+	assert.NotEmpty(t, output.Token)
+	assert.Nil(t, sut.ThrottleRepo.Throttle(domain.LoginThrottleScopeAccount, sut.User.Email))
+}
+
+func TestLogin_ShouldThrottleUnknownEmailsLikeKnownOnes(t *testing.T) {
+	// Arrange
+	sut := makeSut(t)
+	require.NoError(t, sut.Repo.Save(context.Background(), sut.User))
+
+	input := LoginInput{Email: "unknown@gmail.com", Password: password}
+	fail(t, sut, input, sut.Policy.AccountLockoutThreshold)
+
+	// Act
+	_, err := sut.UseCase.Perform(context.Background(), input)
+
+	// Assert
+	assert.ErrorIs(t, err, domain.ErrLoginThrottled)
+}
+
+func TestLogin_ShouldLockClientIPAcrossAccounts(t *testing.T) {
+	// Arrange
+	sut := makeSut(t)
+	require.NoError(t, sut.Repo.Save(context.Background(), sut.User))
+
+	for i := 0; i < sut.Policy.IPLockoutThreshold; i++ {
+		fail(t, sut, LoginInput{Email: fmt.Sprintf("user%d@gmail.com", i), IP: clientIP}, 1)
+	}
+
+	// Act
+	_, fromLockedIP := sut.UseCase.Perform(context.Background(), LoginInput{
+		Email:    sut.User.Email,
+		Password: password,
+		IP:       clientIP,
+	})
+	output, fromOtherIP := sut.UseCase.Perform(context.Background(), LoginInput{
+		Email:    sut.User.Email,
+		Password: password,
+		IP:       "198.51.100.7",
+	})
+
+	// Assert
+	assert.ErrorIs(t, fromLockedIP, domain.ErrLoginThrottled)
+	require.NoError(t, fromOtherIP)
+	assert.NotEmpty(t, output.Token)
+
+	events, err := sut.ThrottleRepo.ListEvents(context.Background(), 10)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, domain.LoginThrottleScopeIP, events[0].Scope)
+	assert.Equal(t, clientIP, events[0].Key)
+}
+
+func TestLogin_ShouldResetAccountFailuresOnSuccess(t *testing.T) {
+	// Arrange
+	sut := makeSut(t)
+	require.NoError(t, sut.Repo.Save(context.Background(), sut.User))
+
+	input := LoginInput{Email: sut.User.Email, Password: password, IP: clientIP}
+	fail(t, sut, input, sut.Policy.FreeFailures)
+
+	// Act
+	_, err := sut.UseCase.Perform(context.Background(), input)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Nil(t, sut.ThrottleRepo.Throttle(domain.LoginThrottleScopeAccount, sut.User.Email))
+	assert.NotNil(t, sut.ThrottleRepo.Throttle(domain.LoginThrottleScopeIP, clientIP))
+}
+
+func TestLogin_ShouldRehashPassword_WhenHasherPrefersAnotherAlgorithm(t *testing.T) {
+	// Arrange
+	sut := makeSut(t)
+	legacyHash := sut.User.Password
+	require.NoError(t, sut.Repo.Save(context.Background(), sut.User))
+
+	argon2id, err := security.NewArgon2idPasswordHasher(security.Argon2idParams{
+		Memory:      64,
+		Iterations:  1,
+		Parallelism: 1,
+		SaltLength:  16,
+		KeyLength:   32,
+	})
+	require.NoError(t, err)
+
+	hasher := security.NewCompositePasswordHasher(argon2id, security.NewBcryptPasswordHasher())
+	usecase := NewLoginUseCase(sut.Repo, hasher, sut.Tokens, sut.ThrottleRepo, sut.TwoFactorRepo, sut.ChallengeRepo, sut.SessionRepo, sut.Policy, domain.DefaultRefreshTokenTTL)
+
+	input := LoginInput{Email: sut.User.Email, Password: password}
+
+	// Act
+	_, first := usecase.Perform(context.Background(), input)
+	_, second := usecase.Perform(context.Background(), input)
+
+	// Assert
+	require.NoError(t, first)
+	require.NoError(t, second)
+
+	user, err := sut.Repo.GetById(context.Background(), sut.User.ID)
+	require.NoError(t, err)
+	assert.NotEqual(t, legacyHash, user.Password)
+	assert.True(t, argon2id.Identifies(user.Password))
+	assert.False(t, hasher.NeedsRehash(user.Password))
+	assert.Equal(t, 0, user.TokenVersion)
+	assert.Empty(t, user.PasswordHistory)
+}
+
+func TestLogin_ShouldReturnError_WhenRehashCannotBeStored(t *testing.T) {
+	// Arrange
+	sut := makeSut(t)
+	require.NoError(t, sut.Repo.Save(context.Background(), sut.User))
+	sut.Repo.FailOnUpdate = true
+
+	hasher, err := security.NewBcryptPasswordHasherWithCost(bcrypt.DefaultCost + 1)
+	require.NoError(t, err)
+	usecase := NewLoginUseCase(sut.Repo, hasher, sut.Tokens, sut.ThrottleRepo, sut.TwoFactorRepo, sut.ChallengeRepo, sut.SessionRepo, sut.Policy, domain.DefaultRefreshTokenTTL)
+
+	// Act
+	output, err := usecase.Perform(context.Background(), LoginInput{Email: sut.User.Email, Password: password})
+
+	// Assert
+	require.Nil(t, output)
+	assert.ErrorIs(t, err, repo.ErrSimulatedFailureRepoUser)
+}
+
+// spyHasher records the hashes passwords were verified against.
+type spyHasher struct {
+	domain.UserPasswordHasher
+	verified []string
+}
+
+func (h *spyHasher) Verify(hashedPassword, password string) (bool, error) {
+	h.verified = append(h.verified, hashedPassword)
+	return h.UserPasswordHasher.Verify(hashedPassword, password)
+}
+
+func TestLogin_ShouldVerifyPassword_WhenEmailIsUnknown(t *testing.T) {
+	// Arrange
+	sut := makeSut(t)
+	require.NoError(t, sut.Repo.Save(context.Background(), sut.User))
+
+	hasher := &spyHasher{UserPasswordHasher: security.NewBcryptPasswordHasher()}
+	usecase := NewLoginUseCase(sut.Repo, hasher, sut.Tokens, sut.ThrottleRepo, sut.TwoFactorRepo, sut.ChallengeRepo, sut.SessionRepo, sut.Policy, domain.DefaultRefreshTokenTTL)
+
+	// Act
+	_, unknown := usecase.Perform(context.Background(), LoginInput{Email: "unknown@gmail.com", Password: password, IP: clientIP})
+	_, wrong := usecase.Perform(context.Background(), LoginInput{Email: sut.User.Email, Password: "@Wrong123", IP: clientIP})
+
+	// Assert
+	assert.ErrorIs(t, unknown, domain.ErrAuthInvalidCredentials)
+	assert.ErrorIs(t, wrong, domain.ErrAuthInvalidCredentials)
+
+	require.Len(t, hasher.verified, 2)
+	assert.NotEmpty(t, hasher.verified[0])
+	assert.NotEqual(t, sut.User.Password, hasher.verified[0])
+	assert.Equal(t, sut.User.Password, hasher.verified[1])
+}
+
+func TestLogin_ShouldRejectUnknownEmail_WhenPasswordMatchesDummyHash(t *testing.T) {
+	// Arrange
+	sut := makeSut(t)
+
+	// A hasher accepting any password shows a match against the dummy hash
+	// is still no login.
+	hasher := &acceptingHasher{UserPasswordHasher: security.NewBcryptPasswordHasher()}
+	usecase := NewLoginUseCase(sut.Repo, hasher, sut.Tokens, sut.ThrottleRepo, sut.TwoFactorRepo, sut.ChallengeRepo, sut.SessionRepo, sut.Policy, domain.DefaultRefreshTokenTTL)
+
+	// Act
+	output, err := usecase.Perform(context.Background(), LoginInput{Email: "unknown@gmail.com", Password: password, IP: clientIP})
+
+	// Assert
+	require.Nil(t, output)
+	assert.ErrorIs(t, err, domain.ErrAuthInvalidCredentials)
+}
+
+type acceptingHasher struct {
+	domain.UserPasswordHasher
+}
+
+func (acceptingHasher) Verify(hashedPassword, password string) (bool, error) {
+	return true, nil
+}
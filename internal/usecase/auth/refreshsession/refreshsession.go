@@ -0,0 +1,121 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+)
+
+type refreshSessionUseCase struct {
+	userRepo    domain.UserRepository
+	tokens      domain.TokenService
+	sessionRepo domain.SessionRepository
+	refreshTTL  time.Duration
+}
+
+type RefreshSessionUseCase interface {
+	Perform(ctx context.Context, input RefreshSessionInput) (*RefreshSessionOutput, error)
+}
+
+func NewRefreshSessionUseCase(
+	userRepo domain.UserRepository,
+	tokens domain.TokenService,
+	sessionRepo domain.SessionRepository,
+	refreshTTL time.Duration,
+) RefreshSessionUseCase {
+	return &refreshSessionUseCase{
+		userRepo:    userRepo,
+		tokens:      tokens,
+		sessionRepo: sessionRepo,
+		refreshTTL:  refreshTTL,
+	}
+}
+
+// Perform exchanges a refresh token for a new access token and the next
+// refresh token of the session. A refresh token is only accepted once; when
+// one comes back after being exchanged, either the client or whoever stole
+// it is replaying it, and since there is no telling which, the whole
+// session is logged out.
+func (uc *refreshSessionUseCase) Perform(ctx context.Context, input RefreshSessionInput) (*RefreshSessionOutput, error) {
+	if input.RefreshToken == "" {
+		return nil, domain.ErrRefreshTokenIsRequired
+	}
+
+	current, err := uc.sessionRepo.GetRefreshToken(ctx, domain.HashSecretToken(input.RefreshToken))
+	if err != nil && !errors.Is(err, domain.ErrRefreshTokenNotFound) {
+		return nil, err
+	}
+
+	if current == nil {
+		return nil, domain.ErrRefreshTokenInvalid
+	}
+
+	session, err := uc.sessionRepo.GetById(ctx, current.SessionId)
+	if err != nil && !errors.Is(err, domain.ErrSessionNotFound) {
+		return nil, err
+	}
+
+	if session == nil {
+		return nil, domain.ErrRefreshTokenInvalid
+	}
+
+	now := time.Now()
+
+	if current.IsRotated() {
+		return nil, uc.revoke(ctx, session, now)
+	}
+
+	if !session.IsActive(now) {
+		return nil, domain.ErrRefreshTokenInvalid
+	}
+
+	user, err := uc.userRepo.GetById(ctx, session.UserId)
+	if err != nil && !errors.Is(err, domain.ErrUserNotFound) {
+		return nil, err
+	}
+
+	if !session.BelongsTo(user) {
+		return nil, domain.ErrRefreshTokenInvalid
+	}
+
+	if !user.IsActive() {
+		return nil, domain.ErrUserDeactivated
+	}
+
+	next, secret, err := domain.NewRefreshToken(session.ID, now)
+	if err != nil {
+		return nil, err
+	}
+
+	session.Refresh(input.IP, uc.refreshTTL, now)
+
+	if err := uc.sessionRepo.Rotate(ctx, session, current, next); err != nil {
+		// Another request exchanged the same token first.
+		if errors.Is(err, domain.ErrRefreshTokenReused) {
+			return nil, uc.revoke(ctx, session, now)
+		}
+
+		return nil, err
+	}
+
+	token, claims, err := uc.tokens.Generate(user, session.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RefreshSessionOutput{
+		Token:        token,
+		RefreshToken: secret,
+		ExpiresAt:    claims.ExpiresAt,
+	}, nil
+}
+
+func (uc *refreshSessionUseCase) revoke(ctx context.Context, session *domain.Session, now time.Time) error {
+	if err := uc.sessionRepo.Revoke(ctx, session.ID, now); err != nil {
+		return err
+	}
+
+	return domain.ErrRefreshTokenReused
+}
@@ -0,0 +1,17 @@
+package auth
+
+import "time"
+
+type RefreshSessionInput struct {
+	RefreshToken string
+	// IP is the client address, recorded as the session's last seen.
+	IP string
+}
+
+// RefreshSessionOutput carries the new access token and the refresh token
+// to use next time, since the one given is no longer accepted.
+type RefreshSessionOutput struct {
+	Token        string
+	RefreshToken string
+	ExpiresAt    time.Time
+}
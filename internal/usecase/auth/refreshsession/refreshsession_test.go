@@ -0,0 +1,260 @@
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+	sessionRepo "github.com/areteacademy/internal/infra/repository/session"
+	repo "github.com/areteacademy/internal/infra/repository/user"
+	security "github.com/areteacademy/internal/infra/security"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type SUT struct {
+	UseCase      RefreshSessionUseCase
+	Repo         *repo.InMemoryUserRepository
+	SessionRepo  *sessionRepo.InMemorySessionRepository
+	Tokens       *security.JwtTokenService
+	User         *domain.User
+	Session      *domain.Session
+	RefreshToken string
+}
+
+func makeSut(t *testing.T) SUT {
+	repo := repo.NewInMemoryUserRepository()
+	sessionRepo := sessionRepo.NewInMemorySessionRepository()
+	keys, err := security.NewEphemeralJwtKeySet()
+	require.NoError(t, err)
+
+	tokens, err := security.NewJwtTokenService(security.JwtConfig{
+		Keys:       keys,
+		Issuer:     "areteacademy",
+		Expiration: 15 * time.Minute,
+	})
+	require.NoError(t, err)
+
+	usecase := NewRefreshSessionUseCase(repo, tokens, sessionRepo, time.Hour)
+
+	now := time.Now()
+
+	user := &domain.User{
+		ID:        "123456",
+		Name:      "Daniel",
+		Email:     "daniel@gmail.com",
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	session, token, secret, err := domain.NewSession(user, "Firefox", "192.0.2.1", time.Hour, now.Add(-time.Minute))
+	require.NoError(t, err)
+
+	require.NoError(t, repo.Save(context.Background(), user))
+	require.NoError(t, sessionRepo.Create(context.Background(), session, token))
+
+	return SUT{
+		UseCase:      usecase,
+		Repo:         repo,
+		SessionRepo:  sessionRepo,
+		Tokens:       tokens,
+		User:         user,
+		Session:      session,
+		RefreshToken: secret,
+	}
+}
+
+func TestRefreshSession_GivenInvalidInput_ShouldReturnError(t *testing.T) {
+	testCases := []struct {
+		name        string
+		setup       func(sut SUT)
+		input       func(sut SUT) RefreshSessionInput
+		expectedErr error
+	}{
+		{
+			name: "Empty Refresh Token",
+			input: func(sut SUT) RefreshSessionInput {
+				return RefreshSessionInput{}
+			},
+			expectedErr: domain.ErrRefreshTokenIsRequired,
+		},
+		{
+			name: "Unknown Refresh Token",
+			input: func(sut SUT) RefreshSessionInput {
+				return RefreshSessionInput{RefreshToken: "unknown"}
+			},
+			expectedErr: domain.ErrRefreshTokenInvalid,
+		},
+		{
+			name: "Session Logged Out",
+			setup: func(sut SUT) {
+				revokedAt := time.Now()
+				sut.Session.RevokedAt = &revokedAt
+			},
+			input: func(sut SUT) RefreshSessionInput {
+				return RefreshSessionInput{RefreshToken: sut.RefreshToken}
+			},
+			expectedErr: domain.ErrRefreshTokenInvalid,
+		},
+		{
+			name: "Session Expired",
+			setup: func(sut SUT) {
+				sut.Session.ExpiresAt = time.Now().Add(-time.Second)
+			},
+			input: func(sut SUT) RefreshSessionInput {
+				return RefreshSessionInput{RefreshToken: sut.RefreshToken}
+			},
+			expectedErr: domain.ErrRefreshTokenInvalid,
+		},
+		{
+			name: "Tokens Revoked Since Login",
+			setup: func(sut SUT) {
+				sut.User.RevokeTokens()
+			},
+			input: func(sut SUT) RefreshSessionInput {
+				return RefreshSessionInput{RefreshToken: sut.RefreshToken}
+			},
+			expectedErr: domain.ErrRefreshTokenInvalid,
+		},
+		{
+			name: "User Not Found",
+			setup: func(sut SUT) {
+				sut.Session.UserId = "654321"
+			},
+			input: func(sut SUT) RefreshSessionInput {
+				return RefreshSessionInput{RefreshToken: sut.RefreshToken}
+			},
+			expectedErr: domain.ErrRefreshTokenInvalid,
+		},
+		{
+			name: "User Deactivated",
+			setup: func(sut SUT) {
+				deactivatedAt := time.Now()
+				sut.User.DeactivatedAt = &deactivatedAt
+			},
+			input: func(sut SUT) RefreshSessionInput {
+				return RefreshSessionInput{RefreshToken: sut.RefreshToken}
+			},
+			expectedErr: domain.ErrUserDeactivated,
+		},
+		{
+			name: "Repo Session Fail On Get",
+			setup: func(sut SUT) {
+				sut.SessionRepo.FailOnGet = true
+			},
+			input: func(sut SUT) RefreshSessionInput {
+				return RefreshSessionInput{RefreshToken: sut.RefreshToken}
+			},
+			expectedErr: sessionRepo.ErrSimulatedFailureRepoSession,
+		},
+		{
+			name: "Repo Session Fail On Rotate",
+			setup: func(sut SUT) {
+				sut.SessionRepo.FailOnRotate = true
+			},
+			input: func(sut SUT) RefreshSessionInput {
+				return RefreshSessionInput{RefreshToken: sut.RefreshToken}
+			},
+			expectedErr: sessionRepo.ErrSimulatedFailureRepoSession,
+		},
+		{
+			name: "Repo User Fail On Get",
+			setup: func(sut SUT) {
+				sut.Repo.FailOnGet = true
+			},
+			input: func(sut SUT) RefreshSessionInput {
+				return RefreshSessionInput{RefreshToken: sut.RefreshToken}
+			},
+			expectedErr: repo.ErrSimulatedFailureRepoUser,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			sut := makeSut(t)
+
+			if tc.setup != nil {
+				tc.setup(sut)
+			}
+
+			// Act
+			output, err := sut.UseCase.Perform(context.Background(), tc.input(sut))
+
+			// Assert
+			require.Nil(t, output)
+			assert.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestRefreshSession_ShouldRotateRefreshToken(t *testing.T) {
+	// Arrange
+	sut := makeSut(t)
+
+	// Act
+	output, err := sut.UseCase.Perform(context.Background(), RefreshSessionInput{
+		RefreshToken: sut.RefreshToken,
+		IP:           "198.51.100.1",
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.NotEqual(t, sut.RefreshToken, output.RefreshToken)
+
+	claims, err := sut.Tokens.Validate(output.Token)
+	require.NoError(t, err)
+	assert.Equal(t, sut.User.ID, claims.UserId)
+	assert.Equal(t, sut.Session.ID, claims.SessionId)
+
+	old, err := sut.SessionRepo.GetRefreshToken(context.Background(), domain.HashSecretToken(sut.RefreshToken))
+	require.NoError(t, err)
+	assert.True(t, old.IsRotated())
+
+	next, err := sut.SessionRepo.GetRefreshToken(context.Background(), domain.HashSecretToken(output.RefreshToken))
+	require.NoError(t, err)
+	require.NotNil(t, next)
+	assert.Equal(t, sut.Session.ID, next.SessionId)
+
+	assert.Equal(t, "198.51.100.1", sut.Session.IP)
+	assert.WithinDuration(t, time.Now(), sut.Session.LastUsedAt, time.Second)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), sut.Session.ExpiresAt, time.Second)
+}
+
+func TestRefreshSession_ShouldRevokeSession_WhenRefreshTokenIsReused(t *testing.T) {
+	// Arrange
+	sut := makeSut(t)
+
+	first, err := sut.UseCase.Perform(context.Background(), RefreshSessionInput{RefreshToken: sut.RefreshToken})
+	require.NoError(t, err)
+
+	// Act
+	replayed, replayErr := sut.UseCase.Perform(context.Background(), RefreshSessionInput{RefreshToken: sut.RefreshToken})
+	latest, latestErr := sut.UseCase.Perform(context.Background(), RefreshSessionInput{RefreshToken: first.RefreshToken})
+
+	// Assert
+	require.Nil(t, replayed)
+	assert.ErrorIs(t, replayErr, domain.ErrRefreshTokenReused)
+	assert.True(t, sut.Session.IsRevoked())
+
+	require.Nil(t, latest)
+	assert.ErrorIs(t, latestErr, domain.ErrRefreshTokenInvalid)
+}
+
+func TestRefreshSession_ShouldReturnError_WhenReusedSessionCannotBeRevoked(t *testing.T) {
+	// Arrange
+	sut := makeSut(t)
+
+	_, err := sut.UseCase.Perform(context.Background(), RefreshSessionInput{RefreshToken: sut.RefreshToken})
+	require.NoError(t, err)
+
+	sut.SessionRepo.FailOnRevoke = true
+
+	// Act
+	output, err := sut.UseCase.Perform(context.Background(), RefreshSessionInput{RefreshToken: sut.RefreshToken})
+
+	// Assert
+	require.Nil(t, output)
+	assert.ErrorIs(t, err, sessionRepo.ErrSimulatedFailureRepoSession)
+}
@@ -0,0 +1,94 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+)
+
+type requestPasswordResetUseCase struct {
+	userRepo  domain.UserRepository
+	tokenRepo domain.PasswordResetTokenRepository
+	mailer    domain.Mailer
+	reporter  domain.ErrorReporter
+	ttl       time.Duration
+}
+
+type RequestPasswordResetUseCase interface {
+	Perform(ctx context.Context, input RequestPasswordResetInput) error
+}
+
+// NewRequestPasswordResetUseCase issues tokens valid for ttl. A ttl that is
+// not positive falls back to domain.DefaultPasswordResetTokenTTL.
+func NewRequestPasswordResetUseCase(
+	userRepo domain.UserRepository,
+	tokenRepo domain.PasswordResetTokenRepository,
+	mailer domain.Mailer,
+	reporter domain.ErrorReporter,
+	ttl time.Duration,
+) RequestPasswordResetUseCase {
+	if ttl <= 0 {
+		ttl = domain.DefaultPasswordResetTokenTTL
+	}
+
+	return &requestPasswordResetUseCase{
+		userRepo:  userRepo,
+		tokenRepo: tokenRepo,
+		mailer:    mailer,
+		reporter:  reporter,
+		ttl:       ttl,
+	}
+}
+
+// Perform succeeds without sending anything for an unknown email, so the
+// endpoint cannot be used to find out which emails are registered. Tokens
+// issued earlier for the user stop working. A mail that cannot be sent is
+// reported rather than returned, for the same reason. Answering still takes
+// longer than for an unknown email, which is accepted: it only tells that
+// the email is registered, and the login throttle's per-IP limits still
+// keep whoever learns that from guessing the password quickly.
+func (uc *requestPasswordResetUseCase) Perform(ctx context.Context, input RequestPasswordResetInput) error {
+	if input.Email == "" {
+		return domain.ErrAuthEmailIsRequired
+	}
+
+	user, err := uc.userRepo.GetByEmail(ctx, input.Email)
+	if err != nil && !errors.Is(err, domain.ErrUserNotFound) {
+		return err
+	}
+
+	if user == nil || !user.IsActive() {
+		return nil
+	}
+
+	token, secret, err := domain.NewPasswordResetToken(user.ID, uc.ttl)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.tokenRepo.InvalidateByUserId(ctx, user.ID, token.CreatedAt); err != nil {
+		return err
+	}
+
+	if err := uc.tokenRepo.Save(ctx, token); err != nil {
+		return err
+	}
+
+	err = uc.mailer.Send(ctx, domain.MailMessage{
+		To:      user.Email,
+		Subject: "Password reset",
+		Body: fmt.Sprintf(
+			"Use the token below to choose a new password. It expires at %s.\n\n%s\n",
+			token.ExpiresAt.UTC().Format(time.RFC1123),
+			secret,
+		),
+	})
+	if err != nil {
+		uc.reporter.Report(ctx, fmt.Errorf("send password reset to user %s: %w", user.ID, err))
+	}
+
+	return nil
+}
@@ -0,0 +1,5 @@
+package auth
+
+type RequestPasswordResetInput struct {
+	Email string
+}
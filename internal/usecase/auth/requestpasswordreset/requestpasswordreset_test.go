@@ -0,0 +1,208 @@
+package auth
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+	"github.com/areteacademy/internal/infra/mail"
+	"github.com/areteacademy/internal/infra/report"
+	tokenRepo "github.com/areteacademy/internal/infra/repository/passwordreset"
+	userRepo "github.com/areteacademy/internal/infra/repository/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type SUT struct {
+	UseCase   RequestPasswordResetUseCase
+	UserRepo  *userRepo.InMemoryUserRepository
+	TokenRepo *tokenRepo.InMemoryPasswordResetTokenRepository
+	Mailer    *mail.InMemoryMailer
+	Reporter  *report.InMemoryErrorReporter
+	User      *domain.User
+}
+
+func makeSut() SUT {
+	userRepo := userRepo.NewInMemoryUserRepository()
+	tokenRepo := tokenRepo.NewInMemoryPasswordResetTokenRepository()
+	mailer := mail.NewInMemoryMailer()
+	reporter := report.NewInMemoryErrorReporter()
+	usecase := NewRequestPasswordResetUseCase(userRepo, tokenRepo, mailer, reporter, time.Hour)
+
+	now := time.Now()
+
+	user := &domain.User{
+		ID:        "123456",
+		Name:      "Daniel",
+		Email:     "daniel@gmail.com",
+		Password:  "hash",
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	return SUT{
+		UseCase:   usecase,
+		UserRepo:  userRepo,
+		TokenRepo: tokenRepo,
+		Mailer:    mailer,
+		Reporter:  reporter,
+		User:      user,
+	}
+}
+
+func TestRequestPasswordReset_GivenInvalidInput_ShouldReturnError(t *testing.T) {
+	testCases := []struct {
+		name        string
+		setup       func(sut SUT)
+		email       string
+		expectedErr error
+	}{
+		{
+			name:        "Empty Email",
+			email:       "",
+			expectedErr: domain.ErrAuthEmailIsRequired,
+		},
+		{
+			name: "Repo User Fail On Get By Email",
+			setup: func(sut SUT) {
+				sut.UserRepo.FailOnGetByEmail = true
+			},
+			email:       "daniel@gmail.com",
+			expectedErr: userRepo.ErrSimulatedFailureRepoUser,
+		},
+		{
+			name: "Repo Token Fail On Invalidate",
+			setup: func(sut SUT) {
+				sut.TokenRepo.FailOnInvalidate = true
+			},
+			email:       "daniel@gmail.com",
+			expectedErr: tokenRepo.ErrSimulatedFailureRepoPasswordResetToken,
+		},
+		{
+			name: "Repo Token Fail On Save",
+			setup: func(sut SUT) {
+				sut.TokenRepo.FailOnSave = true
+			},
+			email:       "daniel@gmail.com",
+			expectedErr: tokenRepo.ErrSimulatedFailureRepoPasswordResetToken,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			sut := makeSut()
+			require.NoError(t, sut.UserRepo.Save(context.Background(), sut.User))
+
+			if tc.setup != nil {
+				tc.setup(sut)
+			}
+
+			// Act
+			err := sut.UseCase.Perform(context.Background(), RequestPasswordResetInput{Email: tc.email})
+
+			// Assert
+			assert.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestRequestPasswordReset_ShouldSucceedSilently_WhenEmailIsUnknown(t *testing.T) {
+	// Arrange
+	sut := makeSut()
+	require.NoError(t, sut.UserRepo.Save(context.Background(), sut.User))
+
+	// Act
+	err := sut.UseCase.Perform(context.Background(), RequestPasswordResetInput{Email: "maria@gmail.com"})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, sut.Mailer.Sent)
+	assert.Empty(t, sut.TokenRepo.ByUserId(sut.User.ID))
+}
+
+func TestRequestPasswordReset_ShouldSucceedSilently_WhenAccountIsDeactivated(t *testing.T) {
+	// Arrange
+	sut := makeSut()
+	require.NoError(t, sut.User.Deactivate(time.Now()))
+	require.NoError(t, sut.UserRepo.Save(context.Background(), sut.User))
+
+	// Act
+	err := sut.UseCase.Perform(context.Background(), RequestPasswordResetInput{Email: sut.User.Email})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, sut.Mailer.Sent)
+}
+
+func TestRequestPasswordReset_ShouldReportMailFailure_WithoutReturningIt(t *testing.T) {
+	// Arrange
+	sut := makeSut()
+	require.NoError(t, sut.UserRepo.Save(context.Background(), sut.User))
+	sut.Mailer.FailOnSend = true
+
+	// Act
+	err := sut.UseCase.Perform(context.Background(), RequestPasswordResetInput{Email: sut.User.Email})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, sut.Mailer.Sent)
+	require.Len(t, sut.Reporter.Reported, 1)
+	assert.ErrorIs(t, sut.Reporter.Reported[0], mail.ErrSimulatedFailureMailer)
+	assert.Len(t, sut.TokenRepo.ByUserId(sut.User.ID), 1)
+}
+
+func TestRequestPasswordReset_ShouldMailSecretAndStoreItsHash(t *testing.T) {
+	// Arrange
+	sut := makeSut()
+	require.NoError(t, sut.UserRepo.Save(context.Background(), sut.User))
+
+	// Act
+	err := sut.UseCase.Perform(context.Background(), RequestPasswordResetInput{Email: "Daniel@Gmail.com"})
+
+	// Assert
+	require.NoError(t, err)
+
+	message := sut.Mailer.Last()
+	require.NotNil(t, message)
+	assert.Equal(t, sut.User.Email, message.To)
+
+	tokens := sut.TokenRepo.ByUserId(sut.User.ID)
+	require.Len(t, tokens, 1)
+	assert.NotContains(t, message.Body, tokens[0].TokenHash)
+	assert.True(t, tokens[0].IsUsable(time.Now()))
+	assert.False(t, tokens[0].IsUsable(time.Now().Add(2*time.Hour)))
+
+	stored, err := sut.TokenRepo.GetByTokenHash(context.Background(), domain.HashSecretToken(lastLine(message.Body)))
+	require.NoError(t, err)
+	assert.Equal(t, tokens[0], stored)
+}
+
+func TestRequestPasswordReset_ShouldInvalidatePreviousTokens(t *testing.T) {
+	// Arrange
+	sut := makeSut()
+	require.NoError(t, sut.UserRepo.Save(context.Background(), sut.User))
+	require.NoError(t, sut.UseCase.Perform(context.Background(), RequestPasswordResetInput{Email: sut.User.Email}))
+
+	// Act
+	err := sut.UseCase.Perform(context.Background(), RequestPasswordResetInput{Email: sut.User.Email})
+
+	// Assert
+	require.NoError(t, err)
+
+	usable := 0
+	for _, token := range sut.TokenRepo.ByUserId(sut.User.ID) {
+		if token.IsUsable(time.Now()) {
+			usable++
+		}
+	}
+	assert.Equal(t, 1, usable)
+}
+
+func lastLine(body string) string {
+	lines := strings.Split(strings.TrimSpace(body), "\n")
+
+	return lines[len(lines)-1]
+}
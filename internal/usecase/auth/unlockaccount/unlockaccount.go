@@ -0,0 +1,65 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+)
+
+type unlockAccountUseCase struct {
+	userRepo     domain.UserRepository
+	throttleRepo domain.LoginThrottleRepository
+}
+
+type UnlockAccountUseCase interface {
+	Perform(ctx context.Context, input UnlockAccountInput) error
+}
+
+func NewUnlockAccountUseCase(
+	userRepo domain.UserRepository,
+	throttleRepo domain.LoginThrottleRepository,
+) UnlockAccountUseCase {
+	return &unlockAccountUseCase{
+		userRepo:     userRepo,
+		throttleRepo: throttleRepo,
+	}
+}
+
+// Perform clears the failed logins of the user's account before its lockout
+// runs out. Unlocking an account that is not throttled does nothing, and
+// IP throttles are left alone.
+func (uc *unlockAccountUseCase) Perform(ctx context.Context, input UnlockAccountInput) error {
+	if err := input.Actor.Authorize(domain.PermissionUsersUnlock); err != nil {
+		return err
+	}
+
+	if input.UserId == "" {
+		return domain.ErrUserIdIsRequired
+	}
+
+	user, err := uc.userRepo.GetById(ctx, input.UserId)
+	if err != nil {
+		return err
+	}
+
+	if user == nil {
+		return domain.ErrUserNotFound
+	}
+
+	throttle, err := uc.throttleRepo.Get(ctx, domain.LoginThrottleScopeAccount, user.Email)
+	if err != nil && !errors.Is(err, domain.ErrLoginThrottleNotFound) {
+		return err
+	}
+
+	if throttle == nil {
+		return nil
+	}
+
+	if err := uc.throttleRepo.Delete(ctx, throttle.Scope, throttle.Key); err != nil {
+		return err
+	}
+
+	return uc.throttleRepo.SaveEvent(ctx, domain.NewLockoutEvent(domain.LockoutEventUnlocked, throttle, time.Now()))
+}
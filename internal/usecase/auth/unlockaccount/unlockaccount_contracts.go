@@ -0,0 +1,8 @@
+package auth
+
+import "github.com/areteacademy/internal/domain"
+
+type UnlockAccountInput struct {
+	Actor  domain.Actor
+	UserId string
+}
@@ -0,0 +1,165 @@
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+	throttleRepo "github.com/areteacademy/internal/infra/repository/loginthrottle"
+	userRepo "github.com/areteacademy/internal/infra/repository/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type SUT struct {
+	UseCase      UnlockAccountUseCase
+	UserRepo     *userRepo.InMemoryUserRepository
+	ThrottleRepo *throttleRepo.InMemoryLoginThrottleRepository
+	User         *domain.User
+	Throttle     *domain.LoginThrottle
+}
+
+var admin = domain.Actor{UserId: "999999", Role: domain.RoleAdmin}
+
+func makeSut() SUT {
+	userRepo := userRepo.NewInMemoryUserRepository()
+	throttleRepo := throttleRepo.NewInMemoryLoginThrottleRepository()
+	usecase := NewUnlockAccountUseCase(userRepo, throttleRepo)
+
+	now := time.Now()
+
+	user := &domain.User{
+		ID:        "123456",
+		Name:      "Daniel",
+		Email:     "daniel@gmail.com",
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	policy := domain.DefaultLoginThrottlePolicy()
+	throttle := domain.NewLoginThrottle(domain.LoginThrottleScopeAccount, user.Email)
+	for i := 0; i < policy.AccountLockoutThreshold; i++ {
+		throttle.RecordFailure(now, policy)
+	}
+
+	return SUT{
+		UseCase:      usecase,
+		UserRepo:     userRepo,
+		ThrottleRepo: throttleRepo,
+		User:         user,
+		Throttle:     throttle,
+	}
+}
+
+func TestUnlockAccount_GivenInvalidInput_ShouldReturnError(t *testing.T) {
+	testCases := []struct {
+		name        string
+		setup       func(sut SUT)
+		input       UnlockAccountInput
+		expectedErr error
+	}{
+		{
+			name:        "Not An Admin",
+			input:       UnlockAccountInput{Actor: domain.Actor{UserId: "123456", Role: domain.RoleUser}, UserId: "123456"},
+			expectedErr: domain.ErrPermissionDenied,
+		},
+		{
+			name:        "Empty User Id",
+			input:       UnlockAccountInput{Actor: admin},
+			expectedErr: domain.ErrUserIdIsRequired,
+		},
+		{
+			name:        "User Not Found",
+			input:       UnlockAccountInput{Actor: admin, UserId: "654321"},
+			expectedErr: domain.ErrUserNotFound,
+		},
+		{
+			name: "Repo User Fail On Get",
+			setup: func(sut SUT) {
+				sut.UserRepo.FailOnGet = true
+			},
+			input:       UnlockAccountInput{Actor: admin, UserId: "123456"},
+			expectedErr: userRepo.ErrSimulatedFailureRepoUser,
+		},
+		{
+			name: "Repo Throttle Fail On Get",
+			setup: func(sut SUT) {
+				sut.ThrottleRepo.FailOnGet = true
+			},
+			input:       UnlockAccountInput{Actor: admin, UserId: "123456"},
+			expectedErr: throttleRepo.ErrSimulatedFailureRepoLoginThrottle,
+		},
+		{
+			name: "Repo Throttle Fail On Delete",
+			setup: func(sut SUT) {
+				sut.ThrottleRepo.FailOnDelete = true
+			},
+			input:       UnlockAccountInput{Actor: admin, UserId: "123456"},
+			expectedErr: throttleRepo.ErrSimulatedFailureRepoLoginThrottle,
+		},
+		{
+			name: "Repo Throttle Fail On Save Event",
+			setup: func(sut SUT) {
+				sut.ThrottleRepo.FailOnSaveEvent = true
+			},
+			input:       UnlockAccountInput{Actor: admin, UserId: "123456"},
+			expectedErr: throttleRepo.ErrSimulatedFailureRepoLoginThrottle,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			sut := makeSut()
+			require.NoError(t, sut.UserRepo.Save(context.Background(), sut.User))
+			require.NoError(t, sut.ThrottleRepo.Save(context.Background(), sut.Throttle))
+
+			if tc.setup != nil {
+				tc.setup(sut)
+			}
+
+			// Act
+			err := sut.UseCase.Perform(context.Background(), tc.input)
+
+			// Assert
+			assert.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestUnlockAccount_ShouldClearLockoutAndRecordEvent(t *testing.T) {
+	// Arrange
+	sut := makeSut()
+	require.NoError(t, sut.UserRepo.Save(context.Background(), sut.User))
+	require.NoError(t, sut.ThrottleRepo.Save(context.Background(), sut.Throttle))
+
+	// Act
+	err := sut.UseCase.Perform(context.Background(), UnlockAccountInput{Actor: admin, UserId: sut.User.ID})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Nil(t, sut.ThrottleRepo.Throttle(domain.LoginThrottleScopeAccount, sut.User.Email))
+
+	events, err := sut.ThrottleRepo.ListEvents(context.Background(), 10)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, domain.LockoutEventUnlocked, events[0].Kind)
+	assert.Equal(t, sut.User.Email, events[0].Key)
+}
+
+func TestUnlockAccount_ShouldDoNothing_WhenAccountIsNotThrottled(t *testing.T) {
+	// Arrange
+	sut := makeSut()
+	require.NoError(t, sut.UserRepo.Save(context.Background(), sut.User))
+
+	// Act
+	err := sut.UseCase.Perform(context.Background(), UnlockAccountInput{Actor: admin, UserId: sut.User.ID})
+
+	// Assert
+	require.NoError(t, err)
+
+	events, err := sut.ThrottleRepo.ListEvents(context.Background(), 10)
+	require.NoError(t, err)
+	assert.Empty(t, events)
+}
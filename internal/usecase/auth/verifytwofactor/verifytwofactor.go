@@ -0,0 +1,163 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+)
+
+type verifyTwoFactorUseCase struct {
+	userRepo      domain.UserRepository
+	tokens        domain.TokenService
+	throttleRepo  domain.LoginThrottleRepository
+	twoFactorRepo domain.TwoFactorRepository
+	challengeRepo domain.LoginChallengeRepository
+	sessionRepo   domain.SessionRepository
+	policy        domain.LoginThrottlePolicy
+	refreshTTL    time.Duration
+}
+
+type VerifyTwoFactorUseCase interface {
+	Perform(ctx context.Context, input VerifyTwoFactorInput) (*VerifyTwoFactorOutput, error)
+}
+
+func NewVerifyTwoFactorUseCase(
+	userRepo domain.UserRepository,
+	tokens domain.TokenService,
+	throttleRepo domain.LoginThrottleRepository,
+	twoFactorRepo domain.TwoFactorRepository,
+	challengeRepo domain.LoginChallengeRepository,
+	sessionRepo domain.SessionRepository,
+	policy domain.LoginThrottlePolicy,
+	refreshTTL time.Duration,
+) VerifyTwoFactorUseCase {
+	return &verifyTwoFactorUseCase{
+		userRepo:      userRepo,
+		tokens:        tokens,
+		throttleRepo:  throttleRepo,
+		twoFactorRepo: twoFactorRepo,
+		challengeRepo: challengeRepo,
+		sessionRepo:   sessionRepo,
+		policy:        policy,
+		refreshTTL:    refreshTTL,
+	}
+}
+
+// Perform completes a login started with the right password. Every wrong
+// code counts against the challenge, which stops being accepted after a few,
+// and against the account throttle the login checks, so starting new
+// challenges does not give unlimited guesses either.
+func (uc *verifyTwoFactorUseCase) Perform(ctx context.Context, input VerifyTwoFactorInput) (*VerifyTwoFactorOutput, error) {
+	if input.ChallengeToken == "" {
+		return nil, domain.ErrLoginChallengeIsRequired
+	}
+
+	if input.Code == "" {
+		return nil, domain.ErrTwoFactorCodeIsRequired
+	}
+
+	challenge, err := uc.challengeRepo.GetByTokenHash(ctx, domain.HashSecretToken(input.ChallengeToken))
+	if err != nil && !errors.Is(err, domain.ErrLoginChallengeNotFound) {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	if challenge == nil || !challenge.IsUsable(now) {
+		return nil, domain.ErrLoginChallengeInvalid
+	}
+
+	user, err := uc.userRepo.GetById(ctx, challenge.UserId)
+	if err != nil && !errors.Is(err, domain.ErrUserNotFound) {
+		return nil, err
+	}
+
+	if user == nil {
+		return nil, domain.ErrLoginChallengeInvalid
+	}
+
+	if !user.IsActive() {
+		return nil, domain.ErrUserDeactivated
+	}
+
+	twoFactor, err := uc.twoFactorRepo.GetByUserId(ctx, user.ID)
+	if err != nil && !errors.Is(err, domain.ErrTwoFactorNotFound) {
+		return nil, err
+	}
+
+	// Two-factor authentication was turned off since the challenge was
+	// issued, so it no longer says anything about who is logging in.
+	if twoFactor == nil || !twoFactor.IsEnabled() {
+		return nil, domain.ErrLoginChallengeInvalid
+	}
+
+	throttle, err := uc.throttleRepo.Get(ctx, domain.LoginThrottleScopeAccount, domain.NormalizeEmail(user.Email))
+	if err != nil && !errors.Is(err, domain.ErrLoginThrottleNotFound) {
+		return nil, err
+	}
+
+	if throttle != nil && !throttle.Allows(now, uc.policy) {
+		return nil, domain.ErrLoginThrottled
+	}
+
+	if err := domain.VerifyTwoFactorCode(ctx, uc.twoFactorRepo, twoFactor, input.Code, now); err != nil {
+		if !errors.Is(err, domain.ErrTwoFactorCodeInvalid) {
+			return nil, err
+		}
+
+		if err := uc.recordFailure(ctx, challenge, user, now); err != nil {
+			return nil, err
+		}
+
+		return nil, err
+	}
+
+	if throttle != nil {
+		if err := uc.throttleRepo.Delete(ctx, throttle.Scope, throttle.Key); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := uc.challengeRepo.Delete(ctx, challenge.ID); err != nil {
+		return nil, err
+	}
+
+	session, refreshToken, secret, err := domain.NewSession(user, input.Device, input.IP, uc.refreshTTL, now)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.sessionRepo.Create(ctx, session, refreshToken); err != nil {
+		return nil, err
+	}
+
+	token, claims, err := uc.tokens.Generate(user, session.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &VerifyTwoFactorOutput{
+		Token:        token,
+		RefreshToken: secret,
+		ExpiresAt:    claims.ExpiresAt,
+	}, nil
+}
+
+func (uc *verifyTwoFactorUseCase) recordFailure(ctx context.Context, challenge *domain.LoginChallenge, user *domain.User, now time.Time) error {
+	if err := uc.challengeRepo.RecordFailure(ctx, challenge.ID); err != nil {
+		return err
+	}
+
+	throttle, locked, err := uc.throttleRepo.RecordFailure(ctx, domain.LoginThrottleScopeAccount, domain.NormalizeEmail(user.Email), now, uc.policy)
+	if err != nil {
+		return err
+	}
+
+	if !locked {
+		return nil
+	}
+
+	return uc.throttleRepo.SaveEvent(ctx, domain.NewLockoutEvent(domain.LockoutEventLocked, throttle, now))
+}
@@ -0,0 +1,19 @@
+package auth
+
+import "time"
+
+type VerifyTwoFactorInput struct {
+	ChallengeToken string
+	// Code is either the current code of the authenticator app or one of
+	// the recovery codes.
+	Code string
+	// IP and Device describe the client the session is started for.
+	IP     string
+	Device string
+}
+
+type VerifyTwoFactorOutput struct {
+	Token        string
+	RefreshToken string
+	ExpiresAt    time.Time
+}
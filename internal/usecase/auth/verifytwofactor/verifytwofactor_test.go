@@ -0,0 +1,423 @@
+package auth
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+	challengeRepo "github.com/areteacademy/internal/infra/repository/loginchallenge"
+	throttleRepo "github.com/areteacademy/internal/infra/repository/loginthrottle"
+	sessionRepo "github.com/areteacademy/internal/infra/repository/session"
+	twoFactorRepo "github.com/areteacademy/internal/infra/repository/twofactor"
+	repo "github.com/areteacademy/internal/infra/repository/user"
+	security "github.com/areteacademy/internal/infra/security"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type SUT struct {
+	UseCase        VerifyTwoFactorUseCase
+	Repo           *repo.InMemoryUserRepository
+	ThrottleRepo   *throttleRepo.InMemoryLoginThrottleRepository
+	TwoFactorRepo  *twoFactorRepo.InMemoryTwoFactorRepository
+	ChallengeRepo  *challengeRepo.InMemoryLoginChallengeRepository
+	SessionRepo    *sessionRepo.InMemorySessionRepository
+	Tokens         *security.JwtTokenService
+	User           *domain.User
+	Policy         domain.LoginThrottlePolicy
+	TwoFactor      *domain.TwoFactor
+	RecoveryCodes  []string
+	Challenge      *domain.LoginChallenge
+	ChallengeToken string
+}
+
+func makeSut(t *testing.T) SUT {
+	repo := repo.NewInMemoryUserRepository()
+	throttleRepo := throttleRepo.NewInMemoryLoginThrottleRepository()
+	twoFactorRepo := twoFactorRepo.NewInMemoryTwoFactorRepository()
+	challengeRepo := challengeRepo.NewInMemoryLoginChallengeRepository()
+	sessionRepo := sessionRepo.NewInMemorySessionRepository()
+	keys, err := security.NewEphemeralJwtKeySet()
+	require.NoError(t, err)
+
+	tokens, err := security.NewJwtTokenService(security.JwtConfig{
+		Keys:       keys,
+		Issuer:     "areteacademy",
+		Expiration: time.Hour,
+	})
+	require.NoError(t, err)
+
+	policy := domain.LoginThrottlePolicy{
+		FreeFailures:            100,
+		BaseDelay:               time.Minute,
+		MaxDelay:                time.Minute,
+		AccountLockoutThreshold: 8,
+		IPLockoutThreshold:      100,
+		LockoutDuration:         time.Hour,
+		FailureWindow:           time.Hour,
+	}
+
+	usecase := NewVerifyTwoFactorUseCase(repo, tokens, throttleRepo, twoFactorRepo, challengeRepo, sessionRepo, policy, domain.DefaultRefreshTokenTTL)
+
+	now := time.Now()
+
+	user := &domain.User{
+		ID:        "123456",
+		Name:      "Daniel",
+		Email:     "daniel@gmail.com",
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	twoFactor, err := domain.NewTwoFactor(user.ID, now)
+	require.NoError(t, err)
+	twoFactor.Enable(0, now)
+	codes, plain := domain.NewRecoveryCodes(user.ID, now)
+
+	challenge, challengeToken, err := domain.NewLoginChallenge(user.ID, now)
+	require.NoError(t, err)
+
+	require.NoError(t, repo.Save(context.Background(), user))
+	require.NoError(t, twoFactorRepo.Enable(context.Background(), twoFactor, codes))
+	require.NoError(t, challengeRepo.Save(context.Background(), challenge))
+
+	return SUT{
+		UseCase:        usecase,
+		Repo:           repo,
+		ThrottleRepo:   throttleRepo,
+		TwoFactorRepo:  twoFactorRepo,
+		ChallengeRepo:  challengeRepo,
+		SessionRepo:    sessionRepo,
+		Tokens:         tokens,
+		User:           user,
+		Policy:         policy,
+		TwoFactor:      twoFactor,
+		RecoveryCodes:  plain,
+		Challenge:      challenge,
+		ChallengeToken: challengeToken,
+	}
+}
+
+func currentCode(t *testing.T, sut SUT) string {
+	code, err := sut.TwoFactor.CodeAt(time.Now())
+	require.NoError(t, err)
+
+	return code
+}
+
+func TestVerifyTwoFactor_GivenInvalidInput_ShouldReturnError(t *testing.T) {
+	testCases := []struct {
+		name        string
+		setup       func(sut SUT)
+		input       func(t *testing.T, sut SUT) VerifyTwoFactorInput
+		expectedErr error
+	}{
+		{
+			name: "Empty Challenge Token",
+			input: func(t *testing.T, sut SUT) VerifyTwoFactorInput {
+				return VerifyTwoFactorInput{Code: currentCode(t, sut)}
+			},
+			expectedErr: domain.ErrLoginChallengeIsRequired,
+		},
+		{
+			name: "Empty Code",
+			input: func(t *testing.T, sut SUT) VerifyTwoFactorInput {
+				return VerifyTwoFactorInput{ChallengeToken: sut.ChallengeToken}
+			},
+			expectedErr: domain.ErrTwoFactorCodeIsRequired,
+		},
+		{
+			name: "Unknown Challenge",
+			input: func(t *testing.T, sut SUT) VerifyTwoFactorInput {
+				return VerifyTwoFactorInput{ChallengeToken: "unknown", Code: currentCode(t, sut)}
+			},
+			expectedErr: domain.ErrLoginChallengeInvalid,
+		},
+		{
+			name: "Expired Challenge",
+			setup: func(sut SUT) {
+				sut.Challenge.ExpiresAt = time.Now().Add(-time.Second)
+			},
+			input: func(t *testing.T, sut SUT) VerifyTwoFactorInput {
+				return VerifyTwoFactorInput{ChallengeToken: sut.ChallengeToken, Code: currentCode(t, sut)}
+			},
+			expectedErr: domain.ErrLoginChallengeInvalid,
+		},
+		{
+			name: "Too Many Attempts",
+			setup: func(sut SUT) {
+				sut.Challenge.Attempts = 5
+			},
+			input: func(t *testing.T, sut SUT) VerifyTwoFactorInput {
+				return VerifyTwoFactorInput{ChallengeToken: sut.ChallengeToken, Code: currentCode(t, sut)}
+			},
+			expectedErr: domain.ErrLoginChallengeInvalid,
+		},
+		{
+			name: "Two Factor Disabled Since",
+			setup: func(sut SUT) {
+				sut.TwoFactorRepo.Delete(context.Background(), sut.User.ID)
+			},
+			input: func(t *testing.T, sut SUT) VerifyTwoFactorInput {
+				return VerifyTwoFactorInput{ChallengeToken: sut.ChallengeToken, Code: currentCode(t, sut)}
+			},
+			expectedErr: domain.ErrLoginChallengeInvalid,
+		},
+		{
+			name: "User Deactivated",
+			setup: func(sut SUT) {
+				deactivatedAt := time.Now()
+				sut.User.DeactivatedAt = &deactivatedAt
+			},
+			input: func(t *testing.T, sut SUT) VerifyTwoFactorInput {
+				return VerifyTwoFactorInput{ChallengeToken: sut.ChallengeToken, Code: currentCode(t, sut)}
+			},
+			expectedErr: domain.ErrUserDeactivated,
+		},
+		{
+			name: "Wrong Code",
+			input: func(t *testing.T, sut SUT) VerifyTwoFactorInput {
+				return VerifyTwoFactorInput{ChallengeToken: sut.ChallengeToken, Code: "000000x"}
+			},
+			expectedErr: domain.ErrTwoFactorCodeInvalid,
+		},
+		{
+			name: "Account Locked",
+			setup: func(sut SUT) {
+				throttle := domain.NewLoginThrottle(domain.LoginThrottleScopeAccount, sut.User.Email)
+				for range sut.Policy.AccountLockoutThreshold {
+					throttle.RecordFailure(time.Now(), sut.Policy)
+				}
+				sut.ThrottleRepo.Save(context.Background(), throttle)
+			},
+			input: func(t *testing.T, sut SUT) VerifyTwoFactorInput {
+				return VerifyTwoFactorInput{ChallengeToken: sut.ChallengeToken, Code: currentCode(t, sut)}
+			},
+			expectedErr: domain.ErrLoginThrottled,
+		},
+		{
+			name: "Repo Throttle Fail On Get",
+			setup: func(sut SUT) {
+				sut.ThrottleRepo.FailOnGet = true
+			},
+			input: func(t *testing.T, sut SUT) VerifyTwoFactorInput {
+				return VerifyTwoFactorInput{ChallengeToken: sut.ChallengeToken, Code: currentCode(t, sut)}
+			},
+			expectedErr: throttleRepo.ErrSimulatedFailureRepoLoginThrottle,
+		},
+		{
+			name: "Repo Throttle Fail On Record Failure",
+			setup: func(sut SUT) {
+				sut.ThrottleRepo.FailOnRecordFailure = true
+			},
+			input: func(t *testing.T, sut SUT) VerifyTwoFactorInput {
+				return VerifyTwoFactorInput{ChallengeToken: sut.ChallengeToken, Code: "wrong-code"}
+			},
+			expectedErr: throttleRepo.ErrSimulatedFailureRepoLoginThrottle,
+		},
+		{
+			name: "Repo Challenge Fail On Get",
+			setup: func(sut SUT) {
+				sut.ChallengeRepo.FailOnGet = true
+			},
+			input: func(t *testing.T, sut SUT) VerifyTwoFactorInput {
+				return VerifyTwoFactorInput{ChallengeToken: sut.ChallengeToken, Code: currentCode(t, sut)}
+			},
+			expectedErr: challengeRepo.ErrSimulatedFailureRepoLoginChallenge,
+		},
+		{
+			name: "Repo Challenge Fail On Record Failure",
+			setup: func(sut SUT) {
+				sut.ChallengeRepo.FailOnRecordFailure = true
+			},
+			input: func(t *testing.T, sut SUT) VerifyTwoFactorInput {
+				return VerifyTwoFactorInput{ChallengeToken: sut.ChallengeToken, Code: "wrong-code"}
+			},
+			expectedErr: challengeRepo.ErrSimulatedFailureRepoLoginChallenge,
+		},
+		{
+			name: "Repo Challenge Fail On Delete",
+			setup: func(sut SUT) {
+				sut.ChallengeRepo.FailOnDelete = true
+			},
+			input: func(t *testing.T, sut SUT) VerifyTwoFactorInput {
+				return VerifyTwoFactorInput{ChallengeToken: sut.ChallengeToken, Code: currentCode(t, sut)}
+			},
+			expectedErr: challengeRepo.ErrSimulatedFailureRepoLoginChallenge,
+		},
+		{
+			name: "Repo User Fail On Get",
+			setup: func(sut SUT) {
+				sut.Repo.FailOnGet = true
+			},
+			input: func(t *testing.T, sut SUT) VerifyTwoFactorInput {
+				return VerifyTwoFactorInput{ChallengeToken: sut.ChallengeToken, Code: currentCode(t, sut)}
+			},
+			expectedErr: repo.ErrSimulatedFailureRepoUser,
+		},
+		{
+			name: "Repo Two Factor Fail On Get",
+			setup: func(sut SUT) {
+				sut.TwoFactorRepo.FailOnGet = true
+			},
+			input: func(t *testing.T, sut SUT) VerifyTwoFactorInput {
+				return VerifyTwoFactorInput{ChallengeToken: sut.ChallengeToken, Code: currentCode(t, sut)}
+			},
+			expectedErr: twoFactorRepo.ErrSimulatedFailureRepoTwoFactor,
+		},
+		{
+			name: "Repo Session Fail On Create",
+			setup: func(sut SUT) {
+				sut.SessionRepo.FailOnCreate = true
+			},
+			input: func(t *testing.T, sut SUT) VerifyTwoFactorInput {
+				return VerifyTwoFactorInput{ChallengeToken: sut.ChallengeToken, Code: currentCode(t, sut)}
+			},
+			expectedErr: sessionRepo.ErrSimulatedFailureRepoSession,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			sut := makeSut(t)
+
+			if tc.setup != nil {
+				tc.setup(sut)
+			}
+
+			// Act
+			output, err := sut.UseCase.Perform(context.Background(), tc.input(t, sut))
+
+			// Assert
+			require.Nil(t, output)
+			assert.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestVerifyTwoFactor_ShouldReturnToken_WhenCodeIsValid(t *testing.T) {
+	// Arrange
+	sut := makeSut(t)
+	code := currentCode(t, sut)
+
+	// Act
+	output, err := sut.UseCase.Perform(context.Background(), VerifyTwoFactorInput{
+		ChallengeToken: sut.ChallengeToken,
+		Code:           code,
+		IP:             "192.0.2.1",
+		Device:         "Firefox",
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.NotEmpty(t, output.RefreshToken)
+
+	claims, err := sut.Tokens.Validate(output.Token)
+	require.NoError(t, err)
+	assert.Equal(t, sut.User.ID, claims.UserId)
+	assert.Empty(t, sut.ChallengeRepo.ByUserId(sut.User.ID))
+
+	sessions := sut.SessionRepo.ByUserId(sut.User.ID)
+	require.Len(t, sessions, 1)
+	assert.Equal(t, sessions[0].ID, claims.SessionId)
+	assert.Equal(t, "192.0.2.1", sessions[0].IP)
+}
+
+func TestVerifyTwoFactor_ShouldRejectReplayedCode(t *testing.T) {
+	// Arrange
+	sut := makeSut(t)
+	code := currentCode(t, sut)
+
+	_, err := sut.UseCase.Perform(context.Background(), VerifyTwoFactorInput{ChallengeToken: sut.ChallengeToken, Code: code})
+	require.NoError(t, err)
+
+	challenge, challengeToken, err := domain.NewLoginChallenge(sut.User.ID, time.Now())
+	require.NoError(t, err)
+	require.NoError(t, sut.ChallengeRepo.Save(context.Background(), challenge))
+
+	// Act
+	output, err := sut.UseCase.Perform(context.Background(), VerifyTwoFactorInput{ChallengeToken: challengeToken, Code: code})
+
+	// Assert
+	require.Nil(t, output)
+	assert.ErrorIs(t, err, domain.ErrTwoFactorCodeInvalid)
+	assert.Equal(t, 1, challenge.Attempts)
+}
+
+func TestVerifyTwoFactor_ShouldLockAccount_WhenCodesFailAcrossChallenges(t *testing.T) {
+	// Arrange
+	sut := makeSut(t)
+
+	// Each round starts a fresh challenge, as logging in again does, so only
+	// the account throttle can stop the guessing.
+	newChallenge := func() string {
+		challenge, token, err := domain.NewLoginChallenge(sut.User.ID, time.Now())
+		require.NoError(t, err)
+		require.NoError(t, sut.ChallengeRepo.Save(context.Background(), challenge))
+		return token
+	}
+
+	for range sut.Policy.AccountLockoutThreshold / 4 {
+		token := newChallenge()
+		for range 4 {
+			_, err := sut.UseCase.Perform(context.Background(), VerifyTwoFactorInput{ChallengeToken: token, Code: "wrong-code"})
+			require.ErrorIs(t, err, domain.ErrTwoFactorCodeInvalid)
+		}
+	}
+
+	// Act
+	output, err := sut.UseCase.Perform(context.Background(), VerifyTwoFactorInput{
+		ChallengeToken: newChallenge(),
+		Code:           currentCode(t, sut),
+	})
+
+	// Assert
+	require.Nil(t, output)
+	assert.ErrorIs(t, err, domain.ErrLoginThrottled)
+
+	events, err := sut.ThrottleRepo.ListEvents(context.Background(), 10)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, domain.LockoutEventLocked, events[0].Kind)
+	assert.Equal(t, sut.User.Email, events[0].Key)
+}
+
+func TestVerifyTwoFactor_ShouldResetAccountFailures_WhenCodeIsValid(t *testing.T) {
+	// Arrange
+	sut := makeSut(t)
+
+	_, err := sut.UseCase.Perform(context.Background(), VerifyTwoFactorInput{ChallengeToken: sut.ChallengeToken, Code: "wrong-code"})
+	require.ErrorIs(t, err, domain.ErrTwoFactorCodeInvalid)
+	require.NotNil(t, sut.ThrottleRepo.Throttle(domain.LoginThrottleScopeAccount, sut.User.Email))
+
+	// Act
+	_, err = sut.UseCase.Perform(context.Background(), VerifyTwoFactorInput{ChallengeToken: sut.ChallengeToken, Code: currentCode(t, sut)})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Nil(t, sut.ThrottleRepo.Throttle(domain.LoginThrottleScopeAccount, sut.User.Email))
+}
+
+func TestVerifyTwoFactor_ShouldAcceptRecoveryCodeOnce(t *testing.T) {
+	// Arrange
+	sut := makeSut(t)
+	code := " " + strings.ToUpper(sut.RecoveryCodes[0]) + " "
+
+	second, secondToken, err := domain.NewLoginChallenge(sut.User.ID, time.Now())
+	require.NoError(t, err)
+	require.NoError(t, sut.ChallengeRepo.Save(context.Background(), second))
+
+	// Act
+	firstOutput, firstErr := sut.UseCase.Perform(context.Background(), VerifyTwoFactorInput{ChallengeToken: sut.ChallengeToken, Code: code})
+	secondOutput, secondErr := sut.UseCase.Perform(context.Background(), VerifyTwoFactorInput{ChallengeToken: secondToken, Code: code})
+
+	// Assert
+	require.NoError(t, firstErr)
+	assert.NotEmpty(t, firstOutput.Token)
+
+	require.Nil(t, secondOutput)
+	assert.ErrorIs(t, secondErr, domain.ErrTwoFactorCodeInvalid)
+}
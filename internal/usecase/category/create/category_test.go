@@ -1,6 +1,8 @@
 package category
 
 import (
+	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -32,7 +34,7 @@ func TestCreateCategory_ShouldReturnAnError_WhenUserIdEmpty(t *testing.T) {
 	sut := makeSut()
 
 	// Act
-	category, err := sut.UseCase.Perform(CreateCategoryInput{
+	category, err := sut.UseCase.Perform(context.Background(), CreateCategoryInput{
 		UserId: "",
 		Name:   "Minha categoria",
 		Status: "ACTIVE",
@@ -43,7 +45,7 @@ func TestCreateCategory_ShouldReturnAnError_WhenUserIdEmpty(t *testing.T) {
 		t.Fatalf("expected an error, got nil")
 	}
 
-	if err != domain.ErrCategoryUserIdIsRequired {
+	if !errors.Is(err, domain.ErrCategoryUserIdIsRequired) {
 		t.Errorf("expected ErrCategoryUserIdIsRequired, got %v", err)
 	}
 
@@ -57,7 +59,7 @@ func TestCreateCategory_ShouldReturnAnError_WhenNameEmpty(t *testing.T) {
 	sut := makeSut()
 
 	// Act
-	category, err := sut.UseCase.Perform(CreateCategoryInput{
+	category, err := sut.UseCase.Perform(context.Background(), CreateCategoryInput{
 		UserId: "123456",
 		Name:   "",
 		Status: "ACTIVE",
@@ -68,7 +70,7 @@ func TestCreateCategory_ShouldReturnAnError_WhenNameEmpty(t *testing.T) {
 		t.Fatalf("expected an error, got nil")
 	}
 
-	if err != domain.ErrCategoryNameIsRequired {
+	if !errors.Is(err, domain.ErrCategoryNameIsRequired) {
 		t.Errorf("expected ErrCategoryNameIsRequired, got %v", err)
 	}
 
@@ -82,7 +84,7 @@ func TestCreateCategory_ShouldReturnAnError_WhenStatusEmpty(t *testing.T) {
 	sut := makeSut()
 
 	// Act
-	category, err := sut.UseCase.Perform(CreateCategoryInput{
+	category, err := sut.UseCase.Perform(context.Background(), CreateCategoryInput{
 		UserId: "123456",
 		Name:   "Categoria Daniel",
 		Status: "",
@@ -93,7 +95,7 @@ func TestCreateCategory_ShouldReturnAnError_WhenStatusEmpty(t *testing.T) {
 		t.Fatalf("expected an error, got nil")
 	}
 
-	if err != domain.ErrCategoryStatusIsRequired {
+	if !errors.Is(err, domain.ErrCategoryStatusIsRequired) {
 		t.Errorf("expected ErrCategoryStatusIsRequired, got %v", err)
 	}
 
@@ -107,7 +109,7 @@ func TestCreateCategory_ShouldReturnAnError_WhenStatusInvalid(t *testing.T) {
 	sut := makeSut()
 
 	// Act
-	category, err := sut.UseCase.Perform(CreateCategoryInput{
+	category, err := sut.UseCase.Perform(context.Background(), CreateCategoryInput{
 		UserId: "123456",
 		Name:   "Categoria Daniel",
 		Status: "INACTIV",
@@ -118,7 +120,7 @@ func TestCreateCategory_ShouldReturnAnError_WhenStatusInvalid(t *testing.T) {
 		t.Fatalf("expected an error, got nil")
 	}
 
-	if err != domain.ErrCategoryStatusInvalid {
+	if !errors.Is(err, domain.ErrCategoryStatusInvalid) {
 		t.Errorf("expected ErrCategoryStatusInvalid, got %v", err)
 	}
 
@@ -127,11 +129,38 @@ func TestCreateCategory_ShouldReturnAnError_WhenStatusInvalid(t *testing.T) {
 	}
 }
 
+func TestCreateCategory_ShouldReportEveryInvalidField(t *testing.T) {
+	// Arrange
+	sut := makeSut()
+
+	// Act
+	category, err := sut.UseCase.Perform(context.Background(), CreateCategoryInput{
+		UserId: "",
+		Name:   "",
+		Status: "UNKNOWN",
+	})
+
+	// Assert
+	for _, expected := range []error{
+		domain.ErrCategoryUserIdIsRequired,
+		domain.ErrCategoryNameIsRequired,
+		domain.ErrCategoryStatusInvalid,
+	} {
+		if !errors.Is(err, expected) {
+			t.Errorf("expected %v among %v", expected, err)
+		}
+	}
+
+	if category != nil {
+		t.Errorf("expected nil category, got %+v", category)
+	}
+}
+
 func TestCreateCategory_ShouldReturnAnError_WhenUserNotFound(t *testing.T) {
 	// Arrange
 	sut := makeSut()
 	now := time.Now()
-	sut.UserRepo.Save(&domain.User{
+	sut.UserRepo.Save(context.Background(), &domain.User{
 		ID:        "1234567",
 		Name:      "Daniel",
 		Email:     "daniel@gmail.com",
@@ -140,7 +169,7 @@ func TestCreateCategory_ShouldReturnAnError_WhenUserNotFound(t *testing.T) {
 	})
 
 	// Act
-	category, err := sut.UseCase.Perform(CreateCategoryInput{
+	category, err := sut.UseCase.Perform(context.Background(), CreateCategoryInput{
 		UserId: "123456",
 		Name:   "Categoria Daniel",
 		Status: "ACTIVE",
@@ -151,7 +180,7 @@ func TestCreateCategory_ShouldReturnAnError_WhenUserNotFound(t *testing.T) {
 		t.Fatalf("expected an error, got nil")
 	}
 
-	if err != domain.ErrCategoryUserNotFound {
+	if !errors.Is(err, domain.ErrCategoryUserNotFound) {
 		t.Errorf("expected ErrCategoryUserNotFound, got %v", err)
 	}
 
@@ -164,7 +193,7 @@ func TestCreateCategory_ShouldReturnAnError_WhenUserRepoFailOnGetById(t *testing
 	// Arrange
 	sut := makeSut()
 	now := time.Now()
-	sut.UserRepo.Save(&domain.User{
+	sut.UserRepo.Save(context.Background(), &domain.User{
 		ID:        "1234567",
 		Name:      "Daniel",
 		Email:     "daniel@gmail.com",
@@ -174,7 +203,7 @@ func TestCreateCategory_ShouldReturnAnError_WhenUserRepoFailOnGetById(t *testing
 	sut.UserRepo.FailOnGet = true
 
 	// Act
-	category, err := sut.UseCase.Perform(CreateCategoryInput{
+	category, err := sut.UseCase.Perform(context.Background(), CreateCategoryInput{
 		UserId: "123456",
 		Name:   "Categoria Daniel",
 		Status: "ACTIVE",
@@ -198,7 +227,7 @@ func TestCreateCategory_ShouldReturnAnError_WhenCategoryRepoFailOnSave(t *testin
 	// Arrange
 	sut := makeSut()
 	now := time.Now()
-	sut.UserRepo.Save(&domain.User{
+	sut.UserRepo.Save(context.Background(), &domain.User{
 		ID:        "123456",
 		Name:      "Daniel",
 		Email:     "daniel@gmail.com",
@@ -208,7 +237,7 @@ func TestCreateCategory_ShouldReturnAnError_WhenCategoryRepoFailOnSave(t *testin
 	sut.CategoryRepo.FailOnSave = true
 
 	// Act
-	category, err := sut.UseCase.Perform(CreateCategoryInput{
+	category, err := sut.UseCase.Perform(context.Background(), CreateCategoryInput{
 		UserId: "123456",
 		Name:   "Categoria Daniel",
 		Status: "ACTIVE",
@@ -227,7 +256,7 @@ func TestCreateCategory_ShouldReturnAnError_WhenCategoryRepoFailOnSave(t *testin
 		t.Errorf("expected nil category, got %+v", category)
 	}
 
-	count, err := sut.CategoryRepo.Count()
+	count, err := sut.CategoryRepo.Count(context.Background())
 	if err != nil {
 		t.Fatalf("unexpected error from Count: %v", err)
 	}
@@ -241,7 +270,7 @@ func TestCreateCategory_ShouldReturnSuccess(t *testing.T) {
 	// Arrange
 	sut := makeSut()
 	now := time.Now()
-	sut.UserRepo.Save(&domain.User{
+	sut.UserRepo.Save(context.Background(), &domain.User{
 		ID:        "123456",
 		Name:      "Daniel",
 		Email:     "daniel@gmail.com",
@@ -250,7 +279,7 @@ func TestCreateCategory_ShouldReturnSuccess(t *testing.T) {
 	})
 
 	// Act
-	category, err := sut.UseCase.Perform(CreateCategoryInput{
+	category, err := sut.UseCase.Perform(context.Background(), CreateCategoryInput{
 		UserId: "123456",
 		Name:   "Categoria Daniel",
 		Status: "ACTIVE",
@@ -293,7 +322,7 @@ func TestCreateCategory_ShouldReturnSuccess(t *testing.T) {
 		t.Fatalf("expected CreatedAt and UpdatedAt to be equal on creation")
 	}
 
-	count, err := sut.CategoryRepo.Count()
+	count, err := sut.CategoryRepo.Count(context.Background())
 	if err != nil {
 		t.Fatalf("unexpected error from Count: %v", err)
 	}
@@ -302,3 +331,40 @@ func TestCreateCategory_ShouldReturnSuccess(t *testing.T) {
 		t.Errorf("expected category to be saved, got %d", count)
 	}
 }
+
+func TestCreateCategory_ShouldReturnAnError_WhenContextCanceled(t *testing.T) {
+	// Arrange
+	sut := makeSut()
+
+	_ = sut.UserRepo.Save(context.Background(), &domain.User{
+		ID:        "123456",
+		Name:      "Daniel",
+		Email:     "daniel@gmail.com",
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// Act
+	category, err := sut.UseCase.Perform(ctx, CreateCategoryInput{
+		UserId: "123456",
+		Name:   "Categoria Daniel",
+		Status: "ACTIVE",
+	})
+
+	// Assert
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+
+	if category != nil {
+		t.Errorf("expected nil category, got %+v", category)
+	}
+
+	count, _ := sut.CategoryRepo.Count(context.Background())
+	if count != 0 {
+		t.Errorf("expected no category to be saved, got %d", count)
+	}
+}
@@ -1,6 +1,10 @@
 package category
 
-import "github.com/areteacademy/internal/domain"
+import (
+	"context"
+
+	"github.com/areteacademy/internal/domain"
+)
 
 type createCategoryUseCase struct {
 	categoryRepo domain.CategoryRepository
@@ -8,7 +12,7 @@ type createCategoryUseCase struct {
 }
 
 type CreateCategoryUseCase interface {
-	Perform(input CreateCategoryInput) (*CreateCategoryOutput, error)
+	Perform(ctx context.Context, input CreateCategoryInput) (*CreateCategoryOutput, error)
 }
 
 func NewCreateCategoryUseCase(categoryRepo domain.CategoryRepository, userRepo domain.UserRepository) CreateCategoryUseCase {
@@ -18,7 +22,7 @@ func NewCreateCategoryUseCase(categoryRepo domain.CategoryRepository, userRepo d
 	}
 }
 
-func (uc *createCategoryUseCase) Perform(input CreateCategoryInput) (*CreateCategoryOutput, error) {
+func (uc *createCategoryUseCase) Perform(ctx context.Context, input CreateCategoryInput) (*CreateCategoryOutput, error) {
 	category, err := domain.NewCategory(
 		input.UserId,
 		input.Name,
@@ -29,7 +33,7 @@ func (uc *createCategoryUseCase) Perform(input CreateCategoryInput) (*CreateCate
 		return nil, err
 	}
 
-	user, err := uc.userRepo.GetById(input.UserId)
+	user, err := uc.userRepo.GetById(ctx, input.UserId)
 	if err != nil {
 		return nil, err
 	}
@@ -38,7 +42,7 @@ func (uc *createCategoryUseCase) Perform(input CreateCategoryInput) (*CreateCate
 		return nil, domain.ErrCategoryUserNotFound
 	}
 
-	if err := uc.categoryRepo.Save(category); err != nil {
+	if err := uc.categoryRepo.Save(ctx, category); err != nil {
 		return nil, err
 	}
 
@@ -0,0 +1,155 @@
+package category
+
+import (
+	"context"
+	"errors"
+
+	"github.com/areteacademy/internal/domain"
+)
+
+type deleteCategoryUseCase struct {
+	unitOfWork domain.UnitOfWork
+	userRepo   domain.UserRepository
+}
+
+type DeleteCategoryUseCase interface {
+	Perform(ctx context.Context, input DeleteCategoryInput) (*DeleteCategoryOutput, error)
+}
+
+func NewDeleteCategoryUseCase(unitOfWork domain.UnitOfWork, userRepo domain.UserRepository) DeleteCategoryUseCase {
+	return &deleteCategoryUseCase{
+		unitOfWork: unitOfWork,
+		userRepo:   userRepo,
+	}
+}
+
+func (uc *deleteCategoryUseCase) Perform(ctx context.Context, input DeleteCategoryInput) (*DeleteCategoryOutput, error) {
+	if input.ID == "" {
+		return nil, domain.ErrCategoryIdIsRequired
+	}
+
+	if input.UserId == "" {
+		return nil, domain.ErrCategoryUserIdIsRequired
+	}
+
+	strategy, err := domain.NewCategoryDeleteStrategy(input.Strategy)
+	if err != nil {
+		return nil, err
+	}
+
+	if strategy == domain.CategoryDeleteStrategyReassign {
+		if input.ReassignTo == "" {
+			return nil, domain.ErrCategoryReassignIdIsRequired
+		}
+
+		if input.ReassignTo == input.ID {
+			return nil, domain.ErrCategoryReassignToSameCategory
+		}
+	}
+
+	user, err := uc.userRepo.GetById(ctx, input.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if user == nil {
+		return nil, domain.ErrCategoryUserNotFound
+	}
+
+	output := &DeleteCategoryOutput{
+		ID:       input.ID,
+		Strategy: string(strategy),
+	}
+
+	err = uc.unitOfWork.Do(ctx, func(repos domain.Repositories) error {
+		category, err := repos.Categories().GetByIdAndUserId(ctx, input.ID, input.UserId)
+		if err != nil {
+			return err
+		}
+
+		if category == nil {
+			return domain.ErrCategoryNotFound
+		}
+
+		if err := checkStrategy(ctx, repos, category, strategy, input.ReassignTo); err != nil {
+			return err
+		}
+
+		category.Delete()
+
+		affected, err := applyStrategy(ctx, repos, category, strategy, input.ReassignTo)
+		if err != nil {
+			return err
+		}
+
+		if err := repos.Categories().Delete(ctx, category); err != nil {
+			return err
+		}
+
+		output.DeletedAt = *category.DeletedAt
+		output.AffectedProducts = affected
+		if strategy == domain.CategoryDeleteStrategyReassign {
+			output.ReassignedTo = input.ReassignTo
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// checkStrategy verifies the strategy can be applied before anything is
+// changed.
+func checkStrategy(
+	ctx context.Context,
+	repos domain.Repositories,
+	category *domain.Category,
+	strategy domain.CategoryDeleteStrategy,
+	reassignTo string,
+) error {
+	switch strategy {
+	case domain.CategoryDeleteStrategyReject:
+		count, err := repos.Products().CountByCategoryId(ctx, category.ID)
+		if err != nil {
+			return err
+		}
+
+		if count > 0 {
+			return domain.ErrCategoryHasProducts
+		}
+
+	case domain.CategoryDeleteStrategyReassign:
+		target, err := repos.Categories().GetByIdAndUserId(ctx, reassignTo, category.UserId)
+		if err != nil && !errors.Is(err, domain.ErrCategoryNotFound) {
+			return err
+		}
+
+		if target == nil {
+			return domain.ErrCategoryReassignCategoryNotFound
+		}
+	}
+
+	return nil
+}
+
+func applyStrategy(
+	ctx context.Context,
+	repos domain.Repositories,
+	category *domain.Category,
+	strategy domain.CategoryDeleteStrategy,
+	reassignTo string,
+) (int, error) {
+	switch strategy {
+	case domain.CategoryDeleteStrategyCascade:
+		return repos.Products().DeleteByCategoryId(ctx, category.ID, *category.DeletedAt)
+
+	case domain.CategoryDeleteStrategyReassign:
+		return repos.Products().ReassignCategory(ctx, category.ID, reassignTo, category.UpdatedAt)
+
+	default:
+		return 0, nil
+	}
+}
@@ -0,0 +1,18 @@
+package category
+
+import "time"
+
+type DeleteCategoryInput struct {
+	ID         string
+	UserId     string
+	Strategy   string
+	ReassignTo string
+}
+
+type DeleteCategoryOutput struct {
+	ID               string
+	DeletedAt        time.Time
+	Strategy         string
+	AffectedProducts int
+	ReassignedTo     string
+}
@@ -0,0 +1,380 @@
+package category
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+	categoryRepo "github.com/areteacademy/internal/infra/repository/category"
+	productRepo "github.com/areteacademy/internal/infra/repository/product"
+	"github.com/areteacademy/internal/infra/repository/transaction"
+	userRepo "github.com/areteacademy/internal/infra/repository/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type SUT struct {
+	UseCase      DeleteCategoryUseCase
+	UnitOfWork   *transaction.InMemoryUnitOfWork
+	CategoryRepo *categoryRepo.InMemoryCategoryRepository
+	ProductRepo  *productRepo.InMemoryProductRepository
+	UserRepo     *userRepo.InMemoryUserRepository
+	User         *domain.User
+	Category     *domain.Category
+	Target       *domain.Category
+	Product      *domain.Product
+}
+
+func makeSut() SUT {
+	categoryRepo := categoryRepo.NewInMemoryCategoryRepository()
+	productRepo := productRepo.NewInMemoryProductRepository()
+	userRepo := userRepo.NewInMemoryUserRepository()
+	unitOfWork := transaction.NewInMemoryUnitOfWork(userRepo, categoryRepo, productRepo)
+	usecase := NewDeleteCategoryUseCase(unitOfWork, userRepo)
+
+	now := time.Now()
+	user := &domain.User{
+		ID:        "123456",
+		Name:      "Daniel",
+		Email:     "daniel@gmail.com",
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	category := &domain.Category{
+		ID:        "123456",
+		UserId:    user.ID,
+		Name:      "Categoria",
+		Status:    "ACTIVE",
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	target := &domain.Category{
+		ID:        "654321",
+		UserId:    user.ID,
+		Name:      "Outra categoria",
+		Status:    "ACTIVE",
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	product := &domain.Product{
+		ID:          "123456",
+		UserId:      user.ID,
+		CategoryId:  category.ID,
+		Name:        "Produto",
+		Description: "Meu Produto",
+		Status:      "ACTIVE",
+		Price:       100,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+
+	return SUT{
+		UseCase:      usecase,
+		UnitOfWork:   unitOfWork,
+		CategoryRepo: categoryRepo,
+		ProductRepo:  productRepo,
+		UserRepo:     userRepo,
+		User:         user,
+		Category:     category,
+		Target:       target,
+		Product:      product,
+	}
+}
+
+func seedDefaultData(sut SUT) {
+	sut.UserRepo.Save(context.Background(), sut.User)
+	sut.CategoryRepo.Save(context.Background(), sut.Category)
+	sut.CategoryRepo.Save(context.Background(), sut.Target)
+}
+
+func seedWithProduct(sut SUT) {
+	seedDefaultData(sut)
+	sut.ProductRepo.Save(context.Background(), sut.Product)
+}
+
+func validInput(sut SUT) DeleteCategoryInput {
+	return DeleteCategoryInput{
+		ID:     sut.Category.ID,
+		UserId: sut.User.ID,
+	}
+}
+
+func TestDeleteCategory_GivenInvalidInput_ShouldReturnError(t *testing.T) {
+	testCases := []struct {
+		name        string
+		setup       func(sut SUT)
+		input       func(sut SUT) DeleteCategoryInput
+		expectedErr error
+	}{
+		{
+			name:  "Empty ID",
+			setup: seedDefaultData,
+			input: func(sut SUT) DeleteCategoryInput {
+				in := validInput(sut)
+				in.ID = ""
+				return in
+			},
+			expectedErr: domain.ErrCategoryIdIsRequired,
+		},
+		{
+			name:  "Empty User ID",
+			setup: seedDefaultData,
+			input: func(sut SUT) DeleteCategoryInput {
+				in := validInput(sut)
+				in.UserId = ""
+				return in
+			},
+			expectedErr: domain.ErrCategoryUserIdIsRequired,
+		},
+		{
+			name: "User Not Found",
+			setup: func(sut SUT) {
+				sut.CategoryRepo.Save(context.Background(), sut.Category)
+			},
+			input:       validInput,
+			expectedErr: domain.ErrCategoryUserNotFound,
+		},
+		{
+			name:  "Category Not Found",
+			setup: seedDefaultData,
+			input: func(sut SUT) DeleteCategoryInput {
+				in := validInput(sut)
+				in.ID = "not-found"
+				return in
+			},
+			expectedErr: domain.ErrCategoryNotFound,
+		},
+		{
+			name: "Category Owned By Another User",
+			setup: func(sut SUT) {
+				seedDefaultData(sut)
+				sut.UserRepo.Save(context.Background(), &domain.User{ID: "other", Name: "Other", Email: "other@gmail.com"})
+			},
+			input: func(sut SUT) DeleteCategoryInput {
+				in := validInput(sut)
+				in.UserId = "other"
+				return in
+			},
+			expectedErr: domain.ErrCategoryNotFound,
+		},
+		{
+			name: "Category Already Deleted",
+			setup: func(sut SUT) {
+				seedDefaultData(sut)
+				sut.Category.Delete()
+			},
+			input:       validInput,
+			expectedErr: domain.ErrCategoryNotFound,
+		},
+		{
+			name:  "Invalid Strategy",
+			setup: seedDefaultData,
+			input: func(sut SUT) DeleteCategoryInput {
+				in := validInput(sut)
+				in.Strategy = "ORPHAN"
+				return in
+			},
+			expectedErr: domain.ErrCategoryDeleteStrategyInvalid,
+		},
+		{
+			name:  "Reassign Without Target",
+			setup: seedDefaultData,
+			input: func(sut SUT) DeleteCategoryInput {
+				in := validInput(sut)
+				in.Strategy = string(domain.CategoryDeleteStrategyReassign)
+				return in
+			},
+			expectedErr: domain.ErrCategoryReassignIdIsRequired,
+		},
+		{
+			name:  "Reassign To Same Category",
+			setup: seedDefaultData,
+			input: func(sut SUT) DeleteCategoryInput {
+				in := validInput(sut)
+				in.Strategy = string(domain.CategoryDeleteStrategyReassign)
+				in.ReassignTo = sut.Category.ID
+				return in
+			},
+			expectedErr: domain.ErrCategoryReassignToSameCategory,
+		},
+		{
+			name: "Reassign Target Owned By Another User",
+			setup: func(sut SUT) {
+				sut.Target.UserId = "other"
+				seedWithProduct(sut)
+			},
+			input: func(sut SUT) DeleteCategoryInput {
+				in := validInput(sut)
+				in.Strategy = string(domain.CategoryDeleteStrategyReassign)
+				in.ReassignTo = sut.Target.ID
+				return in
+			},
+			expectedErr: domain.ErrCategoryReassignCategoryNotFound,
+		},
+		{
+			name:        "Reject With Products",
+			setup:       seedWithProduct,
+			input:       validInput,
+			expectedErr: domain.ErrCategoryHasProducts,
+		},
+		{
+			name: "Unit Of Work Fail",
+			setup: func(sut SUT) {
+				seedDefaultData(sut)
+				sut.UnitOfWork.FailOnDo = true
+			},
+			input:       validInput,
+			expectedErr: transaction.ErrSimulatedFailureUnitOfWork,
+		},
+		{
+			name: "Repo Product Fail On Delete By Category",
+			setup: func(sut SUT) {
+				seedWithProduct(sut)
+				sut.ProductRepo.FailOnDeleteByCategory = true
+			},
+			input: func(sut SUT) DeleteCategoryInput {
+				in := validInput(sut)
+				in.Strategy = string(domain.CategoryDeleteStrategyCascade)
+				return in
+			},
+			expectedErr: productRepo.ErrSimulatedFailureRepoProduct,
+		},
+		{
+			name: "Repo User Fail On Get",
+			setup: func(sut SUT) {
+				seedDefaultData(sut)
+				sut.UserRepo.FailOnGet = true
+			},
+			input:       validInput,
+			expectedErr: userRepo.ErrSimulatedFailureRepoUser,
+		},
+		{
+			name: "Repo Category Fail On Get",
+			setup: func(sut SUT) {
+				seedDefaultData(sut)
+				sut.CategoryRepo.FailOnGetByIdAndUserId = true
+			},
+			input:       validInput,
+			expectedErr: categoryRepo.ErrSimulatedFailureRepoCategory,
+		},
+		{
+			name: "Repo Category Fail On Delete",
+			setup: func(sut SUT) {
+				seedDefaultData(sut)
+				sut.CategoryRepo.FailOnDelete = true
+			},
+			input:       validInput,
+			expectedErr: categoryRepo.ErrSimulatedFailureRepoCategory,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			sut := makeSut()
+
+			if tc.setup != nil {
+				tc.setup(sut)
+			}
+
+			input := tc.input(sut)
+
+			// Act
+			output, err := sut.UseCase.Perform(context.Background(), input)
+
+			// Assert
+			require.Error(t, err)
+			require.Nil(t, output)
+			assert.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestDeleteCategory_ShouldSoftDeleteCategory(t *testing.T) {
+	// Arrange
+	sut := makeSut()
+	seedDefaultData(sut)
+
+	// Act
+	output, err := sut.UseCase.Perform(context.Background(), validInput(sut))
+
+	// Assert
+	require.NoError(t, err)
+	require.NotNil(t, output)
+	assert.Equal(t, sut.Category.ID, output.ID)
+	assert.Equal(t, string(domain.CategoryDeleteStrategyReject), output.Strategy)
+	assert.Equal(t, 0, output.AffectedProducts)
+	assert.False(t, output.DeletedAt.IsZero())
+
+	category, err := sut.CategoryRepo.GetById(context.Background(), sut.Category.ID)
+	require.NoError(t, err)
+	assert.Nil(t, category)
+
+	deleted, err := sut.CategoryRepo.GetDeletedByIdAndUserId(context.Background(), sut.Category.ID, sut.User.ID)
+	require.NoError(t, err)
+	require.NotNil(t, deleted)
+}
+
+func TestDeleteCategory_ShouldKeepCategory_WhenRejectedBecauseOfProducts(t *testing.T) {
+	// Arrange
+	sut := makeSut()
+	seedWithProduct(sut)
+
+	// Act
+	_, err := sut.UseCase.Perform(context.Background(), validInput(sut))
+
+	// Assert
+	require.ErrorIs(t, err, domain.ErrCategoryHasProducts)
+
+	category, err := sut.CategoryRepo.GetById(context.Background(), sut.Category.ID)
+	require.NoError(t, err)
+	require.NotNil(t, category)
+}
+
+func TestDeleteCategory_ShouldCascadeDeleteProducts(t *testing.T) {
+	// Arrange
+	sut := makeSut()
+	seedWithProduct(sut)
+
+	input := validInput(sut)
+	input.Strategy = string(domain.CategoryDeleteStrategyCascade)
+
+	// Act
+	output, err := sut.UseCase.Perform(context.Background(), input)
+
+	// Assert
+	require.NoError(t, err)
+	require.NotNil(t, output)
+	assert.Equal(t, string(domain.CategoryDeleteStrategyCascade), output.Strategy)
+	assert.Equal(t, 1, output.AffectedProducts)
+
+	product, err := sut.ProductRepo.GetById(context.Background(), sut.Product.ID)
+	require.NoError(t, err)
+	assert.Nil(t, product)
+}
+
+func TestDeleteCategory_ShouldReassignProducts(t *testing.T) {
+	// Arrange
+	sut := makeSut()
+	seedWithProduct(sut)
+
+	input := validInput(sut)
+	input.Strategy = string(domain.CategoryDeleteStrategyReassign)
+	input.ReassignTo = sut.Target.ID
+
+	// Act
+	output, err := sut.UseCase.Perform(context.Background(), input)
+
+	// Assert
+	require.NoError(t, err)
+	require.NotNil(t, output)
+	assert.Equal(t, string(domain.CategoryDeleteStrategyReassign), output.Strategy)
+	assert.Equal(t, 1, output.AffectedProducts)
+	assert.Equal(t, sut.Target.ID, output.ReassignedTo)
+
+	product, err := sut.ProductRepo.GetById(context.Background(), sut.Product.ID)
+	require.NoError(t, err)
+	require.NotNil(t, product)
+	assert.Equal(t, sut.Target.ID, product.CategoryId)
+}
@@ -1,6 +1,8 @@
 package category
 
 import (
+	"context"
+
 	"github.com/areteacademy/internal/domain"
 )
 
@@ -10,7 +12,7 @@ type getByIdCategoryUseCase struct {
 }
 
 type GetByIdCategoryUseCase interface {
-	Perform(input GetByIdCategoryInput) (*GetByIdCategoryOutput, error)
+	Perform(ctx context.Context, input GetByIdCategoryInput) (*GetByIdCategoryOutput, error)
 }
 
 func NewGetByIdCategoryUseCase(categoryRepo domain.CategoryRepository, userRepo domain.UserRepository) GetByIdCategoryUseCase {
@@ -20,7 +22,7 @@ func NewGetByIdCategoryUseCase(categoryRepo domain.CategoryRepository, userRepo
 	}
 }
 
-func (uc *getByIdCategoryUseCase) Perform(input GetByIdCategoryInput) (*GetByIdCategoryOutput, error) {
+func (uc *getByIdCategoryUseCase) Perform(ctx context.Context, input GetByIdCategoryInput) (*GetByIdCategoryOutput, error) {
 	if input.ID == "" {
 		return nil, domain.ErrCategoryIdIsRequired
 	}
@@ -29,7 +31,7 @@ func (uc *getByIdCategoryUseCase) Perform(input GetByIdCategoryInput) (*GetByIdC
 		return nil, domain.ErrCategoryUserIdIsRequired
 	}
 
-	user, err := uc.userRepo.GetById(input.UserId)
+	user, err := uc.userRepo.GetById(ctx, input.UserId)
 	if err != nil {
 		return nil, err
 	}
@@ -38,7 +40,7 @@ func (uc *getByIdCategoryUseCase) Perform(input GetByIdCategoryInput) (*GetByIdC
 		return nil, domain.ErrCategoryUserNotFound
 	}
 
-	category, err := uc.categoryRepo.GetById(input.UserId)
+	category, err := uc.categoryRepo.GetById(ctx, input.ID)
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,7 @@
 package category
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -32,7 +33,7 @@ func TestGetByIdCategory_ShouldReturnAnError_WhenIdEmpty(t *testing.T) {
 	sut := makeSut()
 
 	// Act
-	category, err := sut.UseCase.Perform(GetByIdCategoryInput{
+	category, err := sut.UseCase.Perform(context.Background(), GetByIdCategoryInput{
 		ID:     "",
 		UserId: "123456",
 	})
@@ -56,7 +57,7 @@ func TestGetByIdCategory_ShouldReturnAnError_WhenUserIdEmpty(t *testing.T) {
 	sut := makeSut()
 
 	// Act
-	category, err := sut.UseCase.Perform(GetByIdCategoryInput{
+	category, err := sut.UseCase.Perform(context.Background(), GetByIdCategoryInput{
 		ID:     "123456",
 		UserId: "",
 	})
@@ -79,7 +80,7 @@ func TestGetByIdCategory_ShouldReturnAnError_WhenUserNotFound(t *testing.T) {
 	// Arrange
 	sut := makeSut()
 	now := time.Now()
-	sut.UserRepo.Save(&domain.User{
+	sut.UserRepo.Save(context.Background(), &domain.User{
 		ID:        "1234567",
 		Name:      "Daniel",
 		Email:     "daniel@gmail.com",
@@ -88,7 +89,7 @@ func TestGetByIdCategory_ShouldReturnAnError_WhenUserNotFound(t *testing.T) {
 	})
 
 	// Act
-	category, err := sut.UseCase.Perform(GetByIdCategoryInput{
+	category, err := sut.UseCase.Perform(context.Background(), GetByIdCategoryInput{
 		ID:     "123456",
 		UserId: "123456",
 	})
@@ -111,7 +112,7 @@ func TestGetByIdCategory_ShouldReturnAnError_WhenCategoryNotFound(t *testing.T)
 	// Arrange
 	sut := makeSut()
 	now := time.Now()
-	sut.UserRepo.Save(&domain.User{
+	sut.UserRepo.Save(context.Background(), &domain.User{
 		ID:        "123456",
 		Name:      "Daniel",
 		Email:     "daniel@gmail.com",
@@ -119,7 +120,7 @@ func TestGetByIdCategory_ShouldReturnAnError_WhenCategoryNotFound(t *testing.T)
 		UpdatedAt: now,
 	})
 
-	sut.CategoryRepo.Save(&domain.Category{
+	sut.CategoryRepo.Save(context.Background(), &domain.Category{
 		ID:        "1234567",
 		UserId:    "123456",
 		Name:      "Categoria Daniel",
@@ -129,7 +130,7 @@ func TestGetByIdCategory_ShouldReturnAnError_WhenCategoryNotFound(t *testing.T)
 	})
 
 	// Act
-	category, err := sut.UseCase.Perform(GetByIdCategoryInput{
+	category, err := sut.UseCase.Perform(context.Background(), GetByIdCategoryInput{
 		ID:     "123456",
 		UserId: "123456",
 	})
@@ -152,7 +153,7 @@ func TestGetByIdCategory_ShouldReturnAnError_WhenUserRepoFailOnGetById(t *testin
 	// Arrange
 	sut := makeSut()
 	now := time.Now()
-	sut.UserRepo.Save(&domain.User{
+	sut.UserRepo.Save(context.Background(), &domain.User{
 		ID:        "123456",
 		Name:      "Daniel",
 		Email:     "daniel@gmail.com",
@@ -162,7 +163,7 @@ func TestGetByIdCategory_ShouldReturnAnError_WhenUserRepoFailOnGetById(t *testin
 	sut.UserRepo.FailOnGet = true
 
 	// Act
-	category, err := sut.UseCase.Perform(GetByIdCategoryInput{
+	category, err := sut.UseCase.Perform(context.Background(), GetByIdCategoryInput{
 		ID:     "123456",
 		UserId: "123456",
 	})
@@ -185,7 +186,7 @@ func TestGetByIdCategory_ShouldReturnAnError_WhenCategoryRepoFailOnGetById(t *te
 	// Arrange
 	sut := makeSut()
 	now := time.Now()
-	sut.UserRepo.Save(&domain.User{
+	sut.UserRepo.Save(context.Background(), &domain.User{
 		ID:        "123456",
 		Name:      "Daniel",
 		Email:     "daniel@gmail.com",
@@ -193,7 +194,7 @@ func TestGetByIdCategory_ShouldReturnAnError_WhenCategoryRepoFailOnGetById(t *te
 		UpdatedAt: now,
 	})
 
-	sut.CategoryRepo.Save(&domain.Category{
+	sut.CategoryRepo.Save(context.Background(), &domain.Category{
 		ID:        "123456",
 		UserId:    "123456",
 		Name:      "Categoria Daniel",
@@ -204,7 +205,7 @@ func TestGetByIdCategory_ShouldReturnAnError_WhenCategoryRepoFailOnGetById(t *te
 	sut.CategoryRepo.FailOnGetById = true
 
 	// Act
-	category, err := sut.UseCase.Perform(GetByIdCategoryInput{
+	category, err := sut.UseCase.Perform(context.Background(), GetByIdCategoryInput{
 		ID:     "123456",
 		UserId: "123456",
 	})
@@ -227,7 +228,7 @@ func TestGetByIdCategory_ShouldReturnAnError_WhenCategoryNotOwner(t *testing.T)
 	// Arrange
 	sut := makeSut()
 	now := time.Now()
-	sut.UserRepo.Save(&domain.User{
+	sut.UserRepo.Save(context.Background(), &domain.User{
 		ID:        "123456",
 		Name:      "Daniel",
 		Email:     "daniel@gmail.com",
@@ -235,7 +236,7 @@ func TestGetByIdCategory_ShouldReturnAnError_WhenCategoryNotOwner(t *testing.T)
 		UpdatedAt: now,
 	})
 
-	sut.UserRepo.Save(&domain.User{
+	sut.UserRepo.Save(context.Background(), &domain.User{
 		ID:        "1234567",
 		Name:      "Daniel 2",
 		Email:     "daniel@gmail.com",
@@ -243,7 +244,7 @@ func TestGetByIdCategory_ShouldReturnAnError_WhenCategoryNotOwner(t *testing.T)
 		UpdatedAt: now,
 	})
 
-	sut.CategoryRepo.Save(&domain.Category{
+	sut.CategoryRepo.Save(context.Background(), &domain.Category{
 		ID:        "123456",
 		UserId:    "1234567",
 		Name:      "Categoria Daniel",
@@ -253,7 +254,7 @@ func TestGetByIdCategory_ShouldReturnAnError_WhenCategoryNotOwner(t *testing.T)
 	})
 
 	// Act
-	category, err := sut.UseCase.Perform(GetByIdCategoryInput{
+	category, err := sut.UseCase.Perform(context.Background(), GetByIdCategoryInput{
 		ID:     "123456",
 		UserId: "123456",
 	})
@@ -276,7 +277,7 @@ func TestGetByIdCategory_ShouldReturnSuccess(t *testing.T) {
 	// Arrange
 	sut := makeSut()
 	now := time.Now()
-	sut.UserRepo.Save(&domain.User{
+	sut.UserRepo.Save(context.Background(), &domain.User{
 		ID:        "123456",
 		Name:      "Daniel",
 		Email:     "daniel@gmail.com",
@@ -284,7 +285,7 @@ func TestGetByIdCategory_ShouldReturnSuccess(t *testing.T) {
 		UpdatedAt: now,
 	})
 
-	sut.CategoryRepo.Save(&domain.Category{
+	sut.CategoryRepo.Save(context.Background(), &domain.Category{
 		ID:        "123456",
 		Name:      "Categoria Daniel",
 		UserId:    "123456",
@@ -294,7 +295,7 @@ func TestGetByIdCategory_ShouldReturnSuccess(t *testing.T) {
 	})
 
 	// Act
-	category, err := sut.UseCase.Perform(GetByIdCategoryInput{
+	category, err := sut.UseCase.Perform(context.Background(), GetByIdCategoryInput{
 		ID:     "123456",
 		UserId: "123456",
 	})
@@ -1,6 +1,8 @@
 package category
 
 import (
+	"context"
+
 	"github.com/areteacademy/internal/domain"
 )
 
@@ -10,7 +12,7 @@ type listByUserIdCategoryUseCase struct {
 }
 
 type ListByUserIdCategoryUseCase interface {
-	Perform(userId string) (ListUserByIdCategoryOutput, error)
+	Perform(ctx context.Context, input ListByUserIdCategoryInput) (*ListUserByIdCategoryOutput, error)
 }
 
 func NewListByUserIdCategoryUseCase(
@@ -23,12 +25,17 @@ func NewListByUserIdCategoryUseCase(
 	}
 }
 
-func (uc *listByUserIdCategoryUseCase) Perform(userId string) (ListUserByIdCategoryOutput, error) {
-	if userId == "" {
+func (uc *listByUserIdCategoryUseCase) Perform(ctx context.Context, input ListByUserIdCategoryInput) (*ListUserByIdCategoryOutput, error) {
+	if input.UserId == "" {
 		return nil, domain.ErrCategoryUserIdIsRequired
 	}
 
-	user, err := uc.userRepo.GetById(userId)
+	request, err := domain.NewPageRequest(input.Limit, input.After, input.Before, domain.DefaultSort)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := uc.userRepo.GetById(ctx, input.UserId)
 	if err != nil {
 		return nil, err
 	}
@@ -37,15 +44,19 @@ func (uc *listByUserIdCategoryUseCase) Perform(userId string) (ListUserByIdCateg
 		return nil, domain.ErrCategoryUserNotFound
 	}
 
-	categories, err := uc.categoryRepo.ListByUserId(userId)
+	page, err := uc.categoryRepo.ListByUserId(ctx, input.UserId, request)
 	if err != nil {
 		return nil, err
 	}
 
-	output := make(ListUserByIdCategoryOutput, 0, len(categories))
+	output := &ListUserByIdCategoryOutput{
+		Items:          make([]CategoryItem, 0, len(page.Items)),
+		NextCursor:     page.NextCursor(),
+		PreviousCursor: page.PreviousCursor(),
+	}
 
-	for _, c := range categories {
-		output = append(output, CategoryItem{
+	for _, c := range page.Items {
+		output.Items = append(output.Items, CategoryItem{
 			ID:        c.ID,
 			UserId:    c.UserId,
 			Name:      c.Name,
@@ -11,4 +11,15 @@ type CategoryItem struct {
 	UpdatedAt time.Time
 }
 
-type ListUserByIdCategoryOutput []CategoryItem
+type ListByUserIdCategoryInput struct {
+	UserId string
+	Limit  int
+	After  string
+	Before string
+}
+
+type ListUserByIdCategoryOutput struct {
+	Items          []CategoryItem
+	NextCursor     string
+	PreviousCursor string
+}
@@ -1,6 +1,9 @@
 package category
 
 import (
+	"context"
+	"errors"
+	"slices"
 	"testing"
 	"time"
 
@@ -31,7 +34,7 @@ func TestListByUserIdCategory_shouldReturnAnError_WhenUserIdEmpty(t *testing.T)
 	// Arrange
 	sut := makeSut()
 	now := time.Now()
-	sut.UserRepo.Save(&domain.User{
+	sut.UserRepo.Save(context.Background(), &domain.User{
 		ID:        "123456",
 		Name:      "Daniel",
 		Email:     "daniel@gmail.com",
@@ -39,7 +42,7 @@ func TestListByUserIdCategory_shouldReturnAnError_WhenUserIdEmpty(t *testing.T)
 		UpdatedAt: now,
 	})
 
-	sut.CategoryRepo.Save(&domain.Category{
+	sut.CategoryRepo.Save(context.Background(), &domain.Category{
 		ID:        "123456",
 		Name:      "Categoria Daniel",
 		UserId:    "123456",
@@ -49,7 +52,7 @@ func TestListByUserIdCategory_shouldReturnAnError_WhenUserIdEmpty(t *testing.T)
 	})
 
 	// Act
-	categories, err := sut.UseCase.Perform("")
+	categories, err := sut.UseCase.Perform(context.Background(), ListByUserIdCategoryInput{UserId: ""})
 
 	// Assert
 	if err == nil {
@@ -69,7 +72,7 @@ func TestListByUserIdCategory_ShouldReturnAnError_WhenUserNotFound(t *testing.T)
 	// Arrange
 	sut := makeSut()
 	now := time.Now()
-	sut.UserRepo.Save(&domain.User{
+	sut.UserRepo.Save(context.Background(), &domain.User{
 		ID:        "1234567",
 		Name:      "Daniel",
 		Email:     "daniel@gmail.com",
@@ -77,7 +80,7 @@ func TestListByUserIdCategory_ShouldReturnAnError_WhenUserNotFound(t *testing.T)
 		UpdatedAt: now,
 	})
 
-	sut.CategoryRepo.Save(&domain.Category{
+	sut.CategoryRepo.Save(context.Background(), &domain.Category{
 		ID:        "123456",
 		Name:      "Categoria Daniel",
 		UserId:    "123456",
@@ -87,7 +90,7 @@ func TestListByUserIdCategory_ShouldReturnAnError_WhenUserNotFound(t *testing.T)
 	})
 
 	// Act
-	categories, err := sut.UseCase.Perform("123456")
+	categories, err := sut.UseCase.Perform(context.Background(), ListByUserIdCategoryInput{UserId: "123456"})
 
 	// Assert
 	if err == nil {
@@ -107,7 +110,7 @@ func TestLisByUserIdCategory_ShouldReturnAnError_WhenUserRepoFailOnGetById(t *te
 	// Arrange
 	sut := makeSut()
 	now := time.Now()
-	sut.UserRepo.Save(&domain.User{
+	sut.UserRepo.Save(context.Background(), &domain.User{
 		ID:        "123456",
 		Name:      "Daniel",
 		Email:     "daniel@gmail.com",
@@ -116,7 +119,7 @@ func TestLisByUserIdCategory_ShouldReturnAnError_WhenUserRepoFailOnGetById(t *te
 	})
 	sut.UserRepo.FailOnGet = true
 
-	sut.CategoryRepo.Save(&domain.Category{
+	sut.CategoryRepo.Save(context.Background(), &domain.Category{
 		ID:        "123456",
 		Name:      "Categoria Daniel",
 		UserId:    "123456",
@@ -126,7 +129,7 @@ func TestLisByUserIdCategory_ShouldReturnAnError_WhenUserRepoFailOnGetById(t *te
 	})
 
 	// Act
-	categories, err := sut.UseCase.Perform("123456")
+	categories, err := sut.UseCase.Perform(context.Background(), ListByUserIdCategoryInput{UserId: "123456"})
 
 	// Assert
 	if err == nil {
@@ -146,7 +149,7 @@ func TestLisByUserIdCategory_ShouldReturnAnError_WhenCategoryRepoFailOnListByUse
 	// Arrange
 	sut := makeSut()
 	now := time.Now()
-	sut.UserRepo.Save(&domain.User{
+	sut.UserRepo.Save(context.Background(), &domain.User{
 		ID:        "123456",
 		Name:      "Daniel",
 		Email:     "daniel@gmail.com",
@@ -154,7 +157,7 @@ func TestLisByUserIdCategory_ShouldReturnAnError_WhenCategoryRepoFailOnListByUse
 		UpdatedAt: now,
 	})
 
-	sut.CategoryRepo.Save(&domain.Category{
+	sut.CategoryRepo.Save(context.Background(), &domain.Category{
 		ID:        "123456",
 		Name:      "Categoria Daniel",
 		UserId:    "123456",
@@ -165,7 +168,7 @@ func TestLisByUserIdCategory_ShouldReturnAnError_WhenCategoryRepoFailOnListByUse
 	sut.CategoryRepo.FailOnList = true
 
 	// Act
-	categories, err := sut.UseCase.Perform("123456")
+	categories, err := sut.UseCase.Perform(context.Background(), ListByUserIdCategoryInput{UserId: "123456"})
 
 	// Assert
 	if err == nil {
@@ -185,7 +188,7 @@ func TestLisByUserIdCategory_ShouldReturnSuccess(t *testing.T) {
 	// Arrange
 	sut := makeSut()
 	now := time.Now()
-	sut.UserRepo.Save(&domain.User{
+	sut.UserRepo.Save(context.Background(), &domain.User{
 		ID:        "123456",
 		Name:      "Daniel",
 		Email:     "daniel@gmail.com",
@@ -193,7 +196,7 @@ func TestLisByUserIdCategory_ShouldReturnSuccess(t *testing.T) {
 		UpdatedAt: now,
 	})
 
-	sut.CategoryRepo.Save(&domain.Category{
+	sut.CategoryRepo.Save(context.Background(), &domain.Category{
 		ID:        "cat1",
 		Name:      "cat1",
 		UserId:    "123456",
@@ -201,7 +204,7 @@ func TestLisByUserIdCategory_ShouldReturnSuccess(t *testing.T) {
 		CreatedAt: now,
 		UpdatedAt: now,
 	})
-	sut.CategoryRepo.Save(&domain.Category{
+	sut.CategoryRepo.Save(context.Background(), &domain.Category{
 		ID:        "cat2",
 		Name:      "cat2",
 		UserId:    "123456",
@@ -209,7 +212,7 @@ func TestLisByUserIdCategory_ShouldReturnSuccess(t *testing.T) {
 		CreatedAt: now,
 		UpdatedAt: now,
 	})
-	sut.CategoryRepo.Save(&domain.Category{
+	sut.CategoryRepo.Save(context.Background(), &domain.Category{
 		ID:        "cat3",
 		Name:      "cat3",
 		UserId:    "999999",
@@ -219,7 +222,7 @@ func TestLisByUserIdCategory_ShouldReturnSuccess(t *testing.T) {
 	})
 
 	// Act
-	categories, err := sut.UseCase.Perform("123456")
+	categories, err := sut.UseCase.Perform(context.Background(), ListByUserIdCategoryInput{UserId: "123456"})
 
 	// Assert
 	if err != nil {
@@ -230,11 +233,11 @@ func TestLisByUserIdCategory_ShouldReturnSuccess(t *testing.T) {
 		t.Fatalf("expected categories, got nil")
 	}
 
-	if len(categories) != 2 {
-		t.Errorf("expected two categories, got %d", len(categories))
+	if len(categories.Items) != 2 {
+		t.Errorf("expected two categories, got %d", len(categories.Items))
 	}
 
-	for _, c := range categories {
+	for _, c := range categories.Items {
 		if c.UserId != "123456" {
 			t.Errorf("expected category to belong to user 123456, got %s", c.UserId)
 		}
@@ -256,3 +259,150 @@ func TestLisByUserIdCategory_ShouldReturnSuccess(t *testing.T) {
 		}
 	}
 }
+
+func TestListByUserIdCategory_ShouldReturnAnError_WhenPageRequestInvalid(t *testing.T) {
+	testCases := []struct {
+		name        string
+		input       ListByUserIdCategoryInput
+		expectedErr error
+	}{
+		{
+			name:        "Negative limit",
+			input:       ListByUserIdCategoryInput{UserId: "123456", Limit: -1},
+			expectedErr: domain.ErrPageSizeInvalid,
+		},
+		{
+			name:        "Limit above maximum",
+			input:       ListByUserIdCategoryInput{UserId: "123456", Limit: domain.MaxPageSize + 1},
+			expectedErr: domain.ErrPageSizeInvalid,
+		},
+		{
+			name:        "Malformed cursor",
+			input:       ListByUserIdCategoryInput{UserId: "123456", After: "not-a-cursor"},
+			expectedErr: domain.ErrPageCursorInvalid,
+		},
+		{
+			name: "Both cursors",
+			input: ListByUserIdCategoryInput{
+				UserId: "123456",
+				After:  domain.Cursor{Sort: domain.DefaultSort, Time: time.Now(), ID: "cat1"}.Encode(),
+				Before: domain.Cursor{Sort: domain.DefaultSort, Time: time.Now(), ID: "cat2"}.Encode(),
+			},
+			expectedErr: domain.ErrPageCursorConflict,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			sut := makeSut()
+
+			// Act
+			categories, err := sut.UseCase.Perform(context.Background(), tc.input)
+
+			// Assert
+			if !errors.Is(err, tc.expectedErr) {
+				t.Fatalf("expected %v, got %v", tc.expectedErr, err)
+			}
+
+			if categories != nil {
+				t.Errorf("expected nil categories, got %+v", categories)
+			}
+		})
+	}
+}
+
+func TestListByUserIdCategory_ShouldPaginateWithCursors(t *testing.T) {
+	// Arrange
+	sut := makeSut()
+	now := time.Now()
+	sut.UserRepo.Save(context.Background(), &domain.User{
+		ID:        "123456",
+		Name:      "Daniel",
+		Email:     "daniel@gmail.com",
+		CreatedAt: now,
+		UpdatedAt: now,
+	})
+
+	for i, id := range []string{"cat1", "cat2", "cat3", "cat4", "cat5"} {
+		createdAt := now.Add(time.Duration(i) * time.Minute)
+		sut.CategoryRepo.Save(context.Background(), &domain.Category{
+			ID:        id,
+			Name:      id,
+			UserId:    "123456",
+			Status:    "ACTIVE",
+			CreatedAt: createdAt,
+			UpdatedAt: createdAt,
+		})
+	}
+
+	ids := func(output *ListUserByIdCategoryOutput) []string {
+		result := make([]string, 0, len(output.Items))
+		for _, c := range output.Items {
+			result = append(result, c.ID)
+		}
+		return result
+	}
+
+	// Act
+	first, err := sut.UseCase.Perform(context.Background(), ListByUserIdCategoryInput{UserId: "123456", Limit: 2})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	second, err := sut.UseCase.Perform(context.Background(), ListByUserIdCategoryInput{
+		UserId: "123456",
+		Limit:  2,
+		After:  first.NextCursor,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	last, err := sut.UseCase.Perform(context.Background(), ListByUserIdCategoryInput{
+		UserId: "123456",
+		Limit:  2,
+		After:  second.NextCursor,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	back, err := sut.UseCase.Perform(context.Background(), ListByUserIdCategoryInput{
+		UserId: "123456",
+		Limit:  2,
+		Before: last.PreviousCursor,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Assert
+	if got := ids(first); !slices.Equal(got, []string{"cat1", "cat2"}) {
+		t.Errorf("expected first page [cat1 cat2], got %v", got)
+	}
+
+	if first.PreviousCursor != "" || first.NextCursor == "" {
+		t.Errorf("expected only a next cursor on the first page, got %+v", first)
+	}
+
+	if got := ids(second); !slices.Equal(got, []string{"cat3", "cat4"}) {
+		t.Errorf("expected second page [cat3 cat4], got %v", got)
+	}
+
+	if second.PreviousCursor == "" || second.NextCursor == "" {
+		t.Errorf("expected both cursors on the second page, got %+v", second)
+	}
+
+	if got := ids(last); !slices.Equal(got, []string{"cat5"}) {
+		t.Errorf("expected last page [cat5], got %v", got)
+	}
+
+	if last.NextCursor != "" {
+		t.Errorf("expected no next cursor on the last page, got %q", last.NextCursor)
+	}
+
+	if got := ids(back); !slices.Equal(got, []string{"cat3", "cat4"}) {
+		t.Errorf("expected previous page [cat3 cat4], got %v", got)
+	}
+}
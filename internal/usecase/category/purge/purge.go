@@ -0,0 +1,72 @@
+package category
+
+import (
+	"context"
+
+	"github.com/areteacademy/internal/domain"
+)
+
+type purgeCategoryUseCase struct {
+	unitOfWork domain.UnitOfWork
+	userRepo   domain.UserRepository
+}
+
+// PurgeCategoryUseCase permanently removes a category that was previously
+// soft deleted, along with its soft deleted products, which could not be
+// restored without it. Active categories must be deleted first.
+type PurgeCategoryUseCase interface {
+	Perform(ctx context.Context, input PurgeCategoryInput) error
+}
+
+func NewPurgeCategoryUseCase(unitOfWork domain.UnitOfWork, userRepo domain.UserRepository) PurgeCategoryUseCase {
+	return &purgeCategoryUseCase{
+		unitOfWork: unitOfWork,
+		userRepo:   userRepo,
+	}
+}
+
+func (uc *purgeCategoryUseCase) Perform(ctx context.Context, input PurgeCategoryInput) error {
+	if input.ID == "" {
+		return domain.ErrCategoryIdIsRequired
+	}
+
+	if input.UserId == "" {
+		return domain.ErrCategoryUserIdIsRequired
+	}
+
+	user, err := uc.userRepo.GetById(ctx, input.UserId)
+	if err != nil {
+		return err
+	}
+
+	if user == nil {
+		return domain.ErrCategoryUserNotFound
+	}
+
+	return uc.unitOfWork.Do(ctx, func(repos domain.Repositories) error {
+		category, err := repos.Categories().GetDeletedByIdAndUserId(ctx, input.ID, input.UserId)
+		if err != nil {
+			return err
+		}
+
+		if category == nil {
+			return domain.ErrCategoryNotFound
+		}
+
+		// Products still active would be left pointing at nothing.
+		count, err := repos.Products().CountByCategoryId(ctx, category.ID)
+		if err != nil {
+			return err
+		}
+
+		if count > 0 {
+			return domain.ErrCategoryHasProducts
+		}
+
+		if _, err := repos.Products().PurgeByCategoryId(ctx, category.ID); err != nil {
+			return err
+		}
+
+		return repos.Categories().Purge(ctx, category.ID)
+	})
+}
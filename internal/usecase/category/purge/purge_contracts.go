@@ -0,0 +1,6 @@
+package category
+
+type PurgeCategoryInput struct {
+	ID     string
+	UserId string
+}
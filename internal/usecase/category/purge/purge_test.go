@@ -0,0 +1,247 @@
+package category
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+	categoryRepo "github.com/areteacademy/internal/infra/repository/category"
+	productRepo "github.com/areteacademy/internal/infra/repository/product"
+	"github.com/areteacademy/internal/infra/repository/transaction"
+	userRepo "github.com/areteacademy/internal/infra/repository/user"
+	restoreProduct "github.com/areteacademy/internal/usecase/product/restore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type SUT struct {
+	UseCase      PurgeCategoryUseCase
+	UnitOfWork   *transaction.InMemoryUnitOfWork
+	CategoryRepo *categoryRepo.InMemoryCategoryRepository
+	ProductRepo  *productRepo.InMemoryProductRepository
+	UserRepo     *userRepo.InMemoryUserRepository
+	User         *domain.User
+	Category     *domain.Category
+	Product      *domain.Product
+}
+
+func makeSut() SUT {
+	categoryRepo := categoryRepo.NewInMemoryCategoryRepository()
+	productRepo := productRepo.NewInMemoryProductRepository()
+	userRepo := userRepo.NewInMemoryUserRepository()
+	unitOfWork := transaction.NewInMemoryUnitOfWork(userRepo, categoryRepo, productRepo)
+	usecase := NewPurgeCategoryUseCase(unitOfWork, userRepo)
+
+	now := time.Now()
+	user := &domain.User{
+		ID:        "123456",
+		Name:      "Daniel",
+		Email:     "daniel@gmail.com",
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	category := &domain.Category{
+		ID:        "123456",
+		UserId:    user.ID,
+		Name:      "Categoria",
+		Status:    "ACTIVE",
+		CreatedAt: now,
+		UpdatedAt: now,
+		DeletedAt: &now,
+	}
+	product := &domain.Product{
+		ID:          "123456",
+		UserId:      user.ID,
+		CategoryId:  category.ID,
+		Name:        "Produto",
+		Description: "Meu Produto",
+		Status:      "ACTIVE",
+		Price:       100,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+		DeletedAt:   &now,
+	}
+
+	return SUT{
+		UseCase:      usecase,
+		UnitOfWork:   unitOfWork,
+		CategoryRepo: categoryRepo,
+		ProductRepo:  productRepo,
+		UserRepo:     userRepo,
+		User:         user,
+		Category:     category,
+		Product:      product,
+	}
+}
+
+func seedDefaultData(sut SUT) {
+	sut.UserRepo.Save(context.Background(), sut.User)
+	sut.CategoryRepo.Save(context.Background(), sut.Category)
+	sut.ProductRepo.Save(context.Background(), sut.Product)
+}
+
+func validInput(sut SUT) PurgeCategoryInput {
+	return PurgeCategoryInput{
+		ID:     sut.Category.ID,
+		UserId: sut.User.ID,
+	}
+}
+
+func TestPurgeCategory_GivenInvalidInput_ShouldReturnError(t *testing.T) {
+	testCases := []struct {
+		name        string
+		setup       func(sut SUT)
+		input       func(sut SUT) PurgeCategoryInput
+		expectedErr error
+	}{
+		{
+			name:  "Empty ID",
+			setup: seedDefaultData,
+			input: func(sut SUT) PurgeCategoryInput {
+				in := validInput(sut)
+				in.ID = ""
+				return in
+			},
+			expectedErr: domain.ErrCategoryIdIsRequired,
+		},
+		{
+			name:  "Empty User ID",
+			setup: seedDefaultData,
+			input: func(sut SUT) PurgeCategoryInput {
+				in := validInput(sut)
+				in.UserId = ""
+				return in
+			},
+			expectedErr: domain.ErrCategoryUserIdIsRequired,
+		},
+		{
+			name: "User Not Found",
+			setup: func(sut SUT) {
+				sut.CategoryRepo.Save(context.Background(), sut.Category)
+			},
+			input:       validInput,
+			expectedErr: domain.ErrCategoryUserNotFound,
+		},
+		{
+			name: "Category Not Deleted",
+			setup: func(sut SUT) {
+				sut.Category.DeletedAt = nil
+				seedDefaultData(sut)
+			},
+			input:       validInput,
+			expectedErr: domain.ErrCategoryNotFound,
+		},
+		{
+			name: "Category Has Active Products",
+			setup: func(sut SUT) {
+				sut.Product.DeletedAt = nil
+				seedDefaultData(sut)
+			},
+			input:       validInput,
+			expectedErr: domain.ErrCategoryHasProducts,
+		},
+		{
+			name: "Repo Product Fail On Purge By Category",
+			setup: func(sut SUT) {
+				seedDefaultData(sut)
+				sut.ProductRepo.FailOnPurgeByCategory = true
+			},
+			input:       validInput,
+			expectedErr: productRepo.ErrSimulatedFailureRepoProduct,
+		},
+		{
+			name: "Unit Of Work Fail",
+			setup: func(sut SUT) {
+				seedDefaultData(sut)
+				sut.UnitOfWork.FailOnDo = true
+			},
+			input:       validInput,
+			expectedErr: transaction.ErrSimulatedFailureUnitOfWork,
+		},
+		{
+			name: "Repo Category Fail On Purge",
+			setup: func(sut SUT) {
+				seedDefaultData(sut)
+				sut.CategoryRepo.FailOnPurge = true
+			},
+			input:       validInput,
+			expectedErr: categoryRepo.ErrSimulatedFailureRepoCategory,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			sut := makeSut()
+
+			if tc.setup != nil {
+				tc.setup(sut)
+			}
+
+			input := tc.input(sut)
+
+			// Act
+			err := sut.UseCase.Perform(context.Background(), input)
+
+			// Assert
+			require.Error(t, err)
+			assert.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestPurgeCategory_ShouldRemoveCategoryPermanently(t *testing.T) {
+	// Arrange
+	sut := makeSut()
+	seedDefaultData(sut)
+
+	// Act
+	err := sut.UseCase.Perform(context.Background(), validInput(sut))
+
+	// Assert
+	require.NoError(t, err)
+
+	deleted, err := sut.CategoryRepo.GetDeletedByIdAndUserId(context.Background(), sut.Category.ID, sut.User.ID)
+	require.NoError(t, err)
+	assert.Nil(t, deleted)
+}
+
+func TestPurgeCategory_ShouldPurgeDeletedProducts(t *testing.T) {
+	// Arrange
+	sut := makeSut()
+	seedDefaultData(sut)
+	restore := restoreProduct.NewRestoreProductUseCase(sut.ProductRepo, sut.CategoryRepo, sut.UserRepo)
+
+	// Act
+	err := sut.UseCase.Perform(context.Background(), validInput(sut))
+
+	// Assert
+	require.NoError(t, err)
+
+	// The product is gone with its category, rather than left behind and
+	// unrestorable.
+	_, err = restore.Perform(context.Background(), restoreProduct.RestoreProductInput{
+		ID:     sut.Product.ID,
+		UserId: sut.User.ID,
+	})
+	assert.ErrorIs(t, err, domain.ErrProductNotFound)
+	assert.NotErrorIs(t, err, domain.ErrProductCategoryNotFound)
+}
+
+func TestPurgeCategory_ShouldKeepDeletedProducts_WhenPurgeFails(t *testing.T) {
+	// Arrange
+	sut := makeSut()
+	seedDefaultData(sut)
+	sut.CategoryRepo.FailOnPurge = true
+
+	// Act
+	err := sut.UseCase.Perform(context.Background(), validInput(sut))
+
+	// Assert
+	require.ErrorIs(t, err, categoryRepo.ErrSimulatedFailureRepoCategory)
+
+	product, err := sut.ProductRepo.GetDeletedByIdAndUserId(context.Background(), sut.Product.ID, sut.User.ID)
+	require.NoError(t, err)
+	assert.NotNil(t, product)
+}
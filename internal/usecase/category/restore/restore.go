@@ -0,0 +1,66 @@
+package category
+
+import (
+	"context"
+
+	"github.com/areteacademy/internal/domain"
+)
+
+type restoreCategoryUseCase struct {
+	categoryRepo domain.CategoryRepository
+	userRepo     domain.UserRepository
+}
+
+type RestoreCategoryUseCase interface {
+	Perform(ctx context.Context, input RestoreCategoryInput) (*RestoreCategoryOutput, error)
+}
+
+func NewRestoreCategoryUseCase(categoryRepo domain.CategoryRepository, userRepo domain.UserRepository) RestoreCategoryUseCase {
+	return &restoreCategoryUseCase{
+		categoryRepo: categoryRepo,
+		userRepo:     userRepo,
+	}
+}
+
+func (uc *restoreCategoryUseCase) Perform(ctx context.Context, input RestoreCategoryInput) (*RestoreCategoryOutput, error) {
+	if input.ID == "" {
+		return nil, domain.ErrCategoryIdIsRequired
+	}
+
+	if input.UserId == "" {
+		return nil, domain.ErrCategoryUserIdIsRequired
+	}
+
+	user, err := uc.userRepo.GetById(ctx, input.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if user == nil {
+		return nil, domain.ErrCategoryUserNotFound
+	}
+
+	category, err := uc.categoryRepo.GetDeletedByIdAndUserId(ctx, input.ID, input.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if category == nil {
+		return nil, domain.ErrCategoryNotFound
+	}
+
+	category.Restore()
+
+	if err := uc.categoryRepo.Restore(ctx, category); err != nil {
+		return nil, err
+	}
+
+	return &RestoreCategoryOutput{
+		ID:        category.ID,
+		UserId:    category.UserId,
+		Name:      category.Name,
+		Status:    category.Status,
+		CreatedAt: category.CreatedAt,
+		UpdatedAt: category.UpdatedAt,
+	}, nil
+}
@@ -0,0 +1,17 @@
+package category
+
+import "time"
+
+type RestoreCategoryInput struct {
+	ID     string
+	UserId string
+}
+
+type RestoreCategoryOutput struct {
+	ID        string
+	UserId    string
+	Name      string
+	Status    string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
@@ -0,0 +1,172 @@
+package category
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+	categoryRepo "github.com/areteacademy/internal/infra/repository/category"
+	userRepo "github.com/areteacademy/internal/infra/repository/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type SUT struct {
+	UseCase      RestoreCategoryUseCase
+	CategoryRepo *categoryRepo.InMemoryCategoryRepository
+	UserRepo     *userRepo.InMemoryUserRepository
+	User         *domain.User
+	Category     *domain.Category
+}
+
+func makeSut() SUT {
+	categoryRepo := categoryRepo.NewInMemoryCategoryRepository()
+	userRepo := userRepo.NewInMemoryUserRepository()
+	usecase := NewRestoreCategoryUseCase(categoryRepo, userRepo)
+
+	now := time.Now()
+	deletedAt := now.Add(-time.Hour)
+	user := &domain.User{
+		ID:        "123456",
+		Name:      "Daniel",
+		Email:     "daniel@gmail.com",
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	category := &domain.Category{
+		ID:        "123456",
+		UserId:    user.ID,
+		Name:      "Categoria",
+		Status:    "ACTIVE",
+		CreatedAt: now,
+		UpdatedAt: now,
+		DeletedAt: &deletedAt,
+	}
+
+	return SUT{
+		UseCase:      usecase,
+		CategoryRepo: categoryRepo,
+		UserRepo:     userRepo,
+		User:         user,
+		Category:     category,
+	}
+}
+
+func seedDefaultData(sut SUT) {
+	sut.UserRepo.Save(context.Background(), sut.User)
+	sut.CategoryRepo.Save(context.Background(), sut.Category)
+}
+
+func validInput(sut SUT) RestoreCategoryInput {
+	return RestoreCategoryInput{
+		ID:     sut.Category.ID,
+		UserId: sut.User.ID,
+	}
+}
+
+func TestRestoreCategory_GivenInvalidInput_ShouldReturnError(t *testing.T) {
+	testCases := []struct {
+		name        string
+		setup       func(sut SUT)
+		input       func(sut SUT) RestoreCategoryInput
+		expectedErr error
+	}{
+		{
+			name:  "Empty ID",
+			setup: seedDefaultData,
+			input: func(sut SUT) RestoreCategoryInput {
+				in := validInput(sut)
+				in.ID = ""
+				return in
+			},
+			expectedErr: domain.ErrCategoryIdIsRequired,
+		},
+		{
+			name:  "Empty User ID",
+			setup: seedDefaultData,
+			input: func(sut SUT) RestoreCategoryInput {
+				in := validInput(sut)
+				in.UserId = ""
+				return in
+			},
+			expectedErr: domain.ErrCategoryUserIdIsRequired,
+		},
+		{
+			name: "User Not Found",
+			setup: func(sut SUT) {
+				sut.CategoryRepo.Save(context.Background(), sut.Category)
+			},
+			input:       validInput,
+			expectedErr: domain.ErrCategoryUserNotFound,
+		},
+		{
+			name: "Category Not Deleted",
+			setup: func(sut SUT) {
+				sut.Category.DeletedAt = nil
+				seedDefaultData(sut)
+			},
+			input:       validInput,
+			expectedErr: domain.ErrCategoryNotFound,
+		},
+		{
+			name: "Repo Category Fail On Get Deleted",
+			setup: func(sut SUT) {
+				seedDefaultData(sut)
+				sut.CategoryRepo.FailOnGetDeleted = true
+			},
+			input:       validInput,
+			expectedErr: categoryRepo.ErrSimulatedFailureRepoCategory,
+		},
+		{
+			name: "Repo Category Fail On Restore",
+			setup: func(sut SUT) {
+				seedDefaultData(sut)
+				sut.CategoryRepo.FailOnRestore = true
+			},
+			input:       validInput,
+			expectedErr: categoryRepo.ErrSimulatedFailureRepoCategory,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			sut := makeSut()
+
+			if tc.setup != nil {
+				tc.setup(sut)
+			}
+
+			input := tc.input(sut)
+
+			// Act
+			output, err := sut.UseCase.Perform(context.Background(), input)
+
+			// Assert
+			require.Error(t, err)
+			require.Nil(t, output)
+			assert.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestRestoreCategory_ShouldRestoreDeletedCategory(t *testing.T) {
+	// Arrange
+	sut := makeSut()
+	seedDefaultData(sut)
+
+	// Act
+	output, err := sut.UseCase.Perform(context.Background(), validInput(sut))
+
+	// Assert
+	require.NoError(t, err)
+	require.NotNil(t, output)
+	assert.Equal(t, sut.Category.ID, output.ID)
+	assert.Equal(t, sut.Category.Name, output.Name)
+
+	category, err := sut.CategoryRepo.GetById(context.Background(), sut.Category.ID)
+	require.NoError(t, err)
+	require.NotNil(t, category)
+	assert.False(t, category.IsDeleted())
+}
@@ -1,6 +1,10 @@
 package category
 
-import "github.com/areteacademy/internal/domain"
+import (
+	"context"
+
+	"github.com/areteacademy/internal/domain"
+)
 
 type updateCategoryUseCase struct {
 	categoryRepo domain.CategoryRepository
@@ -8,7 +12,7 @@ type updateCategoryUseCase struct {
 }
 
 type UpdateCategoryUseCase interface {
-	Perform(input UpdateCategoryInput) (*UpdateCategoryOutput, error)
+	Perform(ctx context.Context, input UpdateCategoryInput) (*UpdateCategoryOutput, error)
 }
 
 func NewUpdateCategoryUseCase(categoryRepo domain.CategoryRepository, userRepo domain.UserRepository) UpdateCategoryUseCase {
@@ -18,7 +22,7 @@ func NewUpdateCategoryUseCase(categoryRepo domain.CategoryRepository, userRepo d
 	}
 }
 
-func (uc *updateCategoryUseCase) Perform(input UpdateCategoryInput) (*UpdateCategoryOutput, error) {
+func (uc *updateCategoryUseCase) Perform(ctx context.Context, input UpdateCategoryInput) (*UpdateCategoryOutput, error) {
 	category, err := domain.UpdateCategory(
 		input.ID,
 		input.UserId,
@@ -30,7 +34,7 @@ func (uc *updateCategoryUseCase) Perform(input UpdateCategoryInput) (*UpdateCate
 		return nil, err
 	}
 
-	exists, err := uc.categoryRepo.GetById(input.ID)
+	exists, err := uc.categoryRepo.GetById(ctx, input.ID)
 	if err != nil {
 		return nil, err
 	}
@@ -43,7 +47,7 @@ func (uc *updateCategoryUseCase) Perform(input UpdateCategoryInput) (*UpdateCate
 		return nil, domain.ErrCategoryUserNotOwner
 	}
 
-	user, err := uc.userRepo.GetById(input.UserId)
+	user, err := uc.userRepo.GetById(ctx, input.UserId)
 	if err != nil {
 		return nil, err
 	}
@@ -52,7 +56,7 @@ func (uc *updateCategoryUseCase) Perform(input UpdateCategoryInput) (*UpdateCate
 		return nil, domain.ErrCategoryUserNotFound
 	}
 
-	if err := uc.categoryRepo.Update(category); err != nil {
+	if err := uc.categoryRepo.Update(ctx, category); err != nil {
 		return nil, err
 	}
 
@@ -1,6 +1,8 @@
 package category
 
 import (
+	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -92,11 +94,11 @@ func TestUpdateCategory_ShouldReturnAnError_WhenInputValidators(t *testing.T) {
 	// Assert
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			category, err := sut.UseCase.Perform(tc.input)
+			category, err := sut.UseCase.Perform(context.Background(), tc.input)
 
 			if err == nil && tc.expectedErr != nil {
 				t.Errorf("expected error %v, got nil", tc.expectedErr)
-			} else if err != nil && err != tc.expectedErr {
+			} else if err != nil && !errors.Is(err, tc.expectedErr) {
 				t.Errorf("expected error %v, got %v", tc.expectedErr, err)
 			}
 
@@ -111,7 +113,7 @@ func TestUpdateCategory_ShouldReturnAnError_WhenCategoryNotFound(t *testing.T) {
 	// Arrange
 	sut := makeSut()
 	now := time.Now()
-	sut.UserRepo.Save(&domain.User{
+	sut.UserRepo.Save(context.Background(), &domain.User{
 		ID:        "123456",
 		Name:      "Daniel",
 		Email:     "daniel@gmail.com",
@@ -119,7 +121,7 @@ func TestUpdateCategory_ShouldReturnAnError_WhenCategoryNotFound(t *testing.T) {
 		UpdatedAt: now,
 	})
 
-	sut.CategoryRepo.Save(&domain.Category{
+	sut.CategoryRepo.Save(context.Background(), &domain.Category{
 		ID:        "1234567",
 		UserId:    "123456",
 		Name:      "Categoria1",
@@ -129,7 +131,7 @@ func TestUpdateCategory_ShouldReturnAnError_WhenCategoryNotFound(t *testing.T) {
 	})
 
 	// Act
-	category, err := sut.UseCase.Perform(UpdateCategoryInput{
+	category, err := sut.UseCase.Perform(context.Background(), UpdateCategoryInput{
 		ID:     "123456",
 		UserId: "123456",
 		Name:   "Categoria editada",
@@ -141,7 +143,7 @@ func TestUpdateCategory_ShouldReturnAnError_WhenCategoryNotFound(t *testing.T) {
 		t.Fatalf("expected an error, got nil")
 	}
 
-	if err != domain.ErrCategoryNotFound {
+	if !errors.Is(err, domain.ErrCategoryNotFound) {
 		t.Errorf("expected ErrCategoryNotFound, got %v", err)
 	}
 
@@ -154,7 +156,7 @@ func TestUpdateCategory_ShouldReturnAnError_WhenCategoryRepoFailOnGetById(t *tes
 	// Arrange
 	sut := makeSut()
 	now := time.Now()
-	sut.UserRepo.Save(&domain.User{
+	sut.UserRepo.Save(context.Background(), &domain.User{
 		ID:        "123456",
 		Name:      "Daniel",
 		Email:     "daniel@gmail.com",
@@ -162,7 +164,7 @@ func TestUpdateCategory_ShouldReturnAnError_WhenCategoryRepoFailOnGetById(t *tes
 		UpdatedAt: now,
 	})
 
-	sut.CategoryRepo.Save(&domain.Category{
+	sut.CategoryRepo.Save(context.Background(), &domain.Category{
 		ID:        "123456",
 		UserId:    "123456",
 		Name:      "Categoria1",
@@ -173,7 +175,7 @@ func TestUpdateCategory_ShouldReturnAnError_WhenCategoryRepoFailOnGetById(t *tes
 	sut.CategoryRepo.FailOnGetById = true
 
 	// Act
-	category, err := sut.UseCase.Perform(UpdateCategoryInput{
+	category, err := sut.UseCase.Perform(context.Background(), UpdateCategoryInput{
 		ID:     "123456",
 		UserId: "123456",
 		Name:   "Categoria editada",
@@ -198,7 +200,7 @@ func TestUpdateCategory_ShouldReturnAnError_WhenCategoryUserIdNotOwner(t *testin
 	// Arrange
 	sut := makeSut()
 	now := time.Now()
-	sut.UserRepo.Save(&domain.User{
+	sut.UserRepo.Save(context.Background(), &domain.User{
 		ID:        "123456",
 		Name:      "Daniel",
 		Email:     "daniel@gmail.com",
@@ -206,7 +208,7 @@ func TestUpdateCategory_ShouldReturnAnError_WhenCategoryUserIdNotOwner(t *testin
 		UpdatedAt: now,
 	})
 
-	sut.CategoryRepo.Save(&domain.Category{
+	sut.CategoryRepo.Save(context.Background(), &domain.Category{
 		ID:        "123456",
 		UserId:    "1234567",
 		Name:      "Categoria1",
@@ -216,7 +218,7 @@ func TestUpdateCategory_ShouldReturnAnError_WhenCategoryUserIdNotOwner(t *testin
 	})
 
 	// Act
-	category, err := sut.UseCase.Perform(UpdateCategoryInput{
+	category, err := sut.UseCase.Perform(context.Background(), UpdateCategoryInput{
 		ID:     "123456",
 		UserId: "123456",
 		Name:   "Categoria editada",
@@ -228,7 +230,7 @@ func TestUpdateCategory_ShouldReturnAnError_WhenCategoryUserIdNotOwner(t *testin
 		t.Fatalf("expected an error, got nil")
 	}
 
-	if err != domain.ErrCategoryUserNotOwner {
+	if !errors.Is(err, domain.ErrCategoryUserNotOwner) {
 		t.Errorf("expected ErrCategoryUserNotOwner, got %v", err)
 	}
 
@@ -241,7 +243,7 @@ func TestUpdateCategory_ShouldReturnAnError_WhenCategoryUserNotFound(t *testing.
 	// Arrange
 	sut := makeSut()
 	now := time.Now()
-	sut.UserRepo.Save(&domain.User{
+	sut.UserRepo.Save(context.Background(), &domain.User{
 		ID:        "1234567",
 		Name:      "Daniel",
 		Email:     "daniel@gmail.com",
@@ -249,7 +251,7 @@ func TestUpdateCategory_ShouldReturnAnError_WhenCategoryUserNotFound(t *testing.
 		UpdatedAt: now,
 	})
 
-	sut.CategoryRepo.Save(&domain.Category{
+	sut.CategoryRepo.Save(context.Background(), &domain.Category{
 		ID:        "123456",
 		UserId:    "123456",
 		Name:      "Categoria1",
@@ -259,7 +261,7 @@ func TestUpdateCategory_ShouldReturnAnError_WhenCategoryUserNotFound(t *testing.
 	})
 
 	// Act
-	category, err := sut.UseCase.Perform(UpdateCategoryInput{
+	category, err := sut.UseCase.Perform(context.Background(), UpdateCategoryInput{
 		ID:     "123456",
 		UserId: "123456",
 		Name:   "Categoria editada",
@@ -271,7 +273,7 @@ func TestUpdateCategory_ShouldReturnAnError_WhenCategoryUserNotFound(t *testing.
 		t.Fatalf("expected an error, got nil")
 	}
 
-	if err != domain.ErrCategoryUserNotFound {
+	if !errors.Is(err, domain.ErrCategoryUserNotFound) {
 		t.Errorf("expected ErrCategoryUserNotFound, got %v", err)
 	}
 
@@ -284,7 +286,7 @@ func TestUpdateCategory_ShouldReturnAnError_WhenUserRepoFailOnGetById(t *testing
 	// Arrange
 	sut := makeSut()
 	now := time.Now()
-	sut.UserRepo.Save(&domain.User{
+	sut.UserRepo.Save(context.Background(), &domain.User{
 		ID:        "123456",
 		Name:      "Daniel",
 		Email:     "daniel@gmail.com",
@@ -293,7 +295,7 @@ func TestUpdateCategory_ShouldReturnAnError_WhenUserRepoFailOnGetById(t *testing
 	})
 	sut.UserRepo.FailOnGet = true
 
-	sut.CategoryRepo.Save(&domain.Category{
+	sut.CategoryRepo.Save(context.Background(), &domain.Category{
 		ID:        "123456",
 		UserId:    "123456",
 		Name:      "Categoria1",
@@ -303,7 +305,7 @@ func TestUpdateCategory_ShouldReturnAnError_WhenUserRepoFailOnGetById(t *testing
 	})
 
 	// Act
-	category, err := sut.UseCase.Perform(UpdateCategoryInput{
+	category, err := sut.UseCase.Perform(context.Background(), UpdateCategoryInput{
 		ID:     "123456",
 		UserId: "123456",
 		Name:   "Categoria editada",
@@ -328,7 +330,7 @@ func TestUpdateCategory_ShouldReturnAnError_WhenCategoryRepoFailOnUpdate(t *test
 	// Arrange
 	sut := makeSut()
 	now := time.Now()
-	sut.UserRepo.Save(&domain.User{
+	sut.UserRepo.Save(context.Background(), &domain.User{
 		ID:        "123456",
 		Name:      "Daniel",
 		Email:     "daniel@gmail.com",
@@ -336,7 +338,7 @@ func TestUpdateCategory_ShouldReturnAnError_WhenCategoryRepoFailOnUpdate(t *test
 		UpdatedAt: now,
 	})
 
-	sut.CategoryRepo.Save(&domain.Category{
+	sut.CategoryRepo.Save(context.Background(), &domain.Category{
 		ID:        "123456",
 		UserId:    "123456",
 		Name:      "Categoria1",
@@ -347,7 +349,7 @@ func TestUpdateCategory_ShouldReturnAnError_WhenCategoryRepoFailOnUpdate(t *test
 	sut.CategoryRepo.FailOnUpdate = true
 
 	// Act
-	category, err := sut.UseCase.Perform(UpdateCategoryInput{
+	category, err := sut.UseCase.Perform(context.Background(), UpdateCategoryInput{
 		ID:     "123456",
 		UserId: "123456",
 		Name:   "Categoria editada",
@@ -372,14 +374,14 @@ func TestUpdateCategory_ShouldReturnSuccess(t *testing.T) {
 	// Arrange
 	sut := makeSut()
 	now := time.Now()
-	sut.UserRepo.Save(&domain.User{
+	sut.UserRepo.Save(context.Background(), &domain.User{
 		ID:        "123456",
 		Name:      "Daniel",
 		Email:     "daniel@gmail.com",
 		CreatedAt: now,
 		UpdatedAt: now,
 	})
-	sut.CategoryRepo.Save(&domain.Category{
+	sut.CategoryRepo.Save(context.Background(), &domain.Category{
 		ID:        "123456",
 		UserId:    "123456",
 		Name:      "Categoria",
@@ -389,7 +391,7 @@ func TestUpdateCategory_ShouldReturnSuccess(t *testing.T) {
 	})
 
 	// Act
-	category, err := sut.UseCase.Perform(UpdateCategoryInput{
+	category, err := sut.UseCase.Perform(context.Background(), UpdateCategoryInput{
 		ID:     "123456",
 		UserId: "123456",
 		Name:   "Categoria editada",
@@ -1,32 +1,26 @@
 package product
 
 import (
+	"context"
+
 	"github.com/areteacademy/internal/domain"
 )
 
 type createProductUseCase struct {
-	productRepo  domain.ProductRepository
-	categoryRepo domain.CategoryRepository
-	userRepo     domain.UserRepository
+	unitOfWork domain.UnitOfWork
 }
 
 type CreateProductUseCase interface {
-	Perform(input CreateProductInput) (*CreateProductOutput, error)
+	Perform(ctx context.Context, input CreateProductInput) (*CreateProductOutput, error)
 }
 
-func NewCreateProductUseCase(
-	productRepo domain.ProductRepository,
-	categoryRepo domain.CategoryRepository,
-	userRepo domain.UserRepository,
-) CreateProductUseCase {
+func NewCreateProductUseCase(unitOfWork domain.UnitOfWork) CreateProductUseCase {
 	return &createProductUseCase{
-		productRepo:  productRepo,
-		categoryRepo: categoryRepo,
-		userRepo:     userRepo,
+		unitOfWork: unitOfWork,
 	}
 }
 
-func (uc *createProductUseCase) Perform(input CreateProductInput) (*CreateProductOutput, error) {
+func (uc *createProductUseCase) Perform(ctx context.Context, input CreateProductInput) (*CreateProductOutput, error) {
 	product, err := domain.NewProduct(
 		input.UserId,
 		input.CategoryId,
@@ -40,29 +34,32 @@ func (uc *createProductUseCase) Perform(input CreateProductInput) (*CreateProduc
 		return nil, err
 	}
 
-	user, err := uc.userRepo.GetById(input.UserId)
-	if err != nil {
-		return nil, err
-	}
+	err = uc.unitOfWork.Do(ctx, func(repos domain.Repositories) error {
+		user, err := repos.Users().GetById(ctx, input.UserId)
+		if err != nil {
+			return err
+		}
 
-	if user == nil {
-		return nil, domain.ErrProductUserNotFound
-	}
+		if user == nil {
+			return domain.ErrProductUserNotFound
+		}
 
-	category, err := uc.categoryRepo.GetById(input.CategoryId)
-	if err != nil {
-		return nil, err
-	}
+		category, err := repos.Categories().GetById(ctx, input.CategoryId)
+		if err != nil {
+			return err
+		}
 
-	if category == nil {
-		return nil, domain.ErrProductCategoryNotFound
-	}
+		if category == nil {
+			return domain.ErrProductCategoryNotFound
+		}
 
-	if category.UserId != input.UserId {
-		return nil, domain.ErrProductCategoryUserNotOwner
-	}
+		if category.UserId != input.UserId {
+			return domain.ErrProductCategoryUserNotOwner
+		}
 
-	if err := uc.productRepo.Save(product); err != nil {
+		return repos.Products().Save(ctx, product)
+	})
+	if err != nil {
 		return nil, err
 	}
 
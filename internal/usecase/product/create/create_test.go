@@ -1,6 +1,7 @@
 package product
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -10,11 +11,13 @@ import (
 	"github.com/areteacademy/internal/domain"
 	categoryRepo "github.com/areteacademy/internal/infra/repository/category"
 	productRepo "github.com/areteacademy/internal/infra/repository/product"
+	"github.com/areteacademy/internal/infra/repository/transaction"
 	userRepo "github.com/areteacademy/internal/infra/repository/user"
 )
 
 type SUT struct {
 	UseCase      CreateProductUseCase
+	UnitOfWork   *transaction.InMemoryUnitOfWork
 	ProductRepo  *productRepo.InMemoryProductRepository
 	CategoryRepo *categoryRepo.InMemoryCategoryRepository
 	UserRepo     *userRepo.InMemoryUserRepository
@@ -24,10 +27,12 @@ func makeSut() SUT {
 	productRepo := productRepo.NewInMemoryProductRepository()
 	categoryRepo := categoryRepo.NewInMemoryCategoryRepository()
 	userRepo := userRepo.NewInMemoryUserRepository()
-	usecase := NewCreateProductUseCase(productRepo, categoryRepo, userRepo)
+	unitOfWork := transaction.NewInMemoryUnitOfWork(userRepo, categoryRepo, productRepo)
+	usecase := NewCreateProductUseCase(unitOfWork)
 
 	return SUT{
 		UseCase:      usecase,
+		UnitOfWork:   unitOfWork,
 		ProductRepo:  productRepo,
 		CategoryRepo: categoryRepo,
 		UserRepo:     userRepo,
@@ -145,7 +150,7 @@ func TestCreateProduct_ShouldReturnAnError_WhenInputValidators(t *testing.T) {
 	// Assert
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			product, err := sut.UseCase.Perform(tc.input)
+			product, err := sut.UseCase.Perform(context.Background(), tc.input)
 
 			require.Error(t, err)
 			require.Nil(t, product)
@@ -154,11 +159,34 @@ func TestCreateProduct_ShouldReturnAnError_WhenInputValidators(t *testing.T) {
 	}
 }
 
+func TestCreateProduct_ShouldReportEveryInvalidField(t *testing.T) {
+	// Arrange
+	sut := makeSut()
+
+	// Act
+	product, err := sut.UseCase.Perform(context.Background(), CreateProductInput{
+		UserId:     "123456",
+		CategoryId: "123456",
+		Status:     "UNKNOWN",
+		Price:      -1,
+	})
+
+	// Assert
+	require.Nil(t, product)
+	assert.ErrorIs(t, err, domain.ErrValidationFailed)
+	assert.ErrorIs(t, err, domain.ErrProductNameIsRequired)
+	assert.ErrorIs(t, err, domain.ErrProductDescriptionIsRequired)
+	assert.ErrorIs(t, err, domain.ErrProductStatusInvalid)
+	assert.ErrorIs(t, err, domain.ErrProductPriceInvalid)
+	assert.NotErrorIs(t, err, domain.ErrProductStatusIsRequired)
+	assert.Len(t, domain.FieldErrors(err), 4)
+}
+
 func TestCreateProduct_ShouldReturnAnError_WhenUserNotFound(t *testing.T) {
 	// Arrange
 	sut := makeSut()
 	now := time.Now()
-	sut.UserRepo.Save(&domain.User{
+	sut.UserRepo.Save(context.Background(), &domain.User{
 		ID:        "1234567",
 		Name:      "Daniel",
 		Email:     "daniel@gmail.com",
@@ -166,7 +194,7 @@ func TestCreateProduct_ShouldReturnAnError_WhenUserNotFound(t *testing.T) {
 		UpdatedAt: now,
 	})
 
-	sut.CategoryRepo.Save(&domain.Category{
+	sut.CategoryRepo.Save(context.Background(), &domain.Category{
 		ID:        "123456",
 		UserId:    "123456",
 		Name:      "Categoria1",
@@ -176,7 +204,7 @@ func TestCreateProduct_ShouldReturnAnError_WhenUserNotFound(t *testing.T) {
 	})
 
 	// Act
-	product, err := sut.UseCase.Perform(CreateProductInput{
+	product, err := sut.UseCase.Perform(context.Background(), CreateProductInput{
 		UserId:      "123456",
 		CategoryId:  "123456",
 		Name:        "Produto1",
@@ -195,7 +223,7 @@ func TestCreateProduct_ShouldReturnAnError_WhenUserRepoFailOnGetById(t *testing.
 	// Arrange
 	sut := makeSut()
 	now := time.Now()
-	sut.UserRepo.Save(&domain.User{
+	sut.UserRepo.Save(context.Background(), &domain.User{
 		ID:        "1234567",
 		Name:      "Daniel",
 		Email:     "daniel@gmail.com",
@@ -204,7 +232,7 @@ func TestCreateProduct_ShouldReturnAnError_WhenUserRepoFailOnGetById(t *testing.
 	})
 	sut.UserRepo.FailOnGet = true
 
-	sut.CategoryRepo.Save(&domain.Category{
+	sut.CategoryRepo.Save(context.Background(), &domain.Category{
 		ID:        "123456",
 		UserId:    "123456",
 		Name:      "Categoria1",
@@ -214,7 +242,7 @@ func TestCreateProduct_ShouldReturnAnError_WhenUserRepoFailOnGetById(t *testing.
 	})
 
 	// Act
-	product, err := sut.UseCase.Perform(CreateProductInput{
+	product, err := sut.UseCase.Perform(context.Background(), CreateProductInput{
 		UserId:      "123456",
 		CategoryId:  "123456",
 		Name:        "Produto1",
@@ -233,7 +261,7 @@ func TestCreateProduct_ShouldReturnAnError_WhenCategoryNotFound(t *testing.T) {
 	// Arrange
 	sut := makeSut()
 	now := time.Now()
-	sut.UserRepo.Save(&domain.User{
+	sut.UserRepo.Save(context.Background(), &domain.User{
 		ID:        "123456",
 		Name:      "Daniel",
 		Email:     "daniel@gmail.com",
@@ -241,7 +269,7 @@ func TestCreateProduct_ShouldReturnAnError_WhenCategoryNotFound(t *testing.T) {
 		UpdatedAt: now,
 	})
 
-	sut.CategoryRepo.Save(&domain.Category{
+	sut.CategoryRepo.Save(context.Background(), &domain.Category{
 		ID:        "1234567",
 		UserId:    "123456",
 		Name:      "Categoria1",
@@ -251,7 +279,7 @@ func TestCreateProduct_ShouldReturnAnError_WhenCategoryNotFound(t *testing.T) {
 	})
 
 	// Act
-	product, err := sut.UseCase.Perform(CreateProductInput{
+	product, err := sut.UseCase.Perform(context.Background(), CreateProductInput{
 		UserId:      "123456",
 		CategoryId:  "123456",
 		Name:        "Produto1",
@@ -270,7 +298,7 @@ func TestCreateProduct_ShouldReturnAnError_WhenCategoryRepoFailOnGetById(t *test
 	// Arrange
 	sut := makeSut()
 	now := time.Now()
-	sut.UserRepo.Save(&domain.User{
+	sut.UserRepo.Save(context.Background(), &domain.User{
 		ID:        "123456",
 		Name:      "Daniel",
 		Email:     "daniel@gmail.com",
@@ -278,7 +306,7 @@ func TestCreateProduct_ShouldReturnAnError_WhenCategoryRepoFailOnGetById(t *test
 		UpdatedAt: now,
 	})
 
-	sut.CategoryRepo.Save(&domain.Category{
+	sut.CategoryRepo.Save(context.Background(), &domain.Category{
 		ID:        "123456",
 		UserId:    "123456",
 		Name:      "Categoria1",
@@ -289,7 +317,7 @@ func TestCreateProduct_ShouldReturnAnError_WhenCategoryRepoFailOnGetById(t *test
 	sut.CategoryRepo.FailOnGetById = true
 
 	// Act
-	product, err := sut.UseCase.Perform(CreateProductInput{
+	product, err := sut.UseCase.Perform(context.Background(), CreateProductInput{
 		UserId:      "123456",
 		CategoryId:  "123456",
 		Name:        "Produto1",
@@ -308,14 +336,14 @@ func TestCreateProduct_ShouldReturnAnError_WhenCategoryUserNotOwner(t *testing.T
 	// Arrange
 	sut := makeSut()
 	now := time.Now()
-	sut.UserRepo.Save(&domain.User{
+	sut.UserRepo.Save(context.Background(), &domain.User{
 		ID:        "123456",
 		Name:      "Daniel",
 		Email:     "daniel@gmail.com",
 		CreatedAt: now,
 		UpdatedAt: now,
 	})
-	sut.UserRepo.Save(&domain.User{
+	sut.UserRepo.Save(context.Background(), &domain.User{
 		ID:        "1234567",
 		Name:      "Daniel2",
 		Email:     "daniel2@gmail.com",
@@ -323,7 +351,7 @@ func TestCreateProduct_ShouldReturnAnError_WhenCategoryUserNotOwner(t *testing.T
 		UpdatedAt: now,
 	})
 
-	sut.CategoryRepo.Save(&domain.Category{
+	sut.CategoryRepo.Save(context.Background(), &domain.Category{
 		ID:        "123456",
 		UserId:    "123456",
 		Name:      "Categoria1",
@@ -331,7 +359,7 @@ func TestCreateProduct_ShouldReturnAnError_WhenCategoryUserNotOwner(t *testing.T
 		CreatedAt: now,
 		UpdatedAt: now,
 	})
-	sut.CategoryRepo.Save(&domain.Category{
+	sut.CategoryRepo.Save(context.Background(), &domain.Category{
 		ID:        "1234567",
 		UserId:    "1234567",
 		Name:      "Categoria1",
@@ -341,7 +369,7 @@ func TestCreateProduct_ShouldReturnAnError_WhenCategoryUserNotOwner(t *testing.T
 	})
 
 	// Act
-	product, err := sut.UseCase.Perform(CreateProductInput{
+	product, err := sut.UseCase.Perform(context.Background(), CreateProductInput{
 		UserId:      "123456",
 		CategoryId:  "1234567",
 		Name:        "Produto1",
@@ -360,7 +388,7 @@ func TestCreateProduct_ShouldReturnAnError_WhenProductRepoFailOnSave(t *testing.
 	// Arrange
 	sut := makeSut()
 	now := time.Now()
-	sut.UserRepo.Save(&domain.User{
+	sut.UserRepo.Save(context.Background(), &domain.User{
 		ID:        "123456",
 		Name:      "Daniel",
 		Email:     "daniel@gmail.com",
@@ -368,7 +396,7 @@ func TestCreateProduct_ShouldReturnAnError_WhenProductRepoFailOnSave(t *testing.
 		UpdatedAt: now,
 	})
 
-	sut.CategoryRepo.Save(&domain.Category{
+	sut.CategoryRepo.Save(context.Background(), &domain.Category{
 		ID:        "123456",
 		UserId:    "123456",
 		Name:      "Categoria1",
@@ -380,7 +408,7 @@ func TestCreateProduct_ShouldReturnAnError_WhenProductRepoFailOnSave(t *testing.
 	sut.ProductRepo.FailOnSave = true
 
 	// Act
-	product, err := sut.UseCase.Perform(CreateProductInput{
+	product, err := sut.UseCase.Perform(context.Background(), CreateProductInput{
 		UserId:      "123456",
 		CategoryId:  "123456",
 		Name:        "Produto1",
@@ -399,7 +427,7 @@ func TestCreateProduct_ShouldReturnSuccess(t *testing.T) {
 	// Arrange
 	sut := makeSut()
 	now := time.Now()
-	sut.UserRepo.Save(&domain.User{
+	sut.UserRepo.Save(context.Background(), &domain.User{
 		ID:        "123456",
 		Name:      "Daniel",
 		Email:     "daniel@gmail.com",
@@ -407,7 +435,7 @@ func TestCreateProduct_ShouldReturnSuccess(t *testing.T) {
 		UpdatedAt: now,
 	})
 
-	sut.CategoryRepo.Save(&domain.Category{
+	sut.CategoryRepo.Save(context.Background(), &domain.Category{
 		ID:        "123456",
 		UserId:    "123456",
 		Name:      "Categoria1",
@@ -417,7 +445,7 @@ func TestCreateProduct_ShouldReturnSuccess(t *testing.T) {
 	})
 
 	// Act
-	product, err := sut.UseCase.Perform(CreateProductInput{
+	product, err := sut.UseCase.Perform(context.Background(), CreateProductInput{
 		UserId:      "123456",
 		CategoryId:  "123456",
 		Name:        "Produto1",
@@ -441,7 +469,49 @@ func TestCreateProduct_ShouldReturnSuccess(t *testing.T) {
 	assert.False(t, product.CreatedAt.IsZero())
 	assert.False(t, product.UpdatedAt.IsZero())
 
-	count, err := sut.ProductRepo.Count()
+	count, err := sut.ProductRepo.Count(context.Background())
 	require.NoError(t, err)
 	assert.Equal(t, 1, count)
 }
+
+func TestCreateProduct_ShouldRollback_WhenCommitFails(t *testing.T) {
+	// Arrange
+	sut := makeSut()
+	sut.UnitOfWork.FailOnCommit = true
+	now := time.Now()
+
+	sut.UserRepo.Save(context.Background(), &domain.User{
+		ID:        "123456",
+		Name:      "Daniel",
+		Email:     "daniel@gmail.com",
+		CreatedAt: now,
+		UpdatedAt: now,
+	})
+
+	sut.CategoryRepo.Save(context.Background(), &domain.Category{
+		ID:        "123456",
+		UserId:    "123456",
+		Name:      "Categoria1",
+		Status:    "ACTIVE",
+		CreatedAt: now,
+		UpdatedAt: now,
+	})
+
+	// Act
+	product, err := sut.UseCase.Perform(context.Background(), CreateProductInput{
+		UserId:      "123456",
+		CategoryId:  "123456",
+		Name:        "Produto1",
+		Description: "Meu Produto",
+		Status:      "ACTIVE",
+		Price:       100,
+	})
+
+	// Assert
+	require.ErrorIs(t, err, transaction.ErrSimulatedFailureUnitOfWork)
+	require.Nil(t, product)
+
+	count, err := sut.ProductRepo.Count(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+}
@@ -0,0 +1,65 @@
+package product
+
+import (
+	"context"
+
+	"github.com/areteacademy/internal/domain"
+)
+
+type deleteProductUseCase struct {
+	productRepo domain.ProductRepository
+	userRepo    domain.UserRepository
+}
+
+type DeleteProductUseCase interface {
+	Perform(ctx context.Context, input DeleteProductInput) (*DeleteProductOutput, error)
+}
+
+func NewDeleteProductUseCase(
+	productRepo domain.ProductRepository,
+	userRepo domain.UserRepository,
+) DeleteProductUseCase {
+	return &deleteProductUseCase{
+		productRepo: productRepo,
+		userRepo:    userRepo,
+	}
+}
+
+func (uc *deleteProductUseCase) Perform(ctx context.Context, input DeleteProductInput) (*DeleteProductOutput, error) {
+	if input.ID == "" {
+		return nil, domain.ErrProductIdIsRequired
+	}
+
+	if input.UserId == "" {
+		return nil, domain.ErrProductUserIdIsRequired
+	}
+
+	user, err := uc.userRepo.GetById(ctx, input.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if user == nil {
+		return nil, domain.ErrProductUserNotFound
+	}
+
+	product, err := uc.productRepo.GetByIdAndUserId(ctx, input.ID, input.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if product == nil {
+		return nil, domain.ErrProductNotFound
+	}
+
+	product.Delete()
+
+	if err := uc.productRepo.Delete(ctx, product); err != nil {
+		return nil, err
+	}
+
+	return &DeleteProductOutput{
+		ID:        product.ID,
+		DeletedAt: *product.DeletedAt,
+	}, nil
+}
@@ -0,0 +1,13 @@
+package product
+
+import "time"
+
+type DeleteProductInput struct {
+	ID     string
+	UserId string
+}
+
+type DeleteProductOutput struct {
+	ID        string
+	DeletedAt time.Time
+}
@@ -0,0 +1,208 @@
+package product
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+	productRepo "github.com/areteacademy/internal/infra/repository/product"
+	userRepo "github.com/areteacademy/internal/infra/repository/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type SUT struct {
+	UseCase     DeleteProductUseCase
+	ProductRepo *productRepo.InMemoryProductRepository
+	UserRepo    *userRepo.InMemoryUserRepository
+	User        *domain.User
+	Product     *domain.Product
+}
+
+func makeSut() SUT {
+	productRepo := productRepo.NewInMemoryProductRepository()
+	userRepo := userRepo.NewInMemoryUserRepository()
+	usecase := NewDeleteProductUseCase(productRepo, userRepo)
+
+	now := time.Now()
+	user := &domain.User{
+		ID:        "123456",
+		Name:      "Daniel",
+		Email:     "daniel@gmail.com",
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	product := &domain.Product{
+		ID:          "123456",
+		UserId:      user.ID,
+		CategoryId:  "123456",
+		Name:        "Produto",
+		Description: "Meu Produto",
+		Status:      "ACTIVE",
+		Price:       100,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+
+	return SUT{
+		UseCase:     usecase,
+		ProductRepo: productRepo,
+		UserRepo:    userRepo,
+		User:        user,
+		Product:     product,
+	}
+}
+
+func seedDefaultData(sut SUT) {
+	sut.UserRepo.Save(context.Background(), sut.User)
+	sut.ProductRepo.Save(context.Background(), sut.Product)
+}
+
+func validInput(sut SUT) DeleteProductInput {
+	return DeleteProductInput{
+		ID:     sut.Product.ID,
+		UserId: sut.User.ID,
+	}
+}
+
+func TestDeleteProduct_GivenInvalidInput_ShouldReturnError(t *testing.T) {
+	testCases := []struct {
+		name        string
+		setup       func(sut SUT)
+		input       func(sut SUT) DeleteProductInput
+		expectedErr error
+	}{
+		{
+			name:  "Empty ID",
+			setup: seedDefaultData,
+			input: func(sut SUT) DeleteProductInput {
+				in := validInput(sut)
+				in.ID = ""
+				return in
+			},
+			expectedErr: domain.ErrProductIdIsRequired,
+		},
+		{
+			name:  "Empty User ID",
+			setup: seedDefaultData,
+			input: func(sut SUT) DeleteProductInput {
+				in := validInput(sut)
+				in.UserId = ""
+				return in
+			},
+			expectedErr: domain.ErrProductUserIdIsRequired,
+		},
+		{
+			name: "User Not Found",
+			setup: func(sut SUT) {
+				sut.ProductRepo.Save(context.Background(), sut.Product)
+			},
+			input:       validInput,
+			expectedErr: domain.ErrProductUserNotFound,
+		},
+		{
+			name:  "Product Not Found",
+			setup: seedDefaultData,
+			input: func(sut SUT) DeleteProductInput {
+				in := validInput(sut)
+				in.ID = "not-found"
+				return in
+			},
+			expectedErr: domain.ErrProductNotFound,
+		},
+		{
+			name: "Product Owned By Another User",
+			setup: func(sut SUT) {
+				seedDefaultData(sut)
+				sut.UserRepo.Save(context.Background(), &domain.User{ID: "other", Name: "Other", Email: "other@gmail.com"})
+			},
+			input: func(sut SUT) DeleteProductInput {
+				in := validInput(sut)
+				in.UserId = "other"
+				return in
+			},
+			expectedErr: domain.ErrProductNotFound,
+		},
+		{
+			name: "Product Already Deleted",
+			setup: func(sut SUT) {
+				seedDefaultData(sut)
+				sut.Product.Delete()
+			},
+			input:       validInput,
+			expectedErr: domain.ErrProductNotFound,
+		},
+		{
+			name: "Repo User Fail On Get",
+			setup: func(sut SUT) {
+				seedDefaultData(sut)
+				sut.UserRepo.FailOnGet = true
+			},
+			input:       validInput,
+			expectedErr: userRepo.ErrSimulatedFailureRepoUser,
+		},
+		{
+			name: "Repo Product Fail On Get",
+			setup: func(sut SUT) {
+				seedDefaultData(sut)
+				sut.ProductRepo.FailOnGetByIdAndUserId = true
+			},
+			input:       validInput,
+			expectedErr: productRepo.ErrSimulatedFailureRepoProduct,
+		},
+		{
+			name: "Repo Product Fail On Delete",
+			setup: func(sut SUT) {
+				seedDefaultData(sut)
+				sut.ProductRepo.FailOnDelete = true
+			},
+			input:       validInput,
+			expectedErr: productRepo.ErrSimulatedFailureRepoProduct,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			sut := makeSut()
+
+			if tc.setup != nil {
+				tc.setup(sut)
+			}
+
+			input := tc.input(sut)
+
+			// Act
+			output, err := sut.UseCase.Perform(context.Background(), input)
+
+			// Assert
+			require.Error(t, err)
+			require.Nil(t, output)
+			assert.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestDeleteProduct_ShouldSoftDeleteProduct(t *testing.T) {
+	// Arrange
+	sut := makeSut()
+	seedDefaultData(sut)
+
+	// Act
+	output, err := sut.UseCase.Perform(context.Background(), validInput(sut))
+
+	// Assert
+	require.NoError(t, err)
+	require.NotNil(t, output)
+	assert.Equal(t, sut.Product.ID, output.ID)
+	assert.False(t, output.DeletedAt.IsZero())
+
+	product, err := sut.ProductRepo.GetById(context.Background(), sut.Product.ID)
+	require.NoError(t, err)
+	assert.Nil(t, product)
+
+	deleted, err := sut.ProductRepo.GetDeletedByIdAndUserId(context.Background(), sut.Product.ID, sut.User.ID)
+	require.NoError(t, err)
+	require.NotNil(t, deleted)
+}
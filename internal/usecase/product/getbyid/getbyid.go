@@ -1,6 +1,8 @@
 package product
 
 import (
+	"context"
+
 	"github.com/areteacademy/internal/domain"
 )
 
@@ -10,7 +12,7 @@ type getByIdProductUseCase struct {
 }
 
 type GetByIdProductUseCase interface {
-	Perform(input GetByIdProductInput) (*GetByIdProductOutput, error)
+	Perform(ctx context.Context, input GetByIdProductInput) (*GetByIdProductOutput, error)
 }
 
 func NewGetByIdProductUseCase(
@@ -23,7 +25,7 @@ func NewGetByIdProductUseCase(
 	}
 }
 
-func (uc *getByIdProductUseCase) Perform(input GetByIdProductInput) (*GetByIdProductOutput, error) {
+func (uc *getByIdProductUseCase) Perform(ctx context.Context, input GetByIdProductInput) (*GetByIdProductOutput, error) {
 	if input.ID == "" {
 		return nil, domain.ErrProductIdIsRequired
 	}
@@ -32,7 +34,7 @@ func (uc *getByIdProductUseCase) Perform(input GetByIdProductInput) (*GetByIdPro
 		return nil, domain.ErrProductUserIdIsRequired
 	}
 
-	user, err := uc.userRepo.GetById(input.UserId)
+	user, err := uc.userRepo.GetById(ctx, input.UserId)
 	if err != nil {
 		return nil, err
 	}
@@ -41,7 +43,7 @@ func (uc *getByIdProductUseCase) Perform(input GetByIdProductInput) (*GetByIdPro
 		return nil, domain.ErrProductUserNotFound
 	}
 
-	product, err := uc.productRepo.GetByIdAndUserId(input.ID, input.UserId)
+	product, err := uc.productRepo.GetByIdAndUserId(ctx, input.ID, input.UserId)
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,7 @@
 package product
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -34,7 +35,7 @@ func TestGetByIdProduct_ShouldReturnAnError_WhenIdEmpty(t *testing.T) {
 	// Arrange
 	sut := makeSut()
 	now := time.Now()
-	sut.UserRepo.Save(&domain.User{
+	sut.UserRepo.Save(context.Background(), &domain.User{
 		ID:        "123456",
 		Name:      "Daniel",
 		Email:     "daniel@gmail.com",
@@ -42,7 +43,7 @@ func TestGetByIdProduct_ShouldReturnAnError_WhenIdEmpty(t *testing.T) {
 		UpdatedAt: now,
 	})
 
-	sut.ProductRepo.Save(&domain.Product{
+	sut.ProductRepo.Save(context.Background(), &domain.Product{
 		ID:          "123456",
 		UserId:      "123456",
 		CategoryId:  "123456",
@@ -55,7 +56,7 @@ func TestGetByIdProduct_ShouldReturnAnError_WhenIdEmpty(t *testing.T) {
 	})
 
 	// Act
-	product, err := sut.UseCase.Perform(GetByIdProductInput{
+	product, err := sut.UseCase.Perform(context.Background(), GetByIdProductInput{
 		ID:     "",
 		UserId: "123456",
 	})
@@ -70,7 +71,7 @@ func TestGetByIdProduct_ShouldReturnAnError_WhenUserIdEmpty(t *testing.T) {
 	// Arrange
 	sut := makeSut()
 	now := time.Now()
-	sut.UserRepo.Save(&domain.User{
+	sut.UserRepo.Save(context.Background(), &domain.User{
 		ID:        "123456",
 		Name:      "Daniel",
 		Email:     "daniel@gmail.com",
@@ -78,7 +79,7 @@ func TestGetByIdProduct_ShouldReturnAnError_WhenUserIdEmpty(t *testing.T) {
 		UpdatedAt: now,
 	})
 
-	sut.ProductRepo.Save(&domain.Product{
+	sut.ProductRepo.Save(context.Background(), &domain.Product{
 		ID:          "123456",
 		UserId:      "123456",
 		CategoryId:  "123456",
@@ -91,7 +92,7 @@ func TestGetByIdProduct_ShouldReturnAnError_WhenUserIdEmpty(t *testing.T) {
 	})
 
 	// Act
-	product, err := sut.UseCase.Perform(GetByIdProductInput{
+	product, err := sut.UseCase.Perform(context.Background(), GetByIdProductInput{
 		ID:     "123455",
 		UserId: "",
 	})
@@ -106,7 +107,7 @@ func TestGetByIdProduct_ShouldReturnAnError_WhenUserNotFound(t *testing.T) {
 	// Arrange
 	sut := makeSut()
 	now := time.Now()
-	sut.UserRepo.Save(&domain.User{
+	sut.UserRepo.Save(context.Background(), &domain.User{
 		ID:        "1234567",
 		Name:      "Daniel",
 		Email:     "daniel@gmail.com",
@@ -114,7 +115,7 @@ func TestGetByIdProduct_ShouldReturnAnError_WhenUserNotFound(t *testing.T) {
 		UpdatedAt: now,
 	})
 
-	sut.ProductRepo.Save(&domain.Product{
+	sut.ProductRepo.Save(context.Background(), &domain.Product{
 		ID:          "123456",
 		UserId:      "123456",
 		CategoryId:  "123456",
@@ -127,7 +128,7 @@ func TestGetByIdProduct_ShouldReturnAnError_WhenUserNotFound(t *testing.T) {
 	})
 
 	// Act
-	product, err := sut.UseCase.Perform(GetByIdProductInput{
+	product, err := sut.UseCase.Perform(context.Background(), GetByIdProductInput{
 		ID:     "123456",
 		UserId: "123456",
 	})
@@ -142,7 +143,7 @@ func TestGetByIdProduct_ShouldReturnAnError_WhenUserRepoFailOnGetById(t *testing
 	// Arrange
 	sut := makeSut()
 	now := time.Now()
-	sut.UserRepo.Save(&domain.User{
+	sut.UserRepo.Save(context.Background(), &domain.User{
 		ID:        "1234567",
 		Name:      "Daniel",
 		Email:     "daniel@gmail.com",
@@ -151,7 +152,7 @@ func TestGetByIdProduct_ShouldReturnAnError_WhenUserRepoFailOnGetById(t *testing
 	})
 	sut.UserRepo.FailOnGet = true
 
-	sut.ProductRepo.Save(&domain.Product{
+	sut.ProductRepo.Save(context.Background(), &domain.Product{
 		ID:          "123456",
 		UserId:      "123456",
 		CategoryId:  "123456",
@@ -164,7 +165,7 @@ func TestGetByIdProduct_ShouldReturnAnError_WhenUserRepoFailOnGetById(t *testing
 	})
 
 	// Act
-	product, err := sut.UseCase.Perform(GetByIdProductInput{
+	product, err := sut.UseCase.Perform(context.Background(), GetByIdProductInput{
 		ID:     "123456",
 		UserId: "123456",
 	})
@@ -179,7 +180,7 @@ func TestGetByIdProduct_ShouldReturnAnError_WhenProductNotFound(t *testing.T) {
 	// Arrange
 	sut := makeSut()
 	now := time.Now()
-	sut.UserRepo.Save(&domain.User{
+	sut.UserRepo.Save(context.Background(), &domain.User{
 		ID:        "123456",
 		Name:      "Daniel",
 		Email:     "daniel@gmail.com",
@@ -187,7 +188,7 @@ func TestGetByIdProduct_ShouldReturnAnError_WhenProductNotFound(t *testing.T) {
 		UpdatedAt: now,
 	})
 
-	sut.ProductRepo.Save(&domain.Product{
+	sut.ProductRepo.Save(context.Background(), &domain.Product{
 		ID:          "1234567",
 		UserId:      "123456",
 		CategoryId:  "123456",
@@ -200,7 +201,7 @@ func TestGetByIdProduct_ShouldReturnAnError_WhenProductNotFound(t *testing.T) {
 	})
 
 	// Act
-	product, err := sut.UseCase.Perform(GetByIdProductInput{
+	product, err := sut.UseCase.Perform(context.Background(), GetByIdProductInput{
 		ID:     "123456",
 		UserId: "123456",
 	})
@@ -215,7 +216,7 @@ func TestGetByIdProduct_ShouldReturnAnError_WhenProductRepoFailOnGetByIdAnduserI
 	// Arrange
 	sut := makeSut()
 	now := time.Now()
-	sut.UserRepo.Save(&domain.User{
+	sut.UserRepo.Save(context.Background(), &domain.User{
 		ID:        "123456",
 		Name:      "Daniel",
 		Email:     "daniel@gmail.com",
@@ -223,7 +224,7 @@ func TestGetByIdProduct_ShouldReturnAnError_WhenProductRepoFailOnGetByIdAnduserI
 		UpdatedAt: now,
 	})
 
-	sut.ProductRepo.Save(&domain.Product{
+	sut.ProductRepo.Save(context.Background(), &domain.Product{
 		ID:          "123456",
 		UserId:      "123456",
 		CategoryId:  "123456",
@@ -237,7 +238,7 @@ func TestGetByIdProduct_ShouldReturnAnError_WhenProductRepoFailOnGetByIdAnduserI
 	sut.ProductRepo.FailOnGetByIdAndUserId = true
 
 	// Act
-	product, err := sut.UseCase.Perform(GetByIdProductInput{
+	product, err := sut.UseCase.Perform(context.Background(), GetByIdProductInput{
 		ID:     "123456",
 		UserId: "123456",
 	})
@@ -252,7 +253,7 @@ func TestGetByIdProduct_ShouldReturnSuccess(t *testing.T) {
 	// Arrange
 	sut := makeSut()
 	now := time.Now()
-	sut.UserRepo.Save(&domain.User{
+	sut.UserRepo.Save(context.Background(), &domain.User{
 		ID:        "123456",
 		Name:      "Daniel",
 		Email:     "daniel@gmail.com",
@@ -260,7 +261,7 @@ func TestGetByIdProduct_ShouldReturnSuccess(t *testing.T) {
 		UpdatedAt: now,
 	})
 
-	sut.ProductRepo.Save(&domain.Product{
+	sut.ProductRepo.Save(context.Background(), &domain.Product{
 		ID:          "123456",
 		UserId:      "123456",
 		CategoryId:  "123456",
@@ -273,7 +274,7 @@ func TestGetByIdProduct_ShouldReturnSuccess(t *testing.T) {
 	})
 
 	// Act
-	product, err := sut.UseCase.Perform(GetByIdProductInput{
+	product, err := sut.UseCase.Perform(context.Background(), GetByIdProductInput{
 		ID:     "123456",
 		UserId: "123456",
 	})
@@ -1,6 +1,8 @@
 package product
 
 import (
+	"context"
+
 	"github.com/areteacademy/internal/domain"
 )
 
@@ -10,7 +12,7 @@ type listByUserIdProductUseCase struct {
 }
 
 type ListByUserIdProductUseCase interface {
-	Perform(userId string) (ListByUserIdProductOutput, error)
+	Perform(ctx context.Context, input ListByUserIdProductInput) (*ListByUserIdProductOutput, error)
 }
 
 func NewListByUserIdProductUseCase(
@@ -23,12 +25,34 @@ func NewListByUserIdProductUseCase(
 	}
 }
 
-func (uc *listByUserIdProductUseCase) Perform(userId string) (ListByUserIdProductOutput, error) {
-	if userId == "" {
+func (uc *listByUserIdProductUseCase) Perform(ctx context.Context, input ListByUserIdProductInput) (*ListByUserIdProductOutput, error) {
+	if input.UserId == "" {
 		return nil, domain.ErrProductUserIdIsRequired
 	}
 
-	user, err := uc.userRepo.GetById(userId)
+	filter, err := domain.NewProductFilter(
+		domain.ProductStatus(input.Status),
+		input.CategoryId,
+		input.MinPrice,
+		input.MaxPrice,
+		domain.TimeRange{From: input.CreatedFrom, To: input.CreatedTo},
+		domain.TimeRange{From: input.UpdatedFrom, To: input.UpdatedTo},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	sort, err := domain.NewSort(input.SortBy, input.SortOrder, domain.ProductSortFields...)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := domain.NewPageRequest(input.Limit, input.After, input.Before, sort)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := uc.userRepo.GetById(ctx, input.UserId)
 	if err != nil {
 		return nil, err
 	}
@@ -37,19 +61,26 @@ func (uc *listByUserIdProductUseCase) Perform(userId string) (ListByUserIdProduc
 		return nil, domain.ErrProductUserNotFound
 	}
 
-	producties, err := uc.productRepo.ListByUserId(userId)
+	page, err := uc.productRepo.ListByUserId(ctx, input.UserId, domain.ProductCriteria{
+		Filter: filter,
+		Page:   request,
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if producties == nil {
+	if len(page.Items) == 0 && request.After == nil && request.Before == nil {
 		return nil, domain.ErrProductNotFound
 	}
 
-	output := make(ListByUserIdProductOutput, 0, len(producties))
+	output := &ListByUserIdProductOutput{
+		Items:          make([]ProductItem, 0, len(page.Items)),
+		NextCursor:     page.NextCursor(),
+		PreviousCursor: page.PreviousCursor(),
+	}
 
-	for _, c := range producties {
-		output = append(output, ProductItem{
+	for _, c := range page.Items {
+		output.Items = append(output.Items, ProductItem{
 			ID:          c.ID,
 			UserId:      c.UserId,
 			CategoryId:  c.CategoryId,
@@ -14,4 +14,25 @@ type ProductItem struct {
 	UpdatedAt   time.Time
 }
 
-type ListByUserIdProductOutput []ProductItem
+type ListByUserIdProductInput struct {
+	UserId      string
+	Status      string
+	CategoryId  string
+	MinPrice    *int
+	MaxPrice    *int
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	UpdatedFrom *time.Time
+	UpdatedTo   *time.Time
+	SortBy      string
+	SortOrder   string
+	Limit       int
+	After       string
+	Before      string
+}
+
+type ListByUserIdProductOutput struct {
+	Items          []ProductItem
+	NextCursor     string
+	PreviousCursor string
+}
@@ -1,6 +1,7 @@
 package product
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -57,11 +58,11 @@ func makeSut() SUT {
 func TestListByUserIdProduct_ShouldReturnAnError_WhenUserIdEmpty(t *testing.T) {
 	// Arrange
 	sut := makeSut()
-	sut.UserRepo.Save(sut.User)
-	sut.ProductRepo.Save(sut.Product)
+	sut.UserRepo.Save(context.Background(), sut.User)
+	sut.ProductRepo.Save(context.Background(), sut.Product)
 
 	// Act
-	producties, err := sut.UseCase.Perform("")
+	producties, err := sut.UseCase.Perform(context.Background(), ListByUserIdProductInput{UserId: ""})
 
 	// Assert
 	require.Error(t, err)
@@ -72,11 +73,11 @@ func TestListByUserIdProduct_ShouldReturnAnError_WhenUserIdEmpty(t *testing.T) {
 func TestListByUserIdProduct_ShouldReturnAnError_WhenUserNotFound(t *testing.T) {
 	// Arrange
 	sut := makeSut()
-	sut.UserRepo.Save(sut.User)
-	sut.ProductRepo.Save(sut.Product)
+	sut.UserRepo.Save(context.Background(), sut.User)
+	sut.ProductRepo.Save(context.Background(), sut.Product)
 
 	// Act
-	producties, err := sut.UseCase.Perform("1234567")
+	producties, err := sut.UseCase.Perform(context.Background(), ListByUserIdProductInput{UserId: "1234567"})
 
 	// Assert
 	require.Error(t, err)
@@ -87,12 +88,12 @@ func TestListByUserIdProduct_ShouldReturnAnError_WhenUserNotFound(t *testing.T)
 func TestListByUserIdProduct_ShouldReturnAnError_WhenRepoUserFailOnGetById(t *testing.T) {
 	// Arrange
 	sut := makeSut()
-	sut.UserRepo.Save(sut.User)
+	sut.UserRepo.Save(context.Background(), sut.User)
 	sut.UserRepo.FailOnGet = true
-	sut.ProductRepo.Save(sut.Product)
+	sut.ProductRepo.Save(context.Background(), sut.Product)
 
 	// Act
-	producties, err := sut.UseCase.Perform("123456")
+	producties, err := sut.UseCase.Perform(context.Background(), ListByUserIdProductInput{UserId: "123456"})
 
 	// Assert
 	require.Error(t, err)
@@ -103,12 +104,12 @@ func TestListByUserIdProduct_ShouldReturnAnError_WhenRepoUserFailOnGetById(t *te
 func TestListByUserIdProduct_ShouldReturnAnError_WhenProductNotFound(t *testing.T) {
 	// Arrange
 	sut := makeSut()
-	sut.UserRepo.Save(sut.User)
+	sut.UserRepo.Save(context.Background(), sut.User)
 	sut.Product.UserId = "1234567"
-	sut.ProductRepo.Save(sut.Product)
+	sut.ProductRepo.Save(context.Background(), sut.Product)
 
 	// Act
-	producties, err := sut.UseCase.Perform("123456")
+	producties, err := sut.UseCase.Perform(context.Background(), ListByUserIdProductInput{UserId: "123456"})
 
 	// Assert
 	require.Error(t, err)
@@ -119,12 +120,12 @@ func TestListByUserIdProduct_ShouldReturnAnError_WhenProductNotFound(t *testing.
 func TestListByUserIdProduct_ShouldReturnAnError_WhenRepoProductFailOnListByUserId(t *testing.T) {
 	// Arrange
 	sut := makeSut()
-	sut.UserRepo.Save(sut.User)
-	sut.ProductRepo.Save(sut.Product)
+	sut.UserRepo.Save(context.Background(), sut.User)
+	sut.ProductRepo.Save(context.Background(), sut.Product)
 	sut.ProductRepo.FailOnList = true
 
 	// Act
-	producties, err := sut.UseCase.Perform("123456")
+	producties, err := sut.UseCase.Perform(context.Background(), ListByUserIdProductInput{UserId: "123456"})
 
 	// Assert
 	require.Error(t, err)
@@ -135,21 +136,21 @@ func TestListByUserIdProduct_ShouldReturnAnError_WhenRepoProductFailOnListByUser
 func TestListByUserIdProduct_ShouldReturnSuccess(t *testing.T) {
 	// Arrange
 	sut := makeSut()
-	sut.UserRepo.Save(sut.User)
+	sut.UserRepo.Save(context.Background(), sut.User)
 
 	p01 := *sut.Product // Copy
 	p01.ID = "p01"
-	sut.ProductRepo.Save(&p01)
+	sut.ProductRepo.Save(context.Background(), &p01)
 
 	p02 := *sut.Product // Copy
 	p02.ID = "p02"
-	sut.ProductRepo.Save(&p02)
+	sut.ProductRepo.Save(context.Background(), &p02)
 
 	p03 := *sut.Product // Copy
 	p03.ID = "p03"
-	sut.ProductRepo.Save(&p03)
+	sut.ProductRepo.Save(context.Background(), &p03)
 
-	expected := ListByUserIdProductOutput{
+	expected := []ProductItem{
 		{
 			ID:          p01.ID,
 			UserId:      p01.UserId,
@@ -186,11 +187,145 @@ func TestListByUserIdProduct_ShouldReturnSuccess(t *testing.T) {
 	}
 
 	// Act
-	producties, err := sut.UseCase.Perform("123456")
+	producties, err := sut.UseCase.Perform(context.Background(), ListByUserIdProductInput{UserId: "123456"})
 
 	// Assert
 	require.NoError(t, err)
 	require.NotNil(t, producties)
 
-	assert.ElementsMatch(t, expected, producties)
+	assert.ElementsMatch(t, expected, producties.Items)
+}
+
+func TestListByUserIdProduct_ShouldReturnAnError_WhenCriteriaInvalid(t *testing.T) {
+	negative, low, high := -1, 100, 500
+	now := time.Now()
+	earlier := now.Add(-time.Hour)
+	sortedByPrice := domain.Sort{Field: domain.SortByPrice, Direction: domain.SortAscending}
+
+	testCases := []struct {
+		name        string
+		input       ListByUserIdProductInput
+		expectedErr error
+	}{
+		{
+			name:        "Invalid status",
+			input:       ListByUserIdProductInput{UserId: "123456", Status: "ARCHIVED"},
+			expectedErr: domain.ErrProductStatusInvalid,
+		},
+		{
+			name:        "Negative price",
+			input:       ListByUserIdProductInput{UserId: "123456", MinPrice: &negative},
+			expectedErr: domain.ErrProductPriceRangeInvalid,
+		},
+		{
+			name:        "Min price above max price",
+			input:       ListByUserIdProductInput{UserId: "123456", MinPrice: &high, MaxPrice: &low},
+			expectedErr: domain.ErrProductPriceRangeInvalid,
+		},
+		{
+			name:        "Created window reversed",
+			input:       ListByUserIdProductInput{UserId: "123456", CreatedFrom: &now, CreatedTo: &earlier},
+			expectedErr: domain.ErrProductDateRangeInvalid,
+		},
+		{
+			name:        "Updated window reversed",
+			input:       ListByUserIdProductInput{UserId: "123456", UpdatedFrom: &now, UpdatedTo: &earlier},
+			expectedErr: domain.ErrProductDateRangeInvalid,
+		},
+		{
+			name:        "Unknown sort field",
+			input:       ListByUserIdProductInput{UserId: "123456", SortBy: "description"},
+			expectedErr: domain.ErrSortFieldInvalid,
+		},
+		{
+			name:        "Unknown sort direction",
+			input:       ListByUserIdProductInput{UserId: "123456", SortOrder: "UP"},
+			expectedErr: domain.ErrSortDirectionInvalid,
+		},
+		{
+			name: "Cursor from another sort",
+			input: ListByUserIdProductInput{
+				UserId: "123456",
+				After:  domain.Cursor{Sort: sortedByPrice, Number: 100, ID: "123456"}.Encode(),
+			},
+			expectedErr: domain.ErrPageCursorInvalid,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			sut := makeSut()
+			sut.UserRepo.Save(context.Background(), sut.User)
+			sut.ProductRepo.Save(context.Background(), sut.Product)
+
+			// Act
+			producties, err := sut.UseCase.Perform(context.Background(), tc.input)
+
+			// Assert
+			require.Nil(t, producties)
+			assert.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestListByUserIdProduct_ShouldReportEveryInvalidFilter(t *testing.T) {
+	// Arrange
+	sut := makeSut()
+	sut.UserRepo.Save(context.Background(), sut.User)
+	negative := -1
+
+	// Act
+	producties, err := sut.UseCase.Perform(context.Background(), ListByUserIdProductInput{
+		UserId:   "123456",
+		Status:   "ARCHIVED",
+		MinPrice: &negative,
+	})
+
+	// Assert
+	require.Nil(t, producties)
+	assert.ErrorIs(t, err, domain.ErrValidationFailed)
+	assert.ErrorIs(t, err, domain.ErrProductStatusInvalid)
+	assert.ErrorIs(t, err, domain.ErrProductPriceRangeInvalid)
+	assert.Len(t, domain.FieldErrors(err), 2)
+}
+
+func TestListByUserIdProduct_ShouldFilterAndSort(t *testing.T) {
+	// Arrange
+	sut := makeSut()
+	sut.UserRepo.Save(context.Background(), sut.User)
+
+	for _, p := range []struct {
+		id     string
+		status string
+		price  int
+	}{
+		{"p01", "ACTIVE", 100},
+		{"p02", "ACTIVE", 900},
+		{"p03", "INACTIVE", 500},
+		{"p04", "ACTIVE", 500},
+	} {
+		product := *sut.Product
+		product.ID = p.id
+		product.Status = p.status
+		product.Price = p.price
+		sut.ProductRepo.Save(context.Background(), &product)
+	}
+
+	minPrice := 200
+
+	// Act
+	producties, err := sut.UseCase.Perform(context.Background(), ListByUserIdProductInput{
+		UserId:    "123456",
+		Status:    "ACTIVE",
+		MinPrice:  &minPrice,
+		SortBy:    "price",
+		SortOrder: "DESC",
+	})
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, producties.Items, 2)
+	assert.Equal(t, "p02", producties.Items[0].ID)
+	assert.Equal(t, "p04", producties.Items[1].ID)
 }
@@ -0,0 +1,58 @@
+package product
+
+import (
+	"context"
+
+	"github.com/areteacademy/internal/domain"
+)
+
+type purgeProductUseCase struct {
+	productRepo domain.ProductRepository
+	userRepo    domain.UserRepository
+}
+
+// PurgeProductUseCase permanently removes a product that was previously
+// soft deleted. Active products must be deleted first.
+type PurgeProductUseCase interface {
+	Perform(ctx context.Context, input PurgeProductInput) error
+}
+
+func NewPurgeProductUseCase(
+	productRepo domain.ProductRepository,
+	userRepo domain.UserRepository,
+) PurgeProductUseCase {
+	return &purgeProductUseCase{
+		productRepo: productRepo,
+		userRepo:    userRepo,
+	}
+}
+
+func (uc *purgeProductUseCase) Perform(ctx context.Context, input PurgeProductInput) error {
+	if input.ID == "" {
+		return domain.ErrProductIdIsRequired
+	}
+
+	if input.UserId == "" {
+		return domain.ErrProductUserIdIsRequired
+	}
+
+	user, err := uc.userRepo.GetById(ctx, input.UserId)
+	if err != nil {
+		return err
+	}
+
+	if user == nil {
+		return domain.ErrProductUserNotFound
+	}
+
+	product, err := uc.productRepo.GetDeletedByIdAndUserId(ctx, input.ID, input.UserId)
+	if err != nil {
+		return err
+	}
+
+	if product == nil {
+		return domain.ErrProductNotFound
+	}
+
+	return uc.productRepo.Purge(ctx, product.ID)
+}
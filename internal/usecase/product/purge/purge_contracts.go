@@ -0,0 +1,6 @@
+package product
+
+type PurgeProductInput struct {
+	ID     string
+	UserId string
+}
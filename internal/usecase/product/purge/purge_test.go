@@ -0,0 +1,160 @@
+package product
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+	productRepo "github.com/areteacademy/internal/infra/repository/product"
+	userRepo "github.com/areteacademy/internal/infra/repository/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type SUT struct {
+	UseCase     PurgeProductUseCase
+	ProductRepo *productRepo.InMemoryProductRepository
+	UserRepo    *userRepo.InMemoryUserRepository
+	User        *domain.User
+	Product     *domain.Product
+}
+
+func makeSut() SUT {
+	productRepo := productRepo.NewInMemoryProductRepository()
+	userRepo := userRepo.NewInMemoryUserRepository()
+	usecase := NewPurgeProductUseCase(productRepo, userRepo)
+
+	now := time.Now()
+	user := &domain.User{
+		ID:        "123456",
+		Name:      "Daniel",
+		Email:     "daniel@gmail.com",
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	product := &domain.Product{
+		ID:          "123456",
+		UserId:      user.ID,
+		CategoryId:  "123456",
+		Name:        "Produto",
+		Description: "Meu Produto",
+		Status:      "ACTIVE",
+		Price:       100,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+		DeletedAt:   &now,
+	}
+
+	return SUT{
+		UseCase:     usecase,
+		ProductRepo: productRepo,
+		UserRepo:    userRepo,
+		User:        user,
+		Product:     product,
+	}
+}
+
+func seedDefaultData(sut SUT) {
+	sut.UserRepo.Save(context.Background(), sut.User)
+	sut.ProductRepo.Save(context.Background(), sut.Product)
+}
+
+func validInput(sut SUT) PurgeProductInput {
+	return PurgeProductInput{
+		ID:     sut.Product.ID,
+		UserId: sut.User.ID,
+	}
+}
+
+func TestPurgeProduct_GivenInvalidInput_ShouldReturnError(t *testing.T) {
+	testCases := []struct {
+		name        string
+		setup       func(sut SUT)
+		input       func(sut SUT) PurgeProductInput
+		expectedErr error
+	}{
+		{
+			name:  "Empty ID",
+			setup: seedDefaultData,
+			input: func(sut SUT) PurgeProductInput {
+				in := validInput(sut)
+				in.ID = ""
+				return in
+			},
+			expectedErr: domain.ErrProductIdIsRequired,
+		},
+		{
+			name:  "Empty User ID",
+			setup: seedDefaultData,
+			input: func(sut SUT) PurgeProductInput {
+				in := validInput(sut)
+				in.UserId = ""
+				return in
+			},
+			expectedErr: domain.ErrProductUserIdIsRequired,
+		},
+		{
+			name: "User Not Found",
+			setup: func(sut SUT) {
+				sut.ProductRepo.Save(context.Background(), sut.Product)
+			},
+			input:       validInput,
+			expectedErr: domain.ErrProductUserNotFound,
+		},
+		{
+			name: "Product Not Deleted",
+			setup: func(sut SUT) {
+				sut.Product.DeletedAt = nil
+				seedDefaultData(sut)
+			},
+			input:       validInput,
+			expectedErr: domain.ErrProductNotFound,
+		},
+		{
+			name: "Repo Product Fail On Purge",
+			setup: func(sut SUT) {
+				seedDefaultData(sut)
+				sut.ProductRepo.FailOnPurge = true
+			},
+			input:       validInput,
+			expectedErr: productRepo.ErrSimulatedFailureRepoProduct,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			sut := makeSut()
+
+			if tc.setup != nil {
+				tc.setup(sut)
+			}
+
+			input := tc.input(sut)
+
+			// Act
+			err := sut.UseCase.Perform(context.Background(), input)
+
+			// Assert
+			require.Error(t, err)
+			assert.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestPurgeProduct_ShouldRemoveProductPermanently(t *testing.T) {
+	// Arrange
+	sut := makeSut()
+	seedDefaultData(sut)
+
+	// Act
+	err := sut.UseCase.Perform(context.Background(), validInput(sut))
+
+	// Assert
+	require.NoError(t, err)
+
+	deleted, err := sut.ProductRepo.GetDeletedByIdAndUserId(context.Background(), sut.Product.ID, sut.User.ID)
+	require.NoError(t, err)
+	assert.Nil(t, deleted)
+}
@@ -0,0 +1,84 @@
+package product
+
+import (
+	"context"
+
+	"github.com/areteacademy/internal/domain"
+)
+
+type restoreProductUseCase struct {
+	productRepo  domain.ProductRepository
+	categoryRepo domain.CategoryRepository
+	userRepo     domain.UserRepository
+}
+
+type RestoreProductUseCase interface {
+	Perform(ctx context.Context, input RestoreProductInput) (*RestoreProductOutput, error)
+}
+
+func NewRestoreProductUseCase(
+	productRepo domain.ProductRepository,
+	categoryRepo domain.CategoryRepository,
+	userRepo domain.UserRepository,
+) RestoreProductUseCase {
+	return &restoreProductUseCase{
+		productRepo:  productRepo,
+		categoryRepo: categoryRepo,
+		userRepo:     userRepo,
+	}
+}
+
+func (uc *restoreProductUseCase) Perform(ctx context.Context, input RestoreProductInput) (*RestoreProductOutput, error) {
+	if input.ID == "" {
+		return nil, domain.ErrProductIdIsRequired
+	}
+
+	if input.UserId == "" {
+		return nil, domain.ErrProductUserIdIsRequired
+	}
+
+	user, err := uc.userRepo.GetById(ctx, input.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if user == nil {
+		return nil, domain.ErrProductUserNotFound
+	}
+
+	product, err := uc.productRepo.GetDeletedByIdAndUserId(ctx, input.ID, input.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if product == nil {
+		return nil, domain.ErrProductNotFound
+	}
+
+	category, err := uc.categoryRepo.GetByIdAndUserId(ctx, product.CategoryId, product.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if category == nil {
+		return nil, domain.ErrProductCategoryNotFound
+	}
+
+	product.Restore()
+
+	if err := uc.productRepo.Restore(ctx, product); err != nil {
+		return nil, err
+	}
+
+	return &RestoreProductOutput{
+		ID:          product.ID,
+		UserId:      product.UserId,
+		CategoryId:  product.CategoryId,
+		Name:        product.Name,
+		Description: product.Description,
+		Status:      product.Status,
+		Price:       product.Price,
+		CreatedAt:   product.CreatedAt,
+		UpdatedAt:   product.UpdatedAt,
+	}, nil
+}
@@ -0,0 +1,20 @@
+package product
+
+import "time"
+
+type RestoreProductInput struct {
+	ID     string
+	UserId string
+}
+
+type RestoreProductOutput struct {
+	ID          string
+	UserId      string
+	CategoryId  string
+	Name        string
+	Description string
+	Status      string
+	Price       int
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
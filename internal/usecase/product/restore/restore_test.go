@@ -0,0 +1,199 @@
+package product
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+	categoryRepo "github.com/areteacademy/internal/infra/repository/category"
+	productRepo "github.com/areteacademy/internal/infra/repository/product"
+	userRepo "github.com/areteacademy/internal/infra/repository/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type SUT struct {
+	UseCase      RestoreProductUseCase
+	ProductRepo  *productRepo.InMemoryProductRepository
+	CategoryRepo *categoryRepo.InMemoryCategoryRepository
+	UserRepo     *userRepo.InMemoryUserRepository
+	User         *domain.User
+	Category     *domain.Category
+	Product      *domain.Product
+}
+
+func makeSut() SUT {
+	productRepo := productRepo.NewInMemoryProductRepository()
+	categoryRepo := categoryRepo.NewInMemoryCategoryRepository()
+	userRepo := userRepo.NewInMemoryUserRepository()
+	usecase := NewRestoreProductUseCase(productRepo, categoryRepo, userRepo)
+
+	now := time.Now()
+	deletedAt := now.Add(-time.Hour)
+	user := &domain.User{
+		ID:        "123456",
+		Name:      "Daniel",
+		Email:     "daniel@gmail.com",
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	category := &domain.Category{
+		ID:        "123456",
+		UserId:    user.ID,
+		Name:      "Categoria",
+		Status:    "ACTIVE",
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	product := &domain.Product{
+		ID:          "123456",
+		UserId:      user.ID,
+		CategoryId:  category.ID,
+		Name:        "Produto",
+		Description: "Meu Produto",
+		Status:      "ACTIVE",
+		Price:       100,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+		DeletedAt:   &deletedAt,
+	}
+
+	return SUT{
+		UseCase:      usecase,
+		ProductRepo:  productRepo,
+		CategoryRepo: categoryRepo,
+		UserRepo:     userRepo,
+		User:         user,
+		Category:     category,
+		Product:      product,
+	}
+}
+
+func seedDefaultData(sut SUT) {
+	sut.UserRepo.Save(context.Background(), sut.User)
+	sut.CategoryRepo.Save(context.Background(), sut.Category)
+	sut.ProductRepo.Save(context.Background(), sut.Product)
+}
+
+func validInput(sut SUT) RestoreProductInput {
+	return RestoreProductInput{
+		ID:     sut.Product.ID,
+		UserId: sut.User.ID,
+	}
+}
+
+func TestRestoreProduct_GivenInvalidInput_ShouldReturnError(t *testing.T) {
+	testCases := []struct {
+		name        string
+		setup       func(sut SUT)
+		input       func(sut SUT) RestoreProductInput
+		expectedErr error
+	}{
+		{
+			name:  "Empty ID",
+			setup: seedDefaultData,
+			input: func(sut SUT) RestoreProductInput {
+				in := validInput(sut)
+				in.ID = ""
+				return in
+			},
+			expectedErr: domain.ErrProductIdIsRequired,
+		},
+		{
+			name:  "Empty User ID",
+			setup: seedDefaultData,
+			input: func(sut SUT) RestoreProductInput {
+				in := validInput(sut)
+				in.UserId = ""
+				return in
+			},
+			expectedErr: domain.ErrProductUserIdIsRequired,
+		},
+		{
+			name: "User Not Found",
+			setup: func(sut SUT) {
+				sut.ProductRepo.Save(context.Background(), sut.Product)
+			},
+			input:       validInput,
+			expectedErr: domain.ErrProductUserNotFound,
+		},
+		{
+			name: "Product Not Deleted",
+			setup: func(sut SUT) {
+				sut.Product.DeletedAt = nil
+				seedDefaultData(sut)
+			},
+			input:       validInput,
+			expectedErr: domain.ErrProductNotFound,
+		},
+		{
+			name: "Category Deleted",
+			setup: func(sut SUT) {
+				seedDefaultData(sut)
+				sut.Category.Delete()
+			},
+			input:       validInput,
+			expectedErr: domain.ErrProductCategoryNotFound,
+		},
+		{
+			name: "Repo Product Fail On Get Deleted",
+			setup: func(sut SUT) {
+				seedDefaultData(sut)
+				sut.ProductRepo.FailOnGetDeleted = true
+			},
+			input:       validInput,
+			expectedErr: productRepo.ErrSimulatedFailureRepoProduct,
+		},
+		{
+			name: "Repo Product Fail On Restore",
+			setup: func(sut SUT) {
+				seedDefaultData(sut)
+				sut.ProductRepo.FailOnRestore = true
+			},
+			input:       validInput,
+			expectedErr: productRepo.ErrSimulatedFailureRepoProduct,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			sut := makeSut()
+
+			if tc.setup != nil {
+				tc.setup(sut)
+			}
+
+			input := tc.input(sut)
+
+			// Act
+			output, err := sut.UseCase.Perform(context.Background(), input)
+
+			// Assert
+			require.Error(t, err)
+			require.Nil(t, output)
+			assert.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestRestoreProduct_ShouldRestoreDeletedProduct(t *testing.T) {
+	// Arrange
+	sut := makeSut()
+	seedDefaultData(sut)
+
+	// Act
+	output, err := sut.UseCase.Perform(context.Background(), validInput(sut))
+
+	// Assert
+	require.NoError(t, err)
+	require.NotNil(t, output)
+	assert.Equal(t, sut.Product.ID, output.ID)
+	assert.Equal(t, sut.Product.Name, output.Name)
+
+	product, err := sut.ProductRepo.GetById(context.Background(), sut.Product.ID)
+	require.NoError(t, err)
+	require.NotNil(t, product)
+	assert.False(t, product.IsDeleted())
+}
@@ -0,0 +1,73 @@
+package product
+
+import (
+	"context"
+
+	"github.com/areteacademy/internal/domain"
+)
+
+type searchProductUseCase struct {
+	productRepo domain.ProductRepository
+	userRepo    domain.UserRepository
+}
+
+type SearchProductUseCase interface {
+	Perform(ctx context.Context, input SearchProductInput) (*SearchProductOutput, error)
+}
+
+func NewSearchProductUseCase(
+	productRepo domain.ProductRepository,
+	userRepo domain.UserRepository,
+) SearchProductUseCase {
+	return &searchProductUseCase{
+		productRepo: productRepo,
+		userRepo:    userRepo,
+	}
+}
+
+func (uc *searchProductUseCase) Perform(ctx context.Context, input SearchProductInput) (*SearchProductOutput, error) {
+	if input.UserId == "" {
+		return nil, domain.ErrProductUserIdIsRequired
+	}
+
+	query, err := domain.NewProductSearchQuery(input.Query, input.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := uc.userRepo.GetById(ctx, input.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if user == nil {
+		return nil, domain.ErrProductUserNotFound
+	}
+
+	results, err := uc.productRepo.Search(ctx, input.UserId, query)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &SearchProductOutput{
+		Items: make([]SearchProductItem, 0, len(results)),
+	}
+
+	for _, r := range results {
+		output.Items = append(output.Items, SearchProductItem{
+			ID:          r.Product.ID,
+			UserId:      r.Product.UserId,
+			CategoryId:  r.Product.CategoryId,
+			Name:        r.Product.Name,
+			Description: r.Product.Description,
+			Status:      r.Product.Status,
+			Price:       r.Product.Price,
+			CreatedAt:   r.Product.CreatedAt,
+			UpdatedAt:   r.Product.UpdatedAt,
+			Snippet:     r.Snippet,
+			Rank:        r.Rank,
+		})
+	}
+
+	return output, nil
+}
@@ -0,0 +1,27 @@
+package product
+
+import "time"
+
+type SearchProductItem struct {
+	ID          string
+	UserId      string
+	CategoryId  string
+	Name        string
+	Description string
+	Status      string
+	Price       int
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	Snippet     string
+	Rank        float64
+}
+
+type SearchProductInput struct {
+	UserId string
+	Query  string
+	Limit  int
+}
+
+type SearchProductOutput struct {
+	Items []SearchProductItem
+}
@@ -0,0 +1,212 @@
+package product
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/areteacademy/internal/domain"
+	productRepo "github.com/areteacademy/internal/infra/repository/product"
+	userRepo "github.com/areteacademy/internal/infra/repository/user"
+)
+
+type SUT struct {
+	UseCase     SearchProductUseCase
+	ProductRepo *productRepo.InMemoryProductRepository
+	UserRepo    *userRepo.InMemoryUserRepository
+	Product     *domain.Product
+	User        *domain.User
+}
+
+func makeSut() SUT {
+	productRepo := productRepo.NewInMemoryProductRepository()
+	userRepo := userRepo.NewInMemoryUserRepository()
+	usecase := NewSearchProductUseCase(productRepo, userRepo)
+
+	now := time.Now()
+	user := &domain.User{
+		ID:        "123456",
+		Name:      "Daniel",
+		Email:     "daniel@gmail.com",
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	product := &domain.Product{
+		ID:          "123456",
+		UserId:      "123456",
+		CategoryId:  "123456",
+		Name:        "Notebook Gamer",
+		Description: "Notebook com placa de vídeo dedicada",
+		Status:      "ACTIVE",
+		Price:       100,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+
+	return SUT{
+		UseCase:     usecase,
+		ProductRepo: productRepo,
+		UserRepo:    userRepo,
+		User:        user,
+		Product:     product,
+	}
+}
+
+func TestSearchProduct_ShouldReturnAnError_WhenInputInvalid(t *testing.T) {
+	testCases := []struct {
+		name        string
+		input       SearchProductInput
+		expectedErr error
+	}{
+		{
+			name:        "User id empty",
+			input:       SearchProductInput{UserId: "", Query: "notebook"},
+			expectedErr: domain.ErrProductUserIdIsRequired,
+		},
+		{
+			name:        "Query empty",
+			input:       SearchProductInput{UserId: "123456", Query: ""},
+			expectedErr: domain.ErrProductSearchQueryIsRequired,
+		},
+		{
+			name:        "Query only punctuation",
+			input:       SearchProductInput{UserId: "123456", Query: `"*-:()`},
+			expectedErr: domain.ErrProductSearchQueryIsRequired,
+		},
+		{
+			name:        "Limit too large",
+			input:       SearchProductInput{UserId: "123456", Query: "notebook", Limit: domain.MaxPageSize + 1},
+			expectedErr: domain.ErrPageSizeInvalid,
+		},
+		{
+			name:        "User not found",
+			input:       SearchProductInput{UserId: "1234567", Query: "notebook"},
+			expectedErr: domain.ErrProductUserNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			sut := makeSut()
+			sut.UserRepo.Save(context.Background(), sut.User)
+			sut.ProductRepo.Save(context.Background(), sut.Product)
+
+			// Act
+			output, err := sut.UseCase.Perform(context.Background(), tc.input)
+
+			// Assert
+			require.Nil(t, output)
+			assert.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestSearchProduct_ShouldReturnAnError_WhenRepoUserFailOnGetById(t *testing.T) {
+	// Arrange
+	sut := makeSut()
+	sut.UserRepo.Save(context.Background(), sut.User)
+	sut.UserRepo.FailOnGet = true
+
+	// Act
+	output, err := sut.UseCase.Perform(context.Background(), SearchProductInput{UserId: "123456", Query: "notebook"})
+
+	// Assert
+	require.Nil(t, output)
+	assert.ErrorIs(t, err, userRepo.ErrSimulatedFailureRepoUser)
+}
+
+func TestSearchProduct_ShouldReturnAnError_WhenRepoProductFailOnSearch(t *testing.T) {
+	// Arrange
+	sut := makeSut()
+	sut.UserRepo.Save(context.Background(), sut.User)
+	sut.ProductRepo.FailOnSearch = true
+
+	// Act
+	output, err := sut.UseCase.Perform(context.Background(), SearchProductInput{UserId: "123456", Query: "notebook"})
+
+	// Assert
+	require.Nil(t, output)
+	assert.ErrorIs(t, err, productRepo.ErrSimulatedFailureRepoProduct)
+}
+
+func TestSearchProduct_ShouldReturnEmpty_WhenNothingMatches(t *testing.T) {
+	// Arrange
+	sut := makeSut()
+	sut.UserRepo.Save(context.Background(), sut.User)
+	sut.ProductRepo.Save(context.Background(), sut.Product)
+
+	// Act
+	output, err := sut.UseCase.Perform(context.Background(), SearchProductInput{UserId: "123456", Query: "cadeira"})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, output.Items)
+}
+
+func TestSearchProduct_ShouldRankMatchesAndHighlightSnippet(t *testing.T) {
+	// Arrange
+	sut := makeSut()
+	sut.UserRepo.Save(context.Background(), sut.User)
+
+	for _, p := range []struct {
+		id          string
+		userId      string
+		name        string
+		description string
+		deleted     bool
+	}{
+		{"p01", "123456", "Mouse sem fio", "Acompanha notebook", false},
+		{"p02", "123456", "Notebook Gamer", "Notebook com placa dedicada", false},
+		{"p03", "123456", "Cadeira", "Ergonômica", false},
+		{"p04", "654321", "Notebook", "De outro usuário", false},
+		{"p05", "123456", "Notebook antigo", "Removido", true},
+	} {
+		product := *sut.Product
+		product.ID = p.id
+		product.UserId = p.userId
+		product.Name = p.name
+		product.Description = p.description
+		if p.deleted {
+			deletedAt := time.Now()
+			product.DeletedAt = &deletedAt
+		}
+		sut.ProductRepo.Save(context.Background(), &product)
+	}
+
+	// Act
+	output, err := sut.UseCase.Perform(context.Background(), SearchProductInput{UserId: "123456", Query: "NOTE"})
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, output.Items, 2)
+	assert.Equal(t, "p02", output.Items[0].ID)
+	assert.Equal(t, "<mark>Notebook</mark> Gamer", output.Items[0].Snippet)
+	assert.Equal(t, "p01", output.Items[1].ID)
+	assert.Equal(t, "Acompanha <mark>notebook</mark>", output.Items[1].Snippet)
+	assert.Greater(t, output.Items[0].Rank, output.Items[1].Rank)
+}
+
+func TestSearchProduct_ShouldRequireEveryTerm(t *testing.T) {
+	// Arrange
+	sut := makeSut()
+	sut.UserRepo.Save(context.Background(), sut.User)
+	sut.ProductRepo.Save(context.Background(), sut.Product)
+
+	other := *sut.Product
+	other.ID = "p02"
+	other.Name = "Notebook Office"
+	other.Description = "Para escritório"
+	sut.ProductRepo.Save(context.Background(), &other)
+
+	// Act
+	output, err := sut.UseCase.Perform(context.Background(), SearchProductInput{UserId: "123456", Query: "notebook placa"})
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, output.Items, 1)
+	assert.Equal(t, sut.Product.ID, output.Items[0].ID)
+}
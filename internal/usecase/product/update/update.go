@@ -1,6 +1,7 @@
 package product
 
 import (
+	"context"
 	"time"
 
 	"github.com/areteacademy/internal/domain"
@@ -29,28 +30,20 @@ type UpdateProductOutput struct {
 }
 
 type updateProductUseCase struct {
-	productRepo  domain.ProductRepository
-	categoryRepo domain.CategoryRepository
-	userRepo     domain.UserRepository
+	unitOfWork domain.UnitOfWork
 }
 
 type UpdateProductUseCase interface {
-	Perform(input UpdateProductInput) (*UpdateProductOutput, error)
+	Perform(ctx context.Context, input UpdateProductInput) (*UpdateProductOutput, error)
 }
 
-func NewUpdateProductUseCase(
-	productRepo domain.ProductRepository,
-	categoryRepo domain.CategoryRepository,
-	userRepo domain.UserRepository,
-) UpdateProductUseCase {
+func NewUpdateProductUseCase(unitOfWork domain.UnitOfWork) UpdateProductUseCase {
 	return &updateProductUseCase{
-		productRepo:  productRepo,
-		categoryRepo: categoryRepo,
-		userRepo:     userRepo,
+		unitOfWork: unitOfWork,
 	}
 }
 
-func (uc *updateProductUseCase) Perform(input UpdateProductInput) (*UpdateProductOutput, error) {
+func (uc *updateProductUseCase) Perform(ctx context.Context, input UpdateProductInput) (*UpdateProductOutput, error) {
 	if input.ID == "" {
 		return nil, domain.ErrProductIdIsRequired
 	}
@@ -59,46 +52,53 @@ func (uc *updateProductUseCase) Perform(input UpdateProductInput) (*UpdateProduc
 		return nil, domain.ErrProductUserIdIsRequired
 	}
 
-	product, err := uc.productRepo.GetByIdAndUserId(input.ID, input.UserId)
-	if err != nil {
-		return nil, err
-	}
+	var product *domain.Product
 
-	if product == nil {
-		return nil, domain.ErrProductNotFound
-	}
+	err := uc.unitOfWork.Do(ctx, func(repos domain.Repositories) error {
+		var err error
 
-	err = product.UpdateProduct(
-		input.CategoryId,
-		input.Name,
-		input.Description,
-		domain.ProductStatus(input.Status),
-		input.Price,
-	)
+		product, err = repos.Products().GetByIdAndUserId(ctx, input.ID, input.UserId)
+		if err != nil {
+			return err
+		}
 
-	if err != nil {
-		return nil, err
-	}
+		if product == nil {
+			return domain.ErrProductNotFound
+		}
 
-	user, err := uc.userRepo.GetById(product.UserId)
-	if err != nil {
-		return nil, err
-	}
+		err = product.UpdateProduct(
+			input.CategoryId,
+			input.Name,
+			input.Description,
+			domain.ProductStatus(input.Status),
+			input.Price,
+		)
 
-	if user == nil {
-		return nil, domain.ErrProductUserNotFound
-	}
+		if err != nil {
+			return err
+		}
 
-	category, err := uc.categoryRepo.GetByIdAndUserId(input.CategoryId, product.UserId)
-	if err != nil {
-		return nil, err
-	}
+		user, err := repos.Users().GetById(ctx, product.UserId)
+		if err != nil {
+			return err
+		}
 
-	if category == nil {
-		return nil, domain.ErrProductCategoryNotFound
-	}
+		if user == nil {
+			return domain.ErrProductUserNotFound
+		}
+
+		category, err := repos.Categories().GetByIdAndUserId(ctx, input.CategoryId, product.UserId)
+		if err != nil {
+			return err
+		}
 
-	if err := uc.productRepo.Update(product); err != nil {
+		if category == nil {
+			return domain.ErrProductCategoryNotFound
+		}
+
+		return repos.Products().Update(ctx, product)
+	})
+	if err != nil {
 		return nil, err
 	}
 
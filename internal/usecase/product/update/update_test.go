@@ -1,12 +1,14 @@
 package product
 
 import (
+	"context"
 	"testing"
 	"time"
 
 	"github.com/areteacademy/internal/domain"
 	categoryRepo "github.com/areteacademy/internal/infra/repository/category"
 	productRepo "github.com/areteacademy/internal/infra/repository/product"
+	"github.com/areteacademy/internal/infra/repository/transaction"
 	userRepo "github.com/areteacademy/internal/infra/repository/user"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -14,6 +16,7 @@ import (
 
 type SUT struct {
 	UseCase      UpdateProductUseCase
+	UnitOfWork   *transaction.InMemoryUnitOfWork
 	ProductRepo  *productRepo.InMemoryProductRepository
 	CategoryRepo *categoryRepo.InMemoryCategoryRepository
 	UserRepo     *userRepo.InMemoryUserRepository
@@ -26,7 +29,8 @@ func makeSut() SUT {
 	productRepo := productRepo.NewInMemoryProductRepository()
 	categoryRepo := categoryRepo.NewInMemoryCategoryRepository()
 	userRepo := userRepo.NewInMemoryUserRepository()
-	usecase := NewUpdateProductUseCase(productRepo, categoryRepo, userRepo)
+	unitOfWork := transaction.NewInMemoryUnitOfWork(userRepo, categoryRepo, productRepo)
+	usecase := NewUpdateProductUseCase(unitOfWork)
 
 	now := time.Now()
 	user := &domain.User{
@@ -58,6 +62,7 @@ func makeSut() SUT {
 
 	return SUT{
 		UseCase:      usecase,
+		UnitOfWork:   unitOfWork,
 		ProductRepo:  productRepo,
 		CategoryRepo: categoryRepo,
 		UserRepo:     userRepo,
@@ -80,9 +85,9 @@ func validInput(sut SUT) UpdateProductInput {
 }
 
 func seedDefaultData(sut SUT) {
-	sut.UserRepo.Save(sut.User)
-	sut.CategoryRepo.Save(sut.Category)
-	sut.ProductRepo.Save(sut.Product)
+	sut.UserRepo.Save(context.Background(), sut.User)
+	sut.CategoryRepo.Save(context.Background(), sut.Category)
+	sut.ProductRepo.Save(context.Background(), sut.Product)
 }
 
 func TestUpdateProduct_GivenInvalidInput_ShouldReturnError(t *testing.T) {
@@ -286,7 +291,7 @@ func TestUpdateProduct_GivenInvalidInput_ShouldReturnError(t *testing.T) {
 
 			input := tc.input(sut)
 
-			product, err := sut.UseCase.Perform(input)
+			product, err := sut.UseCase.Perform(context.Background(), input)
 
 			// Assert
 			require.Error(t, err)
@@ -312,7 +317,7 @@ func TestUpdateProduct_ShouldReturnSuccess(t *testing.T) {
 	}
 
 	// Act
-	product, err := sut.UseCase.Perform(expected)
+	product, err := sut.UseCase.Perform(context.Background(), expected)
 
 	// Assert
 	require.NoError(t, err)
@@ -328,3 +333,25 @@ func TestUpdateProduct_ShouldReturnSuccess(t *testing.T) {
 	assert.False(t, product.UpdatedAt.IsZero())
 	assert.True(t, product.UpdatedAt.After(product.CreatedAt))
 }
+
+func TestUpdateProduct_ShouldRollback_WhenCommitFails(t *testing.T) {
+	// Arrange
+	sut := makeSut()
+	seedDefaultData(sut)
+	sut.UnitOfWork.FailOnCommit = true
+
+	input := validInput(sut)
+	input.Name = "Produto editado"
+
+	// Act
+	product, err := sut.UseCase.Perform(context.Background(), input)
+
+	// Assert
+	require.ErrorIs(t, err, transaction.ErrSimulatedFailureUnitOfWork)
+	require.Nil(t, product)
+
+	stored, err := sut.ProductRepo.GetById(context.Background(), sut.Product.ID)
+	require.NoError(t, err)
+	require.NotNil(t, stored)
+	assert.Equal(t, "Produto1", stored.Name)
+}
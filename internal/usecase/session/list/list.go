@@ -0,0 +1,65 @@
+package session
+
+import (
+	"context"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+)
+
+type listSessionsUseCase struct {
+	userRepo    domain.UserRepository
+	sessionRepo domain.SessionRepository
+}
+
+type ListSessionsUseCase interface {
+	Perform(ctx context.Context, input ListSessionsInput) (*ListSessionsOutput, error)
+}
+
+func NewListSessionsUseCase(userRepo domain.UserRepository, sessionRepo domain.SessionRepository) ListSessionsUseCase {
+	return &listSessionsUseCase{
+		userRepo:    userRepo,
+		sessionRepo: sessionRepo,
+	}
+}
+
+// Perform leaves out sessions ended by revoking the user's tokens, such as
+// on a password change, which are not marked revoked themselves.
+func (uc *listSessionsUseCase) Perform(ctx context.Context, input ListSessionsInput) (*ListSessionsOutput, error) {
+	if input.UserId == "" {
+		return nil, domain.ErrSessionUserIdIsRequired
+	}
+
+	user, err := uc.userRepo.GetById(ctx, input.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if user == nil {
+		return nil, domain.ErrUserNotFound
+	}
+
+	sessions, err := uc.sessionRepo.ListActiveByUserId(ctx, user.ID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]SessionItem, 0, len(sessions))
+	for _, session := range sessions {
+		if !session.BelongsTo(user) {
+			continue
+		}
+
+		items = append(items, SessionItem{
+			ID:         session.ID,
+			Device:     session.Device,
+			IP:         session.IP,
+			Current:    session.ID == input.CurrentSessionId,
+			LastUsedAt: session.LastUsedAt,
+			ExpiresAt:  session.ExpiresAt,
+			CreatedAt:  session.CreatedAt,
+		})
+	}
+
+	return &ListSessionsOutput{Items: items}, nil
+}
@@ -0,0 +1,23 @@
+package session
+
+import "time"
+
+type SessionItem struct {
+	ID     string
+	Device string
+	IP     string
+	// Current marks the session the request was made from.
+	Current    bool
+	LastUsedAt time.Time
+	ExpiresAt  time.Time
+	CreatedAt  time.Time
+}
+
+type ListSessionsInput struct {
+	UserId           string
+	CurrentSessionId string
+}
+
+type ListSessionsOutput struct {
+	Items []SessionItem
+}
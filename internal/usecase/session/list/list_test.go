@@ -0,0 +1,149 @@
+package session
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+	sessionRepo "github.com/areteacademy/internal/infra/repository/session"
+	repo "github.com/areteacademy/internal/infra/repository/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type SUT struct {
+	UseCase     ListSessionsUseCase
+	Repo        *repo.InMemoryUserRepository
+	SessionRepo *sessionRepo.InMemorySessionRepository
+	User        *domain.User
+}
+
+func makeSut() SUT {
+	repo := repo.NewInMemoryUserRepository()
+	sessionRepo := sessionRepo.NewInMemorySessionRepository()
+
+	now := time.Now()
+
+	user := &domain.User{
+		ID:        "123456",
+		Name:      "Daniel",
+		Email:     "daniel@gmail.com",
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	return SUT{
+		UseCase:     NewListSessionsUseCase(repo, sessionRepo),
+		Repo:        repo,
+		SessionRepo: sessionRepo,
+		User:        user,
+	}
+}
+
+func createSession(t *testing.T, sut SUT, user *domain.User, device string, startedAt time.Time) *domain.Session {
+	session, token, _, err := domain.NewSession(user, device, "192.0.2.1", time.Hour, startedAt)
+	require.NoError(t, err)
+	require.NoError(t, sut.SessionRepo.Create(context.Background(), session, token))
+
+	return session
+}
+
+func TestListSessions_GivenInvalidInput_ShouldReturnError(t *testing.T) {
+	testCases := []struct {
+		name        string
+		setup       func(sut SUT)
+		input       ListSessionsInput
+		expectedErr error
+	}{
+		{
+			name:        "Empty User Id",
+			input:       ListSessionsInput{},
+			expectedErr: domain.ErrSessionUserIdIsRequired,
+		},
+		{
+			name:        "User Not Found",
+			input:       ListSessionsInput{UserId: "654321"},
+			expectedErr: domain.ErrUserNotFound,
+		},
+		{
+			name: "Repo User Fail On Get",
+			setup: func(sut SUT) {
+				sut.Repo.FailOnGet = true
+			},
+			input:       ListSessionsInput{UserId: "123456"},
+			expectedErr: repo.ErrSimulatedFailureRepoUser,
+		},
+		{
+			name: "Repo Session Fail On List",
+			setup: func(sut SUT) {
+				sut.SessionRepo.FailOnList = true
+			},
+			input:       ListSessionsInput{UserId: "123456"},
+			expectedErr: sessionRepo.ErrSimulatedFailureRepoSession,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			sut := makeSut()
+			require.NoError(t, sut.Repo.Save(context.Background(), sut.User))
+
+			if tc.setup != nil {
+				tc.setup(sut)
+			}
+
+			// Act
+			output, err := sut.UseCase.Perform(context.Background(), tc.input)
+
+			// Assert
+			require.Nil(t, output)
+			assert.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestListSessions_ShouldListActiveSessionsAndMarkCurrent(t *testing.T) {
+	// Arrange
+	sut := makeSut()
+	require.NoError(t, sut.Repo.Save(context.Background(), sut.User))
+
+	now := time.Now()
+	older := createSession(t, sut, sut.User, "Firefox", now.Add(-2*time.Minute))
+	current := createSession(t, sut, sut.User, "Chrome", now.Add(-time.Minute))
+	revoked := createSession(t, sut, sut.User, "Safari", now)
+	require.NoError(t, sut.SessionRepo.Revoke(context.Background(), revoked.ID, now))
+	createSession(t, sut, &domain.User{ID: "654321"}, "Edge", now)
+
+	// Act
+	output, err := sut.UseCase.Perform(context.Background(), ListSessionsInput{
+		UserId:           sut.User.ID,
+		CurrentSessionId: current.ID,
+	})
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, output.Items, 2)
+	assert.Equal(t, current.ID, output.Items[0].ID)
+	assert.True(t, output.Items[0].Current)
+	assert.Equal(t, "Chrome", output.Items[0].Device)
+	assert.Equal(t, older.ID, output.Items[1].ID)
+	assert.False(t, output.Items[1].Current)
+}
+
+func TestListSessions_ShouldLeaveOutSessionsOfRevokedTokens(t *testing.T) {
+	// Arrange
+	sut := makeSut()
+	require.NoError(t, sut.Repo.Save(context.Background(), sut.User))
+
+	createSession(t, sut, sut.User, "Firefox", time.Now())
+	sut.User.RevokeTokens()
+
+	// Act
+	output, err := sut.UseCase.Perform(context.Background(), ListSessionsInput{UserId: sut.User.ID})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, output.Items)
+}
@@ -0,0 +1,51 @@
+package session
+
+import (
+	"context"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+)
+
+type revokeSessionUseCase struct {
+	sessionRepo domain.SessionRepository
+}
+
+type RevokeSessionUseCase interface {
+	Perform(ctx context.Context, input RevokeSessionInput) error
+}
+
+func NewRevokeSessionUseCase(sessionRepo domain.SessionRepository) RevokeSessionUseCase {
+	return &revokeSessionUseCase{
+		sessionRepo: sessionRepo,
+	}
+}
+
+// Perform logs a single session out. Its access tokens stop being accepted
+// on the next request and its refresh token can no longer be exchanged.
+// Revoking a session twice succeeds, so a client retrying gets the same
+// answer.
+func (uc *revokeSessionUseCase) Perform(ctx context.Context, input RevokeSessionInput) error {
+	if input.ID == "" {
+		return domain.ErrSessionIdIsRequired
+	}
+
+	if input.UserId == "" {
+		return domain.ErrSessionUserIdIsRequired
+	}
+
+	session, err := uc.sessionRepo.GetByIdAndUserId(ctx, input.ID, input.UserId)
+	if err != nil {
+		return err
+	}
+
+	if session == nil {
+		return domain.ErrSessionNotFound
+	}
+
+	if session.IsRevoked() {
+		return nil
+	}
+
+	return uc.sessionRepo.Revoke(ctx, session.ID, time.Now())
+}
@@ -0,0 +1,6 @@
+package session
+
+type RevokeSessionInput struct {
+	ID     string
+	UserId string
+}
@@ -0,0 +1,133 @@
+package session
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+	repo "github.com/areteacademy/internal/infra/repository/session"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type SUT struct {
+	UseCase RevokeSessionUseCase
+	Repo    *repo.InMemorySessionRepository
+	Session *domain.Session
+}
+
+func makeSut(t *testing.T) SUT {
+	repo := repo.NewInMemorySessionRepository()
+
+	session, token, _, err := domain.NewSession(&domain.User{ID: "123456"}, "Firefox", "", time.Hour, time.Now())
+	require.NoError(t, err)
+	require.NoError(t, repo.Create(context.Background(), session, token))
+
+	return SUT{
+		UseCase: NewRevokeSessionUseCase(repo),
+		Repo:    repo,
+		Session: session,
+	}
+}
+
+func TestRevokeSession_GivenInvalidInput_ShouldReturnError(t *testing.T) {
+	testCases := []struct {
+		name        string
+		setup       func(sut SUT)
+		input       func(sut SUT) RevokeSessionInput
+		expectedErr error
+	}{
+		{
+			name: "Empty Id",
+			input: func(sut SUT) RevokeSessionInput {
+				return RevokeSessionInput{UserId: sut.Session.UserId}
+			},
+			expectedErr: domain.ErrSessionIdIsRequired,
+		},
+		{
+			name: "Empty User Id",
+			input: func(sut SUT) RevokeSessionInput {
+				return RevokeSessionInput{ID: sut.Session.ID}
+			},
+			expectedErr: domain.ErrSessionUserIdIsRequired,
+		},
+		{
+			name: "Session Of Another User",
+			input: func(sut SUT) RevokeSessionInput {
+				return RevokeSessionInput{ID: sut.Session.ID, UserId: "654321"}
+			},
+			expectedErr: domain.ErrSessionNotFound,
+		},
+		{
+			name: "Repo Session Fail On Get",
+			setup: func(sut SUT) {
+				sut.Repo.FailOnGet = true
+			},
+			input: func(sut SUT) RevokeSessionInput {
+				return RevokeSessionInput{ID: sut.Session.ID, UserId: sut.Session.UserId}
+			},
+			expectedErr: repo.ErrSimulatedFailureRepoSession,
+		},
+		{
+			name: "Repo Session Fail On Revoke",
+			setup: func(sut SUT) {
+				sut.Repo.FailOnRevoke = true
+			},
+			input: func(sut SUT) RevokeSessionInput {
+				return RevokeSessionInput{ID: sut.Session.ID, UserId: sut.Session.UserId}
+			},
+			expectedErr: repo.ErrSimulatedFailureRepoSession,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			sut := makeSut(t)
+
+			if tc.setup != nil {
+				tc.setup(sut)
+			}
+
+			// Act
+			err := sut.UseCase.Perform(context.Background(), tc.input(sut))
+
+			// Assert
+			assert.ErrorIs(t, err, tc.expectedErr)
+			assert.False(t, sut.Session.IsRevoked())
+		})
+	}
+}
+
+func TestRevokeSession_ShouldRevokeOnlyThatSession(t *testing.T) {
+	// Arrange
+	sut := makeSut(t)
+
+	other, token, _, err := domain.NewSession(&domain.User{ID: sut.Session.UserId}, "Chrome", "", time.Hour, time.Now())
+	require.NoError(t, err)
+	require.NoError(t, sut.Repo.Create(context.Background(), other, token))
+
+	// Act
+	err = sut.UseCase.Perform(context.Background(), RevokeSessionInput{ID: sut.Session.ID, UserId: sut.Session.UserId})
+
+	// Assert
+	require.NoError(t, err)
+	assert.True(t, sut.Session.IsRevoked())
+	assert.False(t, other.IsRevoked())
+}
+
+func TestRevokeSession_ShouldSucceed_WhenAlreadyRevoked(t *testing.T) {
+	// Arrange
+	sut := makeSut(t)
+	revokedAt := time.Now().Add(-time.Hour)
+	sut.Session.RevokedAt = &revokedAt
+	sut.Repo.FailOnRevoke = true
+
+	// Act
+	err := sut.UseCase.Perform(context.Background(), RevokeSessionInput{ID: sut.Session.ID, UserId: sut.Session.UserId})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, revokedAt, *sut.Session.RevokedAt)
+}
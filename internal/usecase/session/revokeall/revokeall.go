@@ -0,0 +1,49 @@
+package session
+
+import (
+	"context"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+)
+
+type revokeAllSessionsUseCase struct {
+	userRepo    domain.UserRepository
+	sessionRepo domain.SessionRepository
+}
+
+type RevokeAllSessionsUseCase interface {
+	Perform(ctx context.Context, input RevokeAllSessionsInput) error
+}
+
+func NewRevokeAllSessionsUseCase(userRepo domain.UserRepository, sessionRepo domain.SessionRepository) RevokeAllSessionsUseCase {
+	return &revokeAllSessionsUseCase{
+		userRepo:    userRepo,
+		sessionRepo: sessionRepo,
+	}
+}
+
+// Perform logs the user out everywhere, the session the request came from
+// included. The user's tokens are revoked as well, so a session started
+// while this runs does not survive it.
+func (uc *revokeAllSessionsUseCase) Perform(ctx context.Context, input RevokeAllSessionsInput) error {
+	if input.UserId == "" {
+		return domain.ErrSessionUserIdIsRequired
+	}
+
+	user, err := uc.userRepo.GetById(ctx, input.UserId)
+	if err != nil {
+		return err
+	}
+
+	if user == nil {
+		return domain.ErrUserNotFound
+	}
+
+	user.RevokeTokens()
+	if err := uc.userRepo.Update(ctx, user); err != nil {
+		return err
+	}
+
+	return uc.sessionRepo.RevokeAllByUserId(ctx, user.ID, time.Now())
+}
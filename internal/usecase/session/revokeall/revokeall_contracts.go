@@ -0,0 +1,5 @@
+package session
+
+type RevokeAllSessionsInput struct {
+	UserId string
+}
@@ -0,0 +1,130 @@
+package session
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+	sessionRepo "github.com/areteacademy/internal/infra/repository/session"
+	repo "github.com/areteacademy/internal/infra/repository/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type SUT struct {
+	UseCase     RevokeAllSessionsUseCase
+	Repo        *repo.InMemoryUserRepository
+	SessionRepo *sessionRepo.InMemorySessionRepository
+	User        *domain.User
+}
+
+func makeSut(t *testing.T) SUT {
+	repo := repo.NewInMemoryUserRepository()
+	sessionRepo := sessionRepo.NewInMemorySessionRepository()
+
+	now := time.Now()
+
+	user := &domain.User{
+		ID:        "123456",
+		Name:      "Daniel",
+		Email:     "daniel@gmail.com",
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	require.NoError(t, repo.Save(context.Background(), user))
+
+	return SUT{
+		UseCase:     NewRevokeAllSessionsUseCase(repo, sessionRepo),
+		Repo:        repo,
+		SessionRepo: sessionRepo,
+		User:        user,
+	}
+}
+
+func TestRevokeAllSessions_GivenInvalidInput_ShouldReturnError(t *testing.T) {
+	testCases := []struct {
+		name        string
+		setup       func(sut SUT)
+		input       RevokeAllSessionsInput
+		expectedErr error
+	}{
+		{
+			name:        "Empty User Id",
+			input:       RevokeAllSessionsInput{},
+			expectedErr: domain.ErrSessionUserIdIsRequired,
+		},
+		{
+			name:        "User Not Found",
+			input:       RevokeAllSessionsInput{UserId: "654321"},
+			expectedErr: domain.ErrUserNotFound,
+		},
+		{
+			name: "Repo User Fail On Get",
+			setup: func(sut SUT) {
+				sut.Repo.FailOnGet = true
+			},
+			input:       RevokeAllSessionsInput{UserId: "123456"},
+			expectedErr: repo.ErrSimulatedFailureRepoUser,
+		},
+		{
+			name: "Repo User Fail On Update",
+			setup: func(sut SUT) {
+				sut.Repo.FailOnUpdate = true
+			},
+			input:       RevokeAllSessionsInput{UserId: "123456"},
+			expectedErr: repo.ErrSimulatedFailureRepoUser,
+		},
+		{
+			name: "Repo Session Fail On Revoke",
+			setup: func(sut SUT) {
+				sut.SessionRepo.FailOnRevoke = true
+			},
+			input:       RevokeAllSessionsInput{UserId: "123456"},
+			expectedErr: sessionRepo.ErrSimulatedFailureRepoSession,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			sut := makeSut(t)
+
+			if tc.setup != nil {
+				tc.setup(sut)
+			}
+
+			// Act
+			err := sut.UseCase.Perform(context.Background(), tc.input)
+
+			// Assert
+			assert.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestRevokeAllSessions_ShouldRevokeEverySessionAndToken(t *testing.T) {
+	// Arrange
+	sut := makeSut(t)
+
+	var sessions []*domain.Session
+	for _, device := range []string{"Firefox", "Chrome"} {
+		session, token, _, err := domain.NewSession(sut.User, device, "", time.Hour, time.Now())
+		require.NoError(t, err)
+		require.NoError(t, sut.SessionRepo.Create(context.Background(), session, token))
+		sessions = append(sessions, session)
+	}
+
+	// Act
+	err := sut.UseCase.Perform(context.Background(), RevokeAllSessionsInput{UserId: sut.User.ID})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 1, sut.User.TokenVersion)
+
+	for _, session := range sessions {
+		assert.True(t, session.IsRevoked())
+		assert.False(t, session.BelongsTo(sut.User))
+	}
+}
@@ -0,0 +1,66 @@
+package twofactor
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+)
+
+type confirmTwoFactorUseCase struct {
+	twoFactorRepo domain.TwoFactorRepository
+}
+
+type ConfirmTwoFactorUseCase interface {
+	Perform(ctx context.Context, input ConfirmTwoFactorInput) (*ConfirmTwoFactorOutput, error)
+}
+
+func NewConfirmTwoFactorUseCase(twoFactorRepo domain.TwoFactorRepository) ConfirmTwoFactorUseCase {
+	return &confirmTwoFactorUseCase{
+		twoFactorRepo: twoFactorRepo,
+	}
+}
+
+// Perform only enables two-factor authentication once the user shows a code
+// from their authenticator, so a mistyped secret cannot lock them out.
+func (uc *confirmTwoFactorUseCase) Perform(ctx context.Context, input ConfirmTwoFactorInput) (*ConfirmTwoFactorOutput, error) {
+	if input.UserId == "" {
+		return nil, domain.ErrUserIdIsRequired
+	}
+
+	code := strings.TrimSpace(input.Code)
+	if code == "" {
+		return nil, domain.ErrTwoFactorCodeIsRequired
+	}
+
+	twoFactor, err := uc.twoFactorRepo.GetByUserId(ctx, input.UserId)
+	if err != nil && !errors.Is(err, domain.ErrTwoFactorNotFound) {
+		return nil, err
+	}
+
+	if twoFactor == nil {
+		return nil, domain.ErrTwoFactorNotEnrolled
+	}
+
+	if twoFactor.IsEnabled() {
+		return nil, domain.ErrTwoFactorAlreadyEnabled
+	}
+
+	now := time.Now()
+
+	step, ok := twoFactor.MatchCode(code, now)
+	if !ok {
+		return nil, domain.ErrTwoFactorCodeInvalid
+	}
+
+	twoFactor.Enable(step, now)
+	codes, plain := domain.NewRecoveryCodes(twoFactor.UserId, now)
+
+	if err := uc.twoFactorRepo.Enable(ctx, twoFactor, codes); err != nil {
+		return nil, err
+	}
+
+	return &ConfirmTwoFactorOutput{RecoveryCodes: plain}, nil
+}
@@ -0,0 +1,12 @@
+package twofactor
+
+type ConfirmTwoFactorInput struct {
+	UserId string
+	Code   string
+}
+
+type ConfirmTwoFactorOutput struct {
+	// RecoveryCodes can each be used once in place of a code. They cannot
+	// be retrieved again.
+	RecoveryCodes []string
+}
@@ -0,0 +1,152 @@
+package twofactor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+	repo "github.com/areteacademy/internal/infra/repository/twofactor"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type SUT struct {
+	UseCase   ConfirmTwoFactorUseCase
+	Repo      *repo.InMemoryTwoFactorRepository
+	TwoFactor *domain.TwoFactor
+}
+
+func makeSut(t *testing.T) SUT {
+	repo := repo.NewInMemoryTwoFactorRepository()
+
+	twoFactor, err := domain.NewTwoFactor("123456", time.Now())
+	require.NoError(t, err)
+
+	return SUT{
+		UseCase:   NewConfirmTwoFactorUseCase(repo),
+		Repo:      repo,
+		TwoFactor: twoFactor,
+	}
+}
+
+func currentCode(t *testing.T, sut SUT) string {
+	code, err := sut.TwoFactor.CodeAt(time.Now())
+	require.NoError(t, err)
+
+	return code
+}
+
+func TestConfirmTwoFactor_GivenInvalidInput_ShouldReturnError(t *testing.T) {
+	testCases := []struct {
+		name        string
+		setup       func(sut SUT)
+		input       func(t *testing.T, sut SUT) ConfirmTwoFactorInput
+		expectedErr error
+	}{
+		{
+			name: "Empty User Id",
+			input: func(t *testing.T, sut SUT) ConfirmTwoFactorInput {
+				return ConfirmTwoFactorInput{Code: currentCode(t, sut)}
+			},
+			expectedErr: domain.ErrUserIdIsRequired,
+		},
+		{
+			name: "Empty Code",
+			input: func(t *testing.T, sut SUT) ConfirmTwoFactorInput {
+				return ConfirmTwoFactorInput{UserId: sut.TwoFactor.UserId, Code: " "}
+			},
+			expectedErr: domain.ErrTwoFactorCodeIsRequired,
+		},
+		{
+			name: "Not Enrolled",
+			input: func(t *testing.T, sut SUT) ConfirmTwoFactorInput {
+				return ConfirmTwoFactorInput{UserId: "654321", Code: currentCode(t, sut)}
+			},
+			expectedErr: domain.ErrTwoFactorNotEnrolled,
+		},
+		{
+			name: "Already Enabled",
+			setup: func(sut SUT) {
+				sut.TwoFactor.Enable(0, time.Now())
+			},
+			input: func(t *testing.T, sut SUT) ConfirmTwoFactorInput {
+				return ConfirmTwoFactorInput{UserId: sut.TwoFactor.UserId, Code: currentCode(t, sut)}
+			},
+			expectedErr: domain.ErrTwoFactorAlreadyEnabled,
+		},
+		{
+			name: "Wrong Code",
+			input: func(t *testing.T, sut SUT) ConfirmTwoFactorInput {
+				return ConfirmTwoFactorInput{UserId: sut.TwoFactor.UserId, Code: "12345"}
+			},
+			expectedErr: domain.ErrTwoFactorCodeInvalid,
+		},
+		{
+			name: "Repo Two Factor Fail On Get",
+			setup: func(sut SUT) {
+				sut.Repo.FailOnGet = true
+			},
+			input: func(t *testing.T, sut SUT) ConfirmTwoFactorInput {
+				return ConfirmTwoFactorInput{UserId: sut.TwoFactor.UserId, Code: currentCode(t, sut)}
+			},
+			expectedErr: repo.ErrSimulatedFailureRepoTwoFactor,
+		},
+		{
+			name: "Repo Two Factor Fail On Enable",
+			setup: func(sut SUT) {
+				sut.Repo.FailOnEnable = true
+			},
+			input: func(t *testing.T, sut SUT) ConfirmTwoFactorInput {
+				return ConfirmTwoFactorInput{UserId: sut.TwoFactor.UserId, Code: currentCode(t, sut)}
+			},
+			expectedErr: repo.ErrSimulatedFailureRepoTwoFactor,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			sut := makeSut(t)
+			require.NoError(t, sut.Repo.Save(context.Background(), sut.TwoFactor))
+
+			if tc.setup != nil {
+				tc.setup(sut)
+			}
+
+			// Act
+			output, err := sut.UseCase.Perform(context.Background(), tc.input(t, sut))
+
+			// Assert
+			require.Nil(t, output)
+			assert.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestConfirmTwoFactor_ShouldEnableAndIssueRecoveryCodes(t *testing.T) {
+	// Arrange
+	sut := makeSut(t)
+	require.NoError(t, sut.Repo.Save(context.Background(), sut.TwoFactor))
+
+	// Act
+	output, err := sut.UseCase.Perform(context.Background(), ConfirmTwoFactorInput{
+		UserId: sut.TwoFactor.UserId,
+		Code:   currentCode(t, sut),
+	})
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, output.RecoveryCodes, domain.RecoveryCodeCount)
+
+	stored, err := sut.Repo.GetByUserId(context.Background(), sut.TwoFactor.UserId)
+	require.NoError(t, err)
+	assert.True(t, stored.IsEnabled())
+	assert.NotZero(t, stored.LastUsedStep)
+
+	codes := sut.Repo.RecoveryCodes(sut.TwoFactor.UserId)
+	require.Len(t, codes, domain.RecoveryCodeCount)
+	for i, code := range codes {
+		assert.Equal(t, domain.HashRecoveryCode(output.RecoveryCodes[i]), code.CodeHash)
+	}
+}
@@ -0,0 +1,76 @@
+package twofactor
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+)
+
+type disableTwoFactorUseCase struct {
+	userRepo      domain.UserRepository
+	twoFactorRepo domain.TwoFactorRepository
+	hasher        domain.UserPasswordHasher
+}
+
+type DisableTwoFactorUseCase interface {
+	Perform(ctx context.Context, input DisableTwoFactorInput) error
+}
+
+func NewDisableTwoFactorUseCase(userRepo domain.UserRepository, twoFactorRepo domain.TwoFactorRepository, hasher domain.UserPasswordHasher) DisableTwoFactorUseCase {
+	return &disableTwoFactorUseCase{
+		userRepo:      userRepo,
+		twoFactorRepo: twoFactorRepo,
+		hasher:        hasher,
+	}
+}
+
+// Perform asks for the password and a code again, so a stolen access token
+// is not enough to turn two-factor authentication off.
+func (uc *disableTwoFactorUseCase) Perform(ctx context.Context, input DisableTwoFactorInput) error {
+	if input.UserId == "" {
+		return domain.ErrUserIdIsRequired
+	}
+
+	if input.Password == "" {
+		return domain.ErrUserCurrentPasswordInvalid
+	}
+
+	if input.Code == "" {
+		return domain.ErrTwoFactorCodeIsRequired
+	}
+
+	user, err := uc.userRepo.GetById(ctx, input.UserId)
+	if err != nil {
+		return err
+	}
+
+	if user == nil {
+		return domain.ErrUserNotFound
+	}
+
+	matches, err := uc.hasher.Verify(user.Password, input.Password)
+	if err != nil {
+		return err
+	}
+
+	if !matches {
+		return domain.ErrUserCurrentPasswordInvalid
+	}
+
+	twoFactor, err := uc.twoFactorRepo.GetByUserId(ctx, user.ID)
+	if err != nil && !errors.Is(err, domain.ErrTwoFactorNotFound) {
+		return err
+	}
+
+	if twoFactor == nil || !twoFactor.IsEnabled() {
+		return domain.ErrTwoFactorNotEnabled
+	}
+
+	if err := domain.VerifyTwoFactorCode(ctx, uc.twoFactorRepo, twoFactor, input.Code, time.Now()); err != nil {
+		return err
+	}
+
+	return uc.twoFactorRepo.Delete(ctx, user.ID)
+}
@@ -0,0 +1,9 @@
+package twofactor
+
+type DisableTwoFactorInput struct {
+	UserId   string
+	Password string
+	// Code is either the current code of the authenticator app or one of
+	// the recovery codes.
+	Code string
+}
@@ -0,0 +1,219 @@
+package twofactor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+	twoFactorRepo "github.com/areteacademy/internal/infra/repository/twofactor"
+	userRepo "github.com/areteacademy/internal/infra/repository/user"
+	security "github.com/areteacademy/internal/infra/security"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const password = "@Daniel123"
+
+type SUT struct {
+	UseCase       DisableTwoFactorUseCase
+	UserRepo      *userRepo.InMemoryUserRepository
+	TwoFactorRepo *twoFactorRepo.InMemoryTwoFactorRepository
+	User          *domain.User
+	TwoFactor     *domain.TwoFactor
+	RecoveryCodes []string
+}
+
+func makeSut(t *testing.T) SUT {
+	userRepo := userRepo.NewInMemoryUserRepository()
+	twoFactorRepo := twoFactorRepo.NewInMemoryTwoFactorRepository()
+	hasher := security.NewBcryptPasswordHasher()
+	usecase := NewDisableTwoFactorUseCase(userRepo, twoFactorRepo, hasher)
+
+	hash, err := hasher.Hash(password)
+	require.NoError(t, err)
+
+	now := time.Now()
+
+	user := &domain.User{
+		ID:        "123456",
+		Name:      "Daniel",
+		Email:     "daniel@gmail.com",
+		Password:  hash,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	twoFactor, err := domain.NewTwoFactor(user.ID, now)
+	require.NoError(t, err)
+	twoFactor.Enable(0, now)
+	codes, plain := domain.NewRecoveryCodes(user.ID, now)
+
+	require.NoError(t, userRepo.Save(context.Background(), user))
+	require.NoError(t, twoFactorRepo.Enable(context.Background(), twoFactor, codes))
+
+	return SUT{
+		UseCase:       usecase,
+		UserRepo:      userRepo,
+		TwoFactorRepo: twoFactorRepo,
+		User:          user,
+		TwoFactor:     twoFactor,
+		RecoveryCodes: plain,
+	}
+}
+
+func currentCode(t *testing.T, sut SUT) string {
+	code, err := sut.TwoFactor.CodeAt(time.Now())
+	require.NoError(t, err)
+
+	return code
+}
+
+func TestDisableTwoFactor_GivenInvalidInput_ShouldReturnError(t *testing.T) {
+	testCases := []struct {
+		name        string
+		setup       func(sut SUT)
+		input       func(t *testing.T, sut SUT) DisableTwoFactorInput
+		expectedErr error
+	}{
+		{
+			name: "Empty User Id",
+			input: func(t *testing.T, sut SUT) DisableTwoFactorInput {
+				return DisableTwoFactorInput{Password: password, Code: currentCode(t, sut)}
+			},
+			expectedErr: domain.ErrUserIdIsRequired,
+		},
+		{
+			name: "Empty Password",
+			input: func(t *testing.T, sut SUT) DisableTwoFactorInput {
+				return DisableTwoFactorInput{UserId: sut.User.ID, Code: currentCode(t, sut)}
+			},
+			expectedErr: domain.ErrUserCurrentPasswordInvalid,
+		},
+		{
+			name: "Empty Code",
+			input: func(t *testing.T, sut SUT) DisableTwoFactorInput {
+				return DisableTwoFactorInput{UserId: sut.User.ID, Password: password}
+			},
+			expectedErr: domain.ErrTwoFactorCodeIsRequired,
+		},
+		{
+			name: "User Not Found",
+			input: func(t *testing.T, sut SUT) DisableTwoFactorInput {
+				return DisableTwoFactorInput{UserId: "654321", Password: password, Code: currentCode(t, sut)}
+			},
+			expectedErr: domain.ErrUserNotFound,
+		},
+		{
+			name: "Wrong Password",
+			input: func(t *testing.T, sut SUT) DisableTwoFactorInput {
+				return DisableTwoFactorInput{UserId: sut.User.ID, Password: "@Wrong1234", Code: currentCode(t, sut)}
+			},
+			expectedErr: domain.ErrUserCurrentPasswordInvalid,
+		},
+		{
+			name: "Wrong Code",
+			input: func(t *testing.T, sut SUT) DisableTwoFactorInput {
+				return DisableTwoFactorInput{UserId: sut.User.ID, Password: password, Code: "aaaaa-bbbbb"}
+			},
+			expectedErr: domain.ErrTwoFactorCodeInvalid,
+		},
+		{
+			name: "Not Enabled",
+			setup: func(sut SUT) {
+				sut.TwoFactor.EnabledAt = nil
+			},
+			input: func(t *testing.T, sut SUT) DisableTwoFactorInput {
+				return DisableTwoFactorInput{UserId: sut.User.ID, Password: password, Code: currentCode(t, sut)}
+			},
+			expectedErr: domain.ErrTwoFactorNotEnabled,
+		},
+		{
+			name: "Repo Two Factor Fail On Get",
+			setup: func(sut SUT) {
+				sut.TwoFactorRepo.FailOnGet = true
+			},
+			input: func(t *testing.T, sut SUT) DisableTwoFactorInput {
+				return DisableTwoFactorInput{UserId: sut.User.ID, Password: password, Code: currentCode(t, sut)}
+			},
+			expectedErr: twoFactorRepo.ErrSimulatedFailureRepoTwoFactor,
+		},
+		{
+			name: "Repo Two Factor Fail On Delete",
+			setup: func(sut SUT) {
+				sut.TwoFactorRepo.FailOnDelete = true
+			},
+			input: func(t *testing.T, sut SUT) DisableTwoFactorInput {
+				return DisableTwoFactorInput{UserId: sut.User.ID, Password: password, Code: currentCode(t, sut)}
+			},
+			expectedErr: twoFactorRepo.ErrSimulatedFailureRepoTwoFactor,
+		},
+		{
+			name: "Repo User Fail On Get",
+			setup: func(sut SUT) {
+				sut.UserRepo.FailOnGet = true
+			},
+			input: func(t *testing.T, sut SUT) DisableTwoFactorInput {
+				return DisableTwoFactorInput{UserId: sut.User.ID, Password: password, Code: currentCode(t, sut)}
+			},
+			expectedErr: userRepo.ErrSimulatedFailureRepoUser,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			sut := makeSut(t)
+
+			if tc.setup != nil {
+				tc.setup(sut)
+			}
+
+			// Act
+			err := sut.UseCase.Perform(context.Background(), tc.input(t, sut))
+
+			// Assert
+			assert.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestDisableTwoFactor_ShouldRemoveEnrolment(t *testing.T) {
+	testCases := []struct {
+		name string
+		code func(t *testing.T, sut SUT) string
+	}{
+		{
+			name: "Authenticator Code",
+			code: currentCode,
+		},
+		{
+			name: "Recovery Code",
+			code: func(t *testing.T, sut SUT) string {
+				return sut.RecoveryCodes[0]
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			sut := makeSut(t)
+
+			// Act
+			err := sut.UseCase.Perform(context.Background(), DisableTwoFactorInput{
+				UserId:   sut.User.ID,
+				Password: password,
+				Code:     tc.code(t, sut),
+			})
+
+			// Assert
+			require.NoError(t, err)
+
+			stored, err := sut.TwoFactorRepo.GetByUserId(context.Background(), sut.User.ID)
+			require.NoError(t, err)
+			assert.Nil(t, stored)
+			assert.Empty(t, sut.TwoFactorRepo.RecoveryCodes(sut.User.ID))
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package twofactor
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+)
+
+type enrollTwoFactorUseCase struct {
+	userRepo      domain.UserRepository
+	twoFactorRepo domain.TwoFactorRepository
+	issuer        string
+}
+
+type EnrollTwoFactorUseCase interface {
+	Perform(ctx context.Context, input EnrollTwoFactorInput) (*EnrollTwoFactorOutput, error)
+}
+
+// NewEnrollTwoFactorUseCase takes the issuer authenticator apps show next
+// to the account.
+func NewEnrollTwoFactorUseCase(userRepo domain.UserRepository, twoFactorRepo domain.TwoFactorRepository, issuer string) EnrollTwoFactorUseCase {
+	return &enrollTwoFactorUseCase{
+		userRepo:      userRepo,
+		twoFactorRepo: twoFactorRepo,
+		issuer:        issuer,
+	}
+}
+
+// Perform starts over when an enrolment is already waiting for
+// confirmation, so a user who lost the first QR code can scan a new one.
+func (uc *enrollTwoFactorUseCase) Perform(ctx context.Context, input EnrollTwoFactorInput) (*EnrollTwoFactorOutput, error) {
+	if input.UserId == "" {
+		return nil, domain.ErrUserIdIsRequired
+	}
+
+	user, err := uc.userRepo.GetById(ctx, input.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if user == nil {
+		return nil, domain.ErrUserNotFound
+	}
+
+	current, err := uc.twoFactorRepo.GetByUserId(ctx, user.ID)
+	if err != nil && !errors.Is(err, domain.ErrTwoFactorNotFound) {
+		return nil, err
+	}
+
+	if current != nil && current.IsEnabled() {
+		return nil, domain.ErrTwoFactorAlreadyEnabled
+	}
+
+	twoFactor, err := domain.NewTwoFactor(user.ID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.twoFactorRepo.Save(ctx, twoFactor); err != nil {
+		return nil, err
+	}
+
+	return &EnrollTwoFactorOutput{
+		Secret:          twoFactor.Secret,
+		ProvisioningURI: twoFactor.ProvisioningURI(uc.issuer, user.Email),
+	}, nil
+}
@@ -0,0 +1,11 @@
+package twofactor
+
+type EnrollTwoFactorInput struct {
+	UserId string
+}
+
+type EnrollTwoFactorOutput struct {
+	Secret string
+	// ProvisioningURI is the otpauth:// URI to show as a QR code.
+	ProvisioningURI string
+}
@@ -0,0 +1,141 @@
+package twofactor
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+	twoFactorRepo "github.com/areteacademy/internal/infra/repository/twofactor"
+	userRepo "github.com/areteacademy/internal/infra/repository/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type SUT struct {
+	UseCase       EnrollTwoFactorUseCase
+	UserRepo      *userRepo.InMemoryUserRepository
+	TwoFactorRepo *twoFactorRepo.InMemoryTwoFactorRepository
+	User          *domain.User
+}
+
+func makeSut() SUT {
+	userRepo := userRepo.NewInMemoryUserRepository()
+	twoFactorRepo := twoFactorRepo.NewInMemoryTwoFactorRepository()
+	usecase := NewEnrollTwoFactorUseCase(userRepo, twoFactorRepo, "areteacademy")
+
+	now := time.Now()
+
+	user := &domain.User{
+		ID:        "123456",
+		Name:      "Daniel",
+		Email:     "daniel@gmail.com",
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	return SUT{
+		UseCase:       usecase,
+		UserRepo:      userRepo,
+		TwoFactorRepo: twoFactorRepo,
+		User:          user,
+	}
+}
+
+func TestEnrollTwoFactor_GivenInvalidInput_ShouldReturnError(t *testing.T) {
+	testCases := []struct {
+		name        string
+		setup       func(sut SUT)
+		input       EnrollTwoFactorInput
+		expectedErr error
+	}{
+		{
+			name:        "Empty User Id",
+			input:       EnrollTwoFactorInput{},
+			expectedErr: domain.ErrUserIdIsRequired,
+		},
+		{
+			name:        "User Not Found",
+			input:       EnrollTwoFactorInput{UserId: "654321"},
+			expectedErr: domain.ErrUserNotFound,
+		},
+		{
+			name: "Already Enabled",
+			setup: func(sut SUT) {
+				now := time.Now()
+				sut.TwoFactorRepo.Save(context.Background(), &domain.TwoFactor{UserId: sut.User.ID, EnabledAt: &now})
+			},
+			input:       EnrollTwoFactorInput{UserId: "123456"},
+			expectedErr: domain.ErrTwoFactorAlreadyEnabled,
+		},
+		{
+			name: "Repo User Fail On Get",
+			setup: func(sut SUT) {
+				sut.UserRepo.FailOnGet = true
+			},
+			input:       EnrollTwoFactorInput{UserId: "123456"},
+			expectedErr: userRepo.ErrSimulatedFailureRepoUser,
+		},
+		{
+			name: "Repo Two Factor Fail On Get",
+			setup: func(sut SUT) {
+				sut.TwoFactorRepo.FailOnGet = true
+			},
+			input:       EnrollTwoFactorInput{UserId: "123456"},
+			expectedErr: twoFactorRepo.ErrSimulatedFailureRepoTwoFactor,
+		},
+		{
+			name: "Repo Two Factor Fail On Save",
+			setup: func(sut SUT) {
+				sut.TwoFactorRepo.FailOnSave = true
+			},
+			input:       EnrollTwoFactorInput{UserId: "123456"},
+			expectedErr: twoFactorRepo.ErrSimulatedFailureRepoTwoFactor,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			sut := makeSut()
+			require.NoError(t, sut.UserRepo.Save(context.Background(), sut.User))
+
+			if tc.setup != nil {
+				tc.setup(sut)
+			}
+
+			// Act
+			output, err := sut.UseCase.Perform(context.Background(), tc.input)
+
+			// Assert
+			require.Nil(t, output)
+			assert.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestEnrollTwoFactor_ShouldReturnPendingSecretAndURI(t *testing.T) {
+	// Arrange
+	sut := makeSut()
+	require.NoError(t, sut.UserRepo.Save(context.Background(), sut.User))
+
+	// Act
+	output, err := sut.UseCase.Perform(context.Background(), EnrollTwoFactorInput{UserId: sut.User.ID})
+
+	// Assert
+	require.NoError(t, err)
+
+	uri, err := url.Parse(output.ProvisioningURI)
+	require.NoError(t, err)
+	assert.Equal(t, "otpauth", uri.Scheme)
+	assert.Equal(t, "totp", uri.Host)
+	assert.Equal(t, "/areteacademy:daniel@gmail.com", uri.Path)
+	assert.Equal(t, output.Secret, uri.Query().Get("secret"))
+	assert.Equal(t, "areteacademy", uri.Query().Get("issuer"))
+
+	stored, err := sut.TwoFactorRepo.GetByUserId(context.Background(), sut.User.ID)
+	require.NoError(t, err)
+	assert.Equal(t, output.Secret, stored.Secret)
+	assert.False(t, stored.IsEnabled())
+}
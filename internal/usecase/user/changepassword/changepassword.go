@@ -0,0 +1,95 @@
+package user
+
+import (
+	"context"
+
+	"github.com/areteacademy/internal/domain"
+)
+
+type changePasswordUserUseCase struct {
+	repo        domain.UserRepository
+	hasher      domain.UserPasswordHasher
+	historySize int
+	policy      domain.PasswordPolicy
+}
+
+type ChangePasswordUserUseCase interface {
+	Perform(ctx context.Context, input ChangePasswordUserInput) error
+}
+
+// NewChangePasswordUserUseCase rejects a new password matching any of the
+// latest historySize passwords, the current one included. A historySize
+// below 1 falls back to domain.DefaultPasswordHistorySize.
+func NewChangePasswordUserUseCase(
+	repo domain.UserRepository,
+	hasher domain.UserPasswordHasher,
+	historySize int,
+	policy domain.PasswordPolicy,
+) ChangePasswordUserUseCase {
+	if historySize < 1 {
+		historySize = domain.DefaultPasswordHistorySize
+	}
+
+	return &changePasswordUserUseCase{
+		repo:        repo,
+		hasher:      hasher,
+		historySize: historySize,
+		policy:      policy,
+	}
+}
+
+func (uc *changePasswordUserUseCase) Perform(ctx context.Context, input ChangePasswordUserInput) error {
+	if input.ID == "" {
+		return domain.ErrUserIdIsRequired
+	}
+
+	if input.CurrentPassword == "" {
+		return domain.ErrUserCurrentPasswordInvalid
+	}
+
+	if input.NewPassword == "" {
+		return domain.ErrUserPasswordIsRequired
+	}
+
+	user, err := uc.repo.GetById(ctx, input.ID)
+	if err != nil {
+		return err
+	}
+
+	if user == nil {
+		return domain.ErrUserNotFound
+	}
+
+	matches, err := uc.hasher.Verify(user.Password, input.CurrentPassword)
+	if err != nil {
+		return err
+	}
+
+	if !matches {
+		return domain.ErrUserCurrentPasswordInvalid
+	}
+
+	if err := uc.policy.Validate(input.NewPassword, user.Name, user.Email); err != nil {
+		return err
+	}
+
+	for _, recent := range user.RecentPasswords(uc.historySize) {
+		reused, err := uc.hasher.Verify(recent, input.NewPassword)
+		if err != nil {
+			return err
+		}
+
+		if reused {
+			return domain.ErrUserPasswordReused
+		}
+	}
+
+	hashedPassword, err := uc.hasher.Hash(input.NewPassword)
+	if err != nil {
+		return err
+	}
+
+	user.ChangePassword(hashedPassword, uc.historySize)
+
+	return uc.repo.Update(ctx, user)
+}
@@ -0,0 +1,7 @@
+package user
+
+type ChangePasswordUserInput struct {
+	ID              string
+	CurrentPassword string
+	NewPassword     string
+}
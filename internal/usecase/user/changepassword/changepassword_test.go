@@ -0,0 +1,236 @@
+package user
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+	repo "github.com/areteacademy/internal/infra/repository/user"
+	security "github.com/areteacademy/internal/infra/security"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	currentPassword = "@Secret123"
+	newPassword     = "@Secret456"
+	historySize     = 3
+)
+
+type SUT struct {
+	UseCase ChangePasswordUserUseCase
+	Repo    *repo.InMemoryUserRepository
+	Hasher  *security.BcryptPasswordhasher
+	User    *domain.User
+}
+
+func makeSut(t *testing.T) SUT {
+	repo := repo.NewInMemoryUserRepository()
+	hasher := security.NewBcryptPasswordHasher()
+	usecase := NewChangePasswordUserUseCase(repo, hasher, historySize, domain.DefaultPasswordPolicy())
+
+	hash, err := hasher.Hash(currentPassword)
+	require.NoError(t, err)
+
+	now := time.Now()
+
+	user := &domain.User{
+		ID:        "123456",
+		Name:      "Daniel",
+		Email:     "daniel@gmail.com",
+		Password:  hash,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	return SUT{
+		UseCase: usecase,
+		Repo:    repo,
+		Hasher:  hasher,
+		User:    user,
+	}
+}
+
+func validInput(sut SUT) ChangePasswordUserInput {
+	return ChangePasswordUserInput{
+		ID:              sut.User.ID,
+		CurrentPassword: currentPassword,
+		NewPassword:     newPassword,
+	}
+}
+
+func TestChangePasswordUser_GivenInvalidInput_ShouldReturnError(t *testing.T) {
+	testCases := []struct {
+		name        string
+		setup       func(sut SUT)
+		input       func(sut SUT) ChangePasswordUserInput
+		expectedErr error
+	}{
+		{
+			name: "Empty Id",
+			input: func(sut SUT) ChangePasswordUserInput {
+				in := validInput(sut)
+				in.ID = ""
+				return in
+			},
+			expectedErr: domain.ErrUserIdIsRequired,
+		},
+		{
+			name: "Empty Current Password",
+			input: func(sut SUT) ChangePasswordUserInput {
+				in := validInput(sut)
+				in.CurrentPassword = ""
+				return in
+			},
+			expectedErr: domain.ErrUserCurrentPasswordInvalid,
+		},
+		{
+			name: "Empty New Password",
+			input: func(sut SUT) ChangePasswordUserInput {
+				in := validInput(sut)
+				in.NewPassword = ""
+				return in
+			},
+			expectedErr: domain.ErrUserPasswordIsRequired,
+		},
+		{
+			name: "Weak New Password",
+			input: func(sut SUT) ChangePasswordUserInput {
+				in := validInput(sut)
+				in.NewPassword = "daniel456"
+				return in
+			},
+			expectedErr: domain.ErrUserPasswordInvalid,
+		},
+		{
+			name: "New Password Contains Email",
+			input: func(sut SUT) ChangePasswordUserInput {
+				in := validInput(sut)
+				in.NewPassword = "@Daniel.Gmail1"
+				return in
+			},
+			expectedErr: domain.ErrUserPasswordInvalid,
+		},
+		{
+			name: "User Not Found",
+			input: func(sut SUT) ChangePasswordUserInput {
+				in := validInput(sut)
+				in.ID = "654321"
+				return in
+			},
+			expectedErr: domain.ErrUserNotFound,
+		},
+		{
+			name: "Wrong Current Password",
+			input: func(sut SUT) ChangePasswordUserInput {
+				in := validInput(sut)
+				in.CurrentPassword = "@Wrong123"
+				return in
+			},
+			expectedErr: domain.ErrUserCurrentPasswordInvalid,
+		},
+		{
+			name: "New Password Equals Current",
+			input: func(sut SUT) ChangePasswordUserInput {
+				in := validInput(sut)
+				in.NewPassword = currentPassword
+				return in
+			},
+			expectedErr: domain.ErrUserPasswordReused,
+		},
+		{
+			name: "Repo User Fail On Get",
+			setup: func(sut SUT) {
+				sut.Repo.FailOnGet = true
+			},
+			input:       validInput,
+			expectedErr: repo.ErrSimulatedFailureRepoUser,
+		},
+		{
+			name: "Repo User Fail On Update",
+			setup: func(sut SUT) {
+				sut.Repo.FailOnUpdate = true
+			},
+			input:       validInput,
+			expectedErr: repo.ErrSimulatedFailureRepoUser,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			sut := makeSut(t)
+			require.NoError(t, sut.Repo.Save(context.Background(), sut.User))
+
+			if tc.setup != nil {
+				tc.setup(sut)
+			}
+
+			// Act
+			err := sut.UseCase.Perform(context.Background(), tc.input(sut))
+
+			// Assert
+			assert.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestChangePasswordUser_ShouldStoreNewHashAndRevokeTokens(t *testing.T) {
+	// Arrange
+	sut := makeSut(t)
+	oldHash := sut.User.Password
+	require.NoError(t, sut.Repo.Save(context.Background(), sut.User))
+
+	// Act
+	err := sut.UseCase.Perform(context.Background(), validInput(sut))
+
+	// Assert
+	require.NoError(t, err)
+
+	user, err := sut.Repo.GetById(context.Background(), sut.User.ID)
+	require.NoError(t, err)
+
+	matches, err := sut.Hasher.Verify(user.Password, newPassword)
+	require.NoError(t, err)
+	assert.True(t, matches)
+	assert.Equal(t, []string{oldHash}, user.PasswordHistory)
+	assert.Equal(t, 1, user.TokenVersion)
+}
+
+func TestChangePasswordUser_ShouldRejectReuseWithinHistoryOnly(t *testing.T) {
+	// Arrange
+	sut := makeSut(t)
+	require.NoError(t, sut.Repo.Save(context.Background(), sut.User))
+
+	passwords := []string{currentPassword, "@Second123", "@Third123", "@Fourth123"}
+	for i := 1; i < len(passwords); i++ {
+		require.NoError(t, sut.UseCase.Perform(context.Background(), ChangePasswordUserInput{
+			ID:              sut.User.ID,
+			CurrentPassword: passwords[i-1],
+			NewPassword:     passwords[i],
+		}))
+	}
+
+	current := passwords[len(passwords)-1]
+
+	// Act
+	reusedErr := sut.UseCase.Perform(context.Background(), ChangePasswordUserInput{
+		ID:              sut.User.ID,
+		CurrentPassword: current,
+		NewPassword:     passwords[1],
+	})
+	expiredErr := sut.UseCase.Perform(context.Background(), ChangePasswordUserInput{
+		ID:              sut.User.ID,
+		CurrentPassword: current,
+		NewPassword:     passwords[0],
+	})
+
+	// Assert
+	assert.ErrorIs(t, reusedErr, domain.ErrUserPasswordReused)
+	assert.NoError(t, expiredErr)
+
+	user, err := sut.Repo.GetById(context.Background(), sut.User.ID)
+	require.NoError(t, err)
+	assert.Len(t, user.PasswordHistory, historySize-1)
+}
@@ -1,9 +1,13 @@
 package user
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"time"
 
 	"github.com/areteacademy/internal/domain"
+	sendVerification "github.com/areteacademy/internal/usecase/user/sendverification"
 )
 
 type CreateUserInput struct {
@@ -13,39 +17,65 @@ type CreateUserInput struct {
 }
 
 type CreateUserOutput struct {
-	ID        string
-	Name      string
-	Email     string
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	ID            string
+	Name          string
+	Email         string
+	EmailVerified bool
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
 }
 
 type createUserUseCase struct {
-	repo   domain.UserRepository
-	hasher domain.UserPasswordHasher
+	repo             domain.UserRepository
+	hasher           domain.UserPasswordHasher
+	sendVerification sendVerification.SendEmailVerificationUserUseCase
+	policy           domain.PasswordPolicy
+	reporter         domain.ErrorReporter
 }
 
 type CreateUserUseCase interface {
-	Perform(input *CreateUserInput) (*CreateUserOutput, error)
+	Perform(ctx context.Context, input *CreateUserInput) (*CreateUserOutput, error)
 }
 
-func NewCreateUserUseCase(repo domain.UserRepository, hasher domain.UserPasswordHasher) CreateUserUseCase {
+func NewCreateUserUseCase(
+	repo domain.UserRepository,
+	hasher domain.UserPasswordHasher,
+	sendVerification sendVerification.SendEmailVerificationUserUseCase,
+	policy domain.PasswordPolicy,
+	reporter domain.ErrorReporter,
+) CreateUserUseCase {
 	return &createUserUseCase{
-		repo:   repo,
-		hasher: hasher,
+		repo:             repo,
+		hasher:           hasher,
+		sendVerification: sendVerification,
+		policy:           policy,
+		reporter:         reporter,
 	}
 }
 
-func (uc *createUserUseCase) Perform(input *CreateUserInput) (*CreateUserOutput, error) {
+func (uc *createUserUseCase) Perform(ctx context.Context, input *CreateUserInput) (*CreateUserOutput, error) {
 	user, err := domain.NewUser(
 		input.Name,
 		input.Email,
 		input.Password,
 	)
+
+	// The password is checked even when the rest of the input is invalid, so
+	// every problem is reported at once.
+	err = domain.NewValidationError(err, uc.policy.Validate(input.Password, input.Name, input.Email))
 	if err != nil {
 		return nil, err
 	}
 
+	owner, err := uc.repo.GetByEmail(ctx, user.Email)
+	if err != nil && !errors.Is(err, domain.ErrUserNotFound) {
+		return nil, err
+	}
+
+	if owner != nil {
+		return nil, domain.ErrUserEmailAlreadyInUse
+	}
+
 	hashedPassword, err := uc.hasher.Hash(user.Password)
 	if err != nil {
 		return nil, err
@@ -53,15 +83,23 @@ func (uc *createUserUseCase) Perform(input *CreateUserInput) (*CreateUserOutput,
 
 	user.Password = hashedPassword
 
-	if err := uc.repo.Save(user); err != nil {
+	if err := uc.repo.Save(ctx, user); err != nil {
 		return nil, err
 	}
 
+	// The account is saved by now, so failing here would only make a retry
+	// hit ErrUserEmailAlreadyInUse. The user can ask for the mail again.
+	err = uc.sendVerification.Perform(ctx, sendVerification.SendEmailVerificationUserInput{ID: user.ID})
+	if err != nil {
+		uc.reporter.Report(ctx, fmt.Errorf("send email verification to user %s: %w", user.ID, err))
+	}
+
 	return &CreateUserOutput{
-		ID:        user.ID,
-		Name:      user.Name,
-		Email:     user.Email,
-		CreatedAt: user.CreatedAt,
-		UpdatedAt: user.UpdatedAt,
+		ID:            user.ID,
+		Name:          user.Name,
+		Email:         user.Email,
+		EmailVerified: user.IsEmailVerified(),
+		CreatedAt:     user.CreatedAt,
+		UpdatedAt:     user.UpdatedAt,
 	}, nil
 }
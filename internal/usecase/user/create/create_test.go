@@ -1,26 +1,41 @@
 package user
 
 import (
+	"context"
 	"testing"
 	"time"
 
 	"github.com/areteacademy/internal/domain"
+	"github.com/areteacademy/internal/infra/mail"
+	"github.com/areteacademy/internal/infra/report"
+	tokenRepo "github.com/areteacademy/internal/infra/repository/emailverification"
 	repo "github.com/areteacademy/internal/infra/repository/user"
 	security "github.com/areteacademy/internal/infra/security"
+	sendVerification "github.com/areteacademy/internal/usecase/user/sendverification"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
 type SUT struct {
-	UseCase CreateUserUseCase
-	Repo    *repo.InMemoryUserRepository
-	User    *domain.User
+	UseCase  CreateUserUseCase
+	Repo     *repo.InMemoryUserRepository
+	Mailer   *mail.InMemoryMailer
+	Reporter *report.InMemoryErrorReporter
+	User     *domain.User
 }
 
 func makeSut() SUT {
 	repo := repo.NewInMemoryUserRepository()
 	hash := security.NewBcryptPasswordHasher()
-	usecase := NewCreateUserUseCase(repo, hash)
+	mailer := mail.NewInMemoryMailer()
+	send := sendVerification.NewSendEmailVerificationUserUseCase(
+		repo,
+		tokenRepo.NewInMemoryEmailVerificationTokenRepository(),
+		mailer,
+		time.Hour,
+	)
+	reporter := report.NewInMemoryErrorReporter()
+	usecase := NewCreateUserUseCase(repo, hash, send, domain.DefaultPasswordPolicy(), reporter)
 
 	now := time.Now()
 
@@ -28,15 +43,17 @@ func makeSut() SUT {
 		ID:        "123456",
 		Name:      "Daniel",
 		Email:     "daniel@com.br",
-		Password:  "@Daniel123",
+		Password:  "@Secret123",
 		CreatedAt: now,
 		UpdatedAt: now,
 	}
 
 	return SUT{
-		UseCase: usecase,
-		Repo:    repo,
-		User:    user,
+		UseCase:  usecase,
+		Repo:     repo,
+		Mailer:   mailer,
+		Reporter: reporter,
+		User:     user,
 	}
 }
 
@@ -106,6 +123,29 @@ func TestCreateUser_GivenInvalidInput_ShouldReturnError(t *testing.T) {
 			},
 			expectedErr: domain.ErrUserPasswordInvalid,
 		},
+		{
+			name: "Email Already In Use",
+			setup: func(sut SUT) {
+				sut.Repo.Save(context.Background(), sut.User)
+			},
+			input: func(sut SUT) CreateUserInput {
+				in := validInput(sut)
+				in.Email = "  DANIEL@com.br "
+				return in
+			},
+			expectedErr: domain.ErrUserEmailAlreadyInUse,
+		},
+		{
+			name: "Repo User Fail On Get By Email",
+			setup: func(sut SUT) {
+				sut.Repo.FailOnGetByEmail = true
+			},
+			input: func(sut SUT) CreateUserInput {
+				in := validInput(sut)
+				return in
+			},
+			expectedErr: repo.ErrSimulatedFailureRepoUser,
+		},
 		{
 			name: "Repo User Fail On Save",
 			setup: func(sut SUT) {
@@ -130,7 +170,7 @@ func TestCreateUser_GivenInvalidInput_ShouldReturnError(t *testing.T) {
 
 			input := tc.input(sut)
 
-			user, err := sut.UseCase.Perform(&input)
+			user, err := sut.UseCase.Perform(context.Background(), &input)
 
 			// Assert
 			require.Error(t, err)
@@ -145,14 +185,14 @@ func TestCreateUser_shouldReturnAnError_WhenValidationFails(t *testing.T) {
 	sut := makeSut()
 
 	// Act
-	_, _ = sut.UseCase.Perform(&CreateUserInput{
+	_, _ = sut.UseCase.Perform(context.Background(), &CreateUserInput{
 		Name:     "",
 		Email:    "daniel@gmail.com",
-		Password: "@Danel123",
+		Password: "@Secret123",
 	})
 
 	// Assert
-	count, err := sut.Repo.Count()
+	count, err := sut.Repo.Count(context.Background())
 	require.Nil(t, err)
 	require.Equal(t, count, 0)
 }
@@ -167,7 +207,7 @@ func TestCreateUser_shouldReturnSuccess(t *testing.T) {
 		Email:    sut.User.Email,
 		Password: sut.User.Password,
 	}
-	user, err := sut.UseCase.Perform(expected)
+	user, err := sut.UseCase.Perform(context.Background(), expected)
 
 	// Assert
 	require.Nil(t, err)
@@ -180,7 +220,157 @@ func TestCreateUser_shouldReturnSuccess(t *testing.T) {
 
 	assert.False(t, user.CreatedAt.IsZero())
 	assert.False(t, user.UpdatedAt.IsZero())
+	assert.False(t, user.EmailVerified)
 
-	count, err := sut.Repo.Count()
+	count, err := sut.Repo.Count(context.Background())
 	assert.Equal(t, count, 1)
+
+	require.Len(t, sut.Mailer.Sent, 1)
+	assert.Empty(t, sut.Reporter.Reported)
+	assert.Equal(t, expected.Email, sut.Mailer.Last().To)
+}
+
+func TestCreateUser_shouldNormalizeEmail(t *testing.T) {
+	// Arrange
+	sut := makeSut()
+	input := validInput(sut)
+	input.Email = "  Daniel@COM.br "
+
+	// Act
+	user, err := sut.UseCase.Perform(context.Background(), &input)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "daniel@com.br", user.Email)
+
+	stored, err := sut.Repo.GetByEmail(context.Background(), "daniel@com.br")
+	require.NoError(t, err)
+	require.NotNil(t, stored)
+	assert.Equal(t, user.ID, stored.ID)
+}
+
+func TestCreateUser_ShouldReportEveryPasswordViolation(t *testing.T) {
+	// Arrange
+	sut := makeSut()
+	input := validInput(sut)
+	input.Password = "daniel"
+
+	// Act
+	user, err := sut.UseCase.Perform(context.Background(), &input)
+
+	// Assert
+	require.Nil(t, user)
+	require.ErrorIs(t, err, domain.ErrUserPasswordInvalid)
+
+	var policyErr *domain.PasswordPolicyError
+	require.ErrorAs(t, err, &policyErr)
+
+	rules := []domain.PasswordRule{}
+	for _, violation := range policyErr.Violations {
+		rules = append(rules, violation.Rule)
+	}
+
+	assert.Equal(t, []domain.PasswordRule{
+		domain.PasswordRuleMinLength,
+		domain.PasswordRuleUppercase,
+		domain.PasswordRuleSpecial,
+		domain.PasswordRuleContainsName,
+		domain.PasswordRuleContainsEmail,
+	}, rules)
+}
+
+func TestCreateUser_ShouldReportInvalidFieldsAlongWithPasswordViolations(t *testing.T) {
+	// Arrange
+	sut := makeSut()
+	input := validInput(sut)
+	input.Name = ""
+	input.Email = "daniel"
+	input.Password = "secret"
+
+	// Act
+	user, err := sut.UseCase.Perform(context.Background(), &input)
+
+	// Assert
+	require.Nil(t, user)
+	assert.ErrorIs(t, err, domain.ErrUserNameIsRequired)
+	assert.ErrorIs(t, err, domain.ErrUserEmailInvalid)
+	assert.ErrorIs(t, err, domain.ErrUserPasswordInvalid)
+
+	var policyErr *domain.PasswordPolicyError
+	require.ErrorAs(t, err, &policyErr)
+	assert.NotEmpty(t, policyErr.Violations)
+}
+
+func TestCreateUser_ShouldApplyConfiguredPolicy(t *testing.T) {
+	testCases := []struct {
+		name     string
+		policy   func(policy *domain.PasswordPolicy)
+		password string
+		rule     domain.PasswordRule
+	}{
+		{
+			name: "Blocklisted",
+			policy: func(policy *domain.PasswordPolicy) {
+				policy.Blocklist = domain.NewPasswordBlocklist([]string{"p@ssw0rD"})
+			},
+			password: "P@ssw0rd",
+			rule:     domain.PasswordRuleBlocklisted,
+		},
+		{
+			name:     "Digit Required",
+			policy:   func(policy *domain.PasswordPolicy) { policy.RequireDigit = true },
+			password: "@SecretWord",
+			rule:     domain.PasswordRuleDigit,
+		},
+		{
+			name:     "Too Long",
+			policy:   func(policy *domain.PasswordPolicy) { policy.MaxLength = 10 },
+			password: "@Secret12345",
+			rule:     domain.PasswordRuleMaxLength,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			sut := makeSut()
+			policy := domain.DefaultPasswordPolicy()
+			tc.policy(&policy)
+			usecase := NewCreateUserUseCase(sut.Repo, security.NewBcryptPasswordHasher(), nil, policy, sut.Reporter)
+
+			input := validInput(sut)
+			input.Password = tc.password
+
+			// Act
+			_, err := usecase.Perform(context.Background(), &input)
+
+			// Assert
+			var policyErr *domain.PasswordPolicyError
+			require.ErrorAs(t, err, &policyErr)
+			require.Len(t, policyErr.Violations, 1)
+			assert.Equal(t, tc.rule, policyErr.Violations[0].Rule)
+		})
+	}
+}
+
+func TestCreateUser_ShouldCreateUser_WhenMailerFails(t *testing.T) {
+	// Arrange
+	sut := makeSut()
+	sut.Mailer.FailOnSend = true
+	input := validInput(sut)
+
+	// Act
+	user, err := sut.UseCase.Perform(context.Background(), &input)
+
+	// Assert
+	require.NoError(t, err)
+	require.NotNil(t, user)
+	assert.False(t, user.EmailVerified)
+	assert.Empty(t, sut.Mailer.Sent)
+	require.Len(t, sut.Reporter.Reported, 1)
+	assert.ErrorIs(t, sut.Reporter.Reported[0], mail.ErrSimulatedFailureMailer)
+
+	saved, err := sut.Repo.GetById(context.Background(), user.ID)
+	require.NoError(t, err)
+	require.NotNil(t, saved)
 }
@@ -1,13 +1,17 @@
 package user
 
-import "github.com/areteacademy/internal/domain"
+import (
+	"context"
+
+	"github.com/areteacademy/internal/domain"
+)
 
 type getByIdUserUseCase struct {
 	repo domain.UserRepository
 }
 
 type GetByIdUserUseCase interface {
-	Perform(id string) (*GetByIdOutput, error)
+	Perform(ctx context.Context, id string) (*GetByIdOutput, error)
 }
 
 func NewGetByIdUserUseCase(repo domain.UserRepository) GetByIdUserUseCase {
@@ -16,12 +20,12 @@ func NewGetByIdUserUseCase(repo domain.UserRepository) GetByIdUserUseCase {
 	}
 }
 
-func (uc *getByIdUserUseCase) Perform(id string) (*GetByIdOutput, error) {
+func (uc *getByIdUserUseCase) Perform(ctx context.Context, id string) (*GetByIdOutput, error) {
 	if id == "" {
 		return nil, domain.ErrUserIdIsRequired
 	}
 
-	user, err := uc.repo.GetById(id)
+	user, err := uc.repo.GetById(ctx, id)
 	if err != nil {
 		return nil, err
 	}
@@ -31,10 +35,14 @@ func (uc *getByIdUserUseCase) Perform(id string) (*GetByIdOutput, error) {
 	}
 
 	return &GetByIdOutput{
-		ID:        user.ID,
-		Name:      user.Name,
-		Email:     user.Email,
-		CreatedAt: user.CreatedAt,
-		UpdatedAt: user.UpdatedAt,
+		ID:            user.ID,
+		Name:          user.Name,
+		Email:         user.Email,
+		PendingEmail:  user.PendingEmail,
+		EmailVerified: user.IsEmailVerified(),
+		Role:          string(user.Role),
+		Locale:        string(user.Locale),
+		CreatedAt:     user.CreatedAt,
+		UpdatedAt:     user.UpdatedAt,
 	}, nil
 }
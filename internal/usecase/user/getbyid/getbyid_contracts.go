@@ -3,9 +3,13 @@ package user
 import "time"
 
 type GetByIdOutput struct {
-	ID        string
-	Name      string
-	Email     string
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	ID            string
+	Name          string
+	Email         string
+	PendingEmail  string
+	EmailVerified bool
+	Role          string
+	Locale        string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
 }
@@ -1,6 +1,7 @@
 package user
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -28,7 +29,7 @@ func TestGetById_shouldReturnAnErrorIfNotFound(t *testing.T) {
 	sut := makeSut()
 
 	// Act
-	_, err := sut.UseCase.Perform("123456")
+	_, err := sut.UseCase.Perform(context.Background(), "123456")
 
 	// Assert
 	if err == nil {
@@ -44,7 +45,7 @@ func TestGetById_shouldReturnUserSuccess(t *testing.T) {
 	// Arrange
 	sut := makeSut()
 	now := time.Now()
-	sut.Repo.Save(&domain.User{
+	sut.Repo.Save(context.Background(), &domain.User{
 		ID:        "123456",
 		Name:      "Daniel",
 		Email:     "daniel@gmail.com",
@@ -53,7 +54,7 @@ func TestGetById_shouldReturnUserSuccess(t *testing.T) {
 	})
 
 	// Act
-	user, err := sut.UseCase.Perform("123456")
+	user, err := sut.UseCase.Perform(context.Background(), "123456")
 
 	// Assert
 	if err != nil {
@@ -86,7 +87,7 @@ func TestGetById_shouldReturnAnErrorIfIdEmpty(t *testing.T) {
 	sut := makeSut()
 
 	// Act
-	_, err := sut.UseCase.Perform("")
+	_, err := sut.UseCase.Perform(context.Background(), "")
 
 	// Assert
 	if err == nil {
@@ -101,7 +102,7 @@ func TestGetById_shouldReturnAnErrorIfIdEmpty(t *testing.T) {
 func TestGetById_shouldReturnErrorWhenRepositoryFail(t *testing.T) {
 	// Arrange
 	sut := makeSut()
-	sut.Repo.Save(&domain.User{
+	sut.Repo.Save(context.Background(), &domain.User{
 		ID:    "123456",
 		Name:  "Daniel",
 		Email: "daniel@gmail.com",
@@ -109,7 +110,7 @@ func TestGetById_shouldReturnErrorWhenRepositoryFail(t *testing.T) {
 	sut.Repo.FailOnGet = true
 
 	// Act
-	user, err := sut.UseCase.Perform("123456")
+	user, err := sut.UseCase.Perform(context.Background(), "123456")
 
 	// Assert
 	if err == nil {
@@ -0,0 +1,85 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+)
+
+type sendEmailVerificationUserUseCase struct {
+	userRepo  domain.UserRepository
+	tokenRepo domain.EmailVerificationTokenRepository
+	mailer    domain.Mailer
+	ttl       time.Duration
+}
+
+type SendEmailVerificationUserUseCase interface {
+	Perform(ctx context.Context, input SendEmailVerificationUserInput) error
+}
+
+// NewSendEmailVerificationUserUseCase issues tokens valid for ttl. A ttl
+// that is not positive falls back to domain.DefaultEmailVerificationTokenTTL.
+func NewSendEmailVerificationUserUseCase(
+	userRepo domain.UserRepository,
+	tokenRepo domain.EmailVerificationTokenRepository,
+	mailer domain.Mailer,
+	ttl time.Duration,
+) SendEmailVerificationUserUseCase {
+	if ttl <= 0 {
+		ttl = domain.DefaultEmailVerificationTokenTTL
+	}
+
+	return &sendEmailVerificationUserUseCase{
+		userRepo:  userRepo,
+		tokenRepo: tokenRepo,
+		mailer:    mailer,
+		ttl:       ttl,
+	}
+}
+
+// Perform mails a token for the pending email or, when there is none, for
+// the unverified current one. Tokens issued earlier stop working.
+func (uc *sendEmailVerificationUserUseCase) Perform(ctx context.Context, input SendEmailVerificationUserInput) error {
+	if input.ID == "" {
+		return domain.ErrUserIdIsRequired
+	}
+
+	user, err := uc.userRepo.GetById(ctx, input.ID)
+	if err != nil {
+		return err
+	}
+
+	if user == nil {
+		return domain.ErrUserNotFound
+	}
+
+	email, needed := user.EmailToVerify()
+	if !needed {
+		return domain.ErrEmailVerificationNotNeeded
+	}
+
+	token, secret, err := domain.NewEmailVerificationToken(user.ID, email, uc.ttl)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.tokenRepo.InvalidateByUserId(ctx, user.ID, token.CreatedAt); err != nil {
+		return err
+	}
+
+	if err := uc.tokenRepo.Save(ctx, token); err != nil {
+		return err
+	}
+
+	return uc.mailer.Send(ctx, domain.MailMessage{
+		To:      email,
+		Subject: "Confirm your email",
+		Body: fmt.Sprintf(
+			"Use the token below to confirm this email address. It expires at %s.\n\n%s\n",
+			token.ExpiresAt.UTC().Format(time.RFC1123),
+			secret,
+		),
+	})
+}
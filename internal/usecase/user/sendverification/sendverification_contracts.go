@@ -0,0 +1,5 @@
+package user
+
+type SendEmailVerificationUserInput struct {
+	ID string
+}
@@ -0,0 +1,191 @@
+package user
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+	"github.com/areteacademy/internal/infra/mail"
+	tokenRepo "github.com/areteacademy/internal/infra/repository/emailverification"
+	userRepo "github.com/areteacademy/internal/infra/repository/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type SUT struct {
+	UseCase   SendEmailVerificationUserUseCase
+	UserRepo  *userRepo.InMemoryUserRepository
+	TokenRepo *tokenRepo.InMemoryEmailVerificationTokenRepository
+	Mailer    *mail.InMemoryMailer
+	User      *domain.User
+}
+
+func makeSut() SUT {
+	userRepo := userRepo.NewInMemoryUserRepository()
+	tokenRepo := tokenRepo.NewInMemoryEmailVerificationTokenRepository()
+	mailer := mail.NewInMemoryMailer()
+	usecase := NewSendEmailVerificationUserUseCase(userRepo, tokenRepo, mailer, time.Hour)
+
+	now := time.Now()
+
+	user := &domain.User{
+		ID:        "123456",
+		Name:      "Daniel",
+		Email:     "daniel@gmail.com",
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	return SUT{
+		UseCase:   usecase,
+		UserRepo:  userRepo,
+		TokenRepo: tokenRepo,
+		Mailer:    mailer,
+		User:      user,
+	}
+}
+
+func validInput(sut SUT) SendEmailVerificationUserInput {
+	return SendEmailVerificationUserInput{ID: sut.User.ID}
+}
+
+func mailedToken(t *testing.T, mailer *mail.InMemoryMailer) string {
+	require.NotNil(t, mailer.Last())
+
+	lines := strings.Split(strings.TrimSpace(mailer.Last().Body), "\n")
+	return lines[len(lines)-1]
+}
+
+func TestSendEmailVerificationUser_GivenInvalidInput_ShouldReturnError(t *testing.T) {
+	testCases := []struct {
+		name        string
+		setup       func(sut SUT)
+		input       func(sut SUT) SendEmailVerificationUserInput
+		expectedErr error
+	}{
+		{
+			name: "Empty Id",
+			input: func(sut SUT) SendEmailVerificationUserInput {
+				return SendEmailVerificationUserInput{}
+			},
+			expectedErr: domain.ErrUserIdIsRequired,
+		},
+		{
+			name: "User Not Found",
+			input: func(sut SUT) SendEmailVerificationUserInput {
+				return SendEmailVerificationUserInput{ID: "654321"}
+			},
+			expectedErr: domain.ErrUserNotFound,
+		},
+		{
+			name: "Already Verified",
+			setup: func(sut SUT) {
+				verifiedAt := time.Now()
+				sut.User.EmailVerifiedAt = &verifiedAt
+			},
+			input:       validInput,
+			expectedErr: domain.ErrEmailVerificationNotNeeded,
+		},
+		{
+			name: "Repo User Fail On Get",
+			setup: func(sut SUT) {
+				sut.UserRepo.FailOnGet = true
+			},
+			input:       validInput,
+			expectedErr: userRepo.ErrSimulatedFailureRepoUser,
+		},
+		{
+			name: "Repo Token Fail On Invalidate",
+			setup: func(sut SUT) {
+				sut.TokenRepo.FailOnInvalidate = true
+			},
+			input:       validInput,
+			expectedErr: tokenRepo.ErrSimulatedFailureRepoEmailVerificationToken,
+		},
+		{
+			name: "Repo Token Fail On Save",
+			setup: func(sut SUT) {
+				sut.TokenRepo.FailOnSave = true
+			},
+			input:       validInput,
+			expectedErr: tokenRepo.ErrSimulatedFailureRepoEmailVerificationToken,
+		},
+		{
+			name: "Mailer Fail On Send",
+			setup: func(sut SUT) {
+				sut.Mailer.FailOnSend = true
+			},
+			input:       validInput,
+			expectedErr: mail.ErrSimulatedFailureMailer,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			sut := makeSut()
+			require.NoError(t, sut.UserRepo.Save(context.Background(), sut.User))
+
+			if tc.setup != nil {
+				tc.setup(sut)
+			}
+
+			// Act
+			err := sut.UseCase.Perform(context.Background(), tc.input(sut))
+
+			// Assert
+			assert.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestSendEmailVerificationUser_ShouldMailTokenForCurrentEmail(t *testing.T) {
+	// Arrange
+	sut := makeSut()
+	require.NoError(t, sut.UserRepo.Save(context.Background(), sut.User))
+
+	// Act
+	err := sut.UseCase.Perform(context.Background(), validInput(sut))
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, sut.Mailer.Sent, 1)
+	assert.Equal(t, sut.User.Email, sut.Mailer.Last().To)
+
+	tokens := sut.TokenRepo.ByUserId(sut.User.ID)
+	require.Len(t, tokens, 1)
+	assert.Equal(t, sut.User.Email, tokens[0].Email)
+	assert.Equal(t, domain.HashSecretToken(mailedToken(t, sut.Mailer)), tokens[0].TokenHash)
+}
+
+func TestSendEmailVerificationUser_ShouldMailPendingEmailAndInvalidatePreviousTokens(t *testing.T) {
+	// Arrange
+	sut := makeSut()
+	verifiedAt := time.Now()
+	sut.User.EmailVerifiedAt = &verifiedAt
+	sut.User.PendingEmail = "daniel.novo@gmail.com"
+	require.NoError(t, sut.UserRepo.Save(context.Background(), sut.User))
+
+	require.NoError(t, sut.UseCase.Perform(context.Background(), validInput(sut)))
+
+	// Act
+	err := sut.UseCase.Perform(context.Background(), validInput(sut))
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, sut.Mailer.Sent, 2)
+	assert.Equal(t, "daniel.novo@gmail.com", sut.Mailer.Last().To)
+
+	tokens := sut.TokenRepo.ByUserId(sut.User.ID)
+	require.Len(t, tokens, 2)
+
+	usable := 0
+	for _, token := range tokens {
+		if token.IsUsable(time.Now()) {
+			usable++
+		}
+	}
+	assert.Equal(t, 1, usable)
+}
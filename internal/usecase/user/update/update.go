@@ -1,49 +1,95 @@
 package user
 
-import "github.com/areteacademy/internal/domain"
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/areteacademy/internal/domain"
+	sendVerification "github.com/areteacademy/internal/usecase/user/sendverification"
+)
 
 type updateUserUseCase struct {
-	repo domain.UserRepository
+	repo             domain.UserRepository
+	sendVerification sendVerification.SendEmailVerificationUserUseCase
+	reporter         domain.ErrorReporter
 }
 
 type UpdateUserUseCase interface {
-	Perform(input UpdateUserInput) (*UpdateUserOutput, error)
+	Perform(ctx context.Context, input UpdateUserInput) (*UpdateUserOutput, error)
 }
 
-func NewUpdateUserUseCase(repo domain.UserRepository) UpdateUserUseCase {
+func NewUpdateUserUseCase(
+	repo domain.UserRepository,
+	sendVerification sendVerification.SendEmailVerificationUserUseCase,
+	reporter domain.ErrorReporter,
+) UpdateUserUseCase {
 	return &updateUserUseCase{
-		repo: repo,
+		repo:             repo,
+		sendVerification: sendVerification,
+		reporter:         reporter,
 	}
 }
 
-func (uc *updateUserUseCase) Perform(input UpdateUserInput) (*UpdateUserOutput, error) {
-	user, err := domain.UpdateUser(
+// Perform applies a new name right away, while a new email only becomes
+// pending: the current one keeps working until the new one is verified.
+func (uc *updateUserUseCase) Perform(ctx context.Context, input UpdateUserInput) (*UpdateUserOutput, error) {
+	changes, err := domain.UpdateUser(
 		input.ID,
 		input.Name,
 		input.Email,
+		input.Locale,
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	exists, err := uc.repo.GetById(input.ID)
+	user, err := uc.repo.GetById(ctx, input.ID)
 	if err != nil {
 		return nil, err
 	}
 
-	if exists == nil {
+	if user == nil {
 		return nil, domain.ErrUserNotFound
 	}
 
-	if err := uc.repo.Update(user); err != nil {
+	owner, err := uc.repo.GetByEmail(ctx, changes.Email)
+	if err != nil && !errors.Is(err, domain.ErrUserNotFound) {
+		return nil, err
+	}
+
+	if owner != nil && owner.ID != user.ID {
+		return nil, domain.ErrUserEmailAlreadyInUse
+	}
+
+	previousPendingEmail := user.PendingEmail
+
+	user.Name = changes.Name
+	user.ChangeEmail(changes.Email)
+	user.Locale = changes.Locale
+	user.UpdatedAt = changes.UpdatedAt
+
+	if err := uc.repo.Update(ctx, user); err != nil {
 		return nil, err
 	}
 
+	// The pending email is saved by now, and a retry would not mail it again
+	// since it is no longer new. The user can ask for the mail again.
+	if user.PendingEmail != "" && user.PendingEmail != previousPendingEmail {
+		err := uc.sendVerification.Perform(ctx, sendVerification.SendEmailVerificationUserInput{ID: user.ID})
+		if err != nil {
+			uc.reporter.Report(ctx, fmt.Errorf("send email verification to user %s: %w", user.ID, err))
+		}
+	}
+
 	return &UpdateUserOutput{
-		ID:        user.ID,
-		Name:      user.Name,
-		Email:     user.Email,
-		CreatedAt: exists.CreatedAt,
-		UpdatedAt: user.UpdatedAt,
+		ID:            user.ID,
+		Name:          user.Name,
+		Email:         user.Email,
+		PendingEmail:  user.PendingEmail,
+		EmailVerified: user.IsEmailVerified(),
+		Locale:        string(user.Locale),
+		CreatedAt:     user.CreatedAt,
+		UpdatedAt:     user.UpdatedAt,
 	}, nil
 }
@@ -6,12 +6,18 @@ type UpdateUserInput struct {
 	ID    string
 	Name  string
 	Email string
+	// Locale is the language the user wants messages in; empty clears the
+	// preference.
+	Locale string
 }
 
 type UpdateUserOutput struct {
-	ID        string
-	Name      string
-	Email     string
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	ID            string
+	Name          string
+	Email         string
+	PendingEmail  string
+	EmailVerified bool
+	Locale        string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
 }
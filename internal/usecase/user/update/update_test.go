@@ -1,25 +1,43 @@
 package user
 
 import (
+	"context"
+	"errors"
 	"testing"
 	"time"
 
 	"github.com/areteacademy/internal/domain"
+	"github.com/areteacademy/internal/infra/mail"
+	"github.com/areteacademy/internal/infra/report"
+	tokenRepo "github.com/areteacademy/internal/infra/repository/emailverification"
 	repo "github.com/areteacademy/internal/infra/repository/user"
+	sendVerification "github.com/areteacademy/internal/usecase/user/sendverification"
 )
 
 type SUT struct {
-	UseCase UpdateUserUseCase
-	Repo    *repo.InMemoryUserRepository
+	UseCase  UpdateUserUseCase
+	Repo     *repo.InMemoryUserRepository
+	Mailer   *mail.InMemoryMailer
+	Reporter *report.InMemoryErrorReporter
 }
 
 func makeSut() SUT {
 	repo := repo.NewInMemoryUserRepository()
-	usecase := NewUpdateUserUseCase(repo)
+	mailer := mail.NewInMemoryMailer()
+	send := sendVerification.NewSendEmailVerificationUserUseCase(
+		repo,
+		tokenRepo.NewInMemoryEmailVerificationTokenRepository(),
+		mailer,
+		time.Hour,
+	)
+	reporter := report.NewInMemoryErrorReporter()
+	usecase := NewUpdateUserUseCase(repo, send, reporter)
 
 	return SUT{
-		UseCase: usecase,
-		Repo:    repo,
+		UseCase:  usecase,
+		Repo:     repo,
+		Mailer:   mailer,
+		Reporter: reporter,
 	}
 }
 
@@ -28,7 +46,7 @@ func TestUpdateUser_ShouldReturnError_WhenIdIsEmpty(t *testing.T) {
 	sut := makeSut()
 
 	// Act
-	_, err := sut.UseCase.Perform(UpdateUserInput{
+	_, err := sut.UseCase.Perform(context.Background(), UpdateUserInput{
 		ID:    "",
 		Name:  "Daniel",
 		Email: "daniel@gmail.com",
@@ -39,7 +57,7 @@ func TestUpdateUser_ShouldReturnError_WhenIdIsEmpty(t *testing.T) {
 		t.Fatalf("expected error, got nil")
 	}
 
-	if err != domain.ErrUserIdIsRequired {
+	if !errors.Is(err, domain.ErrUserIdIsRequired) {
 		t.Errorf("expected ErrUserIdIsRequired, got %v", err)
 	}
 }
@@ -49,7 +67,7 @@ func TestUpdateUser_ShouldReturnError_WhenNameIsEmpty(t *testing.T) {
 	sut := makeSut()
 
 	// Act
-	_, err := sut.UseCase.Perform(UpdateUserInput{
+	_, err := sut.UseCase.Perform(context.Background(), UpdateUserInput{
 		ID:    "123",
 		Name:  "",
 		Email: "daniel@gmail.com",
@@ -60,7 +78,7 @@ func TestUpdateUser_ShouldReturnError_WhenNameIsEmpty(t *testing.T) {
 		t.Fatalf("expected error, got nil")
 	}
 
-	if err != domain.ErrUserNameIsRequired {
+	if !errors.Is(err, domain.ErrUserNameIsRequired) {
 		t.Errorf("expected ErrUserNameIsRequired, got %v", err)
 	}
 }
@@ -70,7 +88,7 @@ func TestUpdateUser_ShouldReturnError_WhenEmailIsEmpty(t *testing.T) {
 	sut := makeSut()
 
 	// Act
-	_, err := sut.UseCase.Perform(UpdateUserInput{
+	_, err := sut.UseCase.Perform(context.Background(), UpdateUserInput{
 		ID:    "123",
 		Name:  "Daniel",
 		Email: "",
@@ -81,7 +99,7 @@ func TestUpdateUser_ShouldReturnError_WhenEmailIsEmpty(t *testing.T) {
 		t.Fatalf("expected error, got nil")
 	}
 
-	if err != domain.ErrUserEmailIsRequired {
+	if !errors.Is(err, domain.ErrUserEmailIsRequired) {
 		t.Errorf("expected ErrUserEmailIsRequired, got %v", err)
 	}
 }
@@ -89,14 +107,14 @@ func TestUpdateUser_ShouldReturnError_WhenEmailIsEmpty(t *testing.T) {
 func TestUpdateUser_ShouldReturnError_WhenUserNotFound(t *testing.T) {
 	// Arrange
 	sut := makeSut()
-	sut.Repo.Save(&domain.User{
+	sut.Repo.Save(context.Background(), &domain.User{
 		ID:    "1234",
 		Name:  "Daniel",
 		Email: "daniel@gmail.com",
 	})
 
 	// Act
-	_, err := sut.UseCase.Perform(UpdateUserInput{
+	_, err := sut.UseCase.Perform(context.Background(), UpdateUserInput{
 		ID:    "123",
 		Name:  "Daniel",
 		Email: "daniel@gmail.com",
@@ -106,7 +124,7 @@ func TestUpdateUser_ShouldReturnError_WhenUserNotFound(t *testing.T) {
 		t.Fatalf("expected error, got nil")
 	}
 
-	if err != domain.ErrUserNotFound {
+	if !errors.Is(err, domain.ErrUserNotFound) {
 		t.Errorf("expected ErrUserNotFound, got %v", err)
 	}
 }
@@ -117,7 +135,7 @@ func TestUpdateUser_ShouldReturnError_WhenRepositoryFailOnGet(t *testing.T) {
 	sut.Repo.FailOnGet = true
 
 	// Act
-	_, err := sut.UseCase.Perform(UpdateUserInput{
+	_, err := sut.UseCase.Perform(context.Background(), UpdateUserInput{
 		ID:    "123",
 		Name:  "Daniel",
 		Email: "daniel@gmail.com",
@@ -135,14 +153,14 @@ func TestUpdateUser_ShouldReturnError_WhenRepositoryFailOnGet(t *testing.T) {
 func TestUpdateUser_ShouldReturnError_WhenEmailIsInvalid(t *testing.T) {
 	// Arrange
 	sut := makeSut()
-	sut.Repo.Save(&domain.User{
+	sut.Repo.Save(context.Background(), &domain.User{
 		ID:    "123",
 		Name:  "Daniel",
 		Email: "daniel@gmail.com",
 	})
 
 	// Act
-	_, err := sut.UseCase.Perform(UpdateUserInput{
+	_, err := sut.UseCase.Perform(context.Background(), UpdateUserInput{
 		ID:    "123",
 		Name:  "Daniel",
 		Email: "danielgmail.com",
@@ -152,7 +170,7 @@ func TestUpdateUser_ShouldReturnError_WhenEmailIsInvalid(t *testing.T) {
 		t.Fatalf("expected error, got nil")
 	}
 
-	if err != domain.ErrUserEmailInvalid {
+	if !errors.Is(err, domain.ErrUserEmailInvalid) {
 		t.Errorf("expected ErrUserEmailInvalid, got %v", err)
 	}
 }
@@ -160,7 +178,7 @@ func TestUpdateUser_ShouldReturnError_WhenEmailIsInvalid(t *testing.T) {
 func TestUpdateUser_ShouldReturnError_WhenRepositoryFailOnUpdate(t *testing.T) {
 	// Arrange
 	sut := makeSut()
-	sut.Repo.Save(&domain.User{
+	sut.Repo.Save(context.Background(), &domain.User{
 		ID:    "123",
 		Name:  "Daniel",
 		Email: "daniel@gmail.com",
@@ -168,7 +186,7 @@ func TestUpdateUser_ShouldReturnError_WhenRepositoryFailOnUpdate(t *testing.T) {
 	sut.Repo.FailOnUpdate = true
 
 	// Act
-	_, err := sut.UseCase.Perform(UpdateUserInput{
+	_, err := sut.UseCase.Perform(context.Background(), UpdateUserInput{
 		ID:    "123",
 		Name:  "Daniel",
 		Email: "daniel@gmail.com",
@@ -183,11 +201,98 @@ func TestUpdateUser_ShouldReturnError_WhenRepositoryFailOnUpdate(t *testing.T) {
 	}
 }
 
+func TestUpdateUser_ShouldReturnError_WhenEmailBelongsToAnotherUser(t *testing.T) {
+	// Arrange
+	sut := makeSut()
+	now := time.Now()
+	sut.Repo.Save(context.Background(), &domain.User{
+		ID:        "123",
+		Name:      "Daniel",
+		Email:     "daniel@gmail.com",
+		CreatedAt: now,
+		UpdatedAt: now,
+	})
+	sut.Repo.Save(context.Background(), &domain.User{
+		ID:        "456",
+		Name:      "Maria",
+		Email:     "maria@gmail.com",
+		CreatedAt: now,
+		UpdatedAt: now,
+	})
+
+	// Act
+	_, err := sut.UseCase.Perform(context.Background(), UpdateUserInput{
+		ID:    "123",
+		Name:  "Daniel",
+		Email: " Maria@Gmail.com",
+	})
+
+	// Assert
+	if !errors.Is(err, domain.ErrUserEmailAlreadyInUse) {
+		t.Fatalf("expected ErrUserEmailAlreadyInUse, got %v", err)
+	}
+}
+
+func TestUpdateUser_ShouldReturnError_WhenRepositoryFailOnGetByEmail(t *testing.T) {
+	// Arrange
+	sut := makeSut()
+	now := time.Now()
+	sut.Repo.Save(context.Background(), &domain.User{
+		ID:        "123",
+		Name:      "Daniel",
+		Email:     "daniel@gmail.com",
+		CreatedAt: now,
+		UpdatedAt: now,
+	})
+	sut.Repo.FailOnGetByEmail = true
+
+	// Act
+	_, err := sut.UseCase.Perform(context.Background(), UpdateUserInput{
+		ID:    "123",
+		Name:  "Daniel",
+		Email: "daniel@gmail.com",
+	})
+
+	// Assert
+	if !errors.Is(err, repo.ErrSimulatedFailureRepoUser) {
+		t.Fatalf("expected ErrSimulatedFailureRepoUser, got %v", err)
+	}
+}
+
+func TestUpdateUser_ShouldKeepOwnEmailInAnotherCase(t *testing.T) {
+	// Arrange
+	sut := makeSut()
+	now := time.Now()
+	sut.Repo.Save(context.Background(), &domain.User{
+		ID:        "123",
+		Name:      "Daniel",
+		Email:     "daniel@gmail.com",
+		CreatedAt: now,
+		UpdatedAt: now,
+	})
+
+	// Act
+	user, err := sut.UseCase.Perform(context.Background(), UpdateUserInput{
+		ID:    "123",
+		Name:  "Daniel",
+		Email: "DANIEL@gmail.com",
+	})
+
+	// Assert
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if user.Email != "daniel@gmail.com" {
+		t.Errorf("expected daniel@gmail.com, got %s", user.Email)
+	}
+}
+
 func TestUpdatUser_ShouldReturnSuccess(t *testing.T) {
 	// Arrange
 	sut := makeSut()
 	now := time.Now()
-	sut.Repo.Save(&domain.User{
+	sut.Repo.Save(context.Background(), &domain.User{
 		ID:        "123",
 		Name:      "Daniel",
 		Email:     "daniel@gmail.com",
@@ -196,7 +301,7 @@ func TestUpdatUser_ShouldReturnSuccess(t *testing.T) {
 	})
 
 	// Act
-	user, err := sut.UseCase.Perform(UpdateUserInput{
+	user, err := sut.UseCase.Perform(context.Background(), UpdateUserInput{
 		ID:    "123",
 		Name:  "Updated",
 		Email: "updated@gmail.com",
@@ -210,8 +315,16 @@ func TestUpdatUser_ShouldReturnSuccess(t *testing.T) {
 		t.Errorf("expected Updated, got %s", user.Name)
 	}
 
-	if user.Email != "updated@gmail.com" {
-		t.Errorf("expected updated@gmail.com, got %s", user.Email)
+	if user.Email != "daniel@gmail.com" {
+		t.Errorf("expected daniel@gmail.com to stay active, got %s", user.Email)
+	}
+
+	if user.PendingEmail != "updated@gmail.com" {
+		t.Errorf("expected pending updated@gmail.com, got %s", user.PendingEmail)
+	}
+
+	if len(sut.Mailer.Sent) != 1 || sut.Mailer.Last().To != "updated@gmail.com" {
+		t.Errorf("expected a verification mail to updated@gmail.com, got %v", sut.Mailer.Sent)
 	}
 
 	if !user.CreatedAt.Equal(now) {
@@ -222,3 +335,130 @@ func TestUpdatUser_ShouldReturnSuccess(t *testing.T) {
 		t.Fatalf("expected UpdatedAt to be greater than original time")
 	}
 }
+
+func TestUpdateUser_ShouldCancelPendingEmail_WhenCurrentEmailIsSentBack(t *testing.T) {
+	// Arrange
+	sut := makeSut()
+	now := time.Now()
+	sut.Repo.Save(context.Background(), &domain.User{
+		ID:           "123",
+		Name:         "Daniel",
+		Email:        "daniel@gmail.com",
+		PendingEmail: "updated@gmail.com",
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	})
+
+	// Act
+	user, err := sut.UseCase.Perform(context.Background(), UpdateUserInput{
+		ID:    "123",
+		Name:  "Daniel",
+		Email: "daniel@gmail.com",
+	})
+
+	// Assert
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if user.PendingEmail != "" {
+		t.Errorf("expected no pending email, got %s", user.PendingEmail)
+	}
+
+	if len(sut.Mailer.Sent) != 0 {
+		t.Errorf("expected no mail, got %d", len(sut.Mailer.Sent))
+	}
+}
+
+func TestUpdateUser_ShouldKeepPendingEmail_WhenMailerFails(t *testing.T) {
+	// Arrange
+	sut := makeSut()
+	now := time.Now()
+	sut.Repo.Save(context.Background(), &domain.User{
+		ID:        "123",
+		Name:      "Daniel",
+		Email:     "daniel@gmail.com",
+		CreatedAt: now,
+		UpdatedAt: now,
+	})
+	sut.Mailer.FailOnSend = true
+
+	// Act
+	output, err := sut.UseCase.Perform(context.Background(), UpdateUserInput{
+		ID:    "123",
+		Name:  "Daniel",
+		Email: "updated@gmail.com",
+	})
+
+	// Assert
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if output.PendingEmail != "updated@gmail.com" {
+		t.Errorf("expected pending email updated@gmail.com, got %q", output.PendingEmail)
+	}
+
+	if len(sut.Mailer.Sent) != 0 {
+		t.Errorf("expected no mail, got %d", len(sut.Mailer.Sent))
+	}
+
+	if len(sut.Reporter.Reported) != 1 || !errors.Is(sut.Reporter.Reported[0], mail.ErrSimulatedFailureMailer) {
+		t.Errorf("expected the mail failure to be reported, got %v", sut.Reporter.Reported)
+	}
+}
+
+func TestUpdateUser_ShouldReturnError_WhenLocaleIsNotSupported(t *testing.T) {
+	// Arrange
+	sut := makeSut()
+	sut.Repo.Save(context.Background(), &domain.User{
+		ID:    "123",
+		Name:  "Daniel",
+		Email: "daniel@gmail.com",
+	})
+
+	// Act
+	_, err := sut.UseCase.Perform(context.Background(), UpdateUserInput{
+		ID:     "123",
+		Name:   "Daniel",
+		Email:  "daniel@gmail.com",
+		Locale: "ja",
+	})
+
+	// Assert
+	if !errors.Is(err, domain.ErrLocaleInvalid) {
+		t.Errorf("expected ErrLocaleInvalid, got %v", err)
+	}
+}
+
+func TestUpdateUser_ShouldSavePreferredLocale(t *testing.T) {
+	// Arrange
+	sut := makeSut()
+	sut.Repo.Save(context.Background(), &domain.User{
+		ID:    "123",
+		Name:  "Daniel",
+		Email: "daniel@gmail.com",
+	})
+
+	// Act
+	output, err := sut.UseCase.Perform(context.Background(), UpdateUserInput{
+		ID:     "123",
+		Name:   "Daniel",
+		Email:  "daniel@gmail.com",
+		Locale: "pt-br",
+	})
+
+	// Assert
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if output.Locale != "pt-BR" {
+		t.Errorf("expected locale pt-BR, got %q", output.Locale)
+	}
+
+	stored, _ := sut.Repo.GetById(context.Background(), "123")
+	if stored.Locale != domain.LocalePortugueseBrazil {
+		t.Errorf("expected stored locale pt-BR, got %q", stored.Locale)
+	}
+}
@@ -0,0 +1,81 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+)
+
+type verifyEmailUserUseCase struct {
+	userRepo  domain.UserRepository
+	tokenRepo domain.EmailVerificationTokenRepository
+}
+
+type VerifyEmailUserUseCase interface {
+	Perform(ctx context.Context, input VerifyEmailUserInput) error
+}
+
+func NewVerifyEmailUserUseCase(
+	userRepo domain.UserRepository,
+	tokenRepo domain.EmailVerificationTokenRepository,
+) VerifyEmailUserUseCase {
+	return &verifyEmailUserUseCase{
+		userRepo:  userRepo,
+		tokenRepo: tokenRepo,
+	}
+}
+
+// Perform only accepts a token for the address currently awaiting
+// confirmation; a token for an address the user changed away from is
+// invalid even before it expires.
+func (uc *verifyEmailUserUseCase) Perform(ctx context.Context, input VerifyEmailUserInput) error {
+	if input.Token == "" {
+		return domain.ErrEmailVerificationTokenIsRequired
+	}
+
+	now := time.Now()
+
+	token, err := uc.tokenRepo.GetByTokenHash(ctx, domain.HashSecretToken(input.Token))
+	if err != nil && !errors.Is(err, domain.ErrEmailVerificationTokenNotFound) {
+		return err
+	}
+
+	if token == nil || !token.IsUsable(now) {
+		return domain.ErrEmailVerificationTokenInvalid
+	}
+
+	user, err := uc.userRepo.GetById(ctx, token.UserId)
+	if err != nil && !errors.Is(err, domain.ErrUserNotFound) {
+		return err
+	}
+
+	if user == nil {
+		return domain.ErrEmailVerificationTokenInvalid
+	}
+
+	email, needed := user.EmailToVerify()
+	if !needed || email != token.Email {
+		return domain.ErrEmailVerificationTokenInvalid
+	}
+
+	if email != user.Email {
+		owner, err := uc.userRepo.GetByEmail(ctx, email)
+		if err != nil && !errors.Is(err, domain.ErrUserNotFound) {
+			return err
+		}
+
+		if owner != nil && owner.ID != user.ID {
+			return domain.ErrUserEmailAlreadyInUse
+		}
+	}
+
+	if err := uc.tokenRepo.MarkUsed(ctx, token.ID, now); err != nil {
+		return err
+	}
+
+	user.ConfirmEmail(email, now)
+
+	return uc.userRepo.Update(ctx, user)
+}
@@ -0,0 +1,5 @@
+package user
+
+type VerifyEmailUserInput struct {
+	Token string
+}
@@ -0,0 +1,239 @@
+package user
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/areteacademy/internal/domain"
+	tokenRepo "github.com/areteacademy/internal/infra/repository/emailverification"
+	userRepo "github.com/areteacademy/internal/infra/repository/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type SUT struct {
+	UseCase   VerifyEmailUserUseCase
+	UserRepo  *userRepo.InMemoryUserRepository
+	TokenRepo *tokenRepo.InMemoryEmailVerificationTokenRepository
+	User      *domain.User
+	Token     *domain.EmailVerificationToken
+	Secret    string
+}
+
+func makeSut(t *testing.T) SUT {
+	userRepo := userRepo.NewInMemoryUserRepository()
+	tokenRepo := tokenRepo.NewInMemoryEmailVerificationTokenRepository()
+	usecase := NewVerifyEmailUserUseCase(userRepo, tokenRepo)
+
+	now := time.Now()
+
+	user := &domain.User{
+		ID:        "123456",
+		Name:      "Daniel",
+		Email:     "daniel@gmail.com",
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	token, secret, err := domain.NewEmailVerificationToken(user.ID, user.Email, time.Hour)
+	require.NoError(t, err)
+
+	return SUT{
+		UseCase:   usecase,
+		UserRepo:  userRepo,
+		TokenRepo: tokenRepo,
+		User:      user,
+		Token:     token,
+		Secret:    secret,
+	}
+}
+
+func validInput(sut SUT) VerifyEmailUserInput {
+	return VerifyEmailUserInput{Token: sut.Secret}
+}
+
+func TestVerifyEmailUser_GivenInvalidInput_ShouldReturnError(t *testing.T) {
+	testCases := []struct {
+		name        string
+		setup       func(sut SUT)
+		input       func(sut SUT) VerifyEmailUserInput
+		expectedErr error
+	}{
+		{
+			name: "Empty Token",
+			input: func(sut SUT) VerifyEmailUserInput {
+				return VerifyEmailUserInput{}
+			},
+			expectedErr: domain.ErrEmailVerificationTokenIsRequired,
+		},
+		{
+			name: "Unknown Token",
+			input: func(sut SUT) VerifyEmailUserInput {
+				return VerifyEmailUserInput{Token: "unknown"}
+			},
+			expectedErr: domain.ErrEmailVerificationTokenInvalid,
+		},
+		{
+			name: "Expired Token",
+			setup: func(sut SUT) {
+				sut.Token.ExpiresAt = time.Now().Add(-time.Minute)
+			},
+			input:       validInput,
+			expectedErr: domain.ErrEmailVerificationTokenInvalid,
+		},
+		{
+			name: "Used Token",
+			setup: func(sut SUT) {
+				usedAt := time.Now()
+				sut.Token.UsedAt = &usedAt
+			},
+			input:       validInput,
+			expectedErr: domain.ErrEmailVerificationTokenInvalid,
+		},
+		{
+			name: "User Not Found",
+			setup: func(sut SUT) {
+				sut.Token.UserId = "654321"
+			},
+			input:       validInput,
+			expectedErr: domain.ErrEmailVerificationTokenInvalid,
+		},
+		{
+			name: "Email Changed Since Token Was Issued",
+			setup: func(sut SUT) {
+				sut.User.PendingEmail = "daniel.novo@gmail.com"
+			},
+			input:       validInput,
+			expectedErr: domain.ErrEmailVerificationTokenInvalid,
+		},
+		{
+			name: "Already Verified",
+			setup: func(sut SUT) {
+				verifiedAt := time.Now()
+				sut.User.EmailVerifiedAt = &verifiedAt
+			},
+			input:       validInput,
+			expectedErr: domain.ErrEmailVerificationTokenInvalid,
+		},
+		{
+			name: "Repo Token Fail On Get",
+			setup: func(sut SUT) {
+				sut.TokenRepo.FailOnGet = true
+			},
+			input:       validInput,
+			expectedErr: tokenRepo.ErrSimulatedFailureRepoEmailVerificationToken,
+		},
+		{
+			name: "Repo Token Fail On Mark Used",
+			setup: func(sut SUT) {
+				sut.TokenRepo.FailOnMarkUsed = true
+			},
+			input:       validInput,
+			expectedErr: tokenRepo.ErrSimulatedFailureRepoEmailVerificationToken,
+		},
+		{
+			name: "Repo User Fail On Get",
+			setup: func(sut SUT) {
+				sut.UserRepo.FailOnGet = true
+			},
+			input:       validInput,
+			expectedErr: userRepo.ErrSimulatedFailureRepoUser,
+		},
+		{
+			name: "Repo User Fail On Update",
+			setup: func(sut SUT) {
+				sut.UserRepo.FailOnUpdate = true
+			},
+			input:       validInput,
+			expectedErr: userRepo.ErrSimulatedFailureRepoUser,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			sut := makeSut(t)
+			require.NoError(t, sut.UserRepo.Save(context.Background(), sut.User))
+			require.NoError(t, sut.TokenRepo.Save(context.Background(), sut.Token))
+
+			if tc.setup != nil {
+				tc.setup(sut)
+			}
+
+			// Act
+			err := sut.UseCase.Perform(context.Background(), tc.input(sut))
+
+			// Assert
+			assert.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestVerifyEmailUser_ShouldVerifyCurrentEmailOnce(t *testing.T) {
+	// Arrange
+	sut := makeSut(t)
+	require.NoError(t, sut.UserRepo.Save(context.Background(), sut.User))
+	require.NoError(t, sut.TokenRepo.Save(context.Background(), sut.Token))
+
+	// Act
+	first := sut.UseCase.Perform(context.Background(), validInput(sut))
+	second := sut.UseCase.Perform(context.Background(), validInput(sut))
+
+	// Assert
+	require.NoError(t, first)
+	assert.ErrorIs(t, second, domain.ErrEmailVerificationTokenInvalid)
+
+	user, err := sut.UserRepo.GetById(context.Background(), sut.User.ID)
+	require.NoError(t, err)
+	assert.True(t, user.IsEmailVerified())
+	assert.NotNil(t, sut.Token.UsedAt)
+}
+
+func TestVerifyEmailUser_ShouldSwitchToPendingEmail(t *testing.T) {
+	// Arrange
+	sut := makeSut(t)
+	verifiedAt := time.Now().Add(-time.Hour)
+	sut.User.EmailVerifiedAt = &verifiedAt
+	sut.User.PendingEmail = "daniel.novo@gmail.com"
+	require.NoError(t, sut.UserRepo.Save(context.Background(), sut.User))
+
+	token, secret, err := domain.NewEmailVerificationToken(sut.User.ID, sut.User.PendingEmail, time.Hour)
+	require.NoError(t, err)
+	require.NoError(t, sut.TokenRepo.Save(context.Background(), token))
+
+	// Act
+	err = sut.UseCase.Perform(context.Background(), VerifyEmailUserInput{Token: secret})
+
+	// Assert
+	require.NoError(t, err)
+
+	user, err := sut.UserRepo.GetById(context.Background(), sut.User.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "daniel.novo@gmail.com", user.Email)
+	assert.Empty(t, user.PendingEmail)
+	assert.True(t, user.EmailVerifiedAt.After(verifiedAt))
+}
+
+func TestVerifyEmailUser_ShouldReturnError_WhenPendingEmailWasTakenMeanwhile(t *testing.T) {
+	// Arrange
+	sut := makeSut(t)
+	sut.User.PendingEmail = "maria@gmail.com"
+	require.NoError(t, sut.UserRepo.Save(context.Background(), sut.User))
+	require.NoError(t, sut.UserRepo.Save(context.Background(), &domain.User{
+		ID:    "654321",
+		Name:  "Maria",
+		Email: "maria@gmail.com",
+	}))
+
+	token, secret, err := domain.NewEmailVerificationToken(sut.User.ID, sut.User.PendingEmail, time.Hour)
+	require.NoError(t, err)
+	require.NoError(t, sut.TokenRepo.Save(context.Background(), token))
+
+	// Act
+	err = sut.UseCase.Perform(context.Background(), VerifyEmailUserInput{Token: secret})
+
+	// Assert
+	assert.ErrorIs(t, err, domain.ErrUserEmailAlreadyInUse)
+	assert.Nil(t, token.UsedAt)
+}